@@ -14,20 +14,35 @@ import (
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	"github.com/redis/go-redis/v9"
 
 	pkgvalidator "github.com/johnquangdev/meeting-assistant/pkg/validator"
 
 	"github.com/johnquangdev/meeting-assistant/internal/adapter/handler"
 	"github.com/johnquangdev/meeting-assistant/internal/adapter/repository"
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
 	"github.com/johnquangdev/meeting-assistant/internal/infrastructure/cache"
 	"github.com/johnquangdev/meeting-assistant/internal/infrastructure/database"
 	"github.com/johnquangdev/meeting-assistant/internal/infrastructure/external/livekit"
+	"github.com/johnquangdev/meeting-assistant/internal/infrastructure/external/mail"
 	"github.com/johnquangdev/meeting-assistant/internal/infrastructure/external/oauth"
 	httpmw "github.com/johnquangdev/meeting-assistant/internal/infrastructure/http/middleware"
+	"github.com/johnquangdev/meeting-assistant/internal/infrastructure/pubsub"
 	"github.com/johnquangdev/meeting-assistant/internal/infrastructure/storage"
+	"github.com/johnquangdev/meeting-assistant/internal/usecase/admin"
 	aiuse "github.com/johnquangdev/meeting-assistant/internal/usecase/ai"
 	"github.com/johnquangdev/meeting-assistant/internal/usecase/auth"
+	"github.com/johnquangdev/meeting-assistant/internal/usecase/breakout"
+	"github.com/johnquangdev/meeting-assistant/internal/usecase/guest"
+	"github.com/johnquangdev/meeting-assistant/internal/usecase/invitation"
+	"github.com/johnquangdev/meeting-assistant/internal/usecase/notification"
+	"github.com/johnquangdev/meeting-assistant/internal/usecase/organization"
+	"github.com/johnquangdev/meeting-assistant/internal/usecase/quota"
+	"github.com/johnquangdev/meeting-assistant/internal/usecase/reconciler"
+	"github.com/johnquangdev/meeting-assistant/internal/usecase/recording"
 	"github.com/johnquangdev/meeting-assistant/internal/usecase/room"
+	"github.com/johnquangdev/meeting-assistant/internal/usecase/scheduler"
+	"github.com/johnquangdev/meeting-assistant/internal/usecase/series"
 	pkgai "github.com/johnquangdev/meeting-assistant/pkg/ai"
 	"github.com/johnquangdev/meeting-assistant/pkg/config"
 	"github.com/johnquangdev/meeting-assistant/pkg/jwt"
@@ -82,7 +97,7 @@ func main() {
 	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
 		AllowOrigins:     cfg.Server.AllowedOrigins,
 		AllowMethods:     []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete, http.MethodPatch},
-		AllowHeaders:     []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAuthorization, "Set-Cookie", "Cookie"},
+		AllowHeaders:     []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAuthorization, "Set-Cookie", "Cookie", handler.OrganizationHeader},
 		AllowCredentials: true,
 	}))
 
@@ -107,6 +122,27 @@ func main() {
 	log.Println("📦 Initializing in-memory cache...")
 	memoryStore := cache.NewMemoryStore()
 
+	// Initialize room event broker (Redis fan-out across instances when configured)
+	log.Println("📡 Initializing room event broker...")
+	var eventBroker pubsub.Broker = pubsub.NewLocalBroker()
+	if addr := cfg.GetRedisAddr(); addr != "" {
+		redisClient := redis.NewClient(&redis.Options{
+			Addr:     addr,
+			Password: cfg.Redis.Password,
+			DB:       cfg.Redis.DB,
+		})
+		redisCtx, cancelRedis := context.WithTimeout(context.Background(), 5*time.Second)
+		redisBroker, err := pubsub.NewRedisBroker(redisCtx, redisClient)
+		cancelRedis()
+		if err != nil {
+			log.Printf("⚠️  Failed to connect to Redis at %s, room events stay on this instance: %v", addr, err)
+			redisClient.Close()
+		} else {
+			eventBroker = redisBroker
+			log.Printf("✅ Room events fanned out through Redis at %s", addr)
+		}
+	}
+
 	// Initialize repositories
 	log.Println("⚙️  Initializing repositories...")
 	userRepo := repository.NewUserRepository(db)
@@ -117,6 +153,47 @@ func main() {
 	transcriptRepo := repository.NewTranscriptRepository(db)
 	recordingRepo := repository.NewRecordingRepository(db)
 	aiRepo := repository.NewAIRepository(db)
+	seriesRepo := repository.NewSeriesRepository(db)
+	invitationRepo := repository.NewInvitationRepository(db)
+	chatRepo := repository.NewChatRepository(db)
+	pollRepo := repository.NewPollRepository(db)
+	agendaRepo := repository.NewAgendaRepository(db)
+	orgRepo := repository.NewOrganizationRepository(db)
+	planRepo := repository.NewPlanRepository(db)
+
+	// Initialize email notifications
+	log.Printf("📧 Initializing mail sender (driver=%s)...", cfg.Mail.Driver)
+	mailSender, err := mail.NewSender(cfg.Mail)
+	if err != nil {
+		log.Fatalf("Failed to initialize mail sender: %v", err)
+	}
+	mailRenderer, err := mail.NewRenderer()
+	if err != nil {
+		log.Fatalf("Failed to load mail templates: %v", err)
+	}
+	notificationService := notification.NewNotificationService(
+		mailSender,
+		mailRenderer,
+		userRepo,
+		roomRepo,
+		participantRepo,
+		invitationRepo,
+		aiRepo,
+		cfg.Server.FrontendURL,
+	)
+
+	// Initialize plans and usage quotas (enforced by the room and AI services)
+	quotaService := quota.NewQuotaService(planRepo, orgRepo)
+
+	// Initialize MinIO client for generating presigned URLs
+	log.Println("💾 Initializing MinIO client...")
+	minioClient, err := storage.NewMinIOClient(&cfg.Storage)
+	if err != nil {
+		log.Printf("⚠️  Failed to initialize MinIO client: %v", err)
+		minioClient = nil
+	} else {
+		log.Println("✅ MinIO client initialized successfully")
+	}
 
 	// Initialize AI repository and clients
 	log.Println("🤖 Initializing AI components...")
@@ -127,7 +204,7 @@ func main() {
 		log.Fatalf("Failed to initialize logger: %v", err)
 	}
 	defer logger.Sync()
-	aiService := aiuse.NewAIService(aiJobRepo, transcriptRepo, aiRepo, recordingRepo, roomRepo, asmClient, groqClient, cfg, logger)
+	aiService := aiuse.NewAIService(aiJobRepo, transcriptRepo, aiRepo, recordingRepo, roomRepo, chatRepo, pollRepo, agendaRepo, orgRepo, quotaService, notificationService, eventBroker, minioClient, asmClient, groqClient, cfg, logger)
 	aiController := handler.NewAIController(aiService, logger)
 	aiWebhookHandler := handler.NewAIWebhookHandler(aiService, cfg.Assembly.WebhookSecret, logger)
 
@@ -183,28 +260,62 @@ func main() {
 
 	// Initialize room service
 	log.Println("🏠 Initializing room service...")
-	roomService := room.NewRoomService(roomRepo, participantRepo, livekitClient, cfg.LiveKit.URL, cfg)
+	roomService := room.NewRoomService(roomRepo, participantRepo, chatRepo, pollRepo, agendaRepo, orgRepo, recordingRepo, aiJobRepo, quotaService, livekitClient, cfg.LiveKit.URL, cfg, eventBroker)
 
 	// Initialize room handler
 	log.Println("🚪 Initializing room handler...")
 	roomHandler := handler.NewRoomHandler(roomService, aiJobRepo, aiRepo, logger)
 	log.Println("✅ Room handler initialized successfully")
 
-	// Initialize MinIO client for generating presigned URLs
-	log.Println("💾 Initializing MinIO client...")
-	minioClient, err := storage.NewMinIOClient(&cfg.Storage)
-	if err != nil {
-		log.Printf("⚠️  Failed to initialize MinIO client: %v", err)
-		minioClient = nil
-	} else {
-		log.Println("✅ MinIO client initialized successfully")
-	}
+	// Initialize recurring series service and handler
+	log.Println("🔁 Initializing series service...")
+	seriesService := series.NewSeriesService(seriesRepo, roomRepo, participantRepo, aiRepo, roomService)
+	seriesHandler := handler.NewSeriesHandler(seriesService, logger)
+	log.Println("✅ Series handler initialized successfully")
+
+	// Initialize invitation service and handler
+	log.Println("✉️  Initializing invitation service...")
+	invitationService := invitation.NewInvitationService(invitationRepo, roomRepo, participantRepo, userRepo, roomService, notificationService, cfg)
+	invitationHandler := handler.NewInvitationHandler(invitationService, roomService, logger)
+	log.Println("✅ Invitation handler initialized successfully")
+
+	// Initialize guest service and handler
+	log.Println("👤 Initializing guest service...")
+	guestService := guest.NewGuestService(roomRepo, participantRepo, invitationRepo, roomService, jwtManager, cfg.Guest)
+	guestHandler := handler.NewGuestHandler(guestService, roomService, logger)
+	log.Println("✅ Guest handler initialized successfully")
+
+	// Initialize breakout service and handler
+	log.Println("🧩 Initializing breakout service...")
+	breakoutService := breakout.NewBreakoutService(roomRepo, participantRepo, roomService, livekitClient)
+	breakoutHandler := handler.NewBreakoutHandler(breakoutService, roomService, logger)
+	log.Println("✅ Breakout handler initialized successfully")
+
+	// Initialize organization service and handler
+	log.Println("🏢 Initializing organization service...")
+	organizationService := organization.NewOrganizationService(orgRepo, notificationService, cfg)
+	organizationHandler := handler.NewOrganizationHandler(organizationService, logger)
+	log.Println("✅ Organization handler initialized successfully")
+
+	// Initialize admin service and handler
+	log.Println("🛡️  Initializing admin service...")
+	adminService := admin.NewAdminService(userRepo, sessionRepo, roomRepo, aiJobRepo, recordingRepo, roomService, aiService, quotaService)
+	adminHandler := handler.NewAdminHandler(adminService, logger)
+	log.Println("✅ Admin handler initialized successfully")
+
+	quotaHandler := handler.NewQuotaHandler(quotaService, logger)
 
 	// Initialize webhook handler (for LiveKit webhooks)
 	log.Println("🪝 Initializing webhook handler...")
-	webhookHandler := handler.NewWebhookHandler(roomService, aiService, minioClient, recordingRepo, aiJobRepo, cfg.LiveKit.APIKey, cfg.LiveKit.APISecret, logger)
+	webhookHandler := handler.NewWebhookHandler(roomService, aiService, minioClient, recordingRepo, cfg.Storage.BucketName, cfg.LiveKit.APIKey, cfg.LiveKit.APISecret, logger)
 	log.Println("✅ Webhook handler initialized successfully")
 
+	// Initialize recording service and handler (playback URLs are presigned by MinIO)
+	log.Println("🎬 Initializing recording service...")
+	recordingService := recording.NewRecordingService(recordingRepo, roomService, minioClient, cfg.Storage.PlaybackURLTTL)
+	recordingHandler := handler.NewRecordingHandler(recordingService, logger)
+	log.Println("✅ Recording handler initialized successfully")
+
 	// Initialize storage test handler
 	log.Println("💾 Initializing storage test handler...")
 	storageTestHandler, err := handler.NewStorageTest(cfg, logger)
@@ -220,8 +331,11 @@ func main() {
 
 	// Create Echo auth middleware from existing OAuth service
 	authEchoMW := httpmw.EchoAuth(oauthService)
+	optionalAuthEchoMW := httpmw.EchoOptionalAuth(oauthService)
+	guestAuthEchoMW := httpmw.EchoGuestAuth(jwtManager)
+	adminEchoMW := httpmw.EchoRequireRole(entities.RoleAdmin)
 
-	router := handler.NewRouter(cfg, authHandler, roomHandler, webhookHandler, aiWebhookHandler, aiController, storageTestHandler, authEchoMW)
+	router := handler.NewRouter(cfg, authHandler, roomHandler, seriesHandler, invitationHandler, guestHandler, breakoutHandler, organizationHandler, adminHandler, quotaHandler, recordingHandler, webhookHandler, aiWebhookHandler, aiController, storageTestHandler, authEchoMW, optionalAuthEchoMW, guestAuthEchoMW, adminEchoMW)
 	router.Setup(e)
 
 	// Start AI worker pool for background summary generation
@@ -231,6 +345,42 @@ func main() {
 	aiService.StartWorkerPool(workerCtx, 3) // Start 3 workers
 	log.Println("✅ AI worker pool started with 3 workers")
 
+	// Start series expansion worker (materializes upcoming occurrences into rooms)
+	if err := seriesService.StartWorker(workerCtx); err != nil {
+		log.Printf("⚠️  Failed to start series worker: %v", err)
+	} else {
+		log.Println("✅ Series worker started")
+	}
+
+	// Start invitation expiry worker
+	if err := invitationService.StartWorker(workerCtx); err != nil {
+		log.Printf("⚠️  Failed to start invitation worker: %v", err)
+	} else {
+		log.Println("✅ Invitation worker started")
+	}
+
+	// Start room scheduler (auto-start, no-show cancel, idle/overdue end)
+	roomScheduler := scheduler.NewScheduler(roomRepo, participantRepo, roomService, livekitClient, notificationService, cfg.Scheduler)
+	if cfg.Scheduler.Enabled {
+		if err := roomScheduler.StartWorker(workerCtx); err != nil {
+			log.Printf("⚠️  Failed to start room scheduler: %v", err)
+		} else {
+			log.Println("✅ Room scheduler started")
+		}
+	}
+
+	// Start LiveKit reconciler (repairs participants and rooms when webhooks are lost).
+	// The mock client forgets its rooms on restart, so it would end every active room.
+	livekitReconciler := reconciler.NewReconciler(roomRepo, participantRepo, roomService, livekitClient, cfg.Reconciler)
+	reconcilerEnabled := cfg.Reconciler.Enabled && !cfg.LiveKit.UseMock
+	if reconcilerEnabled {
+		if err := livekitReconciler.StartWorker(workerCtx); err != nil {
+			log.Printf("⚠️  Failed to start LiveKit reconciler: %v", err)
+		} else {
+			log.Println("✅ LiveKit reconciler started")
+		}
+	}
+
 	// Start server
 	go func() {
 		addr := fmt.Sprintf("%s:%s", cfg.Server.Host, cfg.Server.Port)
@@ -258,6 +408,35 @@ func main() {
 	aiService.StopWorkerPool()
 	log.Println("✅ AI worker pool stopped")
 
+	// Stop series worker
+	if err := seriesService.StopWorker(); err != nil {
+		log.Printf("⚠️  Failed to stop series worker: %v", err)
+	}
+
+	// Stop invitation worker
+	if err := invitationService.StopWorker(); err != nil {
+		log.Printf("⚠️  Failed to stop invitation worker: %v", err)
+	}
+
+	// Stop room scheduler
+	if cfg.Scheduler.Enabled {
+		if err := roomScheduler.StopWorker(); err != nil {
+			log.Printf("⚠️  Failed to stop room scheduler: %v", err)
+		}
+	}
+
+	// Stop LiveKit reconciler
+	if reconcilerEnabled {
+		if err := livekitReconciler.StopWorker(); err != nil {
+			log.Printf("⚠️  Failed to stop LiveKit reconciler: %v", err)
+		}
+	}
+
+	// Close room event streams so open connections don't hold up the shutdown
+	if err := eventBroker.Close(); err != nil {
+		log.Printf("⚠️  Failed to close room event broker: %v", err)
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(cfg.Server.ShutdownTimeout)*time.Second)
 	defer cancel()
 
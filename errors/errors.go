@@ -67,6 +67,14 @@ func ErrAlreadyExists(resource string) AppError {
 	}
 }
 
+func ErrFailedPrecondition(message string) AppError {
+	return AppError{
+		HTTPCode: http.StatusConflict,
+		Code:     ErrorCode_FAILED_PRECONDITION,
+		Message:  message,
+	}
+}
+
 func ErrPermissionDenied(action string) AppError {
 	return AppError{
 		HTTPCode: http.StatusForbidden,
@@ -75,6 +83,14 @@ func ErrPermissionDenied(action string) AppError {
 	}
 }
 
+func ErrResourceExhausted(message string) AppError {
+	return AppError{
+		HTTPCode: http.StatusTooManyRequests,
+		Code:     ErrorCode_RESOURCE_EXHAUSTED,
+		Message:  message,
+	}
+}
+
 func ErrUnauthenticated() AppError {
 	return AppError{
 		HTTPCode: http.StatusUnauthorized,
@@ -0,0 +1,11 @@
+package admin
+
+// UpdateUserRoleRequest represents the request to change the platform role of a user
+type UpdateUserRoleRequest struct {
+	Role string `json:"role" validate:"required,oneof=admin host participant"`
+}
+
+// AssignPlanRequest represents the request to change the plan of a user or organization
+type AssignPlanRequest struct {
+	PlanCode string `json:"plan_code" validate:"omitempty,max=50"` // Empty = default plan
+}
@@ -0,0 +1,94 @@
+package admin
+
+import "time"
+
+// UserResponse represents a user as seen by administrators
+type UserResponse struct {
+	ID              string     `json:"id"`
+	Email           string     `json:"email"`
+	Name            string     `json:"name"`
+	Role            string     `json:"role"`
+	IsActive        bool       `json:"is_active"`
+	PlanID          *string    `json:"plan_id,omitempty"` // Absent for the default plan
+	AvatarURL       string     `json:"avatar_url,omitempty"`
+	OAuthProvider   string     `json:"oauth_provider,omitempty"`
+	IsEmailVerified bool       `json:"is_email_verified"`
+	LastLoginAt     *time.Time `json:"last_login_at,omitempty"`
+	LastActiveAt    *time.Time `json:"last_active_at,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+}
+
+// UserListResponse represents a page of users
+type UserListResponse struct {
+	Users      []*UserResponse `json:"users"`
+	Total      int64           `json:"total"`
+	Page       int             `json:"page"`
+	PageSize   int             `json:"page_size"`
+	TotalPages int             `json:"total_pages"`
+}
+
+// AIJobResponse represents an AI processing job
+type AIJobResponse struct {
+	ID              string     `json:"id"`
+	MeetingID       string     `json:"meeting_id"`
+	JobType         string     `json:"job_type"`
+	Status          string     `json:"status"`
+	ExternalJobID   *string    `json:"external_job_id,omitempty"` // AssemblyAI transcript ID
+	RecordingURL    string     `json:"recording_url"`             // Object key of the recording (URL of the file for older jobs)
+	TranscriptID    *string    `json:"transcript_id,omitempty"`
+	RetryCount      int        `json:"retry_count"`
+	MaxRetries      int        `json:"max_retries"`
+	LastError       *string    `json:"last_error,omitempty"`
+	DurationSeconds int        `json:"duration_seconds,omitempty"`
+	Language        string     `json:"language,omitempty"`
+	StartedAt       *time.Time `json:"started_at,omitempty"`
+	CompletedAt     *time.Time `json:"completed_at,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+}
+
+// AIJobListResponse represents a page of AI jobs
+type AIJobListResponse struct {
+	Jobs       []*AIJobResponse `json:"jobs"`
+	Total      int64            `json:"total"`
+	Page       int              `json:"page"`
+	PageSize   int              `json:"page_size"`
+	TotalPages int              `json:"total_pages"`
+}
+
+// OverviewResponse represents the state of the platform
+type OverviewResponse struct {
+	Users       UserStatsResponse    `json:"users"`
+	Rooms       RoomStatsResponse    `json:"rooms"`
+	AIJobs      AIJobStatsResponse   `json:"ai_jobs"`
+	Storage     StorageStatsResponse `json:"storage"`
+	GeneratedAt time.Time            `json:"generated_at"`
+}
+
+// UserStatsResponse counts users
+type UserStatsResponse struct {
+	Total  int64 `json:"total"`
+	Active int64 `json:"active"`
+	Admins int64 `json:"admins"`
+}
+
+// RoomStatsResponse counts rooms and the people in them
+type RoomStatsResponse struct {
+	Active             int64 `json:"active"`
+	Scheduled          int64 `json:"scheduled"`
+	ParticipantsOnline int64 `json:"participants_online"`
+}
+
+// AIJobStatsResponse counts AI jobs
+type AIJobStatsResponse struct {
+	Queued   int64            `json:"queued"` // Waiting for or going through processing
+	Failed   int64            `json:"failed"`
+	ByStatus map[string]int64 `json:"by_status"`
+}
+
+// StorageStatsResponse reports the space taken by recordings
+type StorageStatsResponse struct {
+	Recordings int64 `json:"recordings"`
+	Bytes      int64 `json:"bytes"`
+}
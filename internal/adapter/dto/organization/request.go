@@ -0,0 +1,25 @@
+package organization
+
+// CreateOrganizationRequest represents the request to create an organization
+type CreateOrganizationRequest struct {
+	Name     string                 `json:"name" validate:"required,min=1,max=255"`
+	Settings map[string]interface{} `json:"settings,omitempty"` // Partial settings on top of the defaults
+}
+
+// UpdateOrganizationRequest represents a change to an organization.
+// Settings is a partial update; room_defaults is replaced as a whole.
+type UpdateOrganizationRequest struct {
+	Name     *string                `json:"name,omitempty" validate:"omitempty,min=1,max=255"`
+	Settings map[string]interface{} `json:"settings,omitempty"`
+}
+
+// UpdateMemberRoleRequest represents the request to change the role of a member
+type UpdateMemberRoleRequest struct {
+	Role string `json:"role" validate:"required,oneof=owner admin member"`
+}
+
+// CreateInviteRequest represents the request to invite someone to an organization
+type CreateInviteRequest struct {
+	Email string `json:"email" validate:"required,email"`
+	Role  string `json:"role,omitempty" validate:"omitempty,oneof=admin member"` // Defaults to member
+}
@@ -0,0 +1,74 @@
+package organization
+
+import (
+	"time"
+
+	"github.com/johnquangdev/meeting-assistant/internal/adapter/dto/auth"
+)
+
+// OrganizationResponse represents an organization in responses
+type OrganizationResponse struct {
+	ID        string               `json:"id"`
+	Name      string               `json:"name"`
+	Slug      string               `json:"slug"`
+	Settings  OrganizationSettings `json:"settings"`
+	MyRole    string               `json:"my_role,omitempty"` // Role of the current user
+	CreatedBy string               `json:"created_by"`
+	CreatedAt time.Time            `json:"created_at"`
+	UpdatedAt time.Time            `json:"updated_at"`
+}
+
+// OrganizationSettings represents the organization-wide defaults
+type OrganizationSettings struct {
+	RoomDefaults map[string]interface{} `json:"room_defaults,omitempty"` // Room settings applied to new rooms
+	AI           OrganizationAISettings `json:"ai"`
+}
+
+// OrganizationAISettings represents how the AI pipeline processes the organization's meetings
+type OrganizationAISettings struct {
+	TranscriptionLanguage string `json:"transcription_language"`     // vi or en
+	SummaryLanguage       string `json:"summary_language,omitempty"` // vi or en, empty = language of the transcript
+}
+
+// OrganizationListResponse represents the organizations of the current user
+type OrganizationListResponse struct {
+	Organizations []*OrganizationResponse `json:"organizations"`
+	Total         int                     `json:"total"`
+}
+
+// MemberResponse represents a member of an organization
+type MemberResponse struct {
+	ID       string             `json:"id"`
+	UserID   string             `json:"user_id"`
+	User     *auth.UserResponse `json:"user,omitempty"`
+	Role     string             `json:"role"` // owner, admin, member
+	JoinedAt time.Time          `json:"joined_at"`
+}
+
+// MemberListResponse represents the members of an organization
+type MemberListResponse struct {
+	Members []*MemberResponse `json:"members"`
+	Total   int               `json:"total"`
+}
+
+// InviteResponse represents an organization invite
+type InviteResponse struct {
+	ID               string     `json:"id"`
+	OrganizationID   string     `json:"organization_id"`
+	OrganizationName string     `json:"organization_name,omitempty"`
+	Email            string     `json:"email"`
+	Role             string     `json:"role"`
+	Status           string     `json:"status"` // pending, accepted, expired, revoked
+	InvitedBy        string     `json:"invited_by"`
+	InviterName      string     `json:"inviter_name,omitempty"`
+	InviteURL        string     `json:"invite_url,omitempty"` // Only returned to owners and admins
+	ExpiresAt        time.Time  `json:"expires_at"`
+	RespondedAt      *time.Time `json:"responded_at,omitempty"`
+	CreatedAt        time.Time  `json:"created_at"`
+}
+
+// InviteListResponse represents the invites of an organization
+type InviteListResponse struct {
+	Invites []*InviteResponse `json:"invites"`
+	Total   int               `json:"total"`
+}
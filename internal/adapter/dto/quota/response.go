@@ -0,0 +1,31 @@
+package quota
+
+import "time"
+
+// PlanResponse represents a plan; a limit of 0 means unlimited
+type PlanResponse struct {
+	ID                    string `json:"id"`
+	Code                  string `json:"code"`
+	Name                  string `json:"name"`
+	MaxParticipants       int    `json:"max_participants"`
+	MaxConcurrentRooms    int    `json:"max_concurrent_rooms"`
+	MonthlyMeetingMinutes int    `json:"monthly_meeting_minutes"`
+	RecordingStorageBytes int64  `json:"recording_storage_bytes"`
+	MonthlyAIMinutes      int    `json:"monthly_ai_minutes"`
+	IsDefault             bool   `json:"is_default"`
+}
+
+// PlanListResponse represents the list of plans
+type PlanListResponse struct {
+	Plans []*PlanResponse `json:"plans"`
+}
+
+// UsageResponse represents the plan of a user or organization with what it used this month
+type UsageResponse struct {
+	Plan                  *PlanResponse `json:"plan,omitempty"` // Absent when no plan applies (no limits)
+	PeriodStart           time.Time     `json:"period_start"`
+	ActiveRooms           int64         `json:"active_rooms"`
+	MeetingMinutes        int64         `json:"meeting_minutes"`
+	RecordingStorageBytes int64         `json:"recording_storage_bytes"`
+	AIMinutes             int64         `json:"ai_minutes"`
+}
@@ -0,0 +1,45 @@
+package room
+
+import "time"
+
+// SetAgendaRequest represents the whole agenda of a room, in order
+type SetAgendaRequest struct {
+	Items []AgendaItemRequest `json:"items" validate:"max=50,dive"`
+}
+
+// AgendaItemRequest represents an item of the agenda. Pass the id of an existing item to keep it (and its timing).
+type AgendaItemRequest struct {
+	ID              *string `json:"id,omitempty" validate:"omitempty,uuid"`
+	Title           string  `json:"title" validate:"required,max=255"`
+	Description     *string `json:"description,omitempty" validate:"omitempty,max=2000"`
+	OwnerID         *string `json:"owner_id,omitempty" validate:"omitempty,uuid"`                  // User presenting the item
+	DurationMinutes int     `json:"duration_minutes,omitempty" validate:"omitempty,min=0,max=480"` // Time box
+}
+
+// SetCurrentAgendaItemRequest represents the agenda item the meeting moves to (none when item_id is omitted)
+type SetCurrentAgendaItemRequest struct {
+	ItemID *string `json:"item_id,omitempty" validate:"omitempty,uuid"`
+}
+
+// AgendaItemResponse represents an item of the agenda
+type AgendaItemResponse struct {
+	ID              string     `json:"id"`
+	Number          int        `json:"number"` // 1-based
+	Title           string     `json:"title"`
+	Description     *string    `json:"description,omitempty"`
+	OwnerID         *string    `json:"owner_id,omitempty"`
+	OwnerName       string     `json:"owner_name,omitempty"`
+	DurationMinutes int        `json:"duration_minutes"`
+	Status          string     `json:"status"` // upcoming, current, done
+	StartedAt       *time.Time `json:"started_at,omitempty"`
+	EndedAt         *time.Time `json:"ended_at,omitempty"`
+	Overtime        bool       `json:"overtime"` // Ran past its time box
+}
+
+// AgendaResponse represents the agenda of a room
+type AgendaResponse struct {
+	RoomID        string                `json:"room_id"`
+	CurrentItemID *string               `json:"current_item_id,omitempty"`
+	Items         []*AgendaItemResponse `json:"items"`
+	TotalMinutes  int                   `json:"total_minutes"` // Sum of the time boxes
+}
@@ -0,0 +1,42 @@
+package room
+
+import "time"
+
+// AttendanceReportResponse represents who attended a meeting and for how long
+type AttendanceReportResponse struct {
+	RoomID             string              `json:"room_id"`
+	RoomName           string              `json:"room_name"`
+	Status             string              `json:"status"`
+	ScheduledStartTime *time.Time          `json:"scheduled_start_time,omitempty"`
+	ScheduledEndTime   *time.Time          `json:"scheduled_end_time,omitempty"`
+	StartedAt          *time.Time          `json:"started_at,omitempty"`
+	EndedAt            *time.Time          `json:"ended_at,omitempty"`
+	Attendees          []*AttendeeResponse `json:"attendees"`
+	Total              int                 `json:"total"`
+	GeneratedAt        time.Time           `json:"generated_at"`
+}
+
+// AttendeeResponse represents the attendance of one participant
+type AttendeeResponse struct {
+	ParticipantID     string                       `json:"participant_id"`
+	UserID            string                       `json:"user_id,omitempty"`
+	DisplayName       string                       `json:"display_name"`
+	Email             string                       `json:"email,omitempty"`
+	Role              string                       `json:"role"`
+	IsGuest           bool                         `json:"is_guest"`
+	Attended          bool                         `json:"attended"` // False for invited participants who never connected
+	SessionCount      int                          `json:"session_count"`
+	FirstJoinedAt     *time.Time                   `json:"first_joined_at,omitempty"`
+	LastLeftAt        *time.Time                   `json:"last_left_at,omitempty"` // Empty while still connected
+	TotalSeconds      int                          `json:"total_seconds"`          // Time connected, overlapping sessions counted once
+	LateSeconds       int                          `json:"late_seconds"`           // First join after the scheduled (or actual) start
+	EarlyLeaveSeconds int                          `json:"early_leave_seconds"`    // Last leave before the scheduled (or actual) end
+	Sessions          []*AttendanceSessionResponse `json:"sessions"`
+}
+
+// AttendanceSessionResponse represents one connection of a participant to the meeting
+type AttendanceSessionResponse struct {
+	JoinedAt time.Time  `json:"joined_at"`
+	LeftAt   *time.Time `json:"left_at,omitempty"`
+	Duration *int       `json:"duration,omitempty"` // seconds
+}
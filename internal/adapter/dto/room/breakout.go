@@ -0,0 +1,56 @@
+package room
+
+import "time"
+
+// CreateBreakoutsRequest is the request to open breakout rooms.
+// When names are given they set both the number and the names of the rooms.
+type CreateBreakoutsRequest struct {
+	Count int      `json:"count,omitempty" validate:"omitempty,min=1,max=20"`
+	Names []string `json:"names,omitempty" validate:"omitempty,max=20,dive,max=255"`
+}
+
+// BreakoutAssignmentRequest moves one participant of the main room
+type BreakoutAssignmentRequest struct {
+	ParticipantID  string  `json:"participant_id" validate:"required,uuid"`
+	BreakoutRoomID *string `json:"breakout_room_id,omitempty" validate:"omitempty,uuid"` // null = back to the main room
+}
+
+// AssignBreakoutsRequest is the request to assign participants to breakout rooms
+type AssignBreakoutsRequest struct {
+	Assignments []BreakoutAssignmentRequest `json:"assignments" validate:"required,min=1,dive"`
+}
+
+// StartBreakoutTimerRequest is the request to broadcast a breakout countdown
+type StartBreakoutTimerRequest struct {
+	DurationSeconds int `json:"duration_seconds" validate:"required,min=1,max=14400"`
+}
+
+// BreakoutRoomResponse represents a breakout room with its assigned participants
+type BreakoutRoomResponse struct {
+	Room         *RoomResponse          `json:"room"`
+	Participants []*ParticipantResponse `json:"participants"`
+}
+
+// BreakoutsResponse represents the breakout rooms of a meeting
+type BreakoutsResponse struct {
+	ParentRoomID string                  `json:"parent_room_id"`
+	TimerEndsAt  *time.Time              `json:"timer_ends_at,omitempty"`
+	Breakouts    []*BreakoutRoomResponse `json:"breakouts"`
+	Unassigned   []*ParticipantResponse  `json:"unassigned"` // In the main room and not assigned yet
+}
+
+// BreakoutTimerResponse represents a running breakout countdown
+type BreakoutTimerResponse struct {
+	EndsAt           time.Time `json:"ends_at"`
+	SecondsRemaining int       `json:"seconds_remaining"`
+}
+
+// BreakoutDestinationResponse tells a participant which room to be in (for polling)
+type BreakoutDestinationResponse struct {
+	InBreakout   bool                 `json:"in_breakout"`             // false = main room
+	Room         *RoomResponse        `json:"room"`                    // Room to connect to
+	Participant  *ParticipantResponse `json:"participant"`             // Participant record in that room
+	LivekitToken string               `json:"livekit_token"`           // Token for the room
+	LivekitURL   string               `json:"livekit_url"`             // LiveKit server URL
+	TimerEndsAt  *time.Time           `json:"timer_ends_at,omitempty"` // Breakout countdown, if running
+}
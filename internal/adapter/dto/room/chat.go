@@ -0,0 +1,39 @@
+package room
+
+import "time"
+
+// PostChatMessageRequest represents a new message in the meeting chat
+type PostChatMessageRequest struct {
+	Content   string  `json:"content" validate:"required,max=4000"`
+	ReplyToID *string `json:"reply_to_id,omitempty" validate:"omitempty,uuid"` // Message this one answers
+}
+
+// EditChatMessageRequest represents the new content of a chat message
+type EditChatMessageRequest struct {
+	Content string `json:"content" validate:"required,max=4000"`
+}
+
+// ChatMessageResponse represents a message of the meeting chat.
+// Deleted messages keep their place in the chat without their content.
+type ChatMessageResponse struct {
+	ID            string     `json:"id"`
+	RoomID        string     `json:"room_id"`
+	ParticipantID string     `json:"participant_id"`
+	DisplayName   string     `json:"display_name"`
+	IsGuest       bool       `json:"is_guest"`
+	Content       string     `json:"content,omitempty"`
+	ReplyToID     *string    `json:"reply_to_id,omitempty"`
+	IsEdited      bool       `json:"is_edited"`
+	EditedAt      *time.Time `json:"edited_at,omitempty"`
+	IsDeleted     bool       `json:"is_deleted"`
+	DeletedAt     *time.Time `json:"deleted_at,omitempty"`
+	Moderated     bool       `json:"moderated,omitempty"` // Deleted by a moderator rather than its author
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+// ChatMessageListResponse represents a page of the meeting chat, oldest message first
+type ChatMessageListResponse struct {
+	Messages   []*ChatMessageResponse `json:"messages"`
+	HasMore    bool                   `json:"has_more"`              // Older messages remain
+	NextBefore *time.Time             `json:"next_before,omitempty"` // Pass as before to get the previous page
+}
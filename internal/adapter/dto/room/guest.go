@@ -0,0 +1,27 @@
+package room
+
+import "time"
+
+// GuestJoinRequest is the request of a guest joining a room without an account.
+// Either room_slug or invite_token identifies the room.
+type GuestJoinRequest struct {
+	DisplayName string `json:"display_name" validate:"required,min=1,max=100"`
+	RoomSlug    string `json:"room_slug,omitempty" validate:"omitempty,max=100"`
+	InviteToken string `json:"invite_token,omitempty" validate:"omitempty,max=100"`
+	Passcode    string `json:"passcode,omitempty" validate:"omitempty,max=32"` // Required by rooms with a passcode (not with an invitation)
+}
+
+// GuestJoinResponse represents the response after a guest joins a room
+type GuestJoinResponse struct {
+	Status              string               `json:"status"`                      // "joined", "waiting" or "waitlisted"
+	Message             string               `json:"message"`                     // User-friendly message
+	Room                *RoomResponse        `json:"room"`                        // Room information
+	Participant         *ParticipantResponse `json:"participant"`                 // Guest's participant record
+	GuestToken          string               `json:"guest_token"`                 // Bearer token for the /guest endpoints
+	GuestTokenExpiresAt time.Time            `json:"guest_token_expires_at"`      // When the guest token expires
+	WaitlistPosition    int                  `json:"waitlist_position,omitempty"` // Place in line for a seat (1 = next), only for waitlisted status
+	LivekitToken        string               `json:"livekit_token,omitempty"`     // Only for joined status
+	LivekitURL          string               `json:"livekit_url,omitempty"`       // Only for joined status
+
+	RecordingConsent *RecordingConsentResponse `json:"recording_consent,omitempty"` // Set when the guest must consent to be recorded
+}
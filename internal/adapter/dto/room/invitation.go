@@ -4,40 +4,49 @@ import "time"
 
 // InviteByEmailRequest is the request to invite a user by email
 type InviteByEmailRequest struct {
-	Email string `json:"email" validate:"required,email"`
+	Email          string  `json:"email" validate:"required,email"`
+	Message        *string `json:"message,omitempty" validate:"omitempty,max=1000"`
+	ExpiresInHours *int    `json:"expires_in_hours,omitempty" validate:"omitempty,min=1"` // Defaults to the server setting (7 days)
 }
 
-// InviteByEmailResponse is the response after inviting a user
-type InviteByEmailResponse struct {
-	ParticipantID string `json:"participant_id"`
-	Email         string `json:"email"`
-	Status        string `json:"status"`
-	Message       string `json:"message"`
+// InvitationResponse represents a room invitation
+type InvitationResponse struct {
+	ID           string     `json:"id"`
+	RoomID       string     `json:"room_id"`
+	RoomName     string     `json:"room_name,omitempty"`
+	RoomType     string     `json:"room_type,omitempty"`
+	InviterID    string     `json:"inviter_id"`
+	InviterName  string     `json:"inviter_name,omitempty"`
+	InviteeEmail *string    `json:"invitee_email,omitempty"`
+	Status       string     `json:"status"` // pending, accepted, declined, expired, revoked
+	Message      *string    `json:"message,omitempty"`
+	InviteURL    string     `json:"invite_url,omitempty"` // Only returned to the host and the invitee
+	ExpiresAt    time.Time  `json:"expires_at"`
+	RespondedAt  *time.Time `json:"responded_at,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
 }
 
-// MyInvitationsResponse contains all invitations for the current user
-type MyInvitationsResponse struct {
-	Invitations []InvitationItem `json:"invitations"`
-	Total       int              `json:"total"`
+// InvitationListResponse contains a list of invitations
+type InvitationListResponse struct {
+	Invitations []*InvitationResponse `json:"invitations"`
+	Total       int                   `json:"total"`
 }
 
-// InvitationItem represents a single invitation
-type InvitationItem struct {
-	ParticipantID string    `json:"participant_id"`
-	RoomID        string    `json:"room_id"`
-	RoomName      string    `json:"room_name"`
-	RoomType      string    `json:"room_type"`
-	InviterID     string    `json:"inviter_id"`
-	InviterName   string    `json:"inviter_name"`
-	InvitedAt     time.Time `json:"invited_at"`
+// InvitationPreviewResponse is returned when an invitation link is opened without logging in
+type InvitationPreviewResponse struct {
+	Status     string              `json:"status"` // login_required
+	Message    string              `json:"message"`
+	Invitation *InvitationResponse `json:"invitation"`
+	LoginURL   string              `json:"login_url"`
 }
 
 // AcceptInvitationResponse is the response after accepting an invitation
 type AcceptInvitationResponse struct {
-	Status       string               `json:"status"`
+	Status       string               `json:"status"` // joined, waiting, accepted
 	Message      string               `json:"message"`
+	Invitation   *InvitationResponse  `json:"invitation,omitempty"`
 	Room         *RoomResponse        `json:"room"`
-	Participant  *ParticipantResponse `json:"participant"`
+	Participant  *ParticipantResponse `json:"participant,omitempty"`
 	LivekitToken string               `json:"livekit_token,omitempty"`
 	LivekitURL   string               `json:"livekit_url,omitempty"`
 }
@@ -0,0 +1,63 @@
+package room
+
+import "time"
+
+// CreatePollRequest represents a new poll
+type CreatePollRequest struct {
+	Question          string   `json:"question" validate:"required,max=500"`
+	Type              string   `json:"type" validate:"required,oneof=single_choice multiple_choice free_text"`
+	Options           []string `json:"options,omitempty" validate:"omitempty,max=10,dive,required,max=255"` // Choices of single- and multiple-choice polls (2-10)
+	Anonymous         bool     `json:"anonymous"`                                                           // Hide who voted what, also from moderators
+	DurationSeconds   int      `json:"duration_seconds,omitempty" validate:"omitempty,min=10,max=86400"`    // Close automatically after this long
+	CloseWhenAllVoted bool     `json:"close_when_all_voted"`                                                // Close once everyone in the meeting voted
+}
+
+// VoteRequest represents a vote: option_ids for choice polls (one for single choice), text for free-text polls.
+// Voting again replaces the previous vote.
+type VoteRequest struct {
+	OptionIDs []string `json:"option_ids,omitempty" validate:"omitempty,max=10,dive,uuid"`
+	Text      string   `json:"text,omitempty" validate:"omitempty,max=1000"`
+}
+
+// PollOptionResponse represents a choice of a poll with its votes
+type PollOptionResponse struct {
+	ID         string   `json:"id"`
+	Text       string   `json:"text"`
+	Votes      int      `json:"votes"`
+	Percentage float64  `json:"percentage"`
+	Voters     []string `json:"voters,omitempty"` // Named polls only
+}
+
+// PollAnswerResponse represents a free-text answer
+type PollAnswerResponse struct {
+	Text  string `json:"text"`
+	Voter string `json:"voter,omitempty"` // Named polls only
+}
+
+// PollResponse represents a poll with its live (open) or final (closed) results
+type PollResponse struct {
+	ID                string                `json:"id"`
+	RoomID            string                `json:"room_id"`
+	Question          string                `json:"question"`
+	Type              string                `json:"type"`
+	Anonymous         bool                  `json:"anonymous"`
+	Status            string                `json:"status"`
+	CreatedBy         string                `json:"created_by"` // Display name of the moderator who opened the poll
+	ClosesAt          *time.Time            `json:"closes_at,omitempty"`
+	CloseWhenAllVoted bool                  `json:"close_when_all_voted"`
+	ClosedAt          *time.Time            `json:"closed_at,omitempty"`
+	CloseReason       string                `json:"close_reason,omitempty"`
+	TotalVoters       int                   `json:"total_voters"`
+	Options           []*PollOptionResponse `json:"options,omitempty"`
+	Answers           []*PollAnswerResponse `json:"answers,omitempty"` // Free-text polls
+	HasVoted          bool                  `json:"has_voted"`
+	MyOptionIDs       []string              `json:"my_option_ids,omitempty"` // Options chosen by the caller
+	MyAnswer          string                `json:"my_answer,omitempty"`     // Free-text answer of the caller
+	CreatedAt         time.Time             `json:"created_at"`
+}
+
+// PollListResponse represents the polls of a meeting, oldest first
+type PollListResponse struct {
+	Polls []*PollResponse `json:"polls"`
+	Total int             `json:"total"`
+}
@@ -0,0 +1,73 @@
+package room
+
+import "time"
+
+// RecordingSegmentResponse represents a stretch of the recording between a start (or resume) and a pause (or stop)
+type RecordingSegmentResponse struct {
+	StartedAt time.Time  `json:"started_at"`
+	EndedAt   *time.Time `json:"ended_at,omitempty"`
+}
+
+// RecordingResponse represents a recording of a room
+type RecordingResponse struct {
+	ID          string                      `json:"id"`
+	RoomID      string                      `json:"room_id"`
+	Status      string                      `json:"status"`               // recording, paused, processing, completed, failed
+	StartedBy   *string                     `json:"started_by,omitempty"` // Empty for automatic recordings
+	StartedAt   time.Time                   `json:"started_at"`
+	CompletedAt *time.Time                  `json:"completed_at,omitempty"`
+	Duration    *int                        `json:"duration,omitempty"` // Seconds recorded, pauses excluded
+	FileSize    *int64                      `json:"file_size,omitempty"`
+	Segments    []*RecordingSegmentResponse `json:"segments"`
+}
+
+// RecordingListResponse represents the recordings of a room
+type RecordingListResponse struct {
+	Recordings []*RecordingResponse `json:"recordings"`
+	Total      int                  `json:"total"`
+}
+
+// RecordingPlaybackResponse represents a recording with short-lived URLs to play its files, in order
+// (none until the recording completed). Request the recording again once the URLs expire.
+type RecordingPlaybackResponse struct {
+	Recording *RecordingResponse `json:"recording"`
+	URLs      []string           `json:"urls"`
+	ExpiresAt *time.Time         `json:"expires_at,omitempty"`
+}
+
+// GiveRecordingConsentRequest represents the request to consent to be recorded
+type GiveRecordingConsentRequest struct {
+	PolicyVersion string `json:"policy_version" validate:"required,max=50"` // Policy version shown to the participant
+}
+
+// RecordingConsentResponse tells a participant whether it must consent to be recorded
+type RecordingConsentResponse struct {
+	Required      bool       `json:"required"`       // The room records only participants that consented
+	PolicyVersion string     `json:"policy_version"` // Policy version to consent to
+	Given         bool       `json:"given"`          // The participant consented to this policy version
+	ConsentedAt   *time.Time `json:"consented_at,omitempty"`
+}
+
+// RecordingConsentReportResponse represents the recording consents given in a room, for audits
+type RecordingConsentReportResponse struct {
+	RoomID        string                            `json:"room_id"`
+	RoomName      string                            `json:"room_name"`
+	PolicyVersion string                            `json:"policy_version"` // Current policy version
+	Consents      []*RecordingConsentRecordResponse `json:"consents"`
+	Total         int                               `json:"total"`
+	GeneratedAt   time.Time                         `json:"generated_at"`
+}
+
+// RecordingConsentRecordResponse represents the consent of one participant to a policy version
+type RecordingConsentRecordResponse struct {
+	ID            string    `json:"id"`
+	ParticipantID string    `json:"participant_id"`
+	UserID        string    `json:"user_id,omitempty"`
+	DisplayName   string    `json:"display_name"`
+	Email         string    `json:"email,omitempty"`
+	IsGuest       bool      `json:"is_guest"`
+	PolicyVersion string    `json:"policy_version"`
+	ConsentedAt   time.Time `json:"consented_at"`
+	IPAddress     string    `json:"ip_address,omitempty"`
+	UserAgent     string    `json:"user_agent,omitempty"`
+}
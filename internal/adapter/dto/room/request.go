@@ -13,6 +13,7 @@ type CreateRoomRequest struct {
 	Settings           map[string]interface{} `json:"settings,omitempty"`
 	ScheduledStartTime *time.Time             `json:"scheduled_start_time,omitempty"`
 	ScheduledEndTime   *time.Time             `json:"scheduled_end_time,omitempty"`
+	Passcode           string                 `json:"passcode,omitempty" validate:"omitempty,min=4,max=32"`
 }
 
 // UpdateRoomRequest represents the request to update a room
@@ -25,11 +26,29 @@ type UpdateRoomRequest struct {
 	ScheduledEndTime   *time.Time             `json:"scheduled_end_time,omitempty"`
 }
 
+// UpdateRoomSettingsRequest represents a partial update of room settings.
+// Only the fields present in the body are changed.
+type UpdateRoomSettingsRequest struct {
+	EnableRecording     *bool `json:"enable_recording,omitempty"`
+	EnableChat          *bool `json:"enable_chat,omitempty"`
+	EnableScreenShare   *bool `json:"enable_screen_share,omitempty"`
+	RequireApproval     *bool `json:"require_approval,omitempty"`
+	AllowGuests         *bool `json:"allow_guests,omitempty"`
+	MuteOnJoin          *bool `json:"mute_on_join,omitempty"`
+	DisableVideoOnJoin  *bool `json:"disable_video_on_join,omitempty"`
+	EnableWaitingRoom   *bool `json:"enable_waiting_room,omitempty"`
+	AutoRecord          *bool `json:"auto_record,omitempty"`
+	EnableTranscription *bool `json:"enable_transcription,omitempty"`
+
+	RequireRecordingConsent *bool `json:"require_recording_consent,omitempty"`
+}
+
 // ListRoomsRequest represents query parameters for listing rooms
 type ListRoomsRequest struct {
 	Type      string   `query:"type" validate:"omitempty,oneof=public private scheduled"`
 	Status    string   `query:"status" validate:"omitempty,oneof=scheduled active ended cancelled"`
 	Search    string   `query:"search"`
+	SeriesID  string   `query:"series_id" validate:"omitempty,uuid"`
 	Tags      []string `query:"tags"`
 	Page      int      `query:"page" validate:"min=1"`
 	PageSize  int      `query:"page_size" validate:"min=1,max=100"`
@@ -39,7 +58,18 @@ type ListRoomsRequest struct {
 
 // JoinRoomRequest represents the request to join a room
 type JoinRoomRequest struct {
-	Token *string `json:"token,omitempty"` // For private rooms
+	Token    *string `json:"token,omitempty"`    // For private rooms
+	Passcode string  `json:"passcode,omitempty"` // For rooms with a passcode
+}
+
+// LockRoomRequest represents the request to lock a room to new joins
+type LockRoomRequest struct {
+	Mode string `json:"mode" validate:"omitempty,oneof=waiting_room reject"` // Defaults to waiting_room
+}
+
+// SetPasscodeRequest represents the request to set or remove the passcode of a room
+type SetPasscodeRequest struct {
+	Passcode string `json:"passcode" validate:"omitempty,min=4,max=32"` // Empty removes the passcode
 }
 
 // RemoveParticipantRequest represents the request to remove a participant
@@ -52,10 +82,27 @@ type TransferHostRequest struct {
 	NewHostID string `json:"new_host_id" validate:"required,uuid"`
 }
 
-// UpdateParticipantRequest represents the request to update participant settings
+// UpdateParticipantRequest represents the request to update participant settings.
+// Metadata keys are merged into the participant metadata; a null value deletes the key.
 type UpdateParticipantRequest struct {
-	IsMuted      *bool `json:"is_muted,omitempty"`
-	IsHandRaised *bool `json:"is_hand_raised,omitempty"`
+	IsMuted        *bool                  `json:"is_muted,omitempty"`
+	IsHandRaised   *bool                  `json:"is_hand_raised,omitempty"`
+	CanShareScreen *bool                  `json:"can_share_screen,omitempty"`
+	CanPublish     *bool                  `json:"can_publish,omitempty"`
+	CanRecord      *bool                  `json:"can_record,omitempty"`      // Host only
+	CanMuteOthers  *bool                  `json:"can_mute_others,omitempty"` // Host only
+	Metadata       map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// MuteParticipantRequest represents the request to mute or unmute a participant's tracks
+type MuteParticipantRequest struct {
+	Muted   *bool    `json:"muted" validate:"required"`
+	Sources []string `json:"sources,omitempty" validate:"omitempty,dive,oneof=camera microphone screen_share screen_share_audio"` // Defaults to microphone
+}
+
+// ReorderHandsRequest represents the request to change the speaking order of raised hands
+type ReorderHandsRequest struct {
+	ParticipantIDs []string `json:"participant_ids" validate:"required,min=1,dive,uuid"` // Called first, in this order; other raised hands follow
 }
 
 // DenyParticipantRequest represents the request to deny a participant
@@ -14,6 +14,7 @@ type RoomResponse struct {
 	Slug                *string                `json:"slug,omitempty"`
 	HostID              string                 `json:"host_id"`
 	Host                *auth.UserResponse     `json:"host,omitempty"`
+	OrganizationID      *string                `json:"organization_id,omitempty"` // Absent for personal rooms
 	Type                string                 `json:"type"`
 	Status              string                 `json:"status"`
 	LivekitRoomName     string                 `json:"livekit_room_name"`
@@ -25,6 +26,13 @@ type RoomResponse struct {
 	StartedAt           *time.Time             `json:"started_at,omitempty"`
 	EndedAt             *time.Time             `json:"ended_at,omitempty"`
 	Duration            *int                   `json:"duration,omitempty"`
+	SeriesID            *string                `json:"series_id,omitempty"`
+	OccurrenceStartTime *time.Time             `json:"occurrence_start_time,omitempty"`
+	ParentRoomID        *string                `json:"parent_room_id,omitempty"`
+	IsLocked            bool                   `json:"is_locked"`
+	LockMode            *string                `json:"lock_mode,omitempty"` // "waiting_room" or "reject" while locked
+	LockedAt            *time.Time             `json:"locked_at,omitempty"`
+	HasPasscode         bool                   `json:"has_passcode"`
 	CreatedAt           time.Time              `json:"created_at"`
 	UpdatedAt           time.Time              `json:"updated_at"`
 }
@@ -42,31 +50,41 @@ type ParticipantResponse struct {
 	RoomID            string             `json:"room_id"`
 	UserID            string             `json:"user_id,omitempty"`
 	User              *auth.UserResponse `json:"user,omitempty"`
+	DisplayName       string             `json:"display_name"`
+	IsGuest           bool               `json:"is_guest"` // Joined without an account
 	Role              string             `json:"role"`
 	Status            string             `json:"status"`
 	InvitedEmail      *string            `json:"invited_email,omitempty"` // For invited participants
 	InvitedBy         *string            `json:"invited_by,omitempty"`    // User ID who invited
 	InvitedAt         *time.Time         `json:"invited_at,omitempty"`
+	WaitlistedAt      *time.Time         `json:"waitlisted_at,omitempty"` // When the participant got in line for a seat of a full room
 	JoinedAt          *time.Time         `json:"joined_at,omitempty"`
 	LeftAt            *time.Time         `json:"left_at,omitempty"`
 	Duration          *int               `json:"duration,omitempty"`
 	CanShareScreen    bool               `json:"can_share_screen"`
+	CanPublish        bool               `json:"can_publish"`
 	CanRecord         bool               `json:"can_record"`
 	CanMuteOthers     bool               `json:"can_mute_others"`
 	IsMuted           bool               `json:"is_muted"`
 	IsHandRaised      bool               `json:"is_hand_raised"`
+	HandRaisedAt      *time.Time         `json:"hand_raised_at,omitempty"`
+	HandQueuePosition *int               `json:"hand_queue_position,omitempty"` // Speaking order among raised hands (1 = next)
 	ConnectionQuality *string            `json:"connection_quality,omitempty"`
+	BreakoutRoomID    *string            `json:"breakout_room_id,omitempty"` // Breakout room the participant is assigned to
 	CreatedAt         time.Time          `json:"created_at"`
 }
 
 // JoinRoomResponse represents the response after joining a room
 type JoinRoomResponse struct {
-	Status       string               `json:"status"`                  // "joined" or "waiting"
-	Message      string               `json:"message"`                 // User-friendly message
-	Room         *RoomResponse        `json:"room"`                    // Room information
-	Participant  *ParticipantResponse `json:"participant"`             // Current user's participant record
-	LivekitToken string               `json:"livekit_token,omitempty"` // Only for joined status
-	LivekitURL   string               `json:"livekit_url,omitempty"`   // Only for joined status
+	Status           string               `json:"status"`                      // "joined", "waiting" or "waitlisted"
+	Message          string               `json:"message"`                     // User-friendly message
+	Room             *RoomResponse        `json:"room"`                        // Room information
+	Participant      *ParticipantResponse `json:"participant"`                 // Current user's participant record
+	WaitlistPosition int                  `json:"waitlist_position,omitempty"` // Place in line for a seat (1 = next), only for waitlisted status
+	LivekitToken     string               `json:"livekit_token,omitempty"`     // Only for joined status
+	LivekitURL       string               `json:"livekit_url,omitempty"`       // Only for joined status
+
+	RecordingConsent *RecordingConsentResponse `json:"recording_consent,omitempty"` // Set when the user must consent to be recorded
 }
 
 // RoomListResponse represents a paginated list of rooms
@@ -86,10 +104,31 @@ type ParticipantListResponse struct {
 
 // ParticipantStatusResponse represents the current participant status (for polling)
 type ParticipantStatusResponse struct {
-	Status       string               `json:"status"`                  // "waiting", "joined", "denied", etc.
-	Message      string               `json:"message"`                 // User-friendly message
-	Room         *RoomResponse        `json:"room"`                    // Room information
-	Participant  *ParticipantResponse `json:"participant"`             // Current user's participant record
-	LivekitToken string               `json:"livekit_token,omitempty"` // Only when status is "joined"
-	LivekitURL   string               `json:"livekit_url,omitempty"`   // Only when status is "joined"
+	Status           string               `json:"status"`                      // "waiting", "waitlisted", "joined", "denied", etc.
+	Message          string               `json:"message"`                     // User-friendly message
+	Room             *RoomResponse        `json:"room"`                        // Room information
+	Participant      *ParticipantResponse `json:"participant"`                 // Current user's participant record
+	WaitlistPosition int                  `json:"waitlist_position,omitempty"` // Place in line for a seat (1 = next), only when status is "waitlisted"
+	LivekitToken     string               `json:"livekit_token,omitempty"`     // Only when status is "joined"
+	LivekitURL       string               `json:"livekit_url,omitempty"`       // Only when status is "joined"
+
+	RecordingConsent *RecordingConsentResponse `json:"recording_consent,omitempty"` // Set when the participant must consent to be recorded
+}
+
+// AuditLogResponse represents an access control change of a room
+type AuditLogResponse struct {
+	ID        string                 `json:"id"`
+	Action    string                 `json:"action"`
+	ActorID   string                 `json:"actor_id,omitempty"`
+	ActorIP   string                 `json:"actor_ip,omitempty"`
+	Details   map[string]interface{} `json:"details,omitempty"`
+	CreatedAt time.Time              `json:"created_at"`
+}
+
+// AuditLogListResponse represents a page of a room's audit trail
+type AuditLogListResponse struct {
+	Entries  []*AuditLogResponse `json:"entries"`
+	Total    int64               `json:"total"`
+	Page     int                 `json:"page"`
+	PageSize int                 `json:"page_size"`
 }
@@ -0,0 +1,48 @@
+package series
+
+import (
+	"time"
+)
+
+// CreateSeriesRequest represents the request to create a recurring meeting series
+type CreateSeriesRequest struct {
+	Name            string                 `json:"name" validate:"required,min=1,max=255"`
+	Description     *string                `json:"description,omitempty"`
+	Type            string                 `json:"type" validate:"omitempty,oneof=public private scheduled"`
+	MaxParticipants int                    `json:"max_participants" validate:"required,min=2,max=100"`
+	Settings        map[string]interface{} `json:"settings,omitempty"`
+	RRule           string                 `json:"rrule" validate:"required"` // e.g. FREQ=WEEKLY;BYDAY=MO,WE
+	Timezone        string                 `json:"timezone,omitempty"`        // IANA name, defaults to UTC
+	StartTime       time.Time              `json:"start_time" validate:"required"`
+	DurationMinutes int                    `json:"duration_minutes" validate:"omitempty,min=1,max=1440"`
+}
+
+// ListOccurrencesRequest represents query parameters for listing occurrences
+type ListOccurrencesRequest struct {
+	From string `query:"from"` // RFC3339, defaults to now
+	To   string `query:"to"`   // RFC3339, defaults to from + 30 days
+}
+
+// MaterializeOccurrenceRequest represents the request to create the room of a single occurrence
+type MaterializeOccurrenceRequest struct {
+	OccurrenceStartTime time.Time `json:"occurrence_start_time" validate:"required"`
+}
+
+// ExpandSeriesRequest represents the request to create rooms for upcoming occurrences
+type ExpandSeriesRequest struct {
+	Until time.Time `json:"until" validate:"required"`
+}
+
+// CancelOccurrenceRequest represents the request to cancel a single occurrence
+type CancelOccurrenceRequest struct {
+	OccurrenceStartTime time.Time `json:"occurrence_start_time" validate:"required"`
+	Reason              *string   `json:"reason,omitempty" validate:"omitempty,max=500"`
+}
+
+// RescheduleOccurrenceRequest represents the request to move a single occurrence
+type RescheduleOccurrenceRequest struct {
+	OccurrenceStartTime time.Time  `json:"occurrence_start_time" validate:"required"`
+	NewStartTime        time.Time  `json:"new_start_time" validate:"required"`
+	NewEndTime          *time.Time `json:"new_end_time,omitempty"`
+	Reason              *string    `json:"reason,omitempty" validate:"omitempty,max=500"`
+}
@@ -0,0 +1,76 @@
+package series
+
+import (
+	"time"
+
+	summaryDTO "github.com/johnquangdev/meeting-assistant/internal/adapter/dto"
+	"github.com/johnquangdev/meeting-assistant/internal/adapter/dto/room"
+)
+
+// SeriesResponse represents a recurring meeting series in responses
+type SeriesResponse struct {
+	ID                string                 `json:"id"`
+	HostID            string                 `json:"host_id"`
+	Name              string                 `json:"name"`
+	Description       *string                `json:"description,omitempty"`
+	Type              string                 `json:"type"`
+	MaxParticipants   int                    `json:"max_participants"`
+	Settings          map[string]interface{} `json:"settings"`
+	RRule             string                 `json:"rrule"`
+	Timezone          string                 `json:"timezone"`
+	StartTime         time.Time              `json:"start_time"`
+	DurationMinutes   int                    `json:"duration_minutes"`
+	Status            string                 `json:"status"`
+	MaterializedUntil *time.Time             `json:"materialized_until,omitempty"`
+	CreatedAt         time.Time              `json:"created_at"`
+	UpdatedAt         time.Time              `json:"updated_at"`
+}
+
+// SeriesListResponse represents a list of series
+type SeriesListResponse struct {
+	Series []*SeriesResponse `json:"series"`
+	Total  int               `json:"total"`
+}
+
+// OccurrenceResponse represents a single occurrence of a series
+type OccurrenceResponse struct {
+	OriginalStartTime time.Time          `json:"original_start_time"`
+	StartTime         time.Time          `json:"start_time"`
+	EndTime           time.Time          `json:"end_time"`
+	Status            string             `json:"status"` // pending, materialized, cancelled
+	Rescheduled       bool               `json:"rescheduled"`
+	Reason            *string            `json:"reason,omitempty"`
+	Room              *room.RoomResponse `json:"room,omitempty"`
+}
+
+// OccurrenceListResponse represents the occurrences of a series in a time window
+type OccurrenceListResponse struct {
+	SeriesID    string                `json:"series_id"`
+	From        time.Time             `json:"from"`
+	To          time.Time             `json:"to"`
+	Occurrences []*OccurrenceResponse `json:"occurrences"`
+}
+
+// OccurrenceSummaryResponse represents the AI summary of a single occurrence
+type OccurrenceSummaryResponse struct {
+	Room             *room.RoomResponse    `json:"room"`
+	SummaryID        *string               `json:"summary_id,omitempty"`
+	ExecutiveSummary *string               `json:"executive_summary,omitempty"`
+	KeyPoints        []summaryDTO.KeyPoint `json:"key_points,omitempty"`
+	Decisions        []summaryDTO.Decision `json:"decisions,omitempty"`
+	Topics           []string              `json:"topics,omitempty"`
+	CreatedAt        *time.Time            `json:"created_at,omitempty"`
+}
+
+// SeriesSummariesResponse represents the summaries of all occurrences
+type SeriesSummariesResponse struct {
+	SeriesID  string                       `json:"series_id"`
+	Summaries []*OccurrenceSummaryResponse `json:"summaries"`
+}
+
+// SeriesActionItemsResponse represents the action items of all occurrences
+type SeriesActionItemsResponse struct {
+	SeriesID    string                     `json:"series_id"`
+	ActionItems []summaryDTO.ActionItemDTO `json:"action_items"`
+	Total       int                        `json:"total"`
+}
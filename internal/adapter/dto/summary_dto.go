@@ -20,6 +20,10 @@ type MeetingSummaryResponse struct {
 	ActionItems        []ActionItemDTO        `json:"action_items"`
 	SentimentBreakdown map[string]interface{} `json:"sentiment_breakdown"`
 	EngagementMetrics  EngagementMetricsDTO   `json:"engagement_metrics"`
+	Attendees          []Attendee             `json:"attendees"`
+	Breakouts          []BreakoutSummary      `json:"breakouts,omitempty"`
+	Timeline           []TimelineEntry        `json:"timeline,omitempty"`
+	AgendaCoverage     []AgendaCoverage       `json:"agenda_coverage,omitempty"` // Only when the meeting had an agenda
 	CreatedAt          time.Time              `json:"created_at"`
 	UpdatedAt          time.Time              `json:"updated_at"`
 }
@@ -29,7 +33,8 @@ type KeyPoint struct {
 	Text             string `json:"text"`
 	TimestampSeconds int    `json:"timestamp_seconds"`
 	MentionedBy      string `json:"mentioned_by"`
-	Importance       string `json:"importance"` // high, medium, low
+	Importance       string `json:"importance"`            // high, medium, low
+	AgendaItem       int    `json:"agenda_item,omitempty"` // 1-based agenda item discussed
 }
 
 // Decision represents a decision made in the meeting
@@ -37,7 +42,8 @@ type Decision struct {
 	DecisionText     string `json:"decision_text"`
 	Owner            string `json:"owner"`
 	TimestampSeconds int    `json:"timestamp_seconds"`
-	Impact           string `json:"impact"` // high, medium, low
+	Impact           string `json:"impact"`                // high, medium, low
+	AgendaItem       int    `json:"agenda_item,omitempty"` // 1-based agenda item decided on
 }
 
 // NextStep represents a next step or follow-up
@@ -69,9 +75,19 @@ type ActionItemDTO struct {
 	DueDate             *time.Time `json:"due_date,omitempty"`
 	TranscriptReference string     `json:"transcript_reference,omitempty"`
 	TimestampInMeeting  int        `json:"timestamp_in_meeting,omitempty"`
+	AgendaItemID        *uuid.UUID `json:"agenda_item_id,omitempty"`
 	CreatedAt           time.Time  `json:"created_at"`
 }
 
+// AgendaCoverage tells whether an agenda item was discussed in the meeting
+type AgendaCoverage struct {
+	AgendaItem       int    `json:"agenda_item"` // 1-based number of the item
+	Title            string `json:"title"`
+	Covered          bool   `json:"covered"`
+	Summary          string `json:"summary,omitempty"`
+	TimestampSeconds int    `json:"timestamp_seconds,omitempty"`
+}
+
 // EngagementMetricsDTO represents engagement metrics
 type EngagementMetricsDTO struct {
 	TotalSpeakingTime       int     `json:"total_speaking_time_seconds"`
@@ -79,6 +95,38 @@ type EngagementMetricsDTO struct {
 	ParticipantBalanceScore float64 `json:"participant_balance_score"` // 0-1
 }
 
+// Attendee represents a participant who joined the meeting, registered user or guest
+type Attendee struct {
+	ParticipantID   uuid.UUID  `json:"participant_id"`
+	UserID          *uuid.UUID `json:"user_id,omitempty"`
+	DisplayName     string     `json:"display_name"`
+	Role            string     `json:"role"`
+	IsGuest         bool       `json:"is_guest"`
+	JoinedAt        time.Time  `json:"joined_at"`
+	LeftAt          *time.Time `json:"left_at,omitempty"`
+	DurationSeconds int        `json:"duration_seconds"`
+}
+
+// TimelineEntry represents something that happened during the meeting (e.g. a hand raise).
+// OffsetSeconds is relative to the start of the meeting, like transcript timestamps.
+type TimelineEntry struct {
+	Type          string     `json:"type"`
+	At            time.Time  `json:"at"`
+	OffsetSeconds int        `json:"offset_seconds"`
+	ParticipantID *uuid.UUID `json:"participant_id,omitempty"`
+	DisplayName   string     `json:"display_name,omitempty"`
+	Text          string     `json:"text"`
+}
+
+// BreakoutSummary represents a breakout room of the meeting and its own summary.
+// Summary is nil until the breakout's recording has been processed; Status tells why.
+type BreakoutSummary struct {
+	RoomID  uuid.UUID               `json:"room_id"`
+	Name    string                  `json:"name"`
+	Status  string                  `json:"status"`
+	Summary *MeetingSummaryResponse `json:"summary,omitempty"`
+}
+
 // ParticipantMetric represents metrics for a single participant
 type ParticipantMetric struct {
 	SpeakingTimeSeconds int     `json:"speaking_time_seconds"`
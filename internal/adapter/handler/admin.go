@@ -0,0 +1,558 @@
+package handler
+
+import (
+	stdErrors "errors"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+
+	"github.com/johnquangdev/meeting-assistant/errors"
+	adminDTO "github.com/johnquangdev/meeting-assistant/internal/adapter/dto/admin"
+	quotaDTO "github.com/johnquangdev/meeting-assistant/internal/adapter/dto/quota"
+	"github.com/johnquangdev/meeting-assistant/internal/adapter/dto/room"
+	"github.com/johnquangdev/meeting-assistant/internal/adapter/presenter"
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+	"github.com/johnquangdev/meeting-assistant/internal/domain/repositories"
+	adminUsecase "github.com/johnquangdev/meeting-assistant/internal/usecase/admin"
+	usecaseErrors "github.com/johnquangdev/meeting-assistant/internal/usecase/errors"
+)
+
+// maxAdminPageSize caps the page size of admin listings
+const maxAdminPageSize = 100
+
+// Admin handles platform administration HTTP requests (admins only)
+type Admin struct {
+	adminService adminUsecase.Service
+	logger       *zap.Logger
+}
+
+// NewAdminHandler creates a new admin handler
+func NewAdminHandler(adminService adminUsecase.Service, logger *zap.Logger) *Admin {
+	return &Admin{
+		adminService: adminService,
+		logger:       logger,
+	}
+}
+
+// GetOverview reports the state of the platform
+// @Summary      System overview
+// @Description  Reports users, active and scheduled rooms, queued and failed AI jobs, and the storage used by recordings (admins only)
+// @Tags         Admin
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  adminDTO.OverviewResponse  "System overview"
+// @Failure      401  {object}  map[string]interface{}     "User not authenticated"
+// @Failure      403  {object}  map[string]interface{}     "User is not an admin"
+// @Failure      500  {object}  map[string]interface{}     "Failed to build overview"
+// @Router       /admin/overview [get]
+func (h *Admin) GetOverview(c echo.Context) error {
+	overview, err := h.adminService.GetOverview(c.Request().Context())
+	if err != nil {
+		return HandleError(h.logger, c, mapAdminError(err))
+	}
+
+	return HandleSuccess(h.logger, c, presenter.ToOverviewResponse(overview))
+}
+
+// ListUsers searches and lists users
+// @Summary      List users
+// @Description  Searches users by email or name, with optional role and active filters (admins only)
+// @Tags         Admin
+// @Produce      json
+// @Security     BearerAuth
+// @Param        search     query     string  false  "Search by email or name"
+// @Param        role       query     string  false  "Role filter (admin/host/participant)"
+// @Param        is_active  query     bool    false  "Active filter"
+// @Param        page       query     int     false  "Page number (default: 1)"
+// @Param        page_size  query     int     false  "Items per page (default: 20, max: 100)"
+// @Success      200        {object}  adminDTO.UserListResponse  "List of users"
+// @Failure      400        {object}  map[string]interface{}     "Invalid filter"
+// @Failure      401        {object}  map[string]interface{}     "User not authenticated"
+// @Failure      403        {object}  map[string]interface{}     "User is not an admin"
+// @Router       /admin/users [get]
+func (h *Admin) ListUsers(c echo.Context) error {
+	page, pageSize := adminPagination(c)
+	filters := repositories.UserFilters{
+		Search: c.QueryParam("search"),
+		Limit:  pageSize,
+		Offset: (page - 1) * pageSize,
+	}
+
+	if raw := c.QueryParam("role"); raw != "" {
+		role := entities.UserRole(raw)
+		if !role.IsValid() {
+			return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid role").WithDetail("error", "role must be admin, host or participant"))
+		}
+		filters.Role = &role
+	}
+	if raw := c.QueryParam("is_active"); raw != "" {
+		active, err := strconv.ParseBool(raw)
+		if err != nil {
+			return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid is_active").WithDetail("error", "is_active must be true or false"))
+		}
+		filters.IsActive = &active
+	}
+
+	users, total, err := h.adminService.ListUsers(c.Request().Context(), filters)
+	if err != nil {
+		return HandleError(h.logger, c, mapAdminError(err))
+	}
+
+	return HandleSuccess(h.logger, c, presenter.ToAdminUserListResponse(users, total, page, pageSize))
+}
+
+// GetUser retrieves a user
+// @Summary      Get user
+// @Description  Retrieves a user with their role and status (admins only)
+// @Tags         Admin
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string                  true  "User ID (UUID)"
+// @Success      200  {object}  adminDTO.UserResponse   "User details"
+// @Failure      400  {object}  map[string]interface{}  "Invalid user ID"
+// @Failure      403  {object}  map[string]interface{}  "User is not an admin"
+// @Failure      404  {object}  map[string]interface{}  "User not found"
+// @Router       /admin/users/{id} [get]
+func (h *Admin) GetUser(c echo.Context) error {
+	userID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid user ID").WithDetail("error", "User ID must be a valid UUID"))
+	}
+
+	user, err := h.adminService.GetUser(c.Request().Context(), userID)
+	if err != nil {
+		return HandleError(h.logger, c, mapAdminError(err))
+	}
+
+	return HandleSuccess(h.logger, c, presenter.ToAdminUserResponse(user))
+}
+
+// UpdateUserRole changes the platform role of a user
+// @Summary      Change user role
+// @Description  Changes the platform role of a user (admins only). Admins cannot change their own role.
+// @Tags         Admin
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id       path      string                          true  "User ID (UUID)"
+// @Param        request  body      adminDTO.UpdateUserRoleRequest  true  "New role"
+// @Success      200      {object}  adminDTO.UserResponse           "Role changed"
+// @Failure      400      {object}  map[string]interface{}          "Invalid request"
+// @Failure      403      {object}  map[string]interface{}          "User is not an admin, or targets themselves"
+// @Failure      404      {object}  map[string]interface{}          "User not found"
+// @Router       /admin/users/{id}/role [patch]
+func (h *Admin) UpdateUserRole(c echo.Context) error {
+	adminID, userID, err := h.userParams(c)
+	if err != nil {
+		return HandleError(h.logger, c, err)
+	}
+
+	var req adminDTO.UpdateUserRoleRequest
+	if err := c.Bind(&req); err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid request body").WithDetail("error", err.Error()))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Validation failed").WithDetail("error", err.Error()))
+	}
+
+	user, err := h.adminService.UpdateUserRole(c.Request().Context(), adminID, userID, entities.UserRole(req.Role))
+	if err != nil {
+		return HandleError(h.logger, c, mapAdminError(err))
+	}
+
+	return HandleSuccess(h.logger, c, presenter.ToAdminUserResponse(user))
+}
+
+// AssignUserPlan changes the plan of the personal rooms of a user
+// @Summary      Change user plan
+// @Description  Changes the plan that caps the personal rooms of a user; an empty plan_code restores the default plan (admins only)
+// @Tags         Admin
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id       path      string                      true  "User ID (UUID)"
+// @Param        request  body      adminDTO.AssignPlanRequest  true  "New plan"
+// @Success      200      {object}  adminDTO.UserResponse       "Plan changed"
+// @Failure      400      {object}  map[string]interface{}      "Invalid request"
+// @Failure      403      {object}  map[string]interface{}      "User is not an admin"
+// @Failure      404      {object}  map[string]interface{}      "User or plan not found"
+// @Router       /admin/users/{id}/plan [put]
+func (h *Admin) AssignUserPlan(c echo.Context) error {
+	adminID, userID, err := h.userParams(c)
+	if err != nil {
+		return HandleError(h.logger, c, err)
+	}
+
+	var req adminDTO.AssignPlanRequest
+	if err := c.Bind(&req); err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid request body").WithDetail("error", err.Error()))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Validation failed").WithDetail("error", err.Error()))
+	}
+
+	user, err := h.adminService.AssignUserPlan(c.Request().Context(), adminID, userID, req.PlanCode)
+	if err != nil {
+		return HandleError(h.logger, c, mapAdminError(err))
+	}
+
+	return HandleSuccess(h.logger, c, presenter.ToAdminUserResponse(user))
+}
+
+// DeactivateUser deactivates a user and revokes their sessions
+// @Summary      Deactivate user
+// @Description  Deactivates a user and revokes all their sessions; they can no longer log in or refresh tokens (admins only)
+// @Tags         Admin
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string                  true  "User ID (UUID)"
+// @Success      200  {object}  adminDTO.UserResponse   "User deactivated"
+// @Failure      400  {object}  map[string]interface{}  "Invalid user ID"
+// @Failure      403  {object}  map[string]interface{}  "User is not an admin, or targets themselves"
+// @Failure      404  {object}  map[string]interface{}  "User not found"
+// @Router       /admin/users/{id}/deactivate [post]
+func (h *Admin) DeactivateUser(c echo.Context) error {
+	return h.setUserActive(c, false)
+}
+
+// ReactivateUser reactivates a deactivated user
+// @Summary      Reactivate user
+// @Description  Reactivates a deactivated user so they can log in again (admins only)
+// @Tags         Admin
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string                  true  "User ID (UUID)"
+// @Success      200  {object}  adminDTO.UserResponse   "User reactivated"
+// @Failure      400  {object}  map[string]interface{}  "Invalid user ID"
+// @Failure      403  {object}  map[string]interface{}  "User is not an admin, or targets themselves"
+// @Failure      404  {object}  map[string]interface{}  "User not found"
+// @Router       /admin/users/{id}/reactivate [post]
+func (h *Admin) ReactivateUser(c echo.Context) error {
+	return h.setUserActive(c, true)
+}
+
+func (h *Admin) setUserActive(c echo.Context, active bool) error {
+	adminID, userID, err := h.userParams(c)
+	if err != nil {
+		return HandleError(h.logger, c, err)
+	}
+
+	user, err := h.adminService.SetUserActive(c.Request().Context(), adminID, userID, active)
+	if err != nil {
+		return HandleError(h.logger, c, mapAdminError(err))
+	}
+
+	return HandleSuccess(h.logger, c, presenter.ToAdminUserResponse(user))
+}
+
+// ListRooms lists the rooms of every tenant
+// @Summary      List all rooms
+// @Description  Lists rooms across all organizations and personal rooms, with the same filters as GET /rooms (admins only)
+// @Tags         Admin
+// @Produce      json
+// @Security     BearerAuth
+// @Param        organization_id  query  string  false  "Only the rooms of this organization"
+// @Param        host_id          query  string  false  "Only the rooms of this host"
+// @Param        type             query  string  false  "Room type filter (public/private/scheduled)"
+// @Param        status           query  string  false  "Room status filter (scheduled/active/ended/cancelled)"
+// @Param        search           query  string  false  "Search by room name"
+// @Param        sort_by          query  string  false  "Sort field (created_at/started_at/name)"
+// @Param        sort_order       query  string  false  "Sort order (asc/desc)"
+// @Param        page             query  int     false  "Page number (default: 1)"
+// @Param        page_size        query  int     false  "Items per page (default: 20, max: 100)"
+// @Success      200              {object}  room.RoomListResponse   "List of rooms"
+// @Failure      400              {object}  map[string]interface{}  "Invalid filter"
+// @Failure      403              {object}  map[string]interface{}  "User is not an admin"
+// @Router       /admin/rooms [get]
+func (h *Admin) ListRooms(c echo.Context) error {
+	page, pageSize := adminPagination(c)
+	req := room.ListRoomsRequest{
+		Type:      c.QueryParam("type"),
+		Status:    c.QueryParam("status"),
+		Search:    c.QueryParam("search"),
+		Page:      page,
+		PageSize:  pageSize,
+		SortBy:    c.QueryParam("sort_by"),
+		SortOrder: c.QueryParam("sort_order"),
+	}
+	if err := c.Validate(&req); err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Validation failed").WithDetail("error", err.Error()))
+	}
+	filters := buildFilters(&req)
+
+	if raw := c.QueryParam("organization_id"); raw != "" {
+		orgID, err := uuid.Parse(raw)
+		if err != nil {
+			return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid organization ID").WithDetail("error", "Organization ID must be a valid UUID"))
+		}
+		filters.OrganizationID = &orgID
+	}
+	if raw := c.QueryParam("host_id"); raw != "" {
+		hostID, err := uuid.Parse(raw)
+		if err != nil {
+			return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid host ID").WithDetail("error", "Host ID must be a valid UUID"))
+		}
+		filters.HostID = &hostID
+	}
+
+	rooms, total, err := h.adminService.ListRooms(c.Request().Context(), filters)
+	if err != nil {
+		return HandleError(h.logger, c, mapAdminError(err))
+	}
+
+	return HandleSuccess(h.logger, c, presenter.ToRoomListResponse(rooms, total, page, pageSize))
+}
+
+// ForceEndRoom ends any room
+// @Summary      Force-end room
+// @Description  Ends a room whoever hosts it: everyone is disconnected and the room is marked as ended (admins only)
+// @Tags         Admin
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string                  true  "Room ID (UUID)"
+// @Success      200  {object}  map[string]interface{}  "Room ended"
+// @Failure      400  {object}  map[string]interface{}  "Invalid room ID"
+// @Failure      403  {object}  map[string]interface{}  "User is not an admin"
+// @Failure      404  {object}  map[string]interface{}  "Room not found"
+// @Failure      409  {object}  map[string]interface{}  "Room already ended"
+// @Router       /admin/rooms/{id}/end [post]
+func (h *Admin) ForceEndRoom(c echo.Context) error {
+	roomID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid room ID").WithDetail("error", "Room ID must be a valid UUID"))
+	}
+
+	adminID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return HandleError(h.logger, c, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated"))
+	}
+
+	if err := h.adminService.ForceEndRoom(c.Request().Context(), adminID, roomID); err != nil {
+		return HandleError(h.logger, c, mapAdminError(err))
+	}
+
+	return HandleSuccess(h.logger, c, map[string]interface{}{
+		"message": "Room ended successfully",
+	})
+}
+
+// ListPlans lists the available plans
+// @Summary      List plans
+// @Description  Lists the plans users and organizations can be assigned (admins only)
+// @Tags         Admin
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  quotaDTO.PlanListResponse  "List of plans"
+// @Failure      401  {object}  map[string]interface{}     "User not authenticated"
+// @Failure      403  {object}  map[string]interface{}     "User is not an admin"
+// @Router       /admin/plans [get]
+func (h *Admin) ListPlans(c echo.Context) error {
+	plans, err := h.adminService.ListPlans(c.Request().Context())
+	if err != nil {
+		return HandleError(h.logger, c, mapAdminError(err))
+	}
+
+	response := &quotaDTO.PlanListResponse{
+		Plans: presenter.ToPlanResponses(plans),
+	}
+	return HandleSuccess(h.logger, c, response)
+}
+
+// AssignOrganizationPlan changes the plan of an organization
+// @Summary      Change organization plan
+// @Description  Changes the plan that caps the rooms of an organization; an empty plan_code restores the default plan (admins only)
+// @Tags         Admin
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id       path      string                      true  "Organization ID (UUID)"
+// @Param        request  body      adminDTO.AssignPlanRequest  true  "New plan"
+// @Success      200      {object}  map[string]interface{}      "Plan changed"
+// @Failure      400      {object}  map[string]interface{}      "Invalid request"
+// @Failure      403      {object}  map[string]interface{}      "User is not an admin"
+// @Failure      404      {object}  map[string]interface{}      "Organization or plan not found"
+// @Router       /admin/organizations/{id}/plan [put]
+func (h *Admin) AssignOrganizationPlan(c echo.Context) error {
+	orgID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid organization ID").WithDetail("error", "Organization ID must be a valid UUID"))
+	}
+
+	adminID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return HandleError(h.logger, c, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated"))
+	}
+
+	var req adminDTO.AssignPlanRequest
+	if err := c.Bind(&req); err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid request body").WithDetail("error", err.Error()))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Validation failed").WithDetail("error", err.Error()))
+	}
+
+	if err := h.adminService.AssignOrganizationPlan(c.Request().Context(), adminID, orgID, req.PlanCode); err != nil {
+		return HandleError(h.logger, c, mapAdminError(err))
+	}
+
+	return HandleSuccess(h.logger, c, map[string]interface{}{
+		"organization_id": orgID.String(),
+		"plan_code":       req.PlanCode,
+	})
+}
+
+// ListAIJobs lists AI processing jobs
+// @Summary      List AI jobs
+// @Description  Lists AI jobs, newest first, optionally filtered by status and meeting (admins only)
+// @Tags         Admin
+// @Produce      json
+// @Security     BearerAuth
+// @Param        status      query     string  false  "Status filter (pending/submitted/transcript_ready/summarizing/processing/completed/failed/cancelled)"
+// @Param        meeting_id  query     string  false  "Only the jobs of this meeting"
+// @Param        page        query     int     false  "Page number (default: 1)"
+// @Param        page_size   query     int     false  "Items per page (default: 20, max: 100)"
+// @Success      200         {object}  adminDTO.AIJobListResponse  "List of AI jobs"
+// @Failure      400         {object}  map[string]interface{}      "Invalid filter"
+// @Failure      403         {object}  map[string]interface{}      "User is not an admin"
+// @Router       /admin/ai-jobs [get]
+func (h *Admin) ListAIJobs(c echo.Context) error {
+	page, pageSize := adminPagination(c)
+	filters := adminUsecase.AIJobFilters{
+		Status: entities.AIJobStatus(c.QueryParam("status")),
+		Limit:  pageSize,
+		Offset: (page - 1) * pageSize,
+	}
+	if raw := c.QueryParam("meeting_id"); raw != "" {
+		meetingID, err := uuid.Parse(raw)
+		if err != nil {
+			return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid meeting ID").WithDetail("error", "Meeting ID must be a valid UUID"))
+		}
+		filters.MeetingID = &meetingID
+	}
+
+	jobs, total, err := h.adminService.ListAIJobs(c.Request().Context(), filters)
+	if err != nil {
+		return HandleError(h.logger, c, mapAdminError(err))
+	}
+
+	return HandleSuccess(h.logger, c, presenter.ToAIJobListResponse(jobs, total, page, pageSize))
+}
+
+// GetAIJob retrieves an AI job
+// @Summary      Get AI job
+// @Description  Retrieves an AI job with its retries and last error (admins only)
+// @Tags         Admin
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string                  true  "AI job ID (UUID)"
+// @Success      200  {object}  adminDTO.AIJobResponse  "AI job details"
+// @Failure      400  {object}  map[string]interface{}  "Invalid job ID"
+// @Failure      403  {object}  map[string]interface{}  "User is not an admin"
+// @Failure      404  {object}  map[string]interface{}  "AI job not found"
+// @Router       /admin/ai-jobs/{id} [get]
+func (h *Admin) GetAIJob(c echo.Context) error {
+	jobID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid job ID").WithDetail("error", "Job ID must be a valid UUID"))
+	}
+
+	job, err := h.adminService.GetAIJob(c.Request().Context(), jobID)
+	if err != nil {
+		return HandleError(h.logger, c, mapAdminError(err))
+	}
+
+	return HandleSuccess(h.logger, c, presenter.ToAIJobResponse(job))
+}
+
+// RetryAIJob puts a failed or cancelled AI job back in the queue
+// @Summary      Retry AI job
+// @Description  Queues a failed or cancelled AI job again with a fresh retry budget. Jobs with a transcript only redo the summary (admins only).
+// @Tags         Admin
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string                  true  "AI job ID (UUID)"
+// @Success      200  {object}  adminDTO.AIJobResponse  "AI job queued"
+// @Failure      400  {object}  map[string]interface{}  "Invalid job ID"
+// @Failure      403  {object}  map[string]interface{}  "User is not an admin"
+// @Failure      404  {object}  map[string]interface{}  "AI job not found"
+// @Failure      409  {object}  map[string]interface{}  "AI job is not failed or cancelled"
+// @Router       /admin/ai-jobs/{id}/retry [post]
+func (h *Admin) RetryAIJob(c echo.Context) error {
+	jobID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid job ID").WithDetail("error", "Job ID must be a valid UUID"))
+	}
+
+	adminID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return HandleError(h.logger, c, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated"))
+	}
+
+	job, err := h.adminService.RetryAIJob(c.Request().Context(), adminID, jobID)
+	if err != nil {
+		return HandleError(h.logger, c, mapAdminError(err))
+	}
+
+	return HandleSuccess(h.logger, c, presenter.ToAIJobResponse(job))
+}
+
+// userParams extracts the current admin and the user ID path parameter
+func (h *Admin) userParams(c echo.Context) (uuid.UUID, uuid.UUID, error) {
+	userID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return uuid.Nil, uuid.Nil, errors.ErrInvalidArgument("Invalid user ID").WithDetail("error", "User ID must be a valid UUID")
+	}
+
+	adminID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return uuid.Nil, uuid.Nil, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated")
+	}
+
+	return adminID, userID, nil
+}
+
+// adminPagination reads the page and page_size query parameters (defaults 1 and 20)
+func adminPagination(c echo.Context) (int, int) {
+	page, _ := strconv.Atoi(c.QueryParam("page"))
+	if page < 1 {
+		page = 1
+	}
+	pageSize, _ := strconv.Atoi(c.QueryParam("page_size"))
+	if pageSize < 1 {
+		pageSize = 20
+	}
+	if pageSize > maxAdminPageSize {
+		pageSize = maxAdminPageSize
+	}
+	return page, pageSize
+}
+
+// mapAdminError maps admin use case errors to API errors
+func mapAdminError(err error) error {
+	switch {
+	case stdErrors.Is(err, usecaseErrors.ErrUserNotFound):
+		return errors.ErrNotFound("User")
+	case stdErrors.Is(err, usecaseErrors.ErrRoomNotFound):
+		return errors.ErrNotFound("Room")
+	case stdErrors.Is(err, usecaseErrors.ErrAIJobNotFound):
+		return errors.ErrNotFound("AI job")
+	case stdErrors.Is(err, usecaseErrors.ErrOrganizationNotFound):
+		return errors.ErrNotFound("Organization")
+	case stdErrors.Is(err, usecaseErrors.ErrPlanNotFound):
+		return errors.ErrNotFound("Plan")
+	case stdErrors.Is(err, usecaseErrors.ErrCannotModifySelf):
+		return errors.ErrForbidden(err.Error())
+	case stdErrors.Is(err, usecaseErrors.ErrInvalidUserRole):
+		return errors.ErrInvalidArgument(err.Error())
+	case stdErrors.Is(err, usecaseErrors.ErrRoomEnded),
+		stdErrors.Is(err, usecaseErrors.ErrAIJobNotRetryable):
+		return errors.ErrFailedPrecondition(err.Error())
+	default:
+		return errors.ErrInternal(err)
+	}
+}
@@ -0,0 +1,168 @@
+package handler
+
+import (
+	stdErrors "errors"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/johnquangdev/meeting-assistant/errors"
+	"github.com/johnquangdev/meeting-assistant/internal/adapter/dto/room"
+	"github.com/johnquangdev/meeting-assistant/internal/adapter/presenter"
+	usecaseErrors "github.com/johnquangdev/meeting-assistant/internal/usecase/errors"
+	roomUsecase "github.com/johnquangdev/meeting-assistant/internal/usecase/room"
+)
+
+// GetAgenda handles GET /rooms/:id/agenda
+// @Summary      Get the agenda
+// @Description  Returns the agenda of a room in order, with owners, time boxes and the item being discussed
+// @Tags         Agenda
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "Room ID (UUID)"
+// @Success      200  {object}  room.AgendaResponse     "Agenda"
+// @Failure      400  {object}  map[string]interface{}  "Invalid room ID"
+// @Failure      401  {object}  map[string]interface{}  "User not authenticated"
+// @Failure      404  {object}  map[string]interface{}  "Room not found"
+// @Failure      500  {object}  map[string]interface{}  "Failed to get agenda"
+// @Router       /rooms/{id}/agenda [get]
+func (h *Room) GetAgenda(c echo.Context) error {
+	roomID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return h.handleError(c, errors.ErrInvalidArgument("Invalid room ID").WithDetail("error", "Room ID must be a valid UUID"))
+	}
+
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return h.handleError(c, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated"))
+	}
+
+	r, items, err := h.roomService.GetAgenda(c.Request().Context(), roomID, userID)
+	if err != nil {
+		return h.handleError(c, mapAgendaError(err))
+	}
+
+	return h.handleSuccess(c, presenter.ToAgendaResponse(r, items))
+}
+
+// SetAgenda handles PUT /rooms/:id/agenda
+// @Summary      Set the agenda
+// @Description  Replaces the agenda of a room with the given items, in order (host or co-host). Items sent with their id are kept along with their timing, new items are added and missing ones removed. Editable until the meeting ends; announced as an agenda.updated room event. The AI summary maps the discussion, decisions and action items to the agenda and flags items that were never covered.
+// @Tags         Agenda
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id       path      string                 true  "Room ID (UUID)"
+// @Param        request  body      room.SetAgendaRequest  true  "Agenda"
+// @Success      200      {object}  room.AgendaResponse     "Updated agenda"
+// @Failure      400      {object}  map[string]interface{}  "Invalid room ID or agenda"
+// @Failure      401      {object}  map[string]interface{}  "User not authenticated"
+// @Failure      403      {object}  map[string]interface{}  "User may not manage the agenda"
+// @Failure      404      {object}  map[string]interface{}  "Room or agenda item not found"
+// @Failure      409      {object}  map[string]interface{}  "Room has ended"
+// @Failure      500      {object}  map[string]interface{}  "Failed to set agenda"
+// @Router       /rooms/{id}/agenda [put]
+func (h *Room) SetAgenda(c echo.Context) error {
+	roomID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return h.handleError(c, errors.ErrInvalidArgument("Invalid room ID").WithDetail("error", "Room ID must be a valid UUID"))
+	}
+
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return h.handleError(c, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated"))
+	}
+
+	var req room.SetAgendaRequest
+	if err := c.Bind(&req); err != nil {
+		return h.handleError(c, errors.ErrInvalidArgument("Invalid request body"))
+	}
+	if err := c.Validate(&req); err != nil {
+		return h.handleError(c, errors.ErrInvalidArgument("Validation failed").WithDetail("error", err.Error()))
+	}
+
+	inputs := make([]roomUsecase.AgendaItemInput, len(req.Items))
+	for i, item := range req.Items {
+		inputs[i] = roomUsecase.AgendaItemInput{
+			ID:              parseOptionalUUID(item.ID),
+			Title:           item.Title,
+			Description:     item.Description,
+			OwnerID:         parseOptionalUUID(item.OwnerID),
+			DurationMinutes: item.DurationMinutes,
+		}
+	}
+
+	r, items, err := h.roomService.SetAgenda(c.Request().Context(), roomID, userID, inputs)
+	if err != nil {
+		return h.handleError(c, mapAgendaError(err))
+	}
+
+	return h.handleSuccess(c, presenter.ToAgendaResponse(r, items))
+}
+
+// SetCurrentAgendaItem handles PUT /rooms/:id/agenda/current
+// @Summary      Move to an agenda item
+// @Description  Marks an agenda item as being discussed, ending the previous one (host or co-host, while the meeting runs). Omit item_id to leave the agenda. Announced as an agenda.item_started room event; the times are passed to the AI summary.
+// @Tags         Agenda
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id       path      string                            true  "Room ID (UUID)"
+// @Param        request  body      room.SetCurrentAgendaItemRequest  true  "Agenda item"
+// @Success      200      {object}  room.AgendaResponse               "Agenda"
+// @Failure      400      {object}  map[string]interface{}            "Invalid room ID or item ID"
+// @Failure      401      {object}  map[string]interface{}            "User not authenticated"
+// @Failure      403      {object}  map[string]interface{}            "User may not manage the agenda"
+// @Failure      404      {object}  map[string]interface{}            "Room or agenda item not found"
+// @Failure      409      {object}  map[string]interface{}            "Room is not active"
+// @Failure      500      {object}  map[string]interface{}            "Failed to change the current agenda item"
+// @Router       /rooms/{id}/agenda/current [put]
+func (h *Room) SetCurrentAgendaItem(c echo.Context) error {
+	roomID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return h.handleError(c, errors.ErrInvalidArgument("Invalid room ID").WithDetail("error", "Room ID must be a valid UUID"))
+	}
+
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return h.handleError(c, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated"))
+	}
+
+	var req room.SetCurrentAgendaItemRequest
+	if err := c.Bind(&req); err != nil {
+		return h.handleError(c, errors.ErrInvalidArgument("Invalid request body"))
+	}
+	if err := c.Validate(&req); err != nil {
+		return h.handleError(c, errors.ErrInvalidArgument("Validation failed").WithDetail("error", err.Error()))
+	}
+
+	r, items, err := h.roomService.SetCurrentAgendaItem(c.Request().Context(), roomID, userID, parseOptionalUUID(req.ItemID))
+	if err != nil {
+		return h.handleError(c, mapAgendaError(err))
+	}
+
+	return h.handleSuccess(c, presenter.ToAgendaResponse(r, items))
+}
+
+// parseOptionalUUID converts an optional string validated as a UUID
+func parseOptionalUUID(raw *string) *uuid.UUID {
+	if raw == nil {
+		return nil
+	}
+	id := uuid.MustParse(*raw) // Validated as a UUID
+	return &id
+}
+
+// mapAgendaError maps agenda use case errors to API errors
+func mapAgendaError(err error) error {
+	switch {
+	case stdErrors.Is(err, usecaseErrors.ErrAgendaItemNotFound):
+		return errors.ErrNotFound("Agenda item")
+	case stdErrors.Is(err, usecaseErrors.ErrInvalidAgenda):
+		return errors.ErrInvalidArgument(err.Error())
+	case stdErrors.Is(err, usecaseErrors.ErrRoomNotActive):
+		return errors.ErrFailedPrecondition(err.Error())
+	default:
+		return mapParticipantError(err)
+	}
+}
@@ -1,11 +1,14 @@
 package handler
 
 import (
+	stdErrors "errors"
+
 	"github.com/labstack/echo/v4"
 	"go.uber.org/zap"
 
 	"github.com/johnquangdev/meeting-assistant/errors"
 	aiuse "github.com/johnquangdev/meeting-assistant/internal/usecase/ai"
+	usecaseErrors "github.com/johnquangdev/meeting-assistant/internal/usecase/errors"
 )
 
 // AIController handles API endpoints that trigger AI processing
@@ -36,10 +39,12 @@ func NewAIController(svc aiuse.Service, logger *zap.Logger) *AIController {
 // @Produce      json
 // @Security     BearerAuth
 // @Param        id       path      string                                true  "Meeting ID (UUID)"
-// @Param        request  body      object{recording_url=string}          true  "Recording URL for processing"
+// @Param        request  body      object{recording_url=string}          true  "Object key of the recording in the recordings bucket, or URL of a file of that bucket"
 // @Success      202      {object}  map[string]interface{}                "Processing started successfully"
-// @Failure      400      {object}  map[string]interface{}                "Missing recording_url or invalid meeting ID"
+// @Failure      400      {object}  map[string]interface{}                "Missing recording_url, recording outside the recordings bucket or invalid meeting ID"
 // @Failure      401      {object}  map[string]interface{}                "User not authenticated"
+// @Failure      409      {object}  map[string]interface{}                "Transcription disabled in room settings"
+// @Failure      429      {object}  map[string]interface{}                "AI processing minutes of the plan are used up"
 // @Failure      500      {object}  map[string]interface{}                "Failed to start processing"
 // @Router       /meetings/{id}/process-ai [post]
 func (ac *AIController) ProcessMeeting(c echo.Context) error {
@@ -54,6 +59,15 @@ func (ac *AIController) ProcessMeeting(c echo.Context) error {
 		return HandleError(ac.logger, c, errors.ErrMissingRecordingURL())
 	}
 	if err := ac.svc.StartProcessing(c.Request().Context(), meetingID, req.RecordingURL); err != nil {
+		if stdErrors.Is(err, usecaseErrors.ErrTranscriptionDisabled) {
+			return HandleError(ac.logger, c, errors.ErrFailedPrecondition("Transcription is disabled for this room"))
+		}
+		if stdErrors.Is(err, usecaseErrors.ErrRecordingNotInBucket) {
+			return HandleError(ac.logger, c, errors.ErrInvalidArgument("Recording is not stored in the recordings bucket"))
+		}
+		if stdErrors.Is(err, usecaseErrors.ErrAIMinutesExhausted) {
+			return HandleError(ac.logger, c, errors.ErrAIQuotaExceeded().WithDetail("error", err.Error()))
+		}
 		if ac.logger != nil {
 			ac.logger.Error("failed to start processing", zap.Error(err))
 		}
@@ -0,0 +1,123 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/johnquangdev/meeting-assistant/errors"
+	"github.com/johnquangdev/meeting-assistant/internal/adapter/dto/room"
+	"github.com/johnquangdev/meeting-assistant/internal/adapter/presenter"
+)
+
+// GetAttendance handles GET /rooms/:id/attendance
+// @Summary      Get the attendance report
+// @Description  Lists who attended the meeting, when they joined and left (every reconnection is a session), how long they stayed and how late they arrived or how early they left, compared with the scheduled (or actual) times. Invited participants who never joined are listed as absent. Set format=csv or format=json to download the report as a file (host or co-host).
+// @Tags         Rooms
+// @Produce      json
+// @Produce      text/csv
+// @Security     BearerAuth
+// @Param        id      path      string  true   "Room ID (UUID)"
+// @Param        format  query     string  false  "Download format: csv or json"
+// @Success      200     {object}  room.AttendanceReportResponse  "Attendance report"
+// @Failure      400     {object}  map[string]interface{}         "Invalid room ID or format"
+// @Failure      401     {object}  map[string]interface{}         "User not authenticated"
+// @Failure      403     {object}  map[string]interface{}         "User is not the host or a co-host"
+// @Failure      404     {object}  map[string]interface{}         "Room not found"
+// @Failure      500     {object}  map[string]interface{}         "Failed to get attendance"
+// @Router       /rooms/{id}/attendance [get]
+func (h *Room) GetAttendance(c echo.Context) error {
+	roomID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return h.handleError(c, errors.ErrInvalidArgument("Invalid room ID").WithDetail("error", "Room ID must be a valid UUID"))
+	}
+
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return h.handleError(c, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated"))
+	}
+
+	format := c.QueryParam("format")
+	if format != "" && format != "csv" && format != "json" {
+		return h.handleError(c, errors.ErrInvalidArgument("Invalid format").WithDetail("error", "Format must be csv or json"))
+	}
+
+	r, attendances, err := h.roomService.GetAttendance(c.Request().Context(), roomID, userID)
+	if err != nil {
+		return h.handleError(c, mapParticipantError(err))
+	}
+
+	report := presenter.ToAttendanceReportResponse(r, attendances, time.Now())
+	filename := fmt.Sprintf("attendance-%s.%s", r.ID, format)
+
+	switch format {
+	case "csv":
+		data, err := attendanceCSV(report)
+		if err != nil {
+			return h.handleError(c, errors.ErrInternal(err).WithDetail("error", "Failed to build attendance report"))
+		}
+		c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", filename))
+		return c.Blob(http.StatusOK, "text/csv; charset=utf-8", data)
+	case "json":
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return h.handleError(c, errors.ErrInternal(err).WithDetail("error", "Failed to build attendance report"))
+		}
+		c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", filename))
+		return c.Blob(http.StatusOK, echo.MIMEApplicationJSONCharsetUTF8, data)
+	}
+
+	return h.handleSuccess(c, report)
+}
+
+// attendanceCSV writes an attendance report as CSV, one row per attendee
+func attendanceCSV(report *room.AttendanceReportResponse) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	header := []string{
+		"participant_id", "user_id", "name", "email", "role", "is_guest", "attended", "sessions",
+		"first_joined_at", "last_left_at", "total_seconds", "late_seconds", "early_leave_seconds",
+	}
+	if err := w.Write(header); err != nil {
+		return nil, err
+	}
+
+	formatTime := func(t *time.Time) string {
+		if t == nil {
+			return ""
+		}
+		return t.UTC().Format(time.RFC3339)
+	}
+
+	for _, a := range report.Attendees {
+		row := []string{
+			a.ParticipantID,
+			a.UserID,
+			a.DisplayName,
+			a.Email,
+			a.Role,
+			strconv.FormatBool(a.IsGuest),
+			strconv.FormatBool(a.Attended),
+			strconv.Itoa(a.SessionCount),
+			formatTime(a.FirstJoinedAt),
+			formatTime(a.LastLeftAt),
+			strconv.Itoa(a.TotalSeconds),
+			strconv.Itoa(a.LateSeconds),
+			strconv.Itoa(a.EarlyLeaveSeconds),
+		}
+		if err := w.Write(row); err != nil {
+			return nil, err
+		}
+	}
+
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
@@ -0,0 +1,362 @@
+package handler
+
+import (
+	stdErrors "errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+
+	"github.com/johnquangdev/meeting-assistant/errors"
+	"github.com/johnquangdev/meeting-assistant/internal/adapter/dto/room"
+	"github.com/johnquangdev/meeting-assistant/internal/adapter/presenter"
+	breakoutUsecase "github.com/johnquangdev/meeting-assistant/internal/usecase/breakout"
+	usecaseErrors "github.com/johnquangdev/meeting-assistant/internal/usecase/errors"
+	roomUsecase "github.com/johnquangdev/meeting-assistant/internal/usecase/room"
+)
+
+// Breakout handles HTTP requests of breakout rooms
+type Breakout struct {
+	breakoutService breakoutUsecase.Service
+	roomService     roomUsecase.Service
+	logger          *zap.Logger
+}
+
+// NewBreakoutHandler creates a new breakout handler
+func NewBreakoutHandler(breakoutService breakoutUsecase.Service, roomService roomUsecase.Service, logger *zap.Logger) *Breakout {
+	return &Breakout{
+		breakoutService: breakoutService,
+		roomService:     roomService,
+		logger:          logger,
+	}
+}
+
+// CreateBreakouts handles POST /rooms/:id/breakouts
+// @Summary      Open breakout rooms
+// @Description  Opens breakout rooms for an active meeting. Each breakout room is a room of its own (LiveKit room, recording and summary) linked to the meeting; its summary rolls up into the meeting summary. Give either a count or the list of names.
+// @Tags         Breakouts
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id       path      string                       true  "Room ID"
+// @Param        request  body      room.CreateBreakoutsRequest  true  "Number or names of the breakout rooms"
+// @Success      200      {object}  room.BreakoutsResponse       "Breakout rooms opened"
+// @Failure      400      {object}  map[string]interface{}       "Invalid request"
+// @Failure      403      {object}  map[string]interface{}       "Only the host can open breakout rooms"
+// @Failure      404      {object}  map[string]interface{}       "Room not found"
+// @Failure      409      {object}  map[string]interface{}       "Room not active or breakout rooms already open"
+// @Failure      500      {object}  map[string]interface{}       "Failed to open breakout rooms"
+// @Router       /rooms/{id}/breakouts [post]
+func (h *Breakout) CreateBreakouts(c echo.Context) error {
+	roomID, userID, err := h.parseRoomAndUser(c)
+	if err != nil {
+		return HandleError(h.logger, c, err)
+	}
+
+	var req room.CreateBreakoutsRequest
+	if err := c.Bind(&req); err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid request body").WithDetail("error", err.Error()))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Validation failed").WithDetail("error", err.Error()))
+	}
+
+	overview, err := h.breakoutService.CreateBreakouts(c.Request().Context(), breakoutUsecase.CreateBreakoutsInput{
+		ParentRoomID: roomID,
+		HostID:       userID,
+		Count:        req.Count,
+		Names:        req.Names,
+	})
+	if err != nil {
+		return HandleError(h.logger, c, mapBreakoutError(err))
+	}
+
+	return HandleSuccess(h.logger, c, presenter.ToBreakoutsResponse(overview))
+}
+
+// ListBreakouts handles GET /rooms/:id/breakouts
+// @Summary      List breakout rooms
+// @Description  Gets the open breakout rooms of a meeting with the participants assigned to each, and the participants still unassigned in the main room (host only)
+// @Tags         Breakouts
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string                  true  "Room ID"
+// @Success      200  {object}  room.BreakoutsResponse  "Breakout rooms"
+// @Failure      400  {object}  map[string]interface{}  "Invalid room ID"
+// @Failure      403  {object}  map[string]interface{}  "Only the host can list breakout rooms"
+// @Failure      404  {object}  map[string]interface{}  "Room not found"
+// @Failure      500  {object}  map[string]interface{}  "Failed to list breakout rooms"
+// @Router       /rooms/{id}/breakouts [get]
+func (h *Breakout) ListBreakouts(c echo.Context) error {
+	roomID, userID, err := h.parseRoomAndUser(c)
+	if err != nil {
+		return HandleError(h.logger, c, err)
+	}
+
+	overview, err := h.breakoutService.ListBreakouts(c.Request().Context(), roomID, userID)
+	if err != nil {
+		return HandleError(h.logger, c, mapBreakoutError(err))
+	}
+
+	return HandleSuccess(h.logger, c, presenter.ToBreakoutsResponse(overview))
+}
+
+// AssignParticipants handles PUT /rooms/:id/breakouts/assignments
+// @Summary      Assign participants to breakout rooms
+// @Description  Moves participants of the meeting to breakout rooms, or back to the main room with a null breakout_room_id. Participants taken out of a breakout room are disconnected from it; everyone is notified on the "breakout" data topic and fetches their room from /rooms/{id}/breakouts/me.
+// @Tags         Breakouts
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id       path      string                       true  "Room ID"
+// @Param        request  body      room.AssignBreakoutsRequest  true  "Assignments"
+// @Success      200      {object}  room.BreakoutsResponse       "Participants assigned"
+// @Failure      400      {object}  map[string]interface{}       "Invalid request"
+// @Failure      403      {object}  map[string]interface{}       "Only the host can assign participants"
+// @Failure      404      {object}  map[string]interface{}       "Room, breakout room or participant not found"
+// @Failure      409      {object}  map[string]interface{}       "No open breakout rooms or participant cannot be assigned"
+// @Failure      500      {object}  map[string]interface{}       "Failed to assign participants"
+// @Router       /rooms/{id}/breakouts/assignments [put]
+func (h *Breakout) AssignParticipants(c echo.Context) error {
+	roomID, userID, err := h.parseRoomAndUser(c)
+	if err != nil {
+		return HandleError(h.logger, c, err)
+	}
+
+	var req room.AssignBreakoutsRequest
+	if err := c.Bind(&req); err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid request body").WithDetail("error", err.Error()))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Validation failed").WithDetail("error", err.Error()))
+	}
+
+	assignments := make([]breakoutUsecase.Assignment, len(req.Assignments))
+	for i, a := range req.Assignments {
+		participantID, err := uuid.Parse(a.ParticipantID)
+		if err != nil {
+			return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid participant ID").WithDetail("error", "Participant ID must be a valid UUID"))
+		}
+		assignments[i].ParticipantID = participantID
+
+		if a.BreakoutRoomID != nil {
+			breakoutRoomID, err := uuid.Parse(*a.BreakoutRoomID)
+			if err != nil {
+				return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid breakout room ID").WithDetail("error", "Breakout room ID must be a valid UUID"))
+			}
+			assignments[i].BreakoutRoomID = &breakoutRoomID
+		}
+	}
+
+	overview, err := h.breakoutService.AssignParticipants(c.Request().Context(), breakoutUsecase.AssignInput{
+		ParentRoomID: roomID,
+		HostID:       userID,
+		Assignments:  assignments,
+	})
+	if err != nil {
+		return HandleError(h.logger, c, mapBreakoutError(err))
+	}
+
+	return HandleSuccess(h.logger, c, presenter.ToBreakoutsResponse(overview))
+}
+
+// AssignRandomly handles POST /rooms/:id/breakouts/assignments/random
+// @Summary      Assign participants randomly
+// @Description  Spreads the unassigned participants of the main room evenly across the open breakout rooms. Hosts and co-hosts stay in the main room.
+// @Tags         Breakouts
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string                  true  "Room ID"
+// @Success      200  {object}  room.BreakoutsResponse  "Participants assigned"
+// @Failure      400  {object}  map[string]interface{}  "Invalid room ID"
+// @Failure      403  {object}  map[string]interface{}  "Only the host can assign participants"
+// @Failure      404  {object}  map[string]interface{}  "Room not found"
+// @Failure      409  {object}  map[string]interface{}  "No open breakout rooms"
+// @Failure      500  {object}  map[string]interface{}  "Failed to assign participants"
+// @Router       /rooms/{id}/breakouts/assignments/random [post]
+func (h *Breakout) AssignRandomly(c echo.Context) error {
+	roomID, userID, err := h.parseRoomAndUser(c)
+	if err != nil {
+		return HandleError(h.logger, c, err)
+	}
+
+	overview, err := h.breakoutService.AssignRandomly(c.Request().Context(), roomID, userID)
+	if err != nil {
+		return HandleError(h.logger, c, mapBreakoutError(err))
+	}
+
+	return HandleSuccess(h.logger, c, presenter.ToBreakoutsResponse(overview))
+}
+
+// StartTimer handles POST /rooms/:id/breakouts/timer
+// @Summary      Broadcast a breakout timer
+// @Description  Broadcasts a countdown on the "breakout" data topic to the main room and every breakout room. Breakout rooms stay open until the host closes them.
+// @Tags         Breakouts
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id       path      string                          true  "Room ID"
+// @Param        request  body      room.StartBreakoutTimerRequest  true  "Timer duration"
+// @Success      200      {object}  room.BreakoutTimerResponse      "Timer started"
+// @Failure      400      {object}  map[string]interface{}          "Invalid request"
+// @Failure      403      {object}  map[string]interface{}          "Only the host can start the timer"
+// @Failure      404      {object}  map[string]interface{}          "Room not found"
+// @Failure      409      {object}  map[string]interface{}          "No open breakout rooms"
+// @Failure      500      {object}  map[string]interface{}          "Failed to start timer"
+// @Router       /rooms/{id}/breakouts/timer [post]
+func (h *Breakout) StartTimer(c echo.Context) error {
+	roomID, userID, err := h.parseRoomAndUser(c)
+	if err != nil {
+		return HandleError(h.logger, c, err)
+	}
+
+	var req room.StartBreakoutTimerRequest
+	if err := c.Bind(&req); err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid request body").WithDetail("error", err.Error()))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Validation failed").WithDetail("error", err.Error()))
+	}
+
+	duration := time.Duration(req.DurationSeconds) * time.Second
+	endsAt, err := h.breakoutService.StartTimer(c.Request().Context(), roomID, userID, duration)
+	if err != nil {
+		return HandleError(h.logger, c, mapBreakoutError(err))
+	}
+
+	return HandleSuccess(h.logger, c, &room.BreakoutTimerResponse{
+		EndsAt:           endsAt,
+		SecondsRemaining: req.DurationSeconds,
+	})
+}
+
+// CloseBreakouts handles DELETE /rooms/:id/breakouts
+// @Summary      Close breakout rooms
+// @Description  Ends every breakout room and brings everyone back to the main room. Breakout recordings are finalized and their summaries roll up into the meeting summary.
+// @Tags         Breakouts
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string                  true  "Room ID"
+// @Success      200  {object}  map[string]interface{}  "Breakout rooms closed"
+// @Failure      400  {object}  map[string]interface{}  "Invalid room ID"
+// @Failure      403  {object}  map[string]interface{}  "Only the host can close breakout rooms"
+// @Failure      404  {object}  map[string]interface{}  "Room not found"
+// @Failure      409  {object}  map[string]interface{}  "No open breakout rooms"
+// @Failure      500  {object}  map[string]interface{}  "Failed to close breakout rooms"
+// @Router       /rooms/{id}/breakouts [delete]
+func (h *Breakout) CloseBreakouts(c echo.Context) error {
+	roomID, userID, err := h.parseRoomAndUser(c)
+	if err != nil {
+		return HandleError(h.logger, c, err)
+	}
+
+	if err := h.breakoutService.CloseBreakouts(c.Request().Context(), roomID, userID); err != nil {
+		return HandleError(h.logger, c, mapBreakoutError(err))
+	}
+
+	return HandleSuccess(h.logger, c, map[string]interface{}{
+		"message": "Breakout rooms closed",
+	})
+}
+
+// GetMyDestination handles GET /rooms/:id/breakouts/me
+// @Summary      Get my breakout room
+// @Description  Returns the room the current user should be in (their breakout room, or the main room) with a LiveKit token for it. Poll it when a notice arrives on the "breakout" data topic.
+// @Tags         Breakouts
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string                            true  "Room ID of the meeting"
+// @Success      200  {object}  room.BreakoutDestinationResponse  "Room to connect to"
+// @Failure      400  {object}  map[string]interface{}            "Invalid room ID"
+// @Failure      404  {object}  map[string]interface{}            "Room not found or not a participant"
+// @Failure      409  {object}  map[string]interface{}            "Meeting ended or participant not admitted"
+// @Failure      500  {object}  map[string]interface{}            "Failed to get breakout room"
+// @Router       /rooms/{id}/breakouts/me [get]
+func (h *Breakout) GetMyDestination(c echo.Context) error {
+	roomID, userID, err := h.parseRoomAndUser(c)
+	if err != nil {
+		return HandleError(h.logger, c, err)
+	}
+
+	destination, err := h.breakoutService.GetUserDestination(c.Request().Context(), roomID, userID)
+	if err != nil {
+		return HandleError(h.logger, c, mapBreakoutError(err))
+	}
+
+	return HandleSuccess(h.logger, c, presenter.ToBreakoutDestinationResponse(destination, h.roomService.GetLivekitURL()))
+}
+
+// GetGuestDestination handles GET /guest/me/breakout
+// @Summary      Get the guest's breakout room
+// @Description  Returns the room the current guest should be in (their breakout room, or the main room) with a LiveKit token for it
+// @Tags         Guests
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  room.BreakoutDestinationResponse  "Room to connect to"
+// @Failure      401  {object}  map[string]interface{}            "Missing or invalid guest token"
+// @Failure      404  {object}  map[string]interface{}            "Guest no longer exists"
+// @Failure      409  {object}  map[string]interface{}            "Meeting ended or guest not admitted"
+// @Failure      500  {object}  map[string]interface{}            "Failed to get breakout room"
+// @Router       /guest/me/breakout [get]
+func (h *Breakout) GetGuestDestination(c echo.Context) error {
+	roomID, participantID, err := currentGuest(c)
+	if err != nil {
+		return HandleError(h.logger, c, err)
+	}
+
+	destination, err := h.breakoutService.GetGuestDestination(c.Request().Context(), roomID, participantID)
+	if err != nil {
+		return HandleError(h.logger, c, mapBreakoutError(err))
+	}
+
+	return HandleSuccess(h.logger, c, presenter.ToBreakoutDestinationResponse(destination, h.roomService.GetLivekitURL()))
+}
+
+// parseRoomAndUser reads the room ID path parameter and the authenticated user
+func (h *Breakout) parseRoomAndUser(c echo.Context) (uuid.UUID, uuid.UUID, error) {
+	roomID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return uuid.Nil, uuid.Nil, errors.ErrInvalidArgument("Invalid room ID").WithDetail("error", "Room ID must be a valid UUID")
+	}
+
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return uuid.Nil, uuid.Nil, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated")
+	}
+
+	return roomID, userID, nil
+}
+
+// mapBreakoutError maps breakout use case errors to API errors
+func mapBreakoutError(err error) error {
+	switch {
+	case stdErrors.Is(err, usecaseErrors.ErrRoomNotFound):
+		return errors.ErrNotFound("Room")
+	case stdErrors.Is(err, usecaseErrors.ErrBreakoutNotFound):
+		return errors.ErrNotFound("Breakout room")
+	case stdErrors.Is(err, usecaseErrors.ErrParticipantNotFound),
+		stdErrors.Is(err, usecaseErrors.ErrNotParticipant):
+		return errors.ErrNotFound("Participant")
+	case stdErrors.Is(err, usecaseErrors.ErrNotHost):
+		return errors.ErrNotHost()
+	case stdErrors.Is(err, usecaseErrors.ErrInvalidBreakoutCount),
+		stdErrors.Is(err, usecaseErrors.ErrInvalidBreakoutTimer):
+		return errors.ErrInvalidArgument(err.Error())
+	case stdErrors.Is(err, usecaseErrors.ErrBreakoutsAlreadyOpen),
+		stdErrors.Is(err, usecaseErrors.ErrNoOpenBreakouts),
+		stdErrors.Is(err, usecaseErrors.ErrNestedBreakout),
+		stdErrors.Is(err, usecaseErrors.ErrRoomNotActive),
+		stdErrors.Is(err, usecaseErrors.ErrRoomEnded),
+		stdErrors.Is(err, usecaseErrors.ErrParticipantNotAssigned),
+		stdErrors.Is(err, usecaseErrors.ErrInvalidParticipantStatus):
+		return errors.ErrFailedPrecondition(err.Error())
+	case stdErrors.Is(err, usecaseErrors.ErrQuotaExceeded):
+		return errors.ErrResourceExhausted(err.Error())
+	default:
+		return errors.ErrInternal(err)
+	}
+}
@@ -0,0 +1,230 @@
+package handler
+
+import (
+	stdErrors "errors"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/johnquangdev/meeting-assistant/errors"
+	"github.com/johnquangdev/meeting-assistant/internal/adapter/dto/room"
+	"github.com/johnquangdev/meeting-assistant/internal/adapter/presenter"
+	usecaseErrors "github.com/johnquangdev/meeting-assistant/internal/usecase/errors"
+)
+
+// ListChatMessages handles GET /rooms/:id/chat
+// @Summary      List chat messages
+// @Description  Pages through the meeting chat, newest page first and messages oldest first within a page. Pass next_before of a page as before to get the previous one. Available to everyone who is or was in the meeting, also after it ended. Deleted messages are listed without their content.
+// @Tags         Chat
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id      path      string  true   "Room ID (UUID)"
+// @Param        before  query     string  false  "Only messages posted before this time (RFC 3339)"
+// @Param        limit   query     int     false  "Messages per page (default 50, max 100)"
+// @Success      200     {object}  room.ChatMessageListResponse  "Chat messages"
+// @Failure      400     {object}  map[string]interface{}        "Invalid room ID or before time"
+// @Failure      401     {object}  map[string]interface{}        "User not authenticated"
+// @Failure      404     {object}  map[string]interface{}        "Participant not found"
+// @Failure      409     {object}  map[string]interface{}        "User was never in the meeting"
+// @Failure      500     {object}  map[string]interface{}        "Failed to list chat messages"
+// @Router       /rooms/{id}/chat [get]
+func (h *Room) ListChatMessages(c echo.Context) error {
+	roomID, participantID, err := h.chatParticipant(c)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	before, limit, err := parseChatPage(c)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	messages, hasMore, err := h.roomService.ListChatMessages(c.Request().Context(), roomID, participantID, before, limit)
+	if err != nil {
+		return h.handleError(c, mapChatError(err))
+	}
+
+	return h.handleSuccess(c, presenter.ToChatMessageListResponse(messages, hasMore))
+}
+
+// PostChatMessage handles POST /rooms/:id/chat
+// @Summary      Post a chat message
+// @Description  Posts a message in the meeting chat and delivers it as a chat.message room event. Requires enable_chat and being in the meeting. Chat messages are included in the AI summary.
+// @Tags         Chat
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id       path      string                       true  "Room ID (UUID)"
+// @Param        request  body      room.PostChatMessageRequest  true  "Message"
+// @Success      200      {object}  room.ChatMessageResponse     "Posted message"
+// @Failure      400      {object}  map[string]interface{}       "Invalid room ID or message"
+// @Failure      401      {object}  map[string]interface{}       "User not authenticated"
+// @Failure      403      {object}  map[string]interface{}       "Chat is disabled for this room"
+// @Failure      404      {object}  map[string]interface{}       "Participant or replied message not found"
+// @Failure      409      {object}  map[string]interface{}       "Room has ended or user is not in the meeting"
+// @Failure      500      {object}  map[string]interface{}       "Failed to post chat message"
+// @Router       /rooms/{id}/chat [post]
+func (h *Room) PostChatMessage(c echo.Context) error {
+	roomID, participantID, err := h.chatParticipant(c)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	var req room.PostChatMessageRequest
+	if err := c.Bind(&req); err != nil {
+		return h.handleError(c, errors.ErrInvalidArgument("Invalid request body"))
+	}
+	if err := c.Validate(&req); err != nil {
+		return h.handleError(c, errors.ErrInvalidArgument("Validation failed").WithDetail("error", err.Error()))
+	}
+
+	message, err := h.roomService.PostChatMessage(c.Request().Context(), roomID, participantID, req.Content, parseOptionalUUID(req.ReplyToID))
+	if err != nil {
+		return h.handleError(c, mapChatError(err))
+	}
+
+	return h.handleSuccess(c, presenter.ToChatMessageResponse(message))
+}
+
+// EditChatMessage handles PATCH /rooms/:id/chat/:mid
+// @Summary      Edit a chat message
+// @Description  Replaces the content of one of your own messages while the meeting is running
+// @Tags         Chat
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id       path      string                       true  "Room ID (UUID)"
+// @Param        mid      path      string                       true  "Message ID (UUID)"
+// @Param        request  body      room.EditChatMessageRequest  true  "New content"
+// @Success      200      {object}  room.ChatMessageResponse     "Edited message"
+// @Failure      400      {object}  map[string]interface{}       "Invalid room ID, message ID or content"
+// @Failure      401      {object}  map[string]interface{}       "User not authenticated"
+// @Failure      403      {object}  map[string]interface{}       "User is not the author"
+// @Failure      404      {object}  map[string]interface{}       "Participant or message not found"
+// @Failure      409      {object}  map[string]interface{}       "Room has ended or message was deleted"
+// @Failure      500      {object}  map[string]interface{}       "Failed to edit chat message"
+// @Router       /rooms/{id}/chat/{mid} [patch]
+func (h *Room) EditChatMessage(c echo.Context) error {
+	roomID, participantID, err := h.chatParticipant(c)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	messageID, err := uuid.Parse(c.Param("mid"))
+	if err != nil {
+		return h.handleError(c, errors.ErrInvalidArgument("Invalid message ID").WithDetail("error", "Message ID must be a valid UUID"))
+	}
+
+	var req room.EditChatMessageRequest
+	if err := c.Bind(&req); err != nil {
+		return h.handleError(c, errors.ErrInvalidArgument("Invalid request body"))
+	}
+	if err := c.Validate(&req); err != nil {
+		return h.handleError(c, errors.ErrInvalidArgument("Validation failed").WithDetail("error", err.Error()))
+	}
+
+	message, err := h.roomService.EditChatMessage(c.Request().Context(), roomID, participantID, messageID, req.Content)
+	if err != nil {
+		return h.handleError(c, mapChatError(err))
+	}
+
+	return h.handleSuccess(c, presenter.ToChatMessageResponse(message))
+}
+
+// DeleteChatMessage handles DELETE /rooms/:id/chat/:mid
+// @Summary      Delete a chat message
+// @Description  Deletes one of your own messages, or anyone's as host or co-host (moderation). The message stays in the chat as a placeholder and is left out of the AI summary.
+// @Tags         Chat
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "Room ID (UUID)"
+// @Param        mid  path      string  true  "Message ID (UUID)"
+// @Success      200  {object}  room.ChatMessageResponse  "Deleted message"
+// @Failure      400  {object}  map[string]interface{}    "Invalid room ID or message ID"
+// @Failure      401  {object}  map[string]interface{}    "User not authenticated"
+// @Failure      403  {object}  map[string]interface{}    "User may not delete this message"
+// @Failure      404  {object}  map[string]interface{}    "Participant or message not found"
+// @Failure      500  {object}  map[string]interface{}    "Failed to delete chat message"
+// @Router       /rooms/{id}/chat/{mid} [delete]
+func (h *Room) DeleteChatMessage(c echo.Context) error {
+	roomID, participantID, err := h.chatParticipant(c)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	messageID, err := uuid.Parse(c.Param("mid"))
+	if err != nil {
+		return h.handleError(c, errors.ErrInvalidArgument("Invalid message ID").WithDetail("error", "Message ID must be a valid UUID"))
+	}
+
+	message, err := h.roomService.DeleteChatMessage(c.Request().Context(), roomID, participantID, messageID)
+	if err != nil {
+		return h.handleError(c, mapChatError(err))
+	}
+
+	return h.handleSuccess(c, presenter.ToChatMessageResponse(message))
+}
+
+// chatParticipant resolves the room and the participant record of the current user
+func (h *Room) chatParticipant(c echo.Context) (uuid.UUID, uuid.UUID, error) {
+	roomID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return uuid.Nil, uuid.Nil, errors.ErrInvalidArgument("Invalid room ID").WithDetail("error", "Room ID must be a valid UUID")
+	}
+
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return uuid.Nil, uuid.Nil, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated")
+	}
+
+	// Rooms of another tenant are not found, not even by people removed from them
+	if _, err := h.roomService.GetRoomForUser(c.Request().Context(), roomID, userID); err != nil {
+		return uuid.Nil, uuid.Nil, mapParticipantError(err)
+	}
+
+	participant, err := h.roomService.GetParticipantByRoomAndUser(c.Request().Context(), roomID, userID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, errors.ErrNotFound("Participant")
+	}
+
+	return roomID, participant.ID, nil
+}
+
+// parseChatPage reads the before and limit query parameters of a chat page
+func parseChatPage(c echo.Context) (*time.Time, int, error) {
+	var before *time.Time
+	if raw := c.QueryParam("before"); raw != "" {
+		t, err := time.Parse(time.RFC3339Nano, raw)
+		if err != nil {
+			return nil, 0, errors.ErrInvalidArgument("Invalid before time").WithDetail("error", "before must be an RFC 3339 time")
+		}
+		before = &t
+	}
+
+	limit, _ := strconv.Atoi(c.QueryParam("limit"))
+	if limit < 1 || limit > 100 {
+		limit = 50
+	}
+
+	return before, limit, nil
+}
+
+// mapChatError maps chat use case errors to API errors
+func mapChatError(err error) error {
+	switch {
+	case stdErrors.Is(err, usecaseErrors.ErrChatMessageNotFound):
+		return errors.ErrNotFound("Chat message")
+	case stdErrors.Is(err, usecaseErrors.ErrChatDisabled),
+		stdErrors.Is(err, usecaseErrors.ErrNotMessageAuthor):
+		return errors.ErrForbidden(err.Error())
+	case stdErrors.Is(err, usecaseErrors.ErrChatMessageEmpty),
+		stdErrors.Is(err, usecaseErrors.ErrChatMessageTooLong):
+		return errors.ErrInvalidArgument(err.Error())
+	case stdErrors.Is(err, usecaseErrors.ErrChatMessageDeleted):
+		return errors.ErrFailedPrecondition(err.Error())
+	default:
+		return mapParticipantError(err)
+	}
+}
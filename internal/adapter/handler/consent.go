@@ -0,0 +1,215 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	stdErrors "errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/johnquangdev/meeting-assistant/errors"
+	"github.com/johnquangdev/meeting-assistant/internal/adapter/dto/room"
+	"github.com/johnquangdev/meeting-assistant/internal/adapter/presenter"
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+	usecaseErrors "github.com/johnquangdev/meeting-assistant/internal/usecase/errors"
+	roomUsecase "github.com/johnquangdev/meeting-assistant/internal/usecase/room"
+)
+
+// GetRecordingConsent handles GET /rooms/:id/recording/consent
+// @Summary      Get my recording consent
+// @Description  Tells whether the current user must consent to be recorded in this room, to which policy version, and whether (and when) they did.
+// @Tags         Recordings
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "Room ID (UUID)"
+// @Success      200  {object}  room.RecordingConsentResponse  "Recording consent status"
+// @Failure      400  {object}  map[string]interface{}         "Invalid room ID"
+// @Failure      401  {object}  map[string]interface{}         "User not authenticated"
+// @Failure      404  {object}  map[string]interface{}         "User is not a participant of this room"
+// @Failure      500  {object}  map[string]interface{}         "Failed to get recording consent"
+// @Router       /rooms/{id}/recording/consent [get]
+func (h *Room) GetRecordingConsent(c echo.Context) error {
+	roomID, participantID, err := h.chatParticipant(c)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	status, err := h.roomService.GetRecordingConsent(c.Request().Context(), roomID, participantID)
+	if err != nil {
+		return h.handleError(c, mapParticipantError(err))
+	}
+
+	return h.handleSuccess(c, presenter.ToRecordingConsentResponse(status))
+}
+
+// GiveRecordingConsent handles POST /rooms/:id/recording/consent
+// @Summary      Consent to be recorded
+// @Description  Records that the current user consents to be recorded under the given policy version, with the time, IP address and user agent for audits.
+// @Description  In rooms requiring consent, recording is paused (or does not start) until everyone in the meeting consented to the current policy.
+// @Tags         Recordings
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id       path      string                            true  "Room ID (UUID)"
+// @Param        request  body      room.GiveRecordingConsentRequest  true  "Policy version shown to the user"
+// @Success      200      {object}  room.RecordingConsentResponse     "Consent recorded"
+// @Failure      400      {object}  map[string]interface{}            "Invalid room ID or request"
+// @Failure      401      {object}  map[string]interface{}            "User not authenticated"
+// @Failure      404      {object}  map[string]interface{}            "User is not a participant of this room"
+// @Failure      409      {object}  map[string]interface{}            "Room has ended, user is not in the meeting or policy version is outdated"
+// @Failure      500      {object}  map[string]interface{}            "Failed to record consent"
+// @Router       /rooms/{id}/recording/consent [post]
+func (h *Room) GiveRecordingConsent(c echo.Context) error {
+	roomID, participantID, err := h.chatParticipant(c)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	var req room.GiveRecordingConsentRequest
+	if err := c.Bind(&req); err != nil {
+		return h.handleError(c, errors.ErrInvalidArgument("Invalid request body").WithDetail("error", err.Error()))
+	}
+	if err := c.Validate(&req); err != nil {
+		return h.handleError(c, errors.ErrInvalidArgument("Validation failed").WithDetail("error", err.Error()))
+	}
+
+	status, err := h.roomService.GiveRecordingConsent(c.Request().Context(), roomUsecase.GiveRecordingConsentInput{
+		RoomID:        roomID,
+		ParticipantID: participantID,
+		PolicyVersion: req.PolicyVersion,
+		ClientIP:      c.RealIP(),
+		UserAgent:     c.Request().UserAgent(),
+	})
+	if err != nil {
+		return h.handleError(c, mapConsentError(err, h.roomService.GetRecordingConsentPolicy()))
+	}
+
+	return h.handleSuccess(c, presenter.ToRecordingConsentResponse(status))
+}
+
+// ExportRecordingConsents handles GET /rooms/:id/recording/consents
+// @Summary      Export the recording consents
+// @Description  Lists who consented to be recorded in the meeting, when and to which policy version, for audits (host or co-host). Set format=csv or format=json to download the report as a file.
+// @Tags         Recordings
+// @Produce      json
+// @Produce      text/csv
+// @Security     BearerAuth
+// @Param        id      path      string  true   "Room ID (UUID)"
+// @Param        format  query     string  false  "Download format: csv or json"
+// @Success      200     {object}  room.RecordingConsentReportResponse  "Recording consents"
+// @Failure      400     {object}  map[string]interface{}               "Invalid room ID or format"
+// @Failure      401     {object}  map[string]interface{}               "User not authenticated"
+// @Failure      403     {object}  map[string]interface{}               "User is not the host or a co-host"
+// @Failure      404     {object}  map[string]interface{}               "Room not found"
+// @Failure      500     {object}  map[string]interface{}               "Failed to get recording consents"
+// @Router       /rooms/{id}/recording/consents [get]
+func (h *Room) ExportRecordingConsents(c echo.Context) error {
+	roomID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return h.handleError(c, errors.ErrInvalidArgument("Invalid room ID").WithDetail("error", "Room ID must be a valid UUID"))
+	}
+
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return h.handleError(c, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated"))
+	}
+
+	format := c.QueryParam("format")
+	if format != "" && format != "csv" && format != "json" {
+		return h.handleError(c, errors.ErrInvalidArgument("Invalid format").WithDetail("error", "Format must be csv or json"))
+	}
+
+	r, consents, err := h.roomService.ListRecordingConsents(c.Request().Context(), roomID, userID)
+	if err != nil {
+		return h.handleError(c, mapParticipantError(err))
+	}
+
+	report := presenter.ToRecordingConsentReportResponse(r, consents, h.roomService.GetRecordingConsentPolicy(), time.Now())
+	filename := fmt.Sprintf("recording-consents-%s.%s", r.ID, format)
+
+	switch format {
+	case "csv":
+		data, err := recordingConsentCSV(report)
+		if err != nil {
+			return h.handleError(c, errors.ErrInternal(err).WithDetail("error", "Failed to build recording consent report"))
+		}
+		c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", filename))
+		return c.Blob(http.StatusOK, "text/csv; charset=utf-8", data)
+	case "json":
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return h.handleError(c, errors.ErrInternal(err).WithDetail("error", "Failed to build recording consent report"))
+		}
+		c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", filename))
+		return c.Blob(http.StatusOK, echo.MIMEApplicationJSONCharsetUTF8, data)
+	}
+
+	return h.handleSuccess(c, report)
+}
+
+// recordingConsentCSV writes a recording consent report as CSV, one row per consent
+func recordingConsentCSV(report *room.RecordingConsentReportResponse) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	header := []string{
+		"consent_id", "participant_id", "user_id", "name", "email", "is_guest",
+		"policy_version", "consented_at", "ip_address", "user_agent",
+	}
+	if err := w.Write(header); err != nil {
+		return nil, err
+	}
+
+	for _, consent := range report.Consents {
+		row := []string{
+			consent.ID,
+			consent.ParticipantID,
+			consent.UserID,
+			consent.DisplayName,
+			consent.Email,
+			strconv.FormatBool(consent.IsGuest),
+			consent.PolicyVersion,
+			consent.ConsentedAt.UTC().Format(time.RFC3339),
+			consent.IPAddress,
+			consent.UserAgent,
+		}
+		if err := w.Write(row); err != nil {
+			return nil, err
+		}
+	}
+
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+// recordingConsentPrompt returns the consent to ask a participant who just entered the meeting for;
+// nil when the participant is not in the meeting or has nothing to consent to
+func recordingConsentPrompt(ctx context.Context, roomService roomUsecase.Service, participant *entities.Participant) (*room.RecordingConsentResponse, error) {
+	if participant.Status != entities.ParticipantStatusJoined {
+		return nil, nil
+	}
+
+	status, err := roomService.GetRecordingConsent(ctx, participant.RoomID, participant.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !status.Pending() {
+		return nil, nil
+	}
+	return presenter.ToRecordingConsentResponse(status), nil
+}
+
+// mapConsentError maps recording consent use case errors to API errors
+func mapConsentError(err error, policyVersion string) error {
+	if stdErrors.Is(err, usecaseErrors.ErrConsentPolicyOutdated) {
+		return errors.ErrFailedPrecondition(err.Error()).WithDetail("policy_version", policyVersion)
+	}
+	return mapParticipantError(err)
+}
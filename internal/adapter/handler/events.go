@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+	roomUsecase "github.com/johnquangdev/meeting-assistant/internal/usecase/room"
+)
+
+// eventStreamHeartbeat keeps idle event streams open through proxies
+const eventStreamHeartbeat = 25 * time.Second
+
+// eventSubscriber is the viewer of a room event stream
+type eventSubscriber struct {
+	participantID uuid.UUID // Participant record of the viewer (uuid.Nil when there is none)
+	moderator     bool      // Host or co-host: receives lobby events of everyone
+}
+
+// streamRoomEvents writes the room's events to the client as Server-Sent Events until it disconnects.
+// The stream ends after the viewer is denied or removed.
+func streamRoomEvents(c echo.Context, roomService roomUsecase.Service, roomID uuid.UUID, subscriber eventSubscriber, logger *zap.Logger) error {
+	events, unsubscribe := roomService.SubscribeEvents(roomID)
+	defer unsubscribe()
+
+	w := c.Response()
+	w.Header().Set(echo.HeaderContentType, "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no") // Disable nginx response buffering
+	w.WriteHeader(http.StatusOK)
+
+	// Tell the client the subscription is live so it can stop polling
+	if _, err := fmt.Fprintf(w, "event: ready\ndata: {\"room_id\":%q}\n\n", roomID); err != nil {
+		return nil
+	}
+	w.Flush()
+
+	heartbeat := time.NewTicker(eventStreamHeartbeat)
+	defer heartbeat.Stop()
+
+	ctx := c.Request().Context()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return nil
+			}
+			w.Flush()
+
+		case event, ok := <-events:
+			if !ok {
+				return nil // Broker shut down
+			}
+			if !event.VisibleTo(subscriber.participantID, subscriber.moderator) {
+				continue
+			}
+
+			payload, err := json.Marshal(event)
+			if err != nil {
+				if logger != nil {
+					logger.Error("failed to encode room event", zap.String("type", string(event.Type)), zap.Error(err))
+				}
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", event.ID, event.Type, payload); err != nil {
+				return nil
+			}
+			w.Flush()
+
+			if endsSubscription(event, subscriber) {
+				return nil
+			}
+		}
+	}
+}
+
+// endsSubscription reports whether the event takes the viewer out of the room
+func endsSubscription(event *entities.RoomEvent, subscriber eventSubscriber) bool {
+	if event.ParticipantID == nil || *event.ParticipantID != subscriber.participantID {
+		return false
+	}
+	return event.Type == entities.RoomEventParticipantDenied || event.Type == entities.RoomEventParticipantRemoved
+}
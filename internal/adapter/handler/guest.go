@@ -0,0 +1,596 @@
+package handler
+
+import (
+	stdErrors "errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+
+	"github.com/johnquangdev/meeting-assistant/errors"
+	"github.com/johnquangdev/meeting-assistant/internal/adapter/dto/room"
+	"github.com/johnquangdev/meeting-assistant/internal/adapter/presenter"
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+	usecaseErrors "github.com/johnquangdev/meeting-assistant/internal/usecase/errors"
+	guestUsecase "github.com/johnquangdev/meeting-assistant/internal/usecase/guest"
+	roomUsecase "github.com/johnquangdev/meeting-assistant/internal/usecase/room"
+)
+
+// Guest handles HTTP requests of guests joining without an account
+type Guest struct {
+	guestService guestUsecase.Service
+	roomService  roomUsecase.Service
+	logger       *zap.Logger
+}
+
+// NewGuestHandler creates a new guest handler
+func NewGuestHandler(guestService guestUsecase.Service, roomService roomUsecase.Service, logger *zap.Logger) *Guest {
+	return &Guest{
+		guestService: guestService,
+		roomService:  roomService,
+		logger:       logger,
+	}
+}
+
+// Join lets a guest join a room with a display name
+// @Summary      Join a room as a guest
+// @Description  Joins a room without an account using a display name and either the room slug (room must allow guests) or an invitation token. Returns a short-lived guest token for the /guest endpoints and, once admitted, a LiveKit token restricted to camera and microphone.
+// @Tags         Guests
+// @Accept       json
+// @Produce      json
+// @Param        request  body      room.GuestJoinRequest   true  "Display name and room slug or invitation token"
+// @Success      200      {object}  room.GuestJoinResponse  "Joined or waiting for host approval"
+// @Failure      400      {object}  map[string]interface{}  "Invalid request"
+// @Failure      403      {object}  map[string]interface{}  "Guests are not allowed, passcode missing or incorrect, or room is locked"
+// @Failure      404      {object}  map[string]interface{}  "Room or invitation not found"
+// @Failure      409      {object}  map[string]interface{}  "Room ended, full or invitation no longer valid"
+// @Failure      429      {object}  map[string]interface{}  "Too many incorrect passcode attempts"
+// @Failure      500      {object}  map[string]interface{}  "Failed to join room"
+// @Router       /guest/join [post]
+func (h *Guest) Join(c echo.Context) error {
+	var req room.GuestJoinRequest
+	if err := c.Bind(&req); err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid request body").WithDetail("error", err.Error()))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Validation failed").WithDetail("error", err.Error()))
+	}
+
+	output, err := h.guestService.Join(c.Request().Context(), guestUsecase.JoinInput{
+		DisplayName: req.DisplayName,
+		RoomSlug:    req.RoomSlug,
+		InviteToken: req.InviteToken,
+		Passcode:    req.Passcode,
+		ClientIP:    c.RealIP(),
+	})
+	if err != nil {
+		return HandleError(h.logger, c, mapGuestError(err))
+	}
+
+	response := &room.GuestJoinResponse{
+		Status:              "waiting",
+		Message:             "You are in the waiting room. Waiting for host approval.",
+		Room:                presenter.ToRoomResponse(output.Room),
+		Participant:         presenter.ToParticipantResponse(output.Participant),
+		GuestToken:          output.GuestToken,
+		GuestTokenExpiresAt: output.GuestExpiresAt,
+	}
+	switch {
+	case output.LivekitToken != "":
+		response.Status = "joined"
+		response.Message = "Successfully joined the room"
+		response.LivekitToken = output.LivekitToken
+		response.LivekitURL = h.roomService.GetLivekitURL()
+		if response.RecordingConsent, err = recordingConsentPrompt(c.Request().Context(), h.roomService, output.Participant); err != nil {
+			return HandleError(h.logger, c, errors.ErrInternal(err))
+		}
+	case output.Participant.Status == entities.ParticipantStatusWaitlisted:
+		if response.WaitlistPosition, err = h.roomService.WaitlistPosition(c.Request().Context(), output.Participant); err != nil {
+			return HandleError(h.logger, c, errors.ErrInternal(err))
+		}
+		response.Status = "waitlisted"
+		response.Message = waitlistMessage(response.WaitlistPosition)
+	}
+
+	return HandleSuccess(h.logger, c, response)
+}
+
+// GetStatus returns the status of the current guest (for polling)
+// @Summary      Get guest status
+// @Description  Polls the guest's participant status. Returns a LiveKit token once the host has admitted the guest.
+// @Tags         Guests
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  room.ParticipantStatusResponse  "Current guest status"
+// @Failure      401  {object}  map[string]interface{}          "Missing or invalid guest token"
+// @Failure      404  {object}  map[string]interface{}          "Guest was denied or no longer exists"
+// @Failure      500  {object}  map[string]interface{}          "Failed to get status"
+// @Router       /guest/me [get]
+func (h *Guest) GetStatus(c echo.Context) error {
+	roomID, participantID, err := currentGuest(c)
+	if err != nil {
+		return HandleError(h.logger, c, err)
+	}
+
+	output, err := h.guestService.GetStatus(c.Request().Context(), roomID, participantID)
+	if err != nil {
+		return HandleError(h.logger, c, mapGuestError(err))
+	}
+
+	position, err := h.roomService.WaitlistPosition(c.Request().Context(), output.Participant)
+	if err != nil {
+		return HandleError(h.logger, c, errors.ErrInternal(err))
+	}
+
+	var message string
+	switch output.Participant.Status {
+	case entities.ParticipantStatusWaiting:
+		message = "You are in the waiting room. Please wait for host approval."
+	case entities.ParticipantStatusWaitlisted:
+		message = waitlistMessage(position)
+	case entities.ParticipantStatusJoined:
+		message = "You have been admitted to the room."
+	case entities.ParticipantStatusDenied, entities.ParticipantStatusRemoved:
+		message = "You have been removed from this room."
+	case entities.ParticipantStatusLeft:
+		message = "You have left the room."
+	default:
+		message = fmt.Sprintf("Current status: %s", output.Participant.Status)
+	}
+
+	response := &room.ParticipantStatusResponse{
+		Status:           string(output.Participant.Status),
+		Message:          message,
+		Room:             presenter.ToRoomResponse(output.Room),
+		Participant:      presenter.ToParticipantResponse(output.Participant),
+		WaitlistPosition: position,
+		LivekitToken:     output.LivekitToken,
+	}
+	if output.LivekitToken != "" {
+		response.LivekitURL = h.roomService.GetLivekitURL()
+	}
+	if response.RecordingConsent, err = recordingConsentPrompt(c.Request().Context(), h.roomService, output.Participant); err != nil {
+		return HandleError(h.logger, c, errors.ErrInternal(err))
+	}
+
+	return HandleSuccess(h.logger, c, response)
+}
+
+// GetRecordingConsent returns whether the current guest must consent to be recorded
+// @Summary      Get my recording consent as a guest
+// @Description  Tells whether the guest must consent to be recorded (see GET /rooms/{id}/recording/consent)
+// @Tags         Guests
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  room.RecordingConsentResponse  "Recording consent status"
+// @Failure      401  {object}  map[string]interface{}         "Missing or invalid guest token"
+// @Failure      404  {object}  map[string]interface{}         "Guest no longer exists"
+// @Failure      500  {object}  map[string]interface{}         "Failed to get recording consent"
+// @Router       /guest/me/recording-consent [get]
+func (h *Guest) GetRecordingConsent(c echo.Context) error {
+	roomID, participantID, err := currentGuest(c)
+	if err != nil {
+		return HandleError(h.logger, c, err)
+	}
+
+	status, err := h.roomService.GetRecordingConsent(c.Request().Context(), roomID, participantID)
+	if err != nil {
+		return HandleError(h.logger, c, mapGuestError(err))
+	}
+
+	return HandleSuccess(h.logger, c, presenter.ToRecordingConsentResponse(status))
+}
+
+// GiveRecordingConsent records that the current guest consents to be recorded
+// @Summary      Consent to be recorded as a guest
+// @Description  Records the guest's consent to be recorded under the given policy version (see POST /rooms/{id}/recording/consent)
+// @Tags         Guests
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request  body      room.GiveRecordingConsentRequest  true  "Policy version shown to the guest"
+// @Success      200      {object}  room.RecordingConsentResponse     "Consent recorded"
+// @Failure      400      {object}  map[string]interface{}            "Invalid request"
+// @Failure      401      {object}  map[string]interface{}            "Missing or invalid guest token"
+// @Failure      404      {object}  map[string]interface{}            "Guest no longer exists"
+// @Failure      409      {object}  map[string]interface{}            "Room has ended, guest is not in the meeting or policy version is outdated"
+// @Failure      500      {object}  map[string]interface{}            "Failed to record consent"
+// @Router       /guest/me/recording-consent [post]
+func (h *Guest) GiveRecordingConsent(c echo.Context) error {
+	roomID, participantID, err := currentGuest(c)
+	if err != nil {
+		return HandleError(h.logger, c, err)
+	}
+
+	var req room.GiveRecordingConsentRequest
+	if err := c.Bind(&req); err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid request body").WithDetail("error", err.Error()))
+	}
+	if err := c.Validate(&req); err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Validation failed").WithDetail("error", err.Error()))
+	}
+
+	status, err := h.roomService.GiveRecordingConsent(c.Request().Context(), roomUsecase.GiveRecordingConsentInput{
+		RoomID:        roomID,
+		ParticipantID: participantID,
+		PolicyVersion: req.PolicyVersion,
+		ClientIP:      c.RealIP(),
+		UserAgent:     c.Request().UserAgent(),
+	})
+	if err != nil {
+		if stdErrors.Is(err, usecaseErrors.ErrConsentPolicyOutdated) {
+			return HandleError(h.logger, c, mapConsentError(err, h.roomService.GetRecordingConsentPolicy()))
+		}
+		return HandleError(h.logger, c, mapGuestError(err))
+	}
+
+	return HandleSuccess(h.logger, c, presenter.ToRecordingConsentResponse(status))
+}
+
+// Leave marks the current guest as left
+// @Summary      Leave the room as a guest
+// @Description  Marks the guest as left. The guest token stays valid for rejoining through an invitation.
+// @Tags         Guests
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  map[string]interface{}  "Successfully left the room"
+// @Failure      401  {object}  map[string]interface{}  "Missing or invalid guest token"
+// @Failure      404  {object}  map[string]interface{}  "Guest no longer exists"
+// @Failure      500  {object}  map[string]interface{}  "Failed to leave room"
+// @Router       /guest/me [delete]
+func (h *Guest) Leave(c echo.Context) error {
+	roomID, participantID, err := currentGuest(c)
+	if err != nil {
+		return HandleError(h.logger, c, err)
+	}
+
+	if err := h.guestService.Leave(c.Request().Context(), roomID, participantID); err != nil {
+		return HandleError(h.logger, c, mapGuestError(err))
+	}
+
+	return HandleSuccess(h.logger, c, map[string]interface{}{
+		"message": "Successfully left the room",
+	})
+}
+
+// StreamEvents streams the events of the guest's room
+// @Summary      Stream room events as a guest
+// @Description  Opens a Server-Sent Events stream of the guest's room (see GET /rooms/{id}/events). Lobby events are only
+// @Description  sent about the guest itself; the stream ends once the guest is denied or removed.
+// @Tags         Guests
+// @Produce      text/event-stream
+// @Security     BearerAuth
+// @Param        access_token  query  string  false  "Guest token (for EventSource clients that cannot set headers)"
+// @Success      200  {object}  entities.RoomEvent      "Stream of room events"
+// @Failure      401  {object}  map[string]interface{}  "Missing or invalid guest token"
+// @Failure      403  {object}  map[string]interface{}  "Guest was removed from the room"
+// @Failure      404  {object}  map[string]interface{}  "Guest was denied or no longer exists"
+// @Router       /guest/me/events [get]
+func (h *Guest) StreamEvents(c echo.Context) error {
+	roomID, participantID, err := currentGuest(c)
+	if err != nil {
+		return HandleError(h.logger, c, err)
+	}
+
+	output, err := h.guestService.GetStatus(c.Request().Context(), roomID, participantID)
+	if err != nil {
+		return HandleError(h.logger, c, mapGuestError(err))
+	}
+	if output.Participant.IsRemoved {
+		return HandleError(h.logger, c, errors.ErrForbidden("You have been removed from this room"))
+	}
+
+	return streamRoomEvents(c, h.roomService, roomID, eventSubscriber{participantID: participantID}, h.logger)
+}
+
+// RaiseHand raises the hand of the current guest
+// @Summary      Raise my hand as a guest
+// @Description  Puts the guest at the end of the room's speaking queue (see POST /rooms/{id}/participants/me/hand)
+// @Tags         Guests
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  room.ParticipantResponse  "Guest with its queue position"
+// @Failure      401  {object}  map[string]interface{}    "Missing or invalid guest token"
+// @Failure      404  {object}  map[string]interface{}    "Guest no longer exists"
+// @Failure      409  {object}  map[string]interface{}    "Room has ended or guest is not in the meeting"
+// @Failure      500  {object}  map[string]interface{}    "Failed to raise hand"
+// @Router       /guest/me/hand [post]
+func (h *Guest) RaiseHand(c echo.Context) error {
+	return h.setHand(c, true)
+}
+
+// LowerHand lowers the hand of the current guest
+// @Summary      Lower my hand as a guest
+// @Description  Takes the guest out of the room's speaking queue
+// @Tags         Guests
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  room.ParticipantResponse  "Updated guest"
+// @Failure      401  {object}  map[string]interface{}    "Missing or invalid guest token"
+// @Failure      404  {object}  map[string]interface{}    "Guest no longer exists"
+// @Failure      500  {object}  map[string]interface{}    "Failed to lower hand"
+// @Router       /guest/me/hand [delete]
+func (h *Guest) LowerHand(c echo.Context) error {
+	return h.setHand(c, false)
+}
+
+// setHand raises or lowers the hand of the current guest
+func (h *Guest) setHand(c echo.Context, raised bool) error {
+	roomID, participantID, err := currentGuest(c)
+	if err != nil {
+		return HandleError(h.logger, c, err)
+	}
+
+	var participant *entities.Participant
+	if raised {
+		participant, err = h.roomService.RaiseHand(c.Request().Context(), roomID, participantID)
+	} else {
+		participant, err = h.roomService.LowerHand(c.Request().Context(), roomID, participantID)
+	}
+	if err != nil {
+		return HandleError(h.logger, c, mapGuestError(err))
+	}
+
+	return HandleSuccess(h.logger, c, presenter.ToParticipantResponse(participant))
+}
+
+// ListChatMessages pages through the chat of the guest's meeting
+// @Summary      List chat messages as a guest
+// @Description  Pages through the meeting chat (see GET /rooms/{id}/chat)
+// @Tags         Guests
+// @Produce      json
+// @Security     BearerAuth
+// @Param        before  query     string  false  "Only messages posted before this time (RFC 3339)"
+// @Param        limit   query     int     false  "Messages per page (default 50, max 100)"
+// @Success      200     {object}  room.ChatMessageListResponse  "Chat messages"
+// @Failure      400     {object}  map[string]interface{}        "Invalid before time"
+// @Failure      401     {object}  map[string]interface{}        "Missing or invalid guest token"
+// @Failure      404     {object}  map[string]interface{}        "Guest no longer exists"
+// @Failure      409     {object}  map[string]interface{}        "Guest was never in the meeting"
+// @Failure      500     {object}  map[string]interface{}        "Failed to list chat messages"
+// @Router       /guest/me/chat [get]
+func (h *Guest) ListChatMessages(c echo.Context) error {
+	roomID, participantID, err := currentGuest(c)
+	if err != nil {
+		return HandleError(h.logger, c, err)
+	}
+
+	before, limit, err := parseChatPage(c)
+	if err != nil {
+		return HandleError(h.logger, c, err)
+	}
+
+	messages, hasMore, err := h.roomService.ListChatMessages(c.Request().Context(), roomID, participantID, before, limit)
+	if err != nil {
+		return HandleError(h.logger, c, mapChatError(err))
+	}
+
+	return HandleSuccess(h.logger, c, presenter.ToChatMessageListResponse(messages, hasMore))
+}
+
+// PostChatMessage posts a message in the chat of the guest's meeting
+// @Summary      Post a chat message as a guest
+// @Description  Posts a message in the meeting chat (see POST /rooms/{id}/chat)
+// @Tags         Guests
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request  body      room.PostChatMessageRequest  true  "Message"
+// @Success      200      {object}  room.ChatMessageResponse     "Posted message"
+// @Failure      400      {object}  map[string]interface{}       "Invalid message"
+// @Failure      401      {object}  map[string]interface{}       "Missing or invalid guest token"
+// @Failure      403      {object}  map[string]interface{}       "Chat is disabled for this room"
+// @Failure      404      {object}  map[string]interface{}       "Guest or replied message not found"
+// @Failure      409      {object}  map[string]interface{}       "Room has ended or guest is not in the meeting"
+// @Failure      500      {object}  map[string]interface{}       "Failed to post chat message"
+// @Router       /guest/me/chat [post]
+func (h *Guest) PostChatMessage(c echo.Context) error {
+	roomID, participantID, err := currentGuest(c)
+	if err != nil {
+		return HandleError(h.logger, c, err)
+	}
+
+	var req room.PostChatMessageRequest
+	if err := c.Bind(&req); err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid request body"))
+	}
+	if err := c.Validate(&req); err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Validation failed").WithDetail("error", err.Error()))
+	}
+
+	message, err := h.roomService.PostChatMessage(c.Request().Context(), roomID, participantID, req.Content, parseOptionalUUID(req.ReplyToID))
+	if err != nil {
+		return HandleError(h.logger, c, mapChatError(err))
+	}
+
+	return HandleSuccess(h.logger, c, presenter.ToChatMessageResponse(message))
+}
+
+// EditChatMessage edits a chat message of the current guest
+// @Summary      Edit a chat message as a guest
+// @Description  Replaces the content of one of the guest's own messages while the meeting is running
+// @Tags         Guests
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        mid      path      string                       true  "Message ID (UUID)"
+// @Param        request  body      room.EditChatMessageRequest  true  "New content"
+// @Success      200      {object}  room.ChatMessageResponse     "Edited message"
+// @Failure      400      {object}  map[string]interface{}       "Invalid message ID or content"
+// @Failure      401      {object}  map[string]interface{}       "Missing or invalid guest token"
+// @Failure      403      {object}  map[string]interface{}       "Guest is not the author"
+// @Failure      404      {object}  map[string]interface{}       "Guest or message not found"
+// @Failure      409      {object}  map[string]interface{}       "Room has ended or message was deleted"
+// @Failure      500      {object}  map[string]interface{}       "Failed to edit chat message"
+// @Router       /guest/me/chat/{mid} [patch]
+func (h *Guest) EditChatMessage(c echo.Context) error {
+	roomID, participantID, err := currentGuest(c)
+	if err != nil {
+		return HandleError(h.logger, c, err)
+	}
+
+	messageID, err := uuid.Parse(c.Param("mid"))
+	if err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid message ID").WithDetail("error", "Message ID must be a valid UUID"))
+	}
+
+	var req room.EditChatMessageRequest
+	if err := c.Bind(&req); err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid request body"))
+	}
+	if err := c.Validate(&req); err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Validation failed").WithDetail("error", err.Error()))
+	}
+
+	message, err := h.roomService.EditChatMessage(c.Request().Context(), roomID, participantID, messageID, req.Content)
+	if err != nil {
+		return HandleError(h.logger, c, mapChatError(err))
+	}
+
+	return HandleSuccess(h.logger, c, presenter.ToChatMessageResponse(message))
+}
+
+// DeleteChatMessage deletes a chat message of the current guest
+// @Summary      Delete a chat message as a guest
+// @Description  Deletes one of the guest's own messages
+// @Tags         Guests
+// @Produce      json
+// @Security     BearerAuth
+// @Param        mid  path      string  true  "Message ID (UUID)"
+// @Success      200  {object}  room.ChatMessageResponse  "Deleted message"
+// @Failure      400  {object}  map[string]interface{}    "Invalid message ID"
+// @Failure      401  {object}  map[string]interface{}    "Missing or invalid guest token"
+// @Failure      403  {object}  map[string]interface{}    "Guest may not delete this message"
+// @Failure      404  {object}  map[string]interface{}    "Guest or message not found"
+// @Failure      500  {object}  map[string]interface{}    "Failed to delete chat message"
+// @Router       /guest/me/chat/{mid} [delete]
+func (h *Guest) DeleteChatMessage(c echo.Context) error {
+	roomID, participantID, err := currentGuest(c)
+	if err != nil {
+		return HandleError(h.logger, c, err)
+	}
+
+	messageID, err := uuid.Parse(c.Param("mid"))
+	if err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid message ID").WithDetail("error", "Message ID must be a valid UUID"))
+	}
+
+	message, err := h.roomService.DeleteChatMessage(c.Request().Context(), roomID, participantID, messageID)
+	if err != nil {
+		return HandleError(h.logger, c, mapChatError(err))
+	}
+
+	return HandleSuccess(h.logger, c, presenter.ToChatMessageResponse(message))
+}
+
+// ListPolls lists the polls of the guest's meeting
+// @Summary      List polls as a guest
+// @Description  Lists the polls of the meeting with their results (see GET /rooms/{id}/polls)
+// @Tags         Guests
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  room.PollListResponse   "Polls"
+// @Failure      401  {object}  map[string]interface{}  "Missing or invalid guest token"
+// @Failure      404  {object}  map[string]interface{}  "Guest no longer exists"
+// @Failure      409  {object}  map[string]interface{}  "Guest was never in the meeting"
+// @Failure      500  {object}  map[string]interface{}  "Failed to list polls"
+// @Router       /guest/me/polls [get]
+func (h *Guest) ListPolls(c echo.Context) error {
+	roomID, participantID, err := currentGuest(c)
+	if err != nil {
+		return HandleError(h.logger, c, err)
+	}
+
+	polls, err := h.roomService.ListPolls(c.Request().Context(), roomID, participantID)
+	if err != nil {
+		return HandleError(h.logger, c, mapPollError(err))
+	}
+
+	return HandleSuccess(h.logger, c, presenter.ToPollListResponse(polls))
+}
+
+// Vote casts the vote of the current guest in a poll
+// @Summary      Vote in a poll as a guest
+// @Description  Casts or replaces the guest's vote in an open poll (see POST /rooms/{id}/polls/{poll_id}/votes)
+// @Tags         Guests
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        poll_id  path      string                  true  "Poll ID (UUID)"
+// @Param        request  body      room.VoteRequest        true  "Vote"
+// @Success      200      {object}  room.PollResponse       "Poll with updated results"
+// @Failure      400      {object}  map[string]interface{}  "Invalid poll ID or vote"
+// @Failure      401      {object}  map[string]interface{}  "Missing or invalid guest token"
+// @Failure      404      {object}  map[string]interface{}  "Guest or poll not found"
+// @Failure      409      {object}  map[string]interface{}  "Poll is closed or guest is not in the meeting"
+// @Failure      500      {object}  map[string]interface{}  "Failed to vote"
+// @Router       /guest/me/polls/{poll_id}/votes [post]
+func (h *Guest) Vote(c echo.Context) error {
+	roomID, participantID, err := currentGuest(c)
+	if err != nil {
+		return HandleError(h.logger, c, err)
+	}
+
+	input, err := parseVote(c, roomID, participantID)
+	if err != nil {
+		return HandleError(h.logger, c, err)
+	}
+
+	poll, err := h.roomService.Vote(c.Request().Context(), input)
+	if err != nil {
+		return HandleError(h.logger, c, mapPollError(err))
+	}
+
+	return HandleSuccess(h.logger, c, presenter.ToPollResponse(poll))
+}
+
+// currentGuest reads the guest participant and room set by the guest auth middleware
+func currentGuest(c echo.Context) (uuid.UUID, uuid.UUID, error) {
+	roomID, ok := c.Get("guest_room_id").(uuid.UUID)
+	if !ok {
+		return uuid.Nil, uuid.Nil, errors.ErrUnauthenticated().WithDetail("error", "Guest not authenticated")
+	}
+
+	participantID, ok := c.Get("guest_participant_id").(uuid.UUID)
+	if !ok {
+		return uuid.Nil, uuid.Nil, errors.ErrUnauthenticated().WithDetail("error", "Guest not authenticated")
+	}
+
+	return roomID, participantID, nil
+}
+
+// mapGuestError maps guest use case errors to API errors
+func mapGuestError(err error) error {
+	switch {
+	case stdErrors.Is(err, usecaseErrors.ErrGuestRoomRequired):
+		return errors.ErrInvalidArgument("Room required").WithDetail("error", "Provide room_slug or invite_token")
+	case stdErrors.Is(err, usecaseErrors.ErrRoomNotFound):
+		return errors.ErrNotFound("Room")
+	case stdErrors.Is(err, usecaseErrors.ErrInvitationNotFound):
+		return errors.ErrNotFound("Invitation")
+	case stdErrors.Is(err, usecaseErrors.ErrParticipantNotFound),
+		stdErrors.Is(err, usecaseErrors.ErrNotParticipant):
+		return errors.ErrNotFound("Participant")
+	case stdErrors.Is(err, usecaseErrors.ErrGuestsNotAllowed),
+		stdErrors.Is(err, usecaseErrors.ErrGuestBlocked),
+		stdErrors.Is(err, usecaseErrors.ErrPasscodeRequired),
+		stdErrors.Is(err, usecaseErrors.ErrIncorrectPasscode),
+		stdErrors.Is(err, usecaseErrors.ErrRoomLocked):
+		return errors.ErrForbidden(err.Error())
+	case stdErrors.Is(err, usecaseErrors.ErrTooManyPasscodeTries),
+		stdErrors.Is(err, usecaseErrors.ErrQuotaExceeded):
+		return errors.ErrResourceExhausted(err.Error())
+	case stdErrors.Is(err, usecaseErrors.ErrInvitationExpired),
+		stdErrors.Is(err, usecaseErrors.ErrInvitationRevoked),
+		stdErrors.Is(err, usecaseErrors.ErrInvitationResponded),
+		stdErrors.Is(err, usecaseErrors.ErrRoomEnded),
+		stdErrors.Is(err, usecaseErrors.ErrInvalidParticipantStatus),
+		stdErrors.Is(err, usecaseErrors.ErrRoomFull),
+		stdErrors.Is(err, usecaseErrors.ErrTooEarly):
+		return errors.ErrFailedPrecondition(err.Error())
+	default:
+		return errors.ErrInternal(err)
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
 	"github.com/johnquangdev/meeting-assistant/internal/domain/repositories"
 
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"go.uber.org/zap"
 )
@@ -111,6 +112,23 @@ func ValidateContentType(r *http.Request, expectedType string) bool {
 	return strings.HasPrefix(strings.ToLower(contentType), strings.ToLower(expectedType))
 }
 
+// OrganizationHeader selects the organization (tenant) a room request works in; without it
+// requests work with personal rooms
+const OrganizationHeader = "X-Organization-ID"
+
+// organizationScope reads the organization of a request (nil = personal rooms)
+func organizationScope(c echo.Context) (*uuid.UUID, error) {
+	raw := strings.TrimSpace(c.Request().Header.Get(OrganizationHeader))
+	if raw == "" {
+		return nil, nil
+	}
+	orgID, err := uuid.Parse(raw)
+	if err != nil {
+		return nil, errors.ErrInvalidArgument("Invalid organization ID").WithDetail("error", OrganizationHeader+" must be a valid UUID")
+	}
+	return &orgID, nil
+}
+
 // buildFilters converts ListRoomsRequest to repository filters
 func buildFilters(req *room.ListRoomsRequest) repositories.RoomFilters {
 	filters := repositories.RoomFilters{
@@ -134,6 +152,13 @@ func buildFilters(req *room.ListRoomsRequest) repositories.RoomFilters {
 		filters.Status = &roomStatus
 	}
 
+	// Only apply series filter if it is a valid UUID
+	if req.SeriesID != "" {
+		if seriesID, err := uuid.Parse(req.SeriesID); err == nil {
+			filters.SeriesID = &seriesID
+		}
+	}
+
 	return filters
 }
 
@@ -0,0 +1,391 @@
+package handler
+
+import (
+	stdErrors "errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+
+	"github.com/johnquangdev/meeting-assistant/errors"
+	"github.com/johnquangdev/meeting-assistant/internal/adapter/dto/room"
+	"github.com/johnquangdev/meeting-assistant/internal/adapter/presenter"
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+	usecaseErrors "github.com/johnquangdev/meeting-assistant/internal/usecase/errors"
+	invitationUsecase "github.com/johnquangdev/meeting-assistant/internal/usecase/invitation"
+	roomUsecase "github.com/johnquangdev/meeting-assistant/internal/usecase/room"
+)
+
+// loginPath is where users that open an invitation link without a session are sent
+const loginPath = "/v1/auth/google/login"
+
+// Invitation handles room invitation HTTP requests
+type Invitation struct {
+	invitationService invitationUsecase.Service
+	roomService       roomUsecase.Service
+	logger            *zap.Logger
+}
+
+// NewInvitationHandler creates a new invitation handler
+func NewInvitationHandler(invitationService invitationUsecase.Service, roomService roomUsecase.Service, logger *zap.Logger) *Invitation {
+	return &Invitation{
+		invitationService: invitationService,
+		roomService:       roomService,
+		logger:            logger,
+	}
+}
+
+// CreateInvitation invites a user to join a room by email
+// @Summary      Invite user by email
+// @Description  Creates a tokenized invitation link for a user (host only). The link expires after expires_in_hours (default 7 days).
+// @Tags         Invitations
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id       path      string                      true  "Room ID (UUID)"
+// @Param        request  body      room.InviteByEmailRequest   true  "Email of user to invite"
+// @Success      200      {object}  room.InvitationResponse     "Invitation created"
+// @Failure      400      {object}  map[string]interface{}      "Invalid request"
+// @Failure      401      {object}  map[string]interface{}      "User not authenticated"
+// @Failure      403      {object}  map[string]interface{}      "User is not the host"
+// @Failure      409      {object}  map[string]interface{}      "User already in room or room has ended"
+// @Failure      500      {object}  map[string]interface{}      "Failed to create invitation"
+// @Router       /rooms/{id}/invitations [post]
+func (h *Invitation) CreateInvitation(c echo.Context) error {
+	roomID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid room ID").WithDetail("error", "Room ID must be a valid UUID"))
+	}
+
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return HandleError(h.logger, c, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated"))
+	}
+
+	var req room.InviteByEmailRequest
+	if err := c.Bind(&req); err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid request body").WithDetail("error", err.Error()))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Validation failed").WithDetail("error", err.Error()))
+	}
+
+	input := invitationUsecase.CreateInvitationInput{
+		RoomID:    roomID,
+		InviterID: userID,
+		Email:     req.Email,
+		Message:   req.Message,
+	}
+	if req.ExpiresInHours != nil {
+		input.ExpiresIn = time.Duration(*req.ExpiresInHours) * time.Hour
+	}
+
+	invitation, err := h.invitationService.CreateInvitation(c.Request().Context(), input)
+	if err != nil {
+		return HandleError(h.logger, c, mapInvitationError(err))
+	}
+
+	return HandleSuccess(h.logger, c, presenter.ToInvitationResponse(invitation, h.invitationService.InvitationURL(invitation)))
+}
+
+// ListRoomInvitations retrieves all invitations for a room (host only)
+// @Summary      Get room invitations
+// @Description  Retrieves all invitations for a room with their status and links (host only)
+// @Tags         Invitations
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string                         true  "Room ID (UUID)"
+// @Success      200  {object}  room.InvitationListResponse    "List of invitations"
+// @Failure      400  {object}  map[string]interface{}         "Invalid room ID"
+// @Failure      401  {object}  map[string]interface{}         "User not authenticated"
+// @Failure      403  {object}  map[string]interface{}         "User is not the host"
+// @Failure      500  {object}  map[string]interface{}         "Failed to get invitations"
+// @Router       /rooms/{id}/invitations [get]
+func (h *Invitation) ListRoomInvitations(c echo.Context) error {
+	roomID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid room ID").WithDetail("error", "Room ID must be a valid UUID"))
+	}
+
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return HandleError(h.logger, c, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated"))
+	}
+
+	invitations, err := h.invitationService.ListRoomInvitations(c.Request().Context(), roomID, userID)
+	if err != nil {
+		return HandleError(h.logger, c, mapInvitationError(err))
+	}
+
+	return HandleSuccess(h.logger, c, presenter.ToInvitationListResponse(invitations, h.invitationService.InvitationURL))
+}
+
+// RevokeInvitation revokes a pending invitation (host only)
+// @Summary      Revoke invitation
+// @Description  Revokes a pending invitation so its link can no longer be used (host only)
+// @Tags         Invitations
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id             path      string                  true  "Room ID (UUID)"
+// @Param        invitation_id  path      string                  true  "Invitation ID (UUID)"
+// @Success      200            {object}  map[string]interface{}  "Invitation revoked"
+// @Failure      400            {object}  map[string]interface{}  "Invalid room or invitation ID"
+// @Failure      401            {object}  map[string]interface{}  "User not authenticated"
+// @Failure      403            {object}  map[string]interface{}  "User is not the host"
+// @Failure      404            {object}  map[string]interface{}  "Invitation not found"
+// @Failure      409            {object}  map[string]interface{}  "Invitation already responded to"
+// @Router       /rooms/{id}/invitations/{invitation_id} [delete]
+func (h *Invitation) RevokeInvitation(c echo.Context) error {
+	roomID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid room ID").WithDetail("error", "Room ID must be a valid UUID"))
+	}
+
+	invitationID, err := uuid.Parse(c.Param("invitation_id"))
+	if err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid invitation ID").WithDetail("error", "Invitation ID must be a valid UUID"))
+	}
+
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return HandleError(h.logger, c, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated"))
+	}
+
+	if err := h.invitationService.RevokeInvitation(c.Request().Context(), roomID, invitationID, userID); err != nil {
+		return HandleError(h.logger, c, mapInvitationError(err))
+	}
+
+	return HandleSuccess(h.logger, c, map[string]interface{}{
+		"message": "Invitation revoked successfully",
+	})
+}
+
+// AcceptRoomInvitation accepts the current user's invitation and joins the room
+// @Summary      Accept invitation
+// @Description  Accepts the current user's pending invitation to a room and joins it
+// @Tags         Invitations
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string                           true  "Room ID (UUID)"
+// @Success      200  {object}  room.AcceptInvitationResponse    "Invitation accepted"
+// @Failure      400  {object}  map[string]interface{}           "Invalid room ID"
+// @Failure      401  {object}  map[string]interface{}           "User not authenticated"
+// @Failure      404  {object}  map[string]interface{}           "Invitation not found"
+// @Failure      500  {object}  map[string]interface{}           "Failed to accept invitation"
+// @Router       /rooms/{id}/invitations/accept [post]
+func (h *Invitation) AcceptRoomInvitation(c echo.Context) error {
+	roomID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid room ID").WithDetail("error", "Room ID must be a valid UUID"))
+	}
+
+	userID, userEmail, err := currentUser(c)
+	if err != nil {
+		return HandleError(h.logger, c, err)
+	}
+
+	output, err := h.invitationService.AcceptRoomInvitation(c.Request().Context(), roomID, userID, userEmail)
+	if err != nil {
+		return HandleError(h.logger, c, mapInvitationError(err))
+	}
+
+	return HandleSuccess(h.logger, c, h.toAcceptResponse(output))
+}
+
+// DeclineRoomInvitation declines the current user's invitation to a room
+// @Summary      Decline invitation
+// @Description  Declines the current user's pending invitation to a room
+// @Tags         Invitations
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string                      true  "Room ID (UUID)"
+// @Success      200  {object}  map[string]interface{}      "Invitation declined"
+// @Failure      400  {object}  map[string]interface{}      "Invalid room ID"
+// @Failure      401  {object}  map[string]interface{}      "User not authenticated"
+// @Failure      404  {object}  map[string]interface{}      "Invitation not found"
+// @Failure      500  {object}  map[string]interface{}      "Failed to decline invitation"
+// @Router       /rooms/{id}/invitations/decline [post]
+func (h *Invitation) DeclineRoomInvitation(c echo.Context) error {
+	roomID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid room ID").WithDetail("error", "Room ID must be a valid UUID"))
+	}
+
+	userID, userEmail, err := currentUser(c)
+	if err != nil {
+		return HandleError(h.logger, c, err)
+	}
+
+	if err := h.invitationService.DeclineRoomInvitation(c.Request().Context(), roomID, userID, userEmail); err != nil {
+		return HandleError(h.logger, c, mapInvitationError(err))
+	}
+
+	return HandleSuccess(h.logger, c, map[string]interface{}{
+		"message": "Invitation declined successfully",
+	})
+}
+
+// GetMyInvitations retrieves all pending room invitations for the current user
+// @Summary      Get my invitations
+// @Description  Retrieves all pending, unexpired invitations sent to the current user
+// @Tags         Invitations
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  room.InvitationListResponse  "List of invitations"
+// @Failure      401  {object}  map[string]interface{}       "User not authenticated"
+// @Failure      500  {object}  map[string]interface{}       "Failed to get invitations"
+// @Router       /invitations/me [get]
+func (h *Invitation) GetMyInvitations(c echo.Context) error {
+	userID, userEmail, err := currentUser(c)
+	if err != nil {
+		return HandleError(h.logger, c, err)
+	}
+
+	invitations, err := h.invitationService.ListMyInvitations(c.Request().Context(), userID, userEmail)
+	if err != nil {
+		return HandleError(h.logger, c, errors.ErrInternal(err))
+	}
+
+	return HandleSuccess(h.logger, c, presenter.ToInvitationListResponse(invitations, h.invitationService.InvitationURL))
+}
+
+// OpenInvitation handles a one-click invitation link
+// @Summary      Open invitation link
+// @Description  One-click invitation link. Logged-in invitees accept the invitation and join the room.
+// @Description  Without a session the invitation is previewed and login_url is returned; open the link again after login.
+// @Tags         Invitations
+// @Produce      json
+// @Param        token  path      string                          true  "Invitation token"
+// @Success      200    {object}  room.AcceptInvitationResponse   "Invitation accepted (logged in)"
+// @Success      202    {object}  room.InvitationPreviewResponse  "Login required to accept"
+// @Failure      403    {object}  map[string]interface{}          "Invitation was issued to another user"
+// @Failure      404    {object}  map[string]interface{}          "Invitation not found"
+// @Failure      409    {object}  map[string]interface{}          "Invitation expired, revoked or already responded to"
+// @Router       /invitations/{token} [get]
+func (h *Invitation) OpenInvitation(c echo.Context) error {
+	token := c.Param("token")
+
+	userID, userEmail, err := currentUser(c)
+	if err != nil {
+		// Not logged in yet: preview the invitation so the client can log in and come back
+		invitation, err := h.invitationService.GetInvitationByToken(c.Request().Context(), token)
+		if err != nil {
+			return HandleError(h.logger, c, mapInvitationError(err))
+		}
+
+		return c.JSON(202, map[string]interface{}{
+			"data": &room.InvitationPreviewResponse{
+				Status:     "login_required",
+				Message:    "Log in to accept this invitation, then open the link again.",
+				Invitation: presenter.ToInvitationResponse(invitation, ""),
+				LoginURL:   loginPath,
+			},
+		})
+	}
+
+	output, err := h.invitationService.AcceptInvitation(c.Request().Context(), token, userID, userEmail)
+	if err != nil {
+		return HandleError(h.logger, c, mapInvitationError(err))
+	}
+
+	return HandleSuccess(h.logger, c, h.toAcceptResponse(output))
+}
+
+// DeclineInvitationByToken declines an invitation through its link
+// @Summary      Decline invitation link
+// @Description  Declines the invitation identified by the link token
+// @Tags         Invitations
+// @Produce      json
+// @Security     BearerAuth
+// @Param        token  path      string                  true  "Invitation token"
+// @Success      200    {object}  map[string]interface{}  "Invitation declined"
+// @Failure      401    {object}  map[string]interface{}  "User not authenticated"
+// @Failure      403    {object}  map[string]interface{}  "Invitation was issued to another user"
+// @Failure      404    {object}  map[string]interface{}  "Invitation not found"
+// @Failure      409    {object}  map[string]interface{}  "Invitation expired, revoked or already responded to"
+// @Router       /invitations/{token}/decline [post]
+func (h *Invitation) DeclineInvitationByToken(c echo.Context) error {
+	userID, userEmail, err := currentUser(c)
+	if err != nil {
+		return HandleError(h.logger, c, err)
+	}
+
+	if err := h.invitationService.DeclineInvitation(c.Request().Context(), c.Param("token"), userID, userEmail); err != nil {
+		return HandleError(h.logger, c, mapInvitationError(err))
+	}
+
+	return HandleSuccess(h.logger, c, map[string]interface{}{
+		"message": "Invitation declined successfully",
+	})
+}
+
+// toAcceptResponse builds the response of an accepted invitation
+func (h *Invitation) toAcceptResponse(output *invitationUsecase.AcceptInvitationOutput) *room.AcceptInvitationResponse {
+	response := &room.AcceptInvitationResponse{
+		Status:      "accepted",
+		Message:     "Invitation accepted. You can join the room once it opens.",
+		Invitation:  presenter.ToInvitationResponse(output.Invitation, ""),
+		Room:        presenter.ToRoomResponse(output.Room),
+		Participant: presenter.ToParticipantResponse(output.Participant),
+	}
+
+	switch {
+	case output.LivekitToken != "":
+		response.Status = "joined"
+		response.Message = "Successfully accepted invitation and joined the room"
+		response.LivekitToken = output.LivekitToken
+		response.LivekitURL = h.roomService.GetLivekitURL()
+	case output.Participant != nil && output.Participant.Status == entities.ParticipantStatusWaiting:
+		response.Status = "waiting"
+		response.Message = "Invitation accepted. Waiting for host approval."
+	case output.Participant != nil && output.Participant.Status == entities.ParticipantStatusWaitlisted:
+		response.Status = "waitlisted"
+		response.Message = "Invitation accepted. The room is full, you will join automatically when a seat frees up."
+	}
+
+	return response
+}
+
+// currentUser reads the authenticated user ID and email set by the auth middleware
+func currentUser(c echo.Context) (uuid.UUID, string, error) {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return uuid.Nil, "", errors.ErrUnauthenticated().WithDetail("error", "User not authenticated")
+	}
+
+	userEmail, ok := c.Get("user_email").(string)
+	if !ok || userEmail == "" {
+		return uuid.Nil, "", errors.ErrUnauthenticated().WithDetail("error", "User email not found")
+	}
+
+	return userID, userEmail, nil
+}
+
+// mapInvitationError maps invitation use case errors to API errors
+func mapInvitationError(err error) error {
+	switch {
+	case stdErrors.Is(err, usecaseErrors.ErrInvitationNotFound):
+		return errors.ErrNotFound("Invitation")
+	case stdErrors.Is(err, usecaseErrors.ErrRoomNotFound):
+		return errors.ErrNotFound("Room")
+	case stdErrors.Is(err, usecaseErrors.ErrNotHost),
+		stdErrors.Is(err, usecaseErrors.ErrNotParticipant):
+		return errors.ErrNotHost()
+	case stdErrors.Is(err, usecaseErrors.ErrInvitationNotForUser),
+		stdErrors.Is(err, usecaseErrors.ErrAccessDenied):
+		return errors.ErrForbidden(err.Error())
+	case stdErrors.Is(err, usecaseErrors.ErrInvalidInvitationTTL):
+		return errors.ErrInvalidArgument("Invalid invitation expiry").WithDetail("error", "expires_in_hours exceeds the allowed maximum")
+	case stdErrors.Is(err, usecaseErrors.ErrInvitationExpired),
+		stdErrors.Is(err, usecaseErrors.ErrInvitationRevoked),
+		stdErrors.Is(err, usecaseErrors.ErrInvitationResponded),
+		stdErrors.Is(err, usecaseErrors.ErrAlreadyInvited),
+		stdErrors.Is(err, usecaseErrors.ErrRoomEnded),
+		stdErrors.Is(err, usecaseErrors.ErrRoomFull):
+		return errors.ErrFailedPrecondition(err.Error())
+	default:
+		return errors.ErrInternal(err)
+	}
+}
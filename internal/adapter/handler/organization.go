@@ -0,0 +1,493 @@
+package handler
+
+import (
+	stdErrors "errors"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+
+	"github.com/johnquangdev/meeting-assistant/errors"
+	orgDTO "github.com/johnquangdev/meeting-assistant/internal/adapter/dto/organization"
+	"github.com/johnquangdev/meeting-assistant/internal/adapter/presenter"
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+	usecaseErrors "github.com/johnquangdev/meeting-assistant/internal/usecase/errors"
+	organizationUsecase "github.com/johnquangdev/meeting-assistant/internal/usecase/organization"
+)
+
+// Organization handles organization (tenant) HTTP requests
+type Organization struct {
+	organizationService organizationUsecase.Service
+	logger              *zap.Logger
+}
+
+// NewOrganizationHandler creates a new organization handler
+func NewOrganizationHandler(organizationService organizationUsecase.Service, logger *zap.Logger) *Organization {
+	return &Organization{
+		organizationService: organizationService,
+		logger:              logger,
+	}
+}
+
+// CreateOrganization creates an organization owned by the current user
+// @Summary      Create organization
+// @Description  Creates an organization with the current user as its owner. Settings are applied on top of the defaults (room_defaults, ai.transcription_language, ai.summary_language).
+// @Tags         Organizations
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request  body      orgDTO.CreateOrganizationRequest  true  "Organization name and settings"
+// @Success      200      {object}  orgDTO.OrganizationResponse       "Organization created"
+// @Failure      400      {object}  map[string]interface{}            "Invalid request or settings"
+// @Failure      401      {object}  map[string]interface{}            "User not authenticated"
+// @Failure      500      {object}  map[string]interface{}            "Failed to create organization"
+// @Router       /organizations [post]
+func (h *Organization) CreateOrganization(c echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return HandleError(h.logger, c, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated"))
+	}
+
+	var req orgDTO.CreateOrganizationRequest
+	if err := c.Bind(&req); err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid request body").WithDetail("error", err.Error()))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Validation failed").WithDetail("error", err.Error()))
+	}
+
+	org, err := h.organizationService.CreateOrganization(c.Request().Context(), organizationUsecase.CreateOrganizationInput{
+		Name:      req.Name,
+		CreatedBy: userID,
+		Settings:  req.Settings,
+	})
+	if err != nil {
+		return HandleError(h.logger, c, mapOrganizationError(err))
+	}
+
+	return HandleSuccess(h.logger, c, presenter.ToOrganizationResponse(org, &entities.OrganizationMember{Role: entities.OrgRoleOwner}))
+}
+
+// ListMyOrganizations retrieves the organizations of the current user
+// @Summary      List my organizations
+// @Description  Retrieves the organizations the current user belongs to, with their role in each
+// @Tags         Organizations
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  orgDTO.OrganizationListResponse  "List of organizations"
+// @Failure      401  {object}  map[string]interface{}           "User not authenticated"
+// @Failure      500  {object}  map[string]interface{}           "Failed to get organizations"
+// @Router       /organizations [get]
+func (h *Organization) ListMyOrganizations(c echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return HandleError(h.logger, c, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated"))
+	}
+
+	members, err := h.organizationService.ListMyOrganizations(c.Request().Context(), userID)
+	if err != nil {
+		return HandleError(h.logger, c, mapOrganizationError(err))
+	}
+
+	return HandleSuccess(h.logger, c, presenter.ToOrganizationListResponse(members))
+}
+
+// GetOrganization retrieves an organization (members only)
+// @Summary      Get organization
+// @Description  Retrieves an organization with its settings (members only)
+// @Tags         Organizations
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string                       true  "Organization ID (UUID)"
+// @Success      200  {object}  orgDTO.OrganizationResponse  "Organization details"
+// @Failure      400  {object}  map[string]interface{}       "Invalid organization ID"
+// @Failure      401  {object}  map[string]interface{}       "User not authenticated"
+// @Failure      404  {object}  map[string]interface{}       "Organization not found"
+// @Router       /organizations/{id} [get]
+func (h *Organization) GetOrganization(c echo.Context) error {
+	orgID, userID, err := h.organizationParams(c)
+	if err != nil {
+		return HandleError(h.logger, c, err)
+	}
+
+	org, member, err := h.organizationService.GetOrganization(c.Request().Context(), orgID, userID)
+	if err != nil {
+		return HandleError(h.logger, c, mapOrganizationError(err))
+	}
+
+	return HandleSuccess(h.logger, c, presenter.ToOrganizationResponse(org, member))
+}
+
+// UpdateOrganization renames an organization or changes its settings (owners and admins)
+// @Summary      Update organization
+// @Description  Renames an organization and/or applies a partial settings update (owners and admins). room_defaults is replaced as a whole and applies to rooms created afterwards.
+// @Tags         Organizations
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id       path      string                            true  "Organization ID (UUID)"
+// @Param        request  body      orgDTO.UpdateOrganizationRequest  true  "Changes"
+// @Success      200      {object}  orgDTO.OrganizationResponse       "Organization updated"
+// @Failure      400      {object}  map[string]interface{}            "Invalid request or settings"
+// @Failure      401      {object}  map[string]interface{}            "User not authenticated"
+// @Failure      403      {object}  map[string]interface{}            "User is not an owner or admin"
+// @Failure      404      {object}  map[string]interface{}            "Organization not found"
+// @Router       /organizations/{id} [patch]
+func (h *Organization) UpdateOrganization(c echo.Context) error {
+	orgID, userID, err := h.organizationParams(c)
+	if err != nil {
+		return HandleError(h.logger, c, err)
+	}
+
+	var req orgDTO.UpdateOrganizationRequest
+	if err := c.Bind(&req); err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid request body").WithDetail("error", err.Error()))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Validation failed").WithDetail("error", err.Error()))
+	}
+
+	org, err := h.organizationService.UpdateOrganization(c.Request().Context(), organizationUsecase.UpdateOrganizationInput{
+		OrganizationID: orgID,
+		UserID:         userID,
+		Name:           req.Name,
+		Settings:       req.Settings,
+	})
+	if err != nil {
+		return HandleError(h.logger, c, mapOrganizationError(err))
+	}
+
+	_, member, err := h.organizationService.GetOrganization(c.Request().Context(), orgID, userID)
+	if err != nil {
+		return HandleError(h.logger, c, mapOrganizationError(err))
+	}
+
+	return HandleSuccess(h.logger, c, presenter.ToOrganizationResponse(org, member))
+}
+
+// DeleteOrganization deletes an organization with all its rooms (owners only)
+// @Summary      Delete organization
+// @Description  Deletes an organization together with its rooms, recordings, summaries and action items (owners only)
+// @Tags         Organizations
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string                  true  "Organization ID (UUID)"
+// @Success      200  {object}  map[string]interface{}  "Organization deleted"
+// @Failure      400  {object}  map[string]interface{}  "Invalid organization ID"
+// @Failure      401  {object}  map[string]interface{}  "User not authenticated"
+// @Failure      403  {object}  map[string]interface{}  "User is not an owner"
+// @Failure      404  {object}  map[string]interface{}  "Organization not found"
+// @Router       /organizations/{id} [delete]
+func (h *Organization) DeleteOrganization(c echo.Context) error {
+	orgID, userID, err := h.organizationParams(c)
+	if err != nil {
+		return HandleError(h.logger, c, err)
+	}
+
+	if err := h.organizationService.DeleteOrganization(c.Request().Context(), orgID, userID); err != nil {
+		return HandleError(h.logger, c, mapOrganizationError(err))
+	}
+
+	return HandleSuccess(h.logger, c, map[string]interface{}{
+		"message": "Organization deleted successfully",
+	})
+}
+
+// ListMembers retrieves the members of an organization (members only)
+// @Summary      List organization members
+// @Description  Retrieves the members of an organization with their roles (members only)
+// @Tags         Organizations
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string                      true  "Organization ID (UUID)"
+// @Success      200  {object}  orgDTO.MemberListResponse   "List of members"
+// @Failure      400  {object}  map[string]interface{}      "Invalid organization ID"
+// @Failure      401  {object}  map[string]interface{}      "User not authenticated"
+// @Failure      404  {object}  map[string]interface{}      "Organization not found"
+// @Router       /organizations/{id}/members [get]
+func (h *Organization) ListMembers(c echo.Context) error {
+	orgID, userID, err := h.organizationParams(c)
+	if err != nil {
+		return HandleError(h.logger, c, err)
+	}
+
+	members, err := h.organizationService.ListMembers(c.Request().Context(), orgID, userID)
+	if err != nil {
+		return HandleError(h.logger, c, mapOrganizationError(err))
+	}
+
+	return HandleSuccess(h.logger, c, presenter.ToMemberListResponse(members))
+}
+
+// UpdateMemberRole changes the role of a member (owners and admins)
+// @Summary      Change member role
+// @Description  Changes the role of a member. Only owners can grant or take away the owner role, and the last owner cannot be demoted.
+// @Tags         Organizations
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id       path      string                          true  "Organization ID (UUID)"
+// @Param        user_id  path      string                          true  "User ID of the member (UUID)"
+// @Param        request  body      orgDTO.UpdateMemberRoleRequest  true  "New role"
+// @Success      200      {object}  orgDTO.MemberResponse           "Role changed"
+// @Failure      400      {object}  map[string]interface{}          "Invalid request"
+// @Failure      401      {object}  map[string]interface{}          "User not authenticated"
+// @Failure      403      {object}  map[string]interface{}          "Not allowed to change this role"
+// @Failure      404      {object}  map[string]interface{}          "Organization or member not found"
+// @Failure      409      {object}  map[string]interface{}          "Last owner"
+// @Router       /organizations/{id}/members/{user_id} [patch]
+func (h *Organization) UpdateMemberRole(c echo.Context) error {
+	orgID, userID, err := h.organizationParams(c)
+	if err != nil {
+		return HandleError(h.logger, c, err)
+	}
+
+	memberUserID, err := uuid.Parse(c.Param("user_id"))
+	if err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid user ID").WithDetail("error", "User ID must be a valid UUID"))
+	}
+
+	var req orgDTO.UpdateMemberRoleRequest
+	if err := c.Bind(&req); err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid request body").WithDetail("error", err.Error()))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Validation failed").WithDetail("error", err.Error()))
+	}
+
+	member, err := h.organizationService.UpdateMemberRole(c.Request().Context(), orgID, userID, memberUserID, entities.OrgRole(req.Role))
+	if err != nil {
+		return HandleError(h.logger, c, mapOrganizationError(err))
+	}
+
+	return HandleSuccess(h.logger, c, presenter.ToMemberResponse(member))
+}
+
+// RemoveMember removes a member from an organization, or leaves it
+// @Summary      Remove member
+// @Description  Removes a member (owners and admins; only owners remove owners). Members can remove themselves to leave. The last owner cannot leave.
+// @Tags         Organizations
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id       path      string                  true  "Organization ID (UUID)"
+// @Param        user_id  path      string                  true  "User ID of the member (UUID)"
+// @Success      200      {object}  map[string]interface{}  "Member removed"
+// @Failure      400      {object}  map[string]interface{}  "Invalid organization or user ID"
+// @Failure      401      {object}  map[string]interface{}  "User not authenticated"
+// @Failure      403      {object}  map[string]interface{}  "Not allowed to remove this member"
+// @Failure      404      {object}  map[string]interface{}  "Organization or member not found"
+// @Failure      409      {object}  map[string]interface{}  "Last owner"
+// @Router       /organizations/{id}/members/{user_id} [delete]
+func (h *Organization) RemoveMember(c echo.Context) error {
+	orgID, userID, err := h.organizationParams(c)
+	if err != nil {
+		return HandleError(h.logger, c, err)
+	}
+
+	memberUserID, err := uuid.Parse(c.Param("user_id"))
+	if err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid user ID").WithDetail("error", "User ID must be a valid UUID"))
+	}
+
+	if err := h.organizationService.RemoveMember(c.Request().Context(), orgID, userID, memberUserID); err != nil {
+		return HandleError(h.logger, c, mapOrganizationError(err))
+	}
+
+	return HandleSuccess(h.logger, c, map[string]interface{}{
+		"message": "Member removed successfully",
+	})
+}
+
+// CreateInvite invites someone to an organization by email (owners and admins)
+// @Summary      Invite to organization
+// @Description  Emails a tokenized invite link (owners and admins). Inviting an email with a pending invite returns that invite.
+// @Tags         Organizations
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id       path      string                      true  "Organization ID (UUID)"
+// @Param        request  body      orgDTO.CreateInviteRequest  true  "Email and role (admin or member)"
+// @Success      200      {object}  orgDTO.InviteResponse       "Invite created"
+// @Failure      400      {object}  map[string]interface{}      "Invalid request"
+// @Failure      401      {object}  map[string]interface{}      "User not authenticated"
+// @Failure      403      {object}  map[string]interface{}      "User is not an owner or admin"
+// @Failure      404      {object}  map[string]interface{}      "Organization not found"
+// @Router       /organizations/{id}/invites [post]
+func (h *Organization) CreateInvite(c echo.Context) error {
+	orgID, userID, err := h.organizationParams(c)
+	if err != nil {
+		return HandleError(h.logger, c, err)
+	}
+
+	var req orgDTO.CreateInviteRequest
+	if err := c.Bind(&req); err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid request body").WithDetail("error", err.Error()))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Validation failed").WithDetail("error", err.Error()))
+	}
+
+	invite, err := h.organizationService.CreateInvite(c.Request().Context(), organizationUsecase.CreateInviteInput{
+		OrganizationID: orgID,
+		InviterID:      userID,
+		Email:          req.Email,
+		Role:           entities.OrgRole(req.Role),
+	})
+	if err != nil {
+		return HandleError(h.logger, c, mapOrganizationError(err))
+	}
+
+	return HandleSuccess(h.logger, c, presenter.ToInviteResponse(invite, h.organizationService.InviteURL(invite)))
+}
+
+// ListInvites retrieves the invites of an organization (owners and admins)
+// @Summary      List organization invites
+// @Description  Retrieves the invites of an organization with their status; pending invites include their link (owners and admins)
+// @Tags         Organizations
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string                      true  "Organization ID (UUID)"
+// @Success      200  {object}  orgDTO.InviteListResponse   "List of invites"
+// @Failure      400  {object}  map[string]interface{}      "Invalid organization ID"
+// @Failure      401  {object}  map[string]interface{}      "User not authenticated"
+// @Failure      403  {object}  map[string]interface{}      "User is not an owner or admin"
+// @Failure      404  {object}  map[string]interface{}      "Organization not found"
+// @Router       /organizations/{id}/invites [get]
+func (h *Organization) ListInvites(c echo.Context) error {
+	orgID, userID, err := h.organizationParams(c)
+	if err != nil {
+		return HandleError(h.logger, c, err)
+	}
+
+	invites, err := h.organizationService.ListInvites(c.Request().Context(), orgID, userID)
+	if err != nil {
+		return HandleError(h.logger, c, mapOrganizationError(err))
+	}
+
+	return HandleSuccess(h.logger, c, presenter.ToInviteListResponse(invites, h.organizationService.InviteURL))
+}
+
+// RevokeInvite revokes a pending invite (owners and admins)
+// @Summary      Revoke organization invite
+// @Description  Revokes a pending invite so its link can no longer be used (owners and admins)
+// @Tags         Organizations
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id         path      string                  true  "Organization ID (UUID)"
+// @Param        invite_id  path      string                  true  "Invite ID (UUID)"
+// @Success      200        {object}  map[string]interface{}  "Invite revoked"
+// @Failure      400        {object}  map[string]interface{}  "Invalid organization or invite ID"
+// @Failure      401        {object}  map[string]interface{}  "User not authenticated"
+// @Failure      403        {object}  map[string]interface{}  "User is not an owner or admin"
+// @Failure      404        {object}  map[string]interface{}  "Invite not found"
+// @Failure      409        {object}  map[string]interface{}  "Invite no longer pending"
+// @Router       /organizations/{id}/invites/{invite_id} [delete]
+func (h *Organization) RevokeInvite(c echo.Context) error {
+	orgID, userID, err := h.organizationParams(c)
+	if err != nil {
+		return HandleError(h.logger, c, err)
+	}
+
+	inviteID, err := uuid.Parse(c.Param("invite_id"))
+	if err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid invite ID").WithDetail("error", "Invite ID must be a valid UUID"))
+	}
+
+	if err := h.organizationService.RevokeInvite(c.Request().Context(), orgID, inviteID, userID); err != nil {
+		return HandleError(h.logger, c, mapOrganizationError(err))
+	}
+
+	return HandleSuccess(h.logger, c, map[string]interface{}{
+		"message": "Invite revoked successfully",
+	})
+}
+
+// GetInvite previews an organization invite by its token
+// @Summary      Preview organization invite
+// @Description  Retrieves the organization, inviter and role of an invite link. Does not require authentication.
+// @Tags         Organizations
+// @Produce      json
+// @Param        token  path      string                  true  "Invite token"
+// @Success      200    {object}  orgDTO.InviteResponse   "Invite details"
+// @Failure      404    {object}  map[string]interface{}  "Invite not found"
+// @Router       /organizations/invites/{token} [get]
+func (h *Organization) GetInvite(c echo.Context) error {
+	invite, err := h.organizationService.GetInviteByToken(c.Request().Context(), c.Param("token"))
+	if err != nil {
+		return HandleError(h.logger, c, mapOrganizationError(err))
+	}
+
+	return HandleSuccess(h.logger, c, presenter.ToInviteResponse(invite, ""))
+}
+
+// AcceptInvite makes the current user a member of the organization of an invite
+// @Summary      Accept organization invite
+// @Description  Joins the organization of an invite sent to the current user's email, with the role of the invite
+// @Tags         Organizations
+// @Produce      json
+// @Security     BearerAuth
+// @Param        token  path      string                  true  "Invite token"
+// @Success      200    {object}  orgDTO.MemberResponse   "Invite accepted"
+// @Failure      401    {object}  map[string]interface{}  "User not authenticated"
+// @Failure      403    {object}  map[string]interface{}  "Invite sent to another email"
+// @Failure      404    {object}  map[string]interface{}  "Invite not found"
+// @Failure      409    {object}  map[string]interface{}  "Invite expired, revoked or already accepted"
+// @Router       /organizations/invites/{token}/accept [post]
+func (h *Organization) AcceptInvite(c echo.Context) error {
+	userID, userEmail, err := currentUser(c)
+	if err != nil {
+		return HandleError(h.logger, c, err)
+	}
+
+	member, err := h.organizationService.AcceptInvite(c.Request().Context(), c.Param("token"), userID, userEmail)
+	if err != nil {
+		return HandleError(h.logger, c, mapOrganizationError(err))
+	}
+
+	return HandleSuccess(h.logger, c, presenter.ToMemberResponse(member))
+}
+
+// organizationParams extracts the organization ID path parameter and the current user
+func (h *Organization) organizationParams(c echo.Context) (uuid.UUID, uuid.UUID, error) {
+	orgID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return uuid.Nil, uuid.Nil, errors.ErrInvalidArgument("Invalid organization ID").WithDetail("error", "Organization ID must be a valid UUID")
+	}
+
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return uuid.Nil, uuid.Nil, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated")
+	}
+
+	return orgID, userID, nil
+}
+
+// mapOrganizationError maps organization use case errors to API errors
+func mapOrganizationError(err error) error {
+	switch {
+	case stdErrors.Is(err, usecaseErrors.ErrOrganizationNotFound):
+		return errors.ErrNotFound("Organization")
+	case stdErrors.Is(err, usecaseErrors.ErrOrgMemberNotFound):
+		return errors.ErrNotFound("Member")
+	case stdErrors.Is(err, usecaseErrors.ErrOrgInviteNotFound):
+		return errors.ErrNotFound("Invite")
+	case stdErrors.Is(err, usecaseErrors.ErrNotOrganizationMember),
+		stdErrors.Is(err, usecaseErrors.ErrNotOrganizationAdmin),
+		stdErrors.Is(err, usecaseErrors.ErrNotOrganizationOwner),
+		stdErrors.Is(err, usecaseErrors.ErrOrgInviteWrongRecipient):
+		return errors.ErrForbidden(err.Error())
+	case stdErrors.Is(err, usecaseErrors.ErrInvalidOrganizationRole):
+		return errors.ErrInvalidArgument(err.Error())
+	case stdErrors.Is(err, usecaseErrors.ErrInvalidOrgSettings):
+		return errors.ErrInvalidArgument("Invalid organization settings").WithDetail("error", err.Error())
+	case stdErrors.Is(err, usecaseErrors.ErrLastOrganizationOwner),
+		stdErrors.Is(err, usecaseErrors.ErrOrgInviteNotPending):
+		return errors.ErrFailedPrecondition(err.Error())
+	default:
+		return errors.ErrInternal(err)
+	}
+}
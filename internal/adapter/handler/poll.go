@@ -0,0 +1,259 @@
+package handler
+
+import (
+	stdErrors "errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/johnquangdev/meeting-assistant/errors"
+	"github.com/johnquangdev/meeting-assistant/internal/adapter/dto/room"
+	"github.com/johnquangdev/meeting-assistant/internal/adapter/presenter"
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+	usecaseErrors "github.com/johnquangdev/meeting-assistant/internal/usecase/errors"
+	roomUsecase "github.com/johnquangdev/meeting-assistant/internal/usecase/room"
+)
+
+// ListPolls handles GET /rooms/:id/polls
+// @Summary      List polls
+// @Description  Lists the polls of a meeting, oldest first, with live results while open and final results once closed. Available to everyone who is or was in the meeting. Voter names are only shown for named polls.
+// @Tags         Polls
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "Room ID (UUID)"
+// @Success      200  {object}  room.PollListResponse   "Polls"
+// @Failure      400  {object}  map[string]interface{}  "Invalid room ID"
+// @Failure      401  {object}  map[string]interface{}  "User not authenticated"
+// @Failure      404  {object}  map[string]interface{}  "Participant not found"
+// @Failure      409  {object}  map[string]interface{}  "User was never in the meeting"
+// @Failure      500  {object}  map[string]interface{}  "Failed to list polls"
+// @Router       /rooms/{id}/polls [get]
+func (h *Room) ListPolls(c echo.Context) error {
+	roomID, participantID, err := h.chatParticipant(c)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	polls, err := h.roomService.ListPolls(c.Request().Context(), roomID, participantID)
+	if err != nil {
+		return h.handleError(c, mapPollError(err))
+	}
+
+	return h.handleSuccess(c, presenter.ToPollListResponse(polls))
+}
+
+// CreatePoll handles POST /rooms/:id/polls
+// @Summary      Create a poll
+// @Description  Opens a single-choice, multiple-choice or free-text poll in an ongoing meeting (host or co-host). Announced as a poll.opened room event; live results follow as poll.results and the final results as poll.closed. A poll closes manually, after duration_seconds, once everyone in the meeting voted (close_when_all_voted) or when the meeting ends. Final results are added to the decisions of the AI summary.
+// @Tags         Polls
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id       path      string                  true  "Room ID (UUID)"
+// @Param        request  body      room.CreatePollRequest  true  "Poll"
+// @Success      200      {object}  room.PollResponse       "Created poll"
+// @Failure      400      {object}  map[string]interface{}  "Invalid room ID or poll"
+// @Failure      401      {object}  map[string]interface{}  "User not authenticated"
+// @Failure      403      {object}  map[string]interface{}  "User may not manage polls"
+// @Failure      404      {object}  map[string]interface{}  "Room not found"
+// @Failure      409      {object}  map[string]interface{}  "Room has ended"
+// @Failure      500      {object}  map[string]interface{}  "Failed to create poll"
+// @Router       /rooms/{id}/polls [post]
+func (h *Room) CreatePoll(c echo.Context) error {
+	roomID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return h.handleError(c, errors.ErrInvalidArgument("Invalid room ID").WithDetail("error", "Room ID must be a valid UUID"))
+	}
+
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return h.handleError(c, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated"))
+	}
+
+	var req room.CreatePollRequest
+	if err := c.Bind(&req); err != nil {
+		return h.handleError(c, errors.ErrInvalidArgument("Invalid request body"))
+	}
+	if err := c.Validate(&req); err != nil {
+		return h.handleError(c, errors.ErrInvalidArgument("Validation failed").WithDetail("error", err.Error()))
+	}
+
+	poll, err := h.roomService.CreatePoll(c.Request().Context(), roomUsecase.CreatePollInput{
+		RoomID:            roomID,
+		UserID:            userID,
+		Question:          req.Question,
+		Type:              entities.PollType(req.Type),
+		Options:           req.Options,
+		Anonymous:         req.Anonymous,
+		Duration:          time.Duration(req.DurationSeconds) * time.Second,
+		CloseWhenAllVoted: req.CloseWhenAllVoted,
+	})
+	if err != nil {
+		return h.handleError(c, mapPollError(err))
+	}
+
+	return h.handleSuccess(c, presenter.ToPollResponse(poll))
+}
+
+// GetPoll handles GET /rooms/:id/polls/:poll_id
+// @Summary      Get a poll
+// @Description  Returns a poll with its live or final results and the caller's own vote
+// @Tags         Polls
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id       path      string  true  "Room ID (UUID)"
+// @Param        poll_id  path      string  true  "Poll ID (UUID)"
+// @Success      200      {object}  room.PollResponse       "Poll"
+// @Failure      400      {object}  map[string]interface{}  "Invalid room ID or poll ID"
+// @Failure      401      {object}  map[string]interface{}  "User not authenticated"
+// @Failure      404      {object}  map[string]interface{}  "Participant or poll not found"
+// @Failure      409      {object}  map[string]interface{}  "User was never in the meeting"
+// @Failure      500      {object}  map[string]interface{}  "Failed to get poll"
+// @Router       /rooms/{id}/polls/{poll_id} [get]
+func (h *Room) GetPoll(c echo.Context) error {
+	roomID, participantID, err := h.chatParticipant(c)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	pollID, err := parsePollID(c)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	poll, err := h.roomService.GetPoll(c.Request().Context(), roomID, pollID, participantID)
+	if err != nil {
+		return h.handleError(c, mapPollError(err))
+	}
+
+	return h.handleSuccess(c, presenter.ToPollResponse(poll))
+}
+
+// Vote handles POST /rooms/:id/polls/:poll_id/votes
+// @Summary      Vote in a poll
+// @Description  Casts a vote in an open poll: option_ids for choice polls (exactly one for single choice), text for free-text polls. Voting again replaces the previous vote. Requires being in the meeting.
+// @Tags         Polls
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id       path      string                  true  "Room ID (UUID)"
+// @Param        poll_id  path      string                  true  "Poll ID (UUID)"
+// @Param        request  body      room.VoteRequest        true  "Vote"
+// @Success      200      {object}  room.PollResponse       "Poll with updated results"
+// @Failure      400      {object}  map[string]interface{}  "Invalid room ID, poll ID or vote"
+// @Failure      401      {object}  map[string]interface{}  "User not authenticated"
+// @Failure      404      {object}  map[string]interface{}  "Participant or poll not found"
+// @Failure      409      {object}  map[string]interface{}  "Poll is closed or user is not in the meeting"
+// @Failure      500      {object}  map[string]interface{}  "Failed to vote"
+// @Router       /rooms/{id}/polls/{poll_id}/votes [post]
+func (h *Room) Vote(c echo.Context) error {
+	roomID, participantID, err := h.chatParticipant(c)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	input, err := parseVote(c, roomID, participantID)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	poll, err := h.roomService.Vote(c.Request().Context(), input)
+	if err != nil {
+		return h.handleError(c, mapPollError(err))
+	}
+
+	return h.handleSuccess(c, presenter.ToPollResponse(poll))
+}
+
+// ClosePoll handles POST /rooms/:id/polls/:poll_id/close
+// @Summary      Close a poll
+// @Description  Stops an open poll and stores its final results (host or co-host). Closing a closed poll returns it unchanged.
+// @Tags         Polls
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id       path      string  true  "Room ID (UUID)"
+// @Param        poll_id  path      string  true  "Poll ID (UUID)"
+// @Success      200      {object}  room.PollResponse       "Closed poll with final results"
+// @Failure      400      {object}  map[string]interface{}  "Invalid room ID or poll ID"
+// @Failure      401      {object}  map[string]interface{}  "User not authenticated"
+// @Failure      403      {object}  map[string]interface{}  "User may not manage polls"
+// @Failure      404      {object}  map[string]interface{}  "Room or poll not found"
+// @Failure      500      {object}  map[string]interface{}  "Failed to close poll"
+// @Router       /rooms/{id}/polls/{poll_id}/close [post]
+func (h *Room) ClosePoll(c echo.Context) error {
+	roomID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return h.handleError(c, errors.ErrInvalidArgument("Invalid room ID").WithDetail("error", "Room ID must be a valid UUID"))
+	}
+
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return h.handleError(c, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated"))
+	}
+
+	pollID, err := parsePollID(c)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
+	poll, err := h.roomService.ClosePoll(c.Request().Context(), roomID, pollID, userID)
+	if err != nil {
+		return h.handleError(c, mapPollError(err))
+	}
+
+	return h.handleSuccess(c, presenter.ToPollResponse(poll))
+}
+
+// parsePollID reads the poll_id path parameter
+func parsePollID(c echo.Context) (uuid.UUID, error) {
+	pollID, err := uuid.Parse(c.Param("poll_id"))
+	if err != nil {
+		return uuid.Nil, errors.ErrInvalidArgument("Invalid poll ID").WithDetail("error", "Poll ID must be a valid UUID")
+	}
+	return pollID, nil
+}
+
+// parseVote binds and validates the vote of a participant
+func parseVote(c echo.Context, roomID, participantID uuid.UUID) (roomUsecase.VoteInput, error) {
+	pollID, err := parsePollID(c)
+	if err != nil {
+		return roomUsecase.VoteInput{}, err
+	}
+
+	var req room.VoteRequest
+	if err := c.Bind(&req); err != nil {
+		return roomUsecase.VoteInput{}, errors.ErrInvalidArgument("Invalid request body")
+	}
+	if err := c.Validate(&req); err != nil {
+		return roomUsecase.VoteInput{}, errors.ErrInvalidArgument("Validation failed").WithDetail("error", err.Error())
+	}
+
+	input := roomUsecase.VoteInput{
+		RoomID:        roomID,
+		PollID:        pollID,
+		ParticipantID: participantID,
+		Text:          req.Text,
+	}
+	for _, raw := range req.OptionIDs {
+		input.OptionIDs = append(input.OptionIDs, uuid.MustParse(raw)) // Validated as UUIDs
+	}
+	return input, nil
+}
+
+// mapPollError maps poll use case errors to API errors
+func mapPollError(err error) error {
+	switch {
+	case stdErrors.Is(err, usecaseErrors.ErrPollNotFound):
+		return errors.ErrNotFound("Poll")
+	case stdErrors.Is(err, usecaseErrors.ErrPollClosed):
+		return errors.ErrFailedPrecondition(err.Error())
+	case stdErrors.Is(err, usecaseErrors.ErrInvalidPollType),
+		stdErrors.Is(err, usecaseErrors.ErrInvalidPollOptions),
+		stdErrors.Is(err, usecaseErrors.ErrInvalidPollClose),
+		stdErrors.Is(err, usecaseErrors.ErrInvalidVote):
+		return errors.ErrInvalidArgument(err.Error())
+	default:
+		return mapChatError(err)
+	}
+}
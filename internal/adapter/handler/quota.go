@@ -0,0 +1,103 @@
+package handler
+
+import (
+	stdErrors "errors"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+
+	"github.com/johnquangdev/meeting-assistant/errors"
+	quotaDTO "github.com/johnquangdev/meeting-assistant/internal/adapter/dto/quota"
+	"github.com/johnquangdev/meeting-assistant/internal/adapter/presenter"
+	usecaseErrors "github.com/johnquangdev/meeting-assistant/internal/usecase/errors"
+	quotaUsecase "github.com/johnquangdev/meeting-assistant/internal/usecase/quota"
+)
+
+// Quota handles plan and usage HTTP requests
+type Quota struct {
+	quotaService quotaUsecase.Service
+	logger       *zap.Logger
+}
+
+// NewQuotaHandler creates a new quota handler
+func NewQuotaHandler(quotaService quotaUsecase.Service, logger *zap.Logger) *Quota {
+	return &Quota{
+		quotaService: quotaService,
+		logger:       logger,
+	}
+}
+
+// GetUsage reports the plan and this month's usage of the current user, or of an organization
+// @Summary      Get plan and usage
+// @Description  Reports the plan limits and this month's usage (active rooms, meeting minutes, recording storage, AI minutes)
+// @Description  of the current user's personal rooms, or of the organization given in X-Organization-ID (members only).
+// @Description  A limit of 0 means unlimited; no plan means no limits.
+// @Tags         Plans
+// @Produce      json
+// @Security     BearerAuth
+// @Param        X-Organization-ID  header    string                   false  "Organization ID (UUID)"
+// @Success      200                {object}  quotaDTO.UsageResponse   "Plan and usage"
+// @Failure      400                {object}  map[string]interface{}   "Invalid organization ID"
+// @Failure      401                {object}  map[string]interface{}   "User not authenticated"
+// @Failure      403                {object}  map[string]interface{}   "Not a member of the organization"
+// @Router       /usage [get]
+func (h *Quota) GetUsage(c echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return HandleError(h.logger, c, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated"))
+	}
+
+	orgID, err := organizationScope(c)
+	if err != nil {
+		return HandleError(h.logger, c, err)
+	}
+
+	report, err := h.quotaService.GetUsage(c.Request().Context(), userID, orgID)
+	if err != nil {
+		return HandleError(h.logger, c, mapQuotaError(err))
+	}
+
+	return HandleSuccess(h.logger, c, presenter.ToUsageResponse(report))
+}
+
+// ListPlans lists the available plans
+// @Summary      List plans
+// @Description  Lists the available plans with their limits (0 = unlimited)
+// @Tags         Plans
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200  {object}  quotaDTO.PlanListResponse  "List of plans"
+// @Failure      401  {object}  map[string]interface{}     "User not authenticated"
+// @Router       /plans [get]
+func (h *Quota) ListPlans(c echo.Context) error {
+	plans, err := h.quotaService.ListPlans(c.Request().Context())
+	if err != nil {
+		return HandleError(h.logger, c, mapQuotaError(err))
+	}
+
+	response := &quotaDTO.PlanListResponse{
+		Plans: presenter.ToPlanResponses(plans),
+	}
+	return HandleSuccess(h.logger, c, response)
+}
+
+// mapQuotaError maps plan and quota use case errors to API errors
+func mapQuotaError(err error) error {
+	switch {
+	case stdErrors.Is(err, usecaseErrors.ErrPlanNotFound):
+		return errors.ErrNotFound("Plan")
+	case stdErrors.Is(err, usecaseErrors.ErrUserNotFound):
+		return errors.ErrNotFound("User")
+	case stdErrors.Is(err, usecaseErrors.ErrOrganizationNotFound):
+		return errors.ErrNotFound("Organization")
+	case stdErrors.Is(err, usecaseErrors.ErrNotOrganizationMember):
+		return errors.ErrForbidden(err.Error())
+	case stdErrors.Is(err, usecaseErrors.ErrAIMinutesExhausted):
+		return errors.ErrAIQuotaExceeded().WithDetail("error", err.Error())
+	case stdErrors.Is(err, usecaseErrors.ErrQuotaExceeded):
+		return errors.ErrResourceExhausted(err.Error())
+	default:
+		return errors.ErrInternal(err)
+	}
+}
@@ -0,0 +1,302 @@
+package handler
+
+import (
+	stdErrors "errors"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+
+	"github.com/johnquangdev/meeting-assistant/errors"
+	"github.com/johnquangdev/meeting-assistant/internal/adapter/presenter"
+	usecaseErrors "github.com/johnquangdev/meeting-assistant/internal/usecase/errors"
+	recordingUsecase "github.com/johnquangdev/meeting-assistant/internal/usecase/recording"
+)
+
+// Recording handles HTTP requests to view, play and delete recordings
+type Recording struct {
+	recordingService recordingUsecase.Service
+	logger           *zap.Logger
+}
+
+// NewRecordingHandler creates a new recording handler
+func NewRecordingHandler(recordingService recordingUsecase.Service, logger *zap.Logger) *Recording {
+	return &Recording{
+		recordingService: recordingService,
+		logger:           logger,
+	}
+}
+
+// StartRecording handles POST /rooms/:id/recording/start
+// @Summary      Start recording
+// @Description  Starts recording a running room (host, or participants allowed to record). The recording is tracked right away and announced as a recording.started room event; its file is stored once the recording stops.
+// @Description  In rooms requiring recording consent, everyone in the meeting must have consented first; otherwise a recording.consent_required event asks them to.
+// @Tags         Recordings
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "Room ID (UUID)"
+// @Success      200  {object}  room.RecordingResponse  "Recording started"
+// @Failure      400  {object}  map[string]interface{}  "Invalid room ID"
+// @Failure      401  {object}  map[string]interface{}  "User not authenticated"
+// @Failure      403  {object}  map[string]interface{}  "User may not record"
+// @Failure      404  {object}  map[string]interface{}  "Room not found"
+// @Failure      409  {object}  map[string]interface{}  "Room not running, recording disabled or already in progress, or someone did not consent to be recorded"
+// @Failure      429  {object}  map[string]interface{}  "Recording storage of the plan is full"
+// @Failure      500  {object}  map[string]interface{}  "Failed to start recording"
+// @Router       /rooms/{id}/recording/start [post]
+func (h *Room) StartRecording(c echo.Context) error {
+	roomID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return h.handleError(c, errors.ErrInvalidArgument("Invalid room ID").WithDetail("error", "Room ID must be a valid UUID"))
+	}
+
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return h.handleError(c, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated"))
+	}
+
+	recording, err := h.roomService.StartRecording(c.Request().Context(), roomID, userID)
+	if err != nil {
+		return h.handleError(c, mapRecordingError(err, roomID, true))
+	}
+
+	return h.handleSuccess(c, presenter.ToRecordingResponse(recording))
+}
+
+// StopRecording handles POST /rooms/:id/recording/stop
+// @Summary      Stop recording
+// @Description  Ends the recording of a room, running or paused (host, or participants allowed to record). Announced as a recording.stopped room event; the recording completes once its files are stored.
+// @Tags         Recordings
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "Room ID (UUID)"
+// @Success      200  {object}  room.RecordingResponse  "Recording stopped"
+// @Failure      400  {object}  map[string]interface{}  "Invalid room ID"
+// @Failure      401  {object}  map[string]interface{}  "User not authenticated"
+// @Failure      403  {object}  map[string]interface{}  "User may not record"
+// @Failure      404  {object}  map[string]interface{}  "Room not found"
+// @Failure      409  {object}  map[string]interface{}  "Room not running or not recording"
+// @Failure      500  {object}  map[string]interface{}  "Failed to stop recording"
+// @Router       /rooms/{id}/recording/stop [post]
+func (h *Room) StopRecording(c echo.Context) error {
+	roomID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return h.handleError(c, errors.ErrInvalidArgument("Invalid room ID").WithDetail("error", "Room ID must be a valid UUID"))
+	}
+
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return h.handleError(c, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated"))
+	}
+
+	recording, err := h.roomService.StopRecording(c.Request().Context(), roomID, userID)
+	if err != nil {
+		return h.handleError(c, mapRecordingError(err, roomID, false))
+	}
+
+	return h.handleSuccess(c, presenter.ToRecordingResponse(recording))
+}
+
+// PauseRecording handles POST /rooms/:id/recording/pause
+// @Summary      Pause recording
+// @Description  Pauses the recording of a room; nothing is recorded until it resumes (host, or participants allowed to record). Announced as a recording.paused room event.
+// @Tags         Recordings
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "Room ID (UUID)"
+// @Success      200  {object}  room.RecordingResponse  "Recording paused"
+// @Failure      400  {object}  map[string]interface{}  "Invalid room ID"
+// @Failure      401  {object}  map[string]interface{}  "User not authenticated"
+// @Failure      403  {object}  map[string]interface{}  "User may not record"
+// @Failure      404  {object}  map[string]interface{}  "Room not found"
+// @Failure      409  {object}  map[string]interface{}  "Room not running, not recording or already paused"
+// @Failure      500  {object}  map[string]interface{}  "Failed to pause recording"
+// @Router       /rooms/{id}/recording/pause [post]
+func (h *Room) PauseRecording(c echo.Context) error {
+	roomID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return h.handleError(c, errors.ErrInvalidArgument("Invalid room ID").WithDetail("error", "Room ID must be a valid UUID"))
+	}
+
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return h.handleError(c, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated"))
+	}
+
+	recording, err := h.roomService.PauseRecording(c.Request().Context(), roomID, userID)
+	if err != nil {
+		return h.handleError(c, mapRecordingError(err, roomID, false))
+	}
+
+	return h.handleSuccess(c, presenter.ToRecordingResponse(recording))
+}
+
+// ResumeRecording handles POST /rooms/:id/recording/resume
+// @Summary      Resume recording
+// @Description  Resumes a paused recording (host, or participants allowed to record). Announced as a recording.resumed room event.
+// @Tags         Recordings
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "Room ID (UUID)"
+// @Success      200  {object}  room.RecordingResponse  "Recording resumed"
+// @Failure      400  {object}  map[string]interface{}  "Invalid room ID"
+// @Failure      401  {object}  map[string]interface{}  "User not authenticated"
+// @Failure      403  {object}  map[string]interface{}  "User may not record"
+// @Failure      404  {object}  map[string]interface{}  "Room not found"
+// @Failure      409  {object}  map[string]interface{}  "Room not running, recording disabled or not paused, or someone did not consent to be recorded"
+// @Failure      429  {object}  map[string]interface{}  "Recording storage of the plan is full"
+// @Failure      500  {object}  map[string]interface{}  "Failed to resume recording"
+// @Router       /rooms/{id}/recording/resume [post]
+func (h *Room) ResumeRecording(c echo.Context) error {
+	roomID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return h.handleError(c, errors.ErrInvalidArgument("Invalid room ID").WithDetail("error", "Room ID must be a valid UUID"))
+	}
+
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return h.handleError(c, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated"))
+	}
+
+	recording, err := h.roomService.ResumeRecording(c.Request().Context(), roomID, userID)
+	if err != nil {
+		return h.handleError(c, mapRecordingError(err, roomID, true))
+	}
+
+	return h.handleSuccess(c, presenter.ToRecordingResponse(recording))
+}
+
+// ListRoomRecordings handles GET /rooms/:id/recordings
+// @Summary      List the recordings of a room
+// @Description  Lists the recordings of a room, newest first, with their state and segments. Available to the host, to participants who joined the meeting and, for organization rooms, to the organization's members.
+// @Tags         Recordings
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "Room ID (UUID)"
+// @Success      200  {object}  room.RecordingListResponse  "Recordings"
+// @Failure      400  {object}  map[string]interface{}      "Invalid room ID"
+// @Failure      401  {object}  map[string]interface{}      "User not authenticated"
+// @Failure      403  {object}  map[string]interface{}      "User did not take part in the meeting"
+// @Failure      404  {object}  map[string]interface{}      "Room not found"
+// @Failure      500  {object}  map[string]interface{}      "Failed to list recordings"
+// @Router       /rooms/{id}/recordings [get]
+func (h *Recording) ListRoomRecordings(c echo.Context) error {
+	roomID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid room ID").WithDetail("error", "Room ID must be a valid UUID"))
+	}
+
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return HandleError(h.logger, c, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated"))
+	}
+
+	recordings, err := h.recordingService.ListRoomRecordings(c.Request().Context(), roomID, userID)
+	if err != nil {
+		return HandleError(h.logger, c, mapRecordingAccessError(err))
+	}
+
+	return HandleSuccess(h.logger, c, presenter.ToRecordingListResponse(recordings))
+}
+
+// GetRecording handles GET /recordings/:id
+// @Summary      Get a recording
+// @Description  Returns a recording with short-lived presigned URLs to play its files, in order (one per segment between pauses). URLs are only given once the recording completed; request the recording again when they expire. Same access as listing the recordings of the room.
+// @Tags         Recordings
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "Recording ID (UUID)"
+// @Success      200  {object}  room.RecordingPlaybackResponse  "Recording with playback URLs"
+// @Failure      400  {object}  map[string]interface{}          "Invalid recording ID"
+// @Failure      401  {object}  map[string]interface{}          "User not authenticated"
+// @Failure      404  {object}  map[string]interface{}          "Recording not found"
+// @Failure      500  {object}  map[string]interface{}          "Failed to get recording"
+// @Router       /recordings/{id} [get]
+func (h *Recording) GetRecording(c echo.Context) error {
+	recordingID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid recording ID").WithDetail("error", "Recording ID must be a valid UUID"))
+	}
+
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return HandleError(h.logger, c, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated"))
+	}
+
+	playback, err := h.recordingService.GetRecording(c.Request().Context(), recordingID, userID)
+	if err != nil {
+		return HandleError(h.logger, c, mapRecordingAccessError(err))
+	}
+
+	return HandleSuccess(h.logger, c, presenter.ToRecordingPlaybackResponse(playback))
+}
+
+// DeleteRecording handles DELETE /recordings/:id
+// @Summary      Delete a recording
+// @Description  Deletes the files of a recording from storage and marks it deleted (host, or owners and admins of its organization). Running or paused recordings have to be stopped first.
+// @Tags         Recordings
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "Recording ID (UUID)"
+// @Success      200  {object}  map[string]interface{}  "Recording deleted"
+// @Failure      400  {object}  map[string]interface{}  "Invalid recording ID"
+// @Failure      401  {object}  map[string]interface{}  "User not authenticated"
+// @Failure      403  {object}  map[string]interface{}  "User may not delete the recording"
+// @Failure      404  {object}  map[string]interface{}  "Recording not found"
+// @Failure      409  {object}  map[string]interface{}  "Recording in progress"
+// @Failure      500  {object}  map[string]interface{}  "Failed to delete recording"
+// @Router       /recordings/{id} [delete]
+func (h *Recording) DeleteRecording(c echo.Context) error {
+	recordingID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid recording ID").WithDetail("error", "Recording ID must be a valid UUID"))
+	}
+
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return HandleError(h.logger, c, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated"))
+	}
+
+	if err := h.recordingService.DeleteRecording(c.Request().Context(), recordingID, userID); err != nil {
+		return HandleError(h.logger, c, mapRecordingAccessError(err))
+	}
+
+	return HandleSuccess(h.logger, c, map[string]interface{}{
+		"message": "Recording deleted successfully",
+	})
+}
+
+// mapRecordingError maps recording use case errors to API errors (starting: the request starts an egress)
+func mapRecordingError(err error, roomID uuid.UUID, starting bool) error {
+	switch {
+	case stdErrors.Is(err, usecaseErrors.ErrRecordingDisabled),
+		stdErrors.Is(err, usecaseErrors.ErrRecordingInProgress),
+		stdErrors.Is(err, usecaseErrors.ErrRecordingNotStarted),
+		stdErrors.Is(err, usecaseErrors.ErrRecordingPaused),
+		stdErrors.Is(err, usecaseErrors.ErrRecordingNotPaused),
+		stdErrors.Is(err, usecaseErrors.ErrRecordingConsentRequired),
+		stdErrors.Is(err, usecaseErrors.ErrRoomNotActive):
+		return errors.ErrFailedPrecondition(err.Error())
+	case stdErrors.Is(err, usecaseErrors.ErrQuotaExceeded):
+		return errors.ErrResourceExhausted(err.Error())
+	case stdErrors.Is(err, usecaseErrors.ErrRecordingFailed):
+		if starting {
+			return errors.ErrRecordingStartFailed(roomID.String(), err)
+		}
+		return errors.ErrInternal(err)
+	default:
+		return mapParticipantError(err)
+	}
+}
+
+// mapRecordingAccessError maps recording access use case errors to API errors
+func mapRecordingAccessError(err error) error {
+	switch {
+	case stdErrors.Is(err, usecaseErrors.ErrRecordingNotFound):
+		return errors.ErrNotFound("Recording")
+	case stdErrors.Is(err, usecaseErrors.ErrRecordingInProgress):
+		return errors.ErrFailedPrecondition(err.Error())
+	default:
+		return mapParticipantError(err)
+	}
+}
@@ -3,6 +3,7 @@ package handler
 import (
 	"context"
 	"encoding/json"
+	stdErrors "errors"
 	"fmt"
 	"strconv"
 
@@ -17,6 +18,7 @@ import (
 	"github.com/johnquangdev/meeting-assistant/internal/adapter/repository"
 	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
 	domainrepo "github.com/johnquangdev/meeting-assistant/internal/domain/repositories"
+	usecaseErrors "github.com/johnquangdev/meeting-assistant/internal/usecase/errors"
 	roomUsecase "github.com/johnquangdev/meeting-assistant/internal/usecase/room"
 )
 
@@ -40,15 +42,18 @@ func NewRoomHandler(roomService roomUsecase.Service, aiJobRepo *repository.AIJob
 
 // CreateRoom handles POST /rooms
 // @Summary      Create a new room
-// @Description  Creates a new meeting room with specified settings
+// @Description  Creates a new meeting room with specified settings. With X-Organization-ID the room belongs to that organization and starts from its room defaults.
 // @Tags         Rooms
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
+// @Param        X-Organization-ID  header    string                  false  "Organization of the room (personal room when absent)"
 // @Param        request  body      room.CreateRoomRequest  true  "Room creation request"
 // @Success      201      {object}  room.RoomResponse  "Room created successfully"
 // @Failure      400      {object}  map[string]interface{}  "Invalid request or validation failed"
 // @Failure      401      {object}  map[string]interface{}  "User not authenticated"
+// @Failure      403      {object}  map[string]interface{}  "Not a member of the organization"
+// @Failure      429      {object}  map[string]interface{}  "Plan limit reached (participants, meeting minutes or recording storage)"
 // @Failure      500      {object}  map[string]interface{}  "Failed to create room"
 // @Router       /rooms [post]
 func (h *Room) CreateRoom(c echo.Context) error {
@@ -81,6 +86,11 @@ func (h *Room) CreateRoom(c echo.Context) error {
 		return h.handleError(c, errors.ErrInvalidArgument("Invalid room type").WithDetail("error", "Room type must be public, private, or scheduled"))
 	}
 
+	orgID, err := organizationScope(c)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+
 	// Create room
 	input := roomUsecase.CreateRoomInput{
 		Name:               req.Name,
@@ -91,10 +101,24 @@ func (h *Room) CreateRoom(c echo.Context) error {
 		Settings:           req.Settings,
 		ScheduledStartTime: req.ScheduledStartTime,
 		ScheduledEndTime:   req.ScheduledEndTime,
+		Passcode:           req.Passcode,
+		OrganizationID:     orgID,
 	}
 
 	output, err := h.roomService.CreateRoom(c.Request().Context(), input)
 	if err != nil {
+		if stdErrors.Is(err, usecaseErrors.ErrNotOrganizationMember) {
+			return h.handleError(c, errors.ErrForbidden(err.Error()))
+		}
+		if stdErrors.Is(err, usecaseErrors.ErrInvalidRoomSettings) {
+			return h.handleError(c, errors.ErrInvalidArgument("Invalid room settings").WithDetail("error", err.Error()))
+		}
+		if stdErrors.Is(err, usecaseErrors.ErrInvalidPasscode) {
+			return h.handleError(c, errors.ErrInvalidArgument(err.Error()))
+		}
+		if stdErrors.Is(err, usecaseErrors.ErrQuotaExceeded) {
+			return h.handleError(c, errors.ErrResourceExhausted(err.Error()))
+		}
 		return h.handleError(c, errors.ErrInternal(err))
 	}
 
@@ -109,13 +133,14 @@ func (h *Room) CreateRoom(c echo.Context) error {
 
 // GetRoom handles GET /rooms/:id
 // @Summary      Get room details
-// @Description  Gets detailed information about a specific room
+// @Description  Gets detailed information about a specific room. Rooms of an organization are only visible to its members and to the people invited to them.
 // @Tags         Rooms
 // @Produce      json
 // @Security     BearerAuth
 // @Param        id   path      string  true  "Room ID (UUID)"
 // @Success      200  {object}  room.RoomResponse  "Room details"
 // @Failure      400  {object}  map[string]interface{}  "Invalid room ID"
+// @Failure      401  {object}  map[string]interface{}  "User not authenticated"
 // @Failure      404  {object}  map[string]interface{}  "Room not found"
 // @Router       /rooms/{id} [get]
 func (h *Room) GetRoom(c echo.Context) error {
@@ -124,7 +149,12 @@ func (h *Room) GetRoom(c echo.Context) error {
 		return h.handleError(c, errors.ErrInvalidArgument("Invalid room ID").WithDetail("error", "Room ID must be a valid UUID"))
 	}
 
-	r, err := h.roomService.GetRoom(c.Request().Context(), roomID)
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return h.handleError(c, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated"))
+	}
+
+	r, err := h.roomService.GetRoomForUser(c.Request().Context(), roomID, userID)
 	if err != nil {
 		return h.handleError(c, errors.ErrNotFound("Room not found").WithDetail("error", err.Error()))
 	}
@@ -134,20 +164,23 @@ func (h *Room) GetRoom(c echo.Context) error {
 
 // ListRooms handles GET /rooms
 // @Summary      List rooms
-// @Description  Gets a paginated list of rooms with optional filters
+// @Description  Gets a paginated list of rooms with optional filters. Lists the rooms of the organization given by X-Organization-ID (members only), or personal rooms without it.
 // @Tags         Rooms
 // @Produce      json
 // @Security     BearerAuth
+// @Param        X-Organization-ID  header  string  false  "Organization whose rooms are listed"
 // @Param        page       query     int     false  "Page number (default: 1)"
 // @Param        page_size  query     int     false  "Items per page (default: 20)"
 // @Param        type       query     string  false  "Room type filter (public/private/scheduled)"
 // @Param        status     query     string  false  "Room status filter (scheduled/active/ended/cancelled)"
 // @Param        search     query     string  false  "Search by room name"
+// @Param        series_id  query     string  false  "Filter by recurring series ID"
 // @Param        tags       query     array   false  "Filter by tags"
 // @Param        sort_by    query     string  false  "Sort field (created_at/start_time/participant_count)"
 // @Param        sort_order query     string  false  "Sort order (asc/desc)"
 // @Success      200        {object}  room.RoomListResponse  "List of rooms"
 // @Failure      400        {object}  map[string]interface{}  "Invalid request"
+// @Failure      403        {object}  map[string]interface{}  "Not a member of the organization"
 // @Failure      500        {object}  map[string]interface{}  "Failed to list rooms"
 // @Router       /rooms [get]
 func (h *Room) ListRooms(c echo.Context) error {
@@ -158,6 +191,7 @@ func (h *Room) ListRooms(c echo.Context) error {
 	req.Type = c.QueryParam("type")
 	req.Status = c.QueryParam("status")
 	req.Search = c.QueryParam("search")
+	req.SeriesID = c.QueryParam("series_id")
 
 	// Parse integer params with defaults
 	page := c.QueryParam("page")
@@ -197,8 +231,22 @@ func (h *Room) ListRooms(c echo.Context) error {
 		zap.Int("page_size", req.PageSize),
 	)
 
-	// Build filters
+	// Build filters, confined to the organization of the request
 	filters := buildFilters(&req)
+	orgID, err := organizationScope(c)
+	if err != nil {
+		return h.handleError(c, err)
+	}
+	if orgID != nil {
+		userID, ok := c.Get("user_id").(uuid.UUID)
+		if !ok {
+			return h.handleError(c, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated"))
+		}
+		if err := h.roomService.AuthorizeOrganization(c.Request().Context(), *orgID, userID); err != nil {
+			return h.handleError(c, mapOrganizationError(err))
+		}
+		filters.OrganizationID = orgID
+	}
 
 	h.logger.Info("ListRooms filters",
 		zap.Any("type_filter", filters.Type),
@@ -220,11 +268,15 @@ func (h *Room) ListRooms(c echo.Context) error {
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
-// @Param        id   path      string  true  "Room ID (UUID)"
+// @Param        id       path      string                true   "Room ID (UUID)"
+// @Param        request  body      room.JoinRoomRequest  false  "Passcode (rooms with a passcode only)"
 // @Success      200  {object}  room.JoinRoomResponse  "Successfully joined room with LiveKit credentials"
-// @Failure      400  {object}  map[string]interface{}  "Invalid room ID or room is full"
+// @Failure      400  {object}  map[string]interface{}  "Invalid room ID"
 // @Failure      401  {object}  map[string]interface{}  "User not authenticated"
-// @Failure      409  {object}  map[string]interface{}  "User already in room"
+// @Failure      403  {object}  map[string]interface{}  "Not invited, passcode missing or incorrect, or room is locked"
+// @Failure      404  {object}  map[string]interface{}  "Room not found"
+// @Failure      409  {object}  map[string]interface{}  "User already in room, room is full or has ended"
+// @Failure      429  {object}  map[string]interface{}  "Too many incorrect passcode attempts, or plan limit reached"
 // @Failure      500  {object}  map[string]interface{}  "Failed to join room"
 // @Router       /rooms/{id}/participants [post]
 func (h *Room) JoinRoom(c echo.Context) error {
@@ -238,14 +290,22 @@ func (h *Room) JoinRoom(c echo.Context) error {
 		return h.handleError(c, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated"))
 	}
 
+	// The body is optional: only rooms with a passcode need one
+	var req room.JoinRoomRequest
+	if err := c.Bind(&req); err != nil {
+		return h.handleError(c, errors.ErrInvalidArgument("Invalid request body"))
+	}
+
 	input := roomUsecase.JoinRoomInput{
-		RoomID: roomID,
-		UserID: userID,
+		RoomID:   roomID,
+		UserID:   userID,
+		Passcode: req.Passcode,
+		ClientIP: c.RealIP(),
 	}
 
 	r, participant, err := h.roomService.JoinRoom(c.Request().Context(), input)
 	if err != nil {
-		return h.handleError(c, errors.ErrInternal(err))
+		return h.handleError(c, mapJoinError(err))
 	}
 
 	// Check if user is in waiting room
@@ -260,6 +320,22 @@ func (h *Room) JoinRoom(c echo.Context) error {
 		return h.handleSuccess(c, response)
 	}
 
+	// Room is full: the user joins automatically when a seat frees up
+	if participant.Status == entities.ParticipantStatusWaitlisted {
+		position, err := h.roomService.WaitlistPosition(c.Request().Context(), participant)
+		if err != nil {
+			return h.handleError(c, errors.ErrInternal(err))
+		}
+		response := &room.JoinRoomResponse{
+			Status:           "waitlisted",
+			Message:          waitlistMessage(position),
+			Room:             presenter.ToRoomResponse(r),
+			Participant:      presenter.ToParticipantResponse(participant),
+			WaitlistPosition: position,
+		}
+		return h.handleSuccess(c, response)
+	}
+
 	// User has joined successfully - generate LiveKit token
 	var livekitToken string
 	livekitToken, err = h.roomService.GenerateParticipantToken(c.Request().Context(), r, participant)
@@ -267,13 +343,20 @@ func (h *Room) JoinRoom(c echo.Context) error {
 		return h.handleError(c, errors.ErrInternal(err))
 	}
 
+	// Rooms requiring recording consent ask for it on join
+	consent, err := recordingConsentPrompt(c.Request().Context(), h.roomService, participant)
+	if err != nil {
+		return h.handleError(c, errors.ErrInternal(err))
+	}
+
 	response := &room.JoinRoomResponse{
-		Status:       "joined",
-		Message:      "Successfully joined the room",
-		Room:         presenter.ToRoomResponse(r),
-		Participant:  presenter.ToParticipantResponse(participant),
-		LivekitToken: livekitToken,
-		LivekitURL:   h.roomService.GetLivekitURL(),
+		Status:           "joined",
+		Message:          "Successfully joined the room",
+		Room:             presenter.ToRoomResponse(r),
+		Participant:      presenter.ToParticipantResponse(participant),
+		LivekitToken:     livekitToken,
+		LivekitURL:       h.roomService.GetLivekitURL(),
+		RecordingConsent: consent,
 	}
 
 	return h.handleSuccess(c, response)
@@ -342,6 +425,70 @@ func (h *Room) EndRoom(c echo.Context) error {
 	return h.handleSuccess(c, map[string]string{"message": "room ended successfully"})
 }
 
+// UpdateRoomSettings handles PATCH /rooms/:id/settings
+// @Summary      Update room settings
+// @Description  Partially updates the settings of a room (host only). Omitted fields keep their current value.
+// @Tags         Rooms
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id       path      string                           true  "Room ID (UUID)"
+// @Param        request  body      room.UpdateRoomSettingsRequest  true  "Settings to change"
+// @Success      200      {object}  room.RoomResponse  "Updated room"
+// @Failure      400      {object}  map[string]interface{}  "Invalid room ID or settings"
+// @Failure      401      {object}  map[string]interface{}  "User not authenticated"
+// @Failure      403      {object}  map[string]interface{}  "User is not the host"
+// @Failure      404      {object}  map[string]interface{}  "Room not found"
+// @Failure      409      {object}  map[string]interface{}  "Room has ended"
+// @Failure      500      {object}  map[string]interface{}  "Failed to update settings"
+// @Router       /rooms/{id}/settings [patch]
+func (h *Room) UpdateRoomSettings(c echo.Context) error {
+	roomID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return h.handleError(c, errors.ErrInvalidArgument("Invalid room ID").WithDetail("error", "Room ID must be a valid UUID"))
+	}
+
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return h.handleError(c, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated"))
+	}
+
+	var req room.UpdateRoomSettingsRequest
+	if err := c.Bind(&req); err != nil {
+		return h.handleError(c, errors.ErrInvalidArgument("Invalid request body"))
+	}
+
+	// Only the fields present in the request are changed
+	raw, err := json.Marshal(req)
+	if err != nil {
+		return h.handleError(c, errors.ErrInternal(err))
+	}
+	var changes map[string]interface{}
+	if err := json.Unmarshal(raw, &changes); err != nil {
+		return h.handleError(c, errors.ErrInternal(err))
+	}
+	if len(changes) == 0 {
+		return h.handleError(c, errors.ErrInvalidArgument("No settings to update"))
+	}
+
+	r, err := h.roomService.UpdateRoomSettings(c.Request().Context(), roomID, userID, changes)
+	if err != nil {
+		switch {
+		case stdErrors.Is(err, usecaseErrors.ErrRoomNotFound):
+			return h.handleError(c, errors.ErrNotFound("Room"))
+		case stdErrors.Is(err, usecaseErrors.ErrNotHost):
+			return h.handleError(c, errors.ErrNotHost())
+		case stdErrors.Is(err, usecaseErrors.ErrRoomEnded):
+			return h.handleError(c, errors.ErrFailedPrecondition("Room has ended"))
+		case stdErrors.Is(err, usecaseErrors.ErrInvalidRoomSettings):
+			return h.handleError(c, errors.ErrInvalidArgument("Invalid room settings").WithDetail("error", err.Error()))
+		}
+		return h.handleError(c, errors.ErrInternal(err))
+	}
+
+	return h.handleSuccess(c, presenter.ToRoomResponse(r))
+}
+
 // GetParticipants handles GET /rooms/:id/participants
 // @Summary      Get room participants
 // @Description  Gets a list of all participants in a room
@@ -351,6 +498,8 @@ func (h *Room) EndRoom(c echo.Context) error {
 // @Param        id   path      string  true  "Room ID (UUID)"
 // @Success      200  {object}  room.ParticipantListResponse  "List of participants"
 // @Failure      400  {object}  map[string]interface{}  "Invalid room ID"
+// @Failure      401  {object}  map[string]interface{}  "User not authenticated"
+// @Failure      404  {object}  map[string]interface{}  "Room not found"
 // @Failure      500  {object}  map[string]interface{}  "Failed to get participants"
 // @Router       /rooms/{id}/participants [get]
 func (h *Room) GetParticipants(c echo.Context) error {
@@ -359,6 +508,16 @@ func (h *Room) GetParticipants(c echo.Context) error {
 		return h.handleError(c, errors.ErrInvalidArgument("Invalid room ID").WithDetail("error", "Room ID must be a valid UUID"))
 	}
 
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return h.handleError(c, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated"))
+	}
+
+	// Participants of organization rooms stay within the organization
+	if _, err := h.roomService.GetRoomForUser(c.Request().Context(), roomID, userID); err != nil {
+		return h.handleError(c, mapParticipantError(err))
+	}
+
 	participants, err := h.roomService.GetParticipants(c.Request().Context(), roomID)
 	if err != nil {
 		return h.handleError(c, errors.ErrInternal(err))
@@ -369,7 +528,7 @@ func (h *Room) GetParticipants(c echo.Context) error {
 
 // GetWaitingParticipants handles GET /rooms/:id/participants/waiting
 // @Summary      Get waiting participants
-// @Description  Gets a list of participants waiting for approval in a room (host only)
+// @Description  Gets a list of participants waiting for approval in a room (host or co-host)
 // @Tags         Participants
 // @Produce      json
 // @Security     BearerAuth
@@ -378,6 +537,7 @@ func (h *Room) GetParticipants(c echo.Context) error {
 // @Failure      400  {object}  map[string]interface{}  "Invalid room ID"
 // @Failure      401  {object}  map[string]interface{}  "User not authenticated"
 // @Failure      403  {object}  map[string]interface{}  "User is not the host"
+// @Failure      404  {object}  map[string]interface{}  "Room not found"
 // @Failure      500  {object}  map[string]interface{}  "Failed to get waiting participants"
 // @Router       /rooms/{id}/participants/waiting [get]
 func (h *Room) GetWaitingParticipants(c echo.Context) error {
@@ -398,7 +558,41 @@ func (h *Room) GetWaitingParticipants(c echo.Context) error {
 
 	participants, err := h.roomService.GetWaitingParticipants(c.Request().Context(), roomID, userID)
 	if err != nil {
-		return h.handleError(c, err)
+		return h.handleError(c, mapParticipantError(err))
+	}
+
+	return h.handleSuccess(c, presenter.ToParticipantListResponse(participants))
+}
+
+// GetWaitlist handles GET /rooms/:id/participants/waitlist
+// @Summary      Get the waitlist
+// @Description  Gets the participants waiting for a free seat of a full room, first in line first (host or co-host).
+// @Description  They join automatically, in this order, when someone leaves.
+// @Tags         Participants
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "Room ID (UUID)"
+// @Success      200  {object}  room.ParticipantListResponse  "Waitlisted participants in line order"
+// @Failure      400  {object}  map[string]interface{}  "Invalid room ID"
+// @Failure      401  {object}  map[string]interface{}  "User not authenticated"
+// @Failure      403  {object}  map[string]interface{}  "User is not the host or a co-host"
+// @Failure      404  {object}  map[string]interface{}  "Room not found"
+// @Failure      500  {object}  map[string]interface{}  "Failed to get waitlist"
+// @Router       /rooms/{id}/participants/waitlist [get]
+func (h *Room) GetWaitlist(c echo.Context) error {
+	roomID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return h.handleError(c, errors.ErrInvalidArgument("Invalid room ID").WithDetail("error", "Room ID must be a valid UUID"))
+	}
+
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return h.handleError(c, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated"))
+	}
+
+	participants, err := h.roomService.GetWaitlist(c.Request().Context(), roomID, userID)
+	if err != nil {
+		return h.handleError(c, mapParticipantError(err))
 	}
 
 	return h.handleSuccess(c, presenter.ToParticipantListResponse(participants))
@@ -448,7 +642,8 @@ func (h *Room) TransferHost(c echo.Context) error {
 
 // AdmitParticipant handles POST /rooms/:id/participants/:pid/admit
 // @Summary      Admit participant
-// @Description  Admits a waiting participant to join the room (host only)
+// @Description  Admits a waiting participant to join the room (host or co-host)
+// @Description  When the room is full the participant is put on the waitlist and joins when a seat frees up
 // @Tags         Participants
 // @Produce      json
 // @Security     BearerAuth
@@ -478,7 +673,7 @@ func (h *Room) AdmitParticipant(c echo.Context) error {
 
 	accessToken, err := h.roomService.AdmitParticipant(c.Request().Context(), roomID, userID, participantID)
 	if err != nil {
-		return h.handleError(c, err)
+		return h.handleError(c, mapParticipantError(err))
 	}
 
 	return h.handleSuccess(c, map[string]interface{}{
@@ -525,7 +720,7 @@ func (h *Room) DenyParticipant(c echo.Context) error {
 	}
 
 	if err := h.roomService.DenyParticipant(c.Request().Context(), roomID, userID, participantID, req.Reason); err != nil {
-		return h.handleError(c, err)
+		return h.handleError(c, mapParticipantError(err))
 	}
 
 	return h.handleSuccess(c, map[string]interface{}{
@@ -572,7 +767,7 @@ func (h *Room) BlockParticipant(c echo.Context) error {
 	}
 
 	if err := h.roomService.BlockParticipant(c.Request().Context(), roomID, userID, participantID, req.Reason); err != nil {
-		return h.handleError(c, err)
+		return h.handleError(c, mapParticipantError(err))
 	}
 
 	return h.handleSuccess(c, map[string]interface{}{
@@ -610,11 +805,18 @@ func (h *Room) GetMyParticipantStatus(c echo.Context) error {
 		return h.handleError(c, err)
 	}
 
+	position, err := h.roomService.WaitlistPosition(c.Request().Context(), participant)
+	if err != nil {
+		return h.handleError(c, errors.ErrInternal(err))
+	}
+
 	// Build response based on participant status
 	var message string
 	switch participant.Status {
 	case entities.ParticipantStatusWaiting:
 		message = "You are in the waiting room. Please wait for host approval."
+	case entities.ParticipantStatusWaitlisted:
+		message = waitlistMessage(position)
 	case entities.ParticipantStatusJoined:
 		message = "You have been admitted to the room."
 	case entities.ParticipantStatusDenied:
@@ -625,18 +827,75 @@ func (h *Room) GetMyParticipantStatus(c echo.Context) error {
 		message = fmt.Sprintf("Current status: %s", participant.Status)
 	}
 
+	consent, err := recordingConsentPrompt(c.Request().Context(), h.roomService, participant)
+	if err != nil {
+		return h.handleError(c, errors.ErrInternal(err))
+	}
+
 	response := &room.ParticipantStatusResponse{
-		Status:       string(participant.Status),
-		Message:      message,
-		Room:         presenter.ToRoomResponse(r),
-		Participant:  presenter.ToParticipantResponse(participant),
-		LivekitToken: token,
-		LivekitURL:   h.roomService.GetLivekitURL(),
+		Status:           string(participant.Status),
+		Message:          message,
+		Room:             presenter.ToRoomResponse(r),
+		Participant:      presenter.ToParticipantResponse(participant),
+		WaitlistPosition: position,
+		LivekitToken:     token,
+		LivekitURL:       h.roomService.GetLivekitURL(),
+		RecordingConsent: consent,
 	}
 
 	return h.handleSuccess(c, response)
 }
 
+// StreamEvents handles GET /rooms/:id/events
+// @Summary      Stream room events
+// @Description  Opens a Server-Sent Events stream of the room's real-time events, replacing status polling.
+// @Description  Events: participant.waiting, participant.waitlisted, participant.admitted, participant.denied, participant.removed, host.transferred,
+// @Description  recording.started/stopped/paused/resumed, recording.consent_required, room.ended, summary.progress, chat.message(_edited/_deleted), poll.opened/results/closed and agenda.updated/item_started. Lobby events are only sent to the host,
+// @Description  co-hosts and the participant concerned. The stream stays open after room.ended to report AI summary progress.
+// @Description  Browsers may authenticate with the session cookie or an access_token query parameter.
+// @Tags         Rooms
+// @Produce      text/event-stream
+// @Security     BearerAuth
+// @Param        id            path   string  true   "Room ID (UUID)"
+// @Param        access_token  query  string  false  "Access token (for EventSource clients that cannot set headers)"
+// @Success      200  {object}  entities.RoomEvent      "Stream of room events"
+// @Failure      400  {object}  map[string]interface{}  "Invalid room ID"
+// @Failure      401  {object}  map[string]interface{}  "User not authenticated"
+// @Failure      403  {object}  map[string]interface{}  "Not a participant of this room"
+// @Failure      404  {object}  map[string]interface{}  "Room not found"
+// @Router       /rooms/{id}/events [get]
+func (h *Room) StreamEvents(c echo.Context) error {
+	roomID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return h.handleError(c, errors.ErrInvalidArgument("Invalid room ID").WithDetail("error", "Room ID must be a valid UUID"))
+	}
+
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return h.handleError(c, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated"))
+	}
+
+	ctx := c.Request().Context()
+	r, err := h.roomService.GetRoomForUser(ctx, roomID, userID)
+	if err != nil {
+		return h.handleError(c, mapParticipantError(err))
+	}
+
+	// Anyone with a participant record (including the lobby) may listen, except removed participants
+	subscriber := eventSubscriber{moderator: h.roomService.Authorize(ctx, r, userID, entities.RoomActionAdmit) == nil}
+	participant, err := h.roomService.GetParticipantByRoomAndUser(ctx, roomID, userID)
+	switch {
+	case err == nil && participant.IsRemoved:
+		return h.handleError(c, errors.ErrForbidden("You have been removed from this room"))
+	case err == nil:
+		subscriber.participantID = participant.ID
+	case r.HostID != userID:
+		return h.handleError(c, errors.ErrForbidden("You are not a participant of this room"))
+	}
+
+	return streamRoomEvents(c, h.roomService, roomID, subscriber, h.logger)
+}
+
 // RemoveParticipant handles DELETE /rooms/:id/participants/:pid
 // @Summary      Remove a participant
 // @Description  Removes a participant from the room (host/co-host only)
@@ -676,144 +935,280 @@ func (h *Room) RemoveParticipant(c echo.Context) error {
 	}
 
 	if err := h.roomService.RemoveParticipant(c.Request().Context(), roomID, userID, participantID, req.Reason); err != nil {
-		return h.handleError(c, err)
+		return h.handleError(c, mapParticipantError(err))
 	}
 
 	return h.handleSuccess(c, map[string]interface{}{"message": "participant removed successfully"})
 }
 
-// InviteByEmail invites a user to join a room by email
-// @Summary      Invite user by email
-// @Description  Allows the host to invite a user to join the room by providing their email
-// @Tags         Invitations
+// MuteParticipant handles POST /rooms/:id/participants/:pid/mute
+// @Summary      Mute or unmute a participant
+// @Description  Mutes or unmutes the published tracks of a participant through LiveKit (host, or participants allowed to mute others). Only the microphone state is mirrored as is_muted.
+// @Tags         Participants
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
-// @Param        id       path      string                      true  "Room ID (UUID)"
-// @Param        request  body      room.InviteByEmailRequest   true  "Email of user to invite"
-// @Success      200      {object}  room.InviteByEmailResponse  "Invitation sent successfully"
-// @Failure      400      {object}  map[string]interface{}      "Invalid request"
-// @Failure      401      {object}  map[string]interface{}      "User not authenticated"
-// @Failure      403      {object}  map[string]interface{}      "User is not the host"
-// @Failure      409      {object}  map[string]interface{}      "User already invited"
-// @Failure      500      {object}  map[string]interface{}      "Failed to send invitation"
-// @Router       /rooms/{id}/invitations [post]
-func (h *Room) InviteByEmail(c echo.Context) error {
+// @Param        id       path      string                        true  "Room ID (UUID)"
+// @Param        pid      path      string                        true  "Participant ID (UUID)"
+// @Param        request  body      room.MuteParticipantRequest   true  "Mute state and track sources"
+// @Success      200      {object}  room.ParticipantResponse      "Updated participant"
+// @Failure      400      {object}  map[string]interface{}        "Invalid room ID, participant ID or track source"
+// @Failure      401      {object}  map[string]interface{}        "User not authenticated"
+// @Failure      403      {object}  map[string]interface{}        "User may not moderate this participant"
+// @Failure      404      {object}  map[string]interface{}        "Room or participant not found"
+// @Failure      409      {object}  map[string]interface{}        "Room has ended or participant is not in the room"
+// @Failure      500      {object}  map[string]interface{}        "Failed to mute participant"
+// @Router       /rooms/{id}/participants/{pid}/mute [post]
+func (h *Room) MuteParticipant(c echo.Context) error {
 	roomID, err := uuid.Parse(c.Param("id"))
 	if err != nil {
 		return h.handleError(c, errors.ErrInvalidArgument("Invalid room ID").WithDetail("error", "Room ID must be a valid UUID"))
 	}
 
+	participantID, err := uuid.Parse(c.Param("pid"))
+	if err != nil {
+		return h.handleError(c, errors.ErrInvalidArgument("Invalid participant ID").WithDetail("error", "Participant ID must be a valid UUID"))
+	}
+
 	userID, ok := c.Get("user_id").(uuid.UUID)
 	if !ok {
 		return h.handleError(c, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated"))
 	}
 
-	var req room.InviteByEmailRequest
+	var req room.MuteParticipantRequest
 	if err := c.Bind(&req); err != nil {
-		return h.handleError(c, errors.ErrInvalidArgument("Invalid request body").WithDetail("error", err.Error()))
+		return h.handleError(c, errors.ErrInvalidArgument("Invalid request body"))
 	}
-
 	if err := c.Validate(&req); err != nil {
 		return h.handleError(c, errors.ErrInvalidArgument("Validation failed").WithDetail("error", err.Error()))
 	}
 
-	participant, err := h.roomService.InviteUserByEmail(c.Request().Context(), roomID, userID, req.Email)
+	participant, err := h.roomService.MuteParticipant(c.Request().Context(), roomUsecase.MuteParticipantInput{
+		RoomID:        roomID,
+		ModeratorID:   userID,
+		ParticipantID: participantID,
+		Muted:         *req.Muted,
+		Sources:       req.Sources,
+	})
 	if err != nil {
-		return h.handleError(c, err)
+		return h.handleError(c, mapParticipantError(err))
 	}
 
-	return h.handleSuccess(c, &room.InviteByEmailResponse{
-		ParticipantID: participant.ID.String(),
-		Email:         req.Email,
-		Status:        string(participant.Status),
-		Message:       "Invitation sent successfully. The user will see this invitation when they log in.",
-	})
+	return h.handleSuccess(c, presenter.ToParticipantResponse(participant))
 }
 
-// GetMyInvitations retrieves all room invitations for the current user
-// @Summary      Get my invitations
-// @Description  Retrieves all room invitations sent to the current user's email
-// @Tags         Invitations
+// UpdateParticipant handles PATCH /rooms/:id/participants/:pid
+// @Summary      Update a participant
+// @Description  Applies moderation changes to a participant (host, or participants allowed to mute others): mute the microphone, revoke or restore screen share and publishing, lower the hand or merge metadata. Permission and metadata changes take effect immediately through LiveKit. Granting can_record or can_mute_others is host only.
+// @Tags         Participants
+// @Accept       json
 // @Produce      json
 // @Security     BearerAuth
-// @Success      200  {object}  room.MyInvitationsResponse  "List of invitations"
-// @Failure      401  {object}  map[string]interface{}      "User not authenticated"
-// @Failure      500  {object}  map[string]interface{}      "Failed to get invitations"
-// @Router       /invitations/me [get]
-func (h *Room) GetMyInvitations(c echo.Context) error {
-	// Get user email from context (set by auth middleware)
-	userEmail, ok := c.Get("user_email").(string)
-	if !ok || userEmail == "" {
-		return h.handleError(c, errors.ErrUnauthenticated().WithDetail("error", "User email not found"))
+// @Param        id       path      string                         true  "Room ID (UUID)"
+// @Param        pid      path      string                         true  "Participant ID (UUID)"
+// @Param        request  body      room.UpdateParticipantRequest  true  "Fields to change"
+// @Success      200      {object}  room.ParticipantResponse       "Updated participant"
+// @Failure      400      {object}  map[string]interface{}         "Invalid room ID, participant ID or metadata"
+// @Failure      401      {object}  map[string]interface{}         "User not authenticated"
+// @Failure      403      {object}  map[string]interface{}         "User may not moderate this participant"
+// @Failure      404      {object}  map[string]interface{}         "Room or participant not found"
+// @Failure      409      {object}  map[string]interface{}         "Room has ended or participant is not in the room"
+// @Failure      500      {object}  map[string]interface{}         "Failed to update participant"
+// @Router       /rooms/{id}/participants/{pid} [patch]
+func (h *Room) UpdateParticipant(c echo.Context) error {
+	roomID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return h.handleError(c, errors.ErrInvalidArgument("Invalid room ID").WithDetail("error", "Room ID must be a valid UUID"))
 	}
 
-	invitations, err := h.roomService.GetInvitationsByEmail(c.Request().Context(), userEmail)
+	participantID, err := uuid.Parse(c.Param("pid"))
 	if err != nil {
-		return h.handleError(c, errors.ErrInternal(err))
+		return h.handleError(c, errors.ErrInvalidArgument("Invalid participant ID").WithDetail("error", "Participant ID must be a valid UUID"))
+	}
+
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return h.handleError(c, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated"))
+	}
+
+	var req room.UpdateParticipantRequest
+	if err := c.Bind(&req); err != nil {
+		return h.handleError(c, errors.ErrInvalidArgument("Invalid request body"))
+	}
+	if req.IsMuted == nil && req.IsHandRaised == nil && req.CanShareScreen == nil && req.CanPublish == nil &&
+		req.CanRecord == nil && req.CanMuteOthers == nil && len(req.Metadata) == 0 {
+		return h.handleError(c, errors.ErrInvalidArgument("No participant fields to update"))
+	}
+
+	participant, err := h.roomService.UpdateParticipant(c.Request().Context(), roomUsecase.UpdateParticipantInput{
+		RoomID:         roomID,
+		ModeratorID:    userID,
+		ParticipantID:  participantID,
+		IsMuted:        req.IsMuted,
+		IsHandRaised:   req.IsHandRaised,
+		CanShareScreen: req.CanShareScreen,
+		CanPublish:     req.CanPublish,
+		CanRecord:      req.CanRecord,
+		CanMuteOthers:  req.CanMuteOthers,
+		Metadata:       req.Metadata,
+	})
+	if err != nil {
+		return h.handleError(c, mapParticipantError(err))
 	}
 
-	return h.handleSuccess(c, presenter.ToMyInvitationsResponse(invitations))
+	return h.handleSuccess(c, presenter.ToParticipantResponse(participant))
 }
 
-// AcceptInvitationToRoom accepts an invitation and joins the room
-// @Summary      Accept invitation
-// @Description  Accepts an invitation to join a room
-// @Tags         Invitations
+// PromoteCoHost handles POST /rooms/:id/participants/:pid/co-host
+// @Summary      Promote a participant to co-host
+// @Description  Makes a registered participant a co-host (host only). Co-hosts may admit, deny, block, remove and invite people and manage breakout rooms, but not end the meeting, change settings or manage co-hosts.
+// @Tags         Participants
 // @Produce      json
 // @Security     BearerAuth
-// @Param        id   path      string                           true  "Room ID (UUID)"
-// @Success      200  {object}  room.AcceptInvitationResponse    "Successfully joined room"
-// @Failure      400  {object}  map[string]interface{}           "Invalid room ID"
-// @Failure      401  {object}  map[string]interface{}           "User not authenticated"
-// @Failure      404  {object}  map[string]interface{}           "Invitation not found"
-// @Failure      500  {object}  map[string]interface{}           "Failed to accept invitation"
-// @Router       /rooms/{id}/invitations/accept [post]
-func (h *Room) AcceptInvitationToRoom(c echo.Context) error {
+// @Param        id   path      string                    true  "Room ID (UUID)"
+// @Param        pid  path      string                    true  "Participant ID (UUID)"
+// @Success      200  {object}  room.ParticipantResponse  "Promoted participant"
+// @Failure      400  {object}  map[string]interface{}    "Invalid room or participant ID, guest or host"
+// @Failure      401  {object}  map[string]interface{}    "User not authenticated"
+// @Failure      403  {object}  map[string]interface{}    "User is not the host"
+// @Failure      404  {object}  map[string]interface{}    "Room or participant not found"
+// @Failure      409  {object}  map[string]interface{}    "Room has ended or participant was removed"
+// @Failure      500  {object}  map[string]interface{}    "Failed to promote participant"
+// @Router       /rooms/{id}/participants/{pid}/co-host [post]
+func (h *Room) PromoteCoHost(c echo.Context) error {
+	return h.setCoHost(c, true)
+}
+
+// DemoteCoHost handles DELETE /rooms/:id/participants/:pid/co-host
+// @Summary      Demote a co-host
+// @Description  Turns a co-host back into a regular participant and revokes its recording and mute permissions (host only)
+// @Tags         Participants
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string                    true  "Room ID (UUID)"
+// @Param        pid  path      string                    true  "Participant ID (UUID)"
+// @Success      200  {object}  room.ParticipantResponse  "Demoted participant"
+// @Failure      400  {object}  map[string]interface{}    "Invalid room or participant ID, or host"
+// @Failure      401  {object}  map[string]interface{}    "User not authenticated"
+// @Failure      403  {object}  map[string]interface{}    "User is not the host"
+// @Failure      404  {object}  map[string]interface{}    "Room or participant not found"
+// @Failure      409  {object}  map[string]interface{}    "Room has ended or participant was removed"
+// @Failure      500  {object}  map[string]interface{}    "Failed to demote participant"
+// @Router       /rooms/{id}/participants/{pid}/co-host [delete]
+func (h *Room) DemoteCoHost(c echo.Context) error {
+	return h.setCoHost(c, false)
+}
+
+// setCoHost promotes or demotes the participant of the request
+func (h *Room) setCoHost(c echo.Context, coHost bool) error {
 	roomID, err := uuid.Parse(c.Param("id"))
 	if err != nil {
 		return h.handleError(c, errors.ErrInvalidArgument("Invalid room ID").WithDetail("error", "Room ID must be a valid UUID"))
 	}
 
+	participantID, err := uuid.Parse(c.Param("pid"))
+	if err != nil {
+		return h.handleError(c, errors.ErrInvalidArgument("Invalid participant ID").WithDetail("error", "Participant ID must be a valid UUID"))
+	}
+
 	userID, ok := c.Get("user_id").(uuid.UUID)
 	if !ok {
 		return h.handleError(c, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated"))
 	}
 
-	userEmail, ok := c.Get("user_email").(string)
-	if !ok || userEmail == "" {
-		return h.handleError(c, errors.ErrUnauthenticated().WithDetail("error", "User email not found"))
+	participant, err := h.roomService.SetCoHost(c.Request().Context(), roomID, userID, participantID, coHost)
+	if err != nil {
+		return h.handleError(c, mapParticipantError(err))
 	}
 
-	r, participant, token, err := h.roomService.AcceptInvitationByEmail(c.Request().Context(), roomID, userEmail, userID)
+	return h.handleSuccess(c, presenter.ToParticipantResponse(participant))
+}
+
+// GetHandQueue handles GET /rooms/:id/hands
+// @Summary      Get the hand-raise queue
+// @Description  Lists the raised hands of the room in speaking order (first raised, first called, unless a moderator reordered them)
+// @Tags         Hands
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "Room ID (UUID)"
+// @Success      200  {object}  room.ParticipantListResponse  "Raised hands in speaking order"
+// @Failure      400  {object}  map[string]interface{}        "Invalid room ID"
+// @Failure      401  {object}  map[string]interface{}        "User not authenticated"
+// @Failure      403  {object}  map[string]interface{}        "Not a participant of this room"
+// @Failure      404  {object}  map[string]interface{}        "Room not found"
+// @Failure      500  {object}  map[string]interface{}        "Failed to get raised hands"
+// @Router       /rooms/{id}/hands [get]
+func (h *Room) GetHandQueue(c echo.Context) error {
+	roomID, err := uuid.Parse(c.Param("id"))
 	if err != nil {
-		return h.handleError(c, err)
+		return h.handleError(c, errors.ErrInvalidArgument("Invalid room ID").WithDetail("error", "Room ID must be a valid UUID"))
 	}
 
-	return h.handleSuccess(c, &room.AcceptInvitationResponse{
-		Status:       "joined",
-		Message:      "Successfully accepted invitation and joined the room",
-		Room:         presenter.ToRoomResponse(r),
-		Participant:  presenter.ToParticipantResponse(participant),
-		LivekitToken: token,
-		LivekitURL:   h.roomService.GetLivekitURL(),
-	})
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return h.handleError(c, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated"))
+	}
+
+	queue, err := h.roomService.GetHandQueue(c.Request().Context(), roomID, userID)
+	if err != nil {
+		return h.handleError(c, mapParticipantError(err))
+	}
+
+	return h.handleSuccess(c, presenter.ToParticipantListResponse(queue))
 }
 
-// DeclineInvitation declines an invitation to join a room
-// @Summary      Decline invitation
-// @Description  Declines an invitation to join a room
-// @Tags         Invitations
+// RaiseHand handles POST /rooms/:id/participants/me/hand
+// @Summary      Raise my hand
+// @Description  Puts the current user at the end of the room's speaking queue. Raising an already raised hand keeps its place.
+// @Tags         Hands
 // @Produce      json
 // @Security     BearerAuth
-// @Param        id   path      string                      true  "Room ID (UUID)"
-// @Success      200  {object}  map[string]interface{}      "Invitation declined"
-// @Failure      400  {object}  map[string]interface{}      "Invalid room ID"
-// @Failure      401  {object}  map[string]interface{}      "User not authenticated"
-// @Failure      404  {object}  map[string]interface{}      "Invitation not found"
-// @Failure      500  {object}  map[string]interface{}      "Failed to decline invitation"
-// @Router       /rooms/{id}/invitations/decline [post]
-func (h *Room) DeclineInvitation(c echo.Context) error {
+// @Param        id   path      string  true  "Room ID (UUID)"
+// @Success      200  {object}  room.ParticipantResponse  "Participant with its queue position"
+// @Failure      400  {object}  map[string]interface{}    "Invalid room ID"
+// @Failure      401  {object}  map[string]interface{}    "User not authenticated"
+// @Failure      404  {object}  map[string]interface{}    "Participant not found"
+// @Failure      409  {object}  map[string]interface{}    "Room has ended or user is not in the meeting"
+// @Failure      500  {object}  map[string]interface{}    "Failed to raise hand"
+// @Router       /rooms/{id}/participants/me/hand [post]
+func (h *Room) RaiseHand(c echo.Context) error {
+	return h.setOwnHand(c, true)
+}
+
+// LowerHand handles DELETE /rooms/:id/participants/me/hand
+// @Summary      Lower my hand
+// @Description  Takes the current user out of the room's speaking queue
+// @Tags         Hands
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "Room ID (UUID)"
+// @Success      200  {object}  room.ParticipantResponse  "Updated participant"
+// @Failure      400  {object}  map[string]interface{}    "Invalid room ID"
+// @Failure      401  {object}  map[string]interface{}    "User not authenticated"
+// @Failure      404  {object}  map[string]interface{}    "Participant not found"
+// @Failure      500  {object}  map[string]interface{}    "Failed to lower hand"
+// @Router       /rooms/{id}/participants/me/hand [delete]
+func (h *Room) LowerHand(c echo.Context) error {
+	return h.setOwnHand(c, false)
+}
+
+// LowerParticipantHand handles DELETE /rooms/:id/participants/:pid/hand
+// @Summary      Lower a participant's hand
+// @Description  Takes a participant out of the speaking queue, e.g. once they have been called on (host or co-host)
+// @Tags         Hands
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "Room ID (UUID)"
+// @Param        pid  path      string  true  "Participant ID (UUID)"
+// @Success      200  {object}  room.ParticipantResponse  "Updated participant"
+// @Failure      400  {object}  map[string]interface{}    "Invalid room ID or participant ID, or the hand is not raised"
+// @Failure      401  {object}  map[string]interface{}    "User not authenticated"
+// @Failure      403  {object}  map[string]interface{}    "User is not the host or a co-host"
+// @Failure      404  {object}  map[string]interface{}    "Room or participant not found"
+// @Failure      409  {object}  map[string]interface{}    "Room has ended"
+// @Failure      500  {object}  map[string]interface{}    "Failed to lower hand"
+// @Router       /rooms/{id}/participants/{pid}/hand [delete]
+func (h *Room) LowerParticipantHand(c echo.Context) error {
 	roomID, err := uuid.Parse(c.Param("id"))
 	if err != nil {
 		return h.handleError(c, errors.ErrInvalidArgument("Invalid room ID").WithDetail("error", "Room ID must be a valid UUID"))
@@ -824,34 +1219,74 @@ func (h *Room) DeclineInvitation(c echo.Context) error {
 		return h.handleError(c, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated"))
 	}
 
-	userEmail, ok := c.Get("user_email").(string)
-	if !ok || userEmail == "" {
-		return h.handleError(c, errors.ErrUnauthenticated().WithDetail("error", "User email not found"))
+	participantID, err := uuid.Parse(c.Param("pid"))
+	if err != nil {
+		return h.handleError(c, errors.ErrInvalidArgument("Invalid participant ID").WithDetail("error", "Participant ID must be a valid UUID"))
 	}
 
-	if err := h.roomService.DeclineInvitationByEmail(c.Request().Context(), roomID, userEmail, userID); err != nil {
-		return h.handleError(c, err)
+	participant, err := h.roomService.LowerParticipantHand(c.Request().Context(), roomID, userID, participantID)
+	if err != nil {
+		return h.handleError(c, mapParticipantError(err))
+	}
+
+	return h.handleSuccess(c, presenter.ToParticipantResponse(participant))
+}
+
+// LowerAllHands handles DELETE /rooms/:id/hands
+// @Summary      Lower all hands
+// @Description  Clears the room's speaking queue (host or co-host)
+// @Tags         Hands
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "Room ID (UUID)"
+// @Success      200  {object}  map[string]interface{}  "Number of hands lowered"
+// @Failure      400  {object}  map[string]interface{}  "Invalid room ID"
+// @Failure      401  {object}  map[string]interface{}  "User not authenticated"
+// @Failure      403  {object}  map[string]interface{}  "User is not the host or a co-host"
+// @Failure      404  {object}  map[string]interface{}  "Room not found"
+// @Failure      409  {object}  map[string]interface{}  "Room has ended"
+// @Failure      500  {object}  map[string]interface{}  "Failed to lower hands"
+// @Router       /rooms/{id}/hands [delete]
+func (h *Room) LowerAllHands(c echo.Context) error {
+	roomID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return h.handleError(c, errors.ErrInvalidArgument("Invalid room ID").WithDetail("error", "Room ID must be a valid UUID"))
+	}
+
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return h.handleError(c, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated"))
+	}
+
+	lowered, err := h.roomService.LowerAllHands(c.Request().Context(), roomID, userID)
+	if err != nil {
+		return h.handleError(c, mapParticipantError(err))
 	}
 
 	return h.handleSuccess(c, map[string]interface{}{
-		"message": "Invitation declined successfully",
+		"message": "all hands lowered",
+		"lowered": lowered,
 	})
 }
 
-// GetRoomInvitations retrieves all invitations for a room (host only)
-// @Summary      Get room invitations
-// @Description  Retrieves all invitations for a room (host only)
-// @Tags         Invitations
+// ReorderHands handles PUT /rooms/:id/hands/order
+// @Summary      Reorder raised hands
+// @Description  Changes the speaking order (host or co-host). Listed participants are called first, in the given order; the other raised hands keep their order after them.
+// @Tags         Hands
+// @Accept       json
 // @Produce      json
 // @Security     BearerAuth
-// @Param        id   path      string                         true  "Room ID (UUID)"
-// @Success      200  {object}  room.ParticipantListResponse   "List of invitations"
-// @Failure      400  {object}  map[string]interface{}         "Invalid room ID"
-// @Failure      401  {object}  map[string]interface{}         "User not authenticated"
-// @Failure      403  {object}  map[string]interface{}         "User is not the host"
-// @Failure      500  {object}  map[string]interface{}         "Failed to get invitations"
-// @Router       /rooms/{id}/invitations [get]
-func (h *Room) GetRoomInvitations(c echo.Context) error {
+// @Param        id       path      string                    true  "Room ID (UUID)"
+// @Param        request  body      room.ReorderHandsRequest  true  "Participant IDs in speaking order"
+// @Success      200      {object}  room.ParticipantListResponse  "Raised hands in the new speaking order"
+// @Failure      400      {object}  map[string]interface{}        "Invalid request or a listed participant has no raised hand"
+// @Failure      401      {object}  map[string]interface{}        "User not authenticated"
+// @Failure      403      {object}  map[string]interface{}        "User is not the host or a co-host"
+// @Failure      404      {object}  map[string]interface{}        "Room not found"
+// @Failure      409      {object}  map[string]interface{}        "Room has ended"
+// @Failure      500      {object}  map[string]interface{}        "Failed to reorder hands"
+// @Router       /rooms/{id}/hands/order [put]
+func (h *Room) ReorderHands(c echo.Context) error {
 	roomID, err := uuid.Parse(c.Param("id"))
 	if err != nil {
 		return h.handleError(c, errors.ErrInvalidArgument("Invalid room ID").WithDetail("error", "Room ID must be a valid UUID"))
@@ -862,12 +1297,284 @@ func (h *Room) GetRoomInvitations(c echo.Context) error {
 		return h.handleError(c, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated"))
 	}
 
-	invitations, err := h.roomService.GetRoomInvitations(c.Request().Context(), roomID, userID)
+	var req room.ReorderHandsRequest
+	if err := c.Bind(&req); err != nil {
+		return h.handleError(c, errors.ErrInvalidArgument("Invalid request body"))
+	}
+	if err := c.Validate(&req); err != nil {
+		return h.handleError(c, errors.ErrInvalidArgument("Validation failed").WithDetail("error", err.Error()))
+	}
+
+	order := make([]uuid.UUID, len(req.ParticipantIDs))
+	for i, id := range req.ParticipantIDs {
+		order[i] = uuid.MustParse(id) // Validated as UUIDs
+	}
+
+	queue, err := h.roomService.ReorderHands(c.Request().Context(), roomID, userID, order)
 	if err != nil {
-		return h.handleError(c, err)
+		return h.handleError(c, mapParticipantError(err))
+	}
+
+	return h.handleSuccess(c, presenter.ToParticipantListResponse(queue))
+}
+
+// setOwnHand raises or lowers the hand of the current user
+func (h *Room) setOwnHand(c echo.Context, raised bool) error {
+	roomID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return h.handleError(c, errors.ErrInvalidArgument("Invalid room ID").WithDetail("error", "Room ID must be a valid UUID"))
+	}
+
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return h.handleError(c, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated"))
+	}
+
+	ctx := c.Request().Context()
+	participant, err := h.roomService.GetParticipantByRoomAndUser(ctx, roomID, userID)
+	if err != nil {
+		return h.handleError(c, errors.ErrNotFound("Participant"))
+	}
+
+	if raised {
+		participant, err = h.roomService.RaiseHand(ctx, roomID, participant.ID)
+	} else {
+		participant, err = h.roomService.LowerHand(ctx, roomID, participant.ID)
+	}
+	if err != nil {
+		return h.handleError(c, mapParticipantError(err))
+	}
+
+	return h.handleSuccess(c, presenter.ToParticipantResponse(participant))
+}
+
+// LockRoom handles POST /rooms/:id/lock
+// @Summary      Lock a room
+// @Description  Closes a running room to new joins (host or co-host). In waiting_room mode (default) new joins wait in the lobby until admitted; in reject mode they are turned away. The host and co-hosts can always join.
+// @Tags         Rooms
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id       path      string                true   "Room ID (UUID)"
+// @Param        request  body      room.LockRoomRequest  false  "Lock mode"
+// @Success      200      {object}  room.RoomResponse       "Locked room"
+// @Failure      400      {object}  map[string]interface{}  "Invalid room ID or lock mode"
+// @Failure      401      {object}  map[string]interface{}  "User not authenticated"
+// @Failure      403      {object}  map[string]interface{}  "User is not the host or a co-host"
+// @Failure      404      {object}  map[string]interface{}  "Room not found"
+// @Failure      409      {object}  map[string]interface{}  "Room has ended"
+// @Failure      500      {object}  map[string]interface{}  "Failed to lock room"
+// @Router       /rooms/{id}/lock [post]
+func (h *Room) LockRoom(c echo.Context) error {
+	roomID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return h.handleError(c, errors.ErrInvalidArgument("Invalid room ID").WithDetail("error", "Room ID must be a valid UUID"))
+	}
+
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return h.handleError(c, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated"))
+	}
+
+	var req room.LockRoomRequest
+	if err := c.Bind(&req); err != nil {
+		return h.handleError(c, errors.ErrInvalidArgument("Invalid request body"))
+	}
+	if err := c.Validate(&req); err != nil {
+		return h.handleError(c, errors.ErrInvalidArgument("Validation failed").WithDetail("error", err.Error()))
+	}
+
+	mode := entities.RoomLockModeWaitingRoom
+	if req.Mode != "" {
+		mode = entities.RoomLockMode(req.Mode)
+	}
+
+	r, err := h.roomService.LockRoom(c.Request().Context(), roomID, userID, mode)
+	if err != nil {
+		return h.handleError(c, mapParticipantError(err))
+	}
+
+	return h.handleSuccess(c, presenter.ToRoomResponse(r))
+}
+
+// UnlockRoom handles DELETE /rooms/:id/lock
+// @Summary      Unlock a room
+// @Description  Opens a locked room to new joins again (host or co-host)
+// @Tags         Rooms
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "Room ID (UUID)"
+// @Success      200  {object}  room.RoomResponse       "Unlocked room"
+// @Failure      400  {object}  map[string]interface{}  "Invalid room ID"
+// @Failure      401  {object}  map[string]interface{}  "User not authenticated"
+// @Failure      403  {object}  map[string]interface{}  "User is not the host or a co-host"
+// @Failure      404  {object}  map[string]interface{}  "Room not found"
+// @Failure      409  {object}  map[string]interface{}  "Room has ended"
+// @Failure      500  {object}  map[string]interface{}  "Failed to unlock room"
+// @Router       /rooms/{id}/lock [delete]
+func (h *Room) UnlockRoom(c echo.Context) error {
+	roomID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return h.handleError(c, errors.ErrInvalidArgument("Invalid room ID").WithDetail("error", "Room ID must be a valid UUID"))
+	}
+
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return h.handleError(c, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated"))
 	}
 
-	return h.handleSuccess(c, presenter.ToParticipantListResponse(invitations))
+	r, err := h.roomService.UnlockRoom(c.Request().Context(), roomID, userID)
+	if err != nil {
+		return h.handleError(c, mapParticipantError(err))
+	}
+
+	return h.handleSuccess(c, presenter.ToRoomResponse(r))
+}
+
+// SetPasscode handles PUT /rooms/:id/passcode
+// @Summary      Set the room passcode
+// @Description  Sets or changes the passcode people need to join the room, or removes it with an empty passcode (host only). Invited participants and guests joining with an invitation do not need the passcode.
+// @Tags         Rooms
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id       path      string                   true  "Room ID (UUID)"
+// @Param        request  body      room.SetPasscodeRequest  true  "New passcode (empty to remove)"
+// @Success      200      {object}  room.RoomResponse       "Updated room"
+// @Failure      400      {object}  map[string]interface{}  "Invalid room ID or passcode"
+// @Failure      401      {object}  map[string]interface{}  "User not authenticated"
+// @Failure      403      {object}  map[string]interface{}  "User is not the host"
+// @Failure      404      {object}  map[string]interface{}  "Room not found"
+// @Failure      409      {object}  map[string]interface{}  "Room has ended"
+// @Failure      500      {object}  map[string]interface{}  "Failed to set passcode"
+// @Router       /rooms/{id}/passcode [put]
+func (h *Room) SetPasscode(c echo.Context) error {
+	roomID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return h.handleError(c, errors.ErrInvalidArgument("Invalid room ID").WithDetail("error", "Room ID must be a valid UUID"))
+	}
+
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return h.handleError(c, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated"))
+	}
+
+	var req room.SetPasscodeRequest
+	if err := c.Bind(&req); err != nil {
+		return h.handleError(c, errors.ErrInvalidArgument("Invalid request body"))
+	}
+	if err := c.Validate(&req); err != nil {
+		return h.handleError(c, errors.ErrInvalidArgument("Validation failed").WithDetail("error", err.Error()))
+	}
+
+	r, err := h.roomService.SetPasscode(c.Request().Context(), roomID, userID, req.Passcode)
+	if err != nil {
+		return h.handleError(c, mapParticipantError(err))
+	}
+
+	return h.handleSuccess(c, presenter.ToRoomResponse(r))
+}
+
+// ListAuditLogs handles GET /rooms/:id/audit-logs
+// @Summary      List the room audit trail
+// @Description  Lists lock, unlock and passcode changes of the room and failed passcode attempts, newest first (host or co-host)
+// @Tags         Rooms
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id         path      string  true   "Room ID (UUID)"
+// @Param        page       query     int     false  "Page number (default 1)"
+// @Param        page_size  query     int     false  "Entries per page (default 50, max 100)"
+// @Success      200        {object}  room.AuditLogListResponse  "Audit trail"
+// @Failure      400        {object}  map[string]interface{}     "Invalid room ID"
+// @Failure      401        {object}  map[string]interface{}     "User not authenticated"
+// @Failure      403        {object}  map[string]interface{}     "User is not the host or a co-host"
+// @Failure      404        {object}  map[string]interface{}     "Room not found"
+// @Failure      500        {object}  map[string]interface{}     "Failed to list audit logs"
+// @Router       /rooms/{id}/audit-logs [get]
+func (h *Room) ListAuditLogs(c echo.Context) error {
+	roomID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return h.handleError(c, errors.ErrInvalidArgument("Invalid room ID").WithDetail("error", "Room ID must be a valid UUID"))
+	}
+
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return h.handleError(c, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated"))
+	}
+
+	page, _ := strconv.Atoi(c.QueryParam("page"))
+	if page < 1 {
+		page = 1
+	}
+	pageSize, _ := strconv.Atoi(c.QueryParam("page_size"))
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 50
+	}
+
+	logs, total, err := h.roomService.ListAuditLogs(c.Request().Context(), roomID, userID, pageSize, (page-1)*pageSize)
+	if err != nil {
+		return h.handleError(c, mapParticipantError(err))
+	}
+
+	return h.handleSuccess(c, presenter.ToAuditLogListResponse(logs, total, page, pageSize))
+}
+
+// waitlistMessage tells a waitlisted participant its place in line
+func waitlistMessage(position int) string {
+	return fmt.Sprintf("The room is full. You are number %d in line and will join automatically when a seat frees up.", position)
+}
+
+// mapParticipantError maps participant management and moderation use case errors to API errors
+func mapParticipantError(err error) error {
+	switch {
+	case stdErrors.Is(err, usecaseErrors.ErrRoomNotFound):
+		return errors.ErrNotFound("Room")
+	case stdErrors.Is(err, usecaseErrors.ErrParticipantNotFound):
+		return errors.ErrNotFound("Participant")
+	case stdErrors.Is(err, usecaseErrors.ErrNotHost):
+		return errors.ErrNotHost()
+	case stdErrors.Is(err, usecaseErrors.ErrCannotModerate),
+		stdErrors.Is(err, usecaseErrors.ErrNotParticipant):
+		return errors.ErrForbidden(err.Error())
+	case stdErrors.Is(err, usecaseErrors.ErrInvalidTrackSource),
+		stdErrors.Is(err, usecaseErrors.ErrReservedMetadataKey),
+		stdErrors.Is(err, usecaseErrors.ErrCannotRemoveSelf),
+		stdErrors.Is(err, usecaseErrors.ErrCannotChangeHostRole),
+		stdErrors.Is(err, usecaseErrors.ErrGuestCannotBeCoHost),
+		stdErrors.Is(err, usecaseErrors.ErrHandNotRaised),
+		stdErrors.Is(err, usecaseErrors.ErrInvalidLockMode),
+		stdErrors.Is(err, usecaseErrors.ErrInvalidPasscode):
+		return errors.ErrInvalidArgument(err.Error())
+	case stdErrors.Is(err, usecaseErrors.ErrRoomEnded),
+		stdErrors.Is(err, usecaseErrors.ErrInvalidParticipantStatus):
+		return errors.ErrFailedPrecondition(err.Error())
+	default:
+		return errors.ErrInternal(err)
+	}
+}
+
+// mapJoinError maps join use case errors to API errors
+func mapJoinError(err error) error {
+	switch {
+	case stdErrors.Is(err, usecaseErrors.ErrRoomNotFound):
+		return errors.ErrNotFound("Room")
+	case stdErrors.Is(err, usecaseErrors.ErrNotInvited),
+		stdErrors.Is(err, usecaseErrors.ErrAccessDenied),
+		stdErrors.Is(err, usecaseErrors.ErrPasscodeRequired),
+		stdErrors.Is(err, usecaseErrors.ErrIncorrectPasscode),
+		stdErrors.Is(err, usecaseErrors.ErrRoomLocked):
+		return errors.ErrForbidden(err.Error())
+	case stdErrors.Is(err, usecaseErrors.ErrTooManyPasscodeTries),
+		stdErrors.Is(err, usecaseErrors.ErrQuotaExceeded):
+		return errors.ErrResourceExhausted(err.Error())
+	case stdErrors.Is(err, usecaseErrors.ErrAlreadyInRoom),
+		stdErrors.Is(err, usecaseErrors.ErrRoomEnded),
+		stdErrors.Is(err, usecaseErrors.ErrRoomFull),
+		stdErrors.Is(err, usecaseErrors.ErrTooEarly):
+		return errors.ErrFailedPrecondition(err.Error())
+	default:
+		return errors.ErrInternal(err)
+	}
 }
 
 // GetMeetingSummary retrieves the AI-generated summary for a meeting
@@ -884,6 +1591,7 @@ func (h *Room) GetRoomInvitations(c echo.Context) error {
 // @Description  - Action items with assignments, priorities, and due dates
 // @Description  - Sentiment analysis (overall and per-speaker breakdown)
 // @Description  - Engagement metrics (speaking time, participation balance)
+// @Description  - Agenda coverage (per agenda item: covered or not, and what was said), when the meeting had an agenda
 // @Description
 // @Description  **Status codes:**
 // @Description  - 200: Summary available and returned
@@ -916,6 +1624,11 @@ func (h *Room) GetMeetingSummary(c echo.Context) error {
 		zap.String("user_id", userID.String()),
 	)
 
+	// Summaries of organization rooms stay within the organization
+	if _, err := h.roomService.GetRoomForUser(c.Request().Context(), roomID, userID); err != nil {
+		return h.handleError(c, mapParticipantError(err))
+	}
+
 	// Try to get existing summary
 	summary, err := h.summaryRepo.GetMeetingSummaryByRoom(c.Request().Context(), roomID)
 	if err == nil && summary != nil {
@@ -1082,6 +1795,16 @@ func (h *Room) buildSummaryResponse(ctx context.Context, summary *entities.Meeti
 		}
 	}
 
+	// Parse agenda coverage
+	if summary.AgendaCoverage != nil {
+		var agendaCoverage []summaryDTO.AgendaCoverage
+		if err := json.Unmarshal(summary.AgendaCoverage, &agendaCoverage); err != nil {
+			h.logger.Warn("Failed to parse agenda coverage", zap.Error(err))
+		} else {
+			response.AgendaCoverage = agendaCoverage
+		}
+	}
+
 	// Set engagement metrics
 	response.EngagementMetrics = summaryDTO.EngagementMetricsDTO{
 		TotalSpeakingTime:       summary.TotalSpeakingTime,
@@ -1103,18 +1826,104 @@ func (h *Room) buildSummaryResponse(ctx context.Context, summary *entities.Meeti
 			}
 
 			response.ActionItems[i] = summaryDTO.ActionItemDTO{
-				ID:          item.ID,
-				Title:       item.Title,
-				Description: item.Description,
-				Type:        string(item.Type),
-				Priority:    string(item.Priority),
-				AssignedTo:  assignedTo,
-				DueDate:     item.DueDate,
-				Status:      string(item.Status),
-				CreatedAt:   item.CreatedAt,
+				ID:           item.ID,
+				Title:        item.Title,
+				Description:  item.Description,
+				Type:         string(item.Type),
+				Priority:     string(item.Priority),
+				AssignedTo:   assignedTo,
+				DueDate:      item.DueDate,
+				Status:       string(item.Status),
+				AgendaItemID: item.AgendaItemID,
+				CreatedAt:    item.CreatedAt,
+			}
+		}
+	}
+
+	// Attendees (registered users and guests) who actually joined the meeting
+	participants, err := h.roomService.GetParticipants(ctx, summary.RoomID)
+	if err != nil {
+		h.logger.Warn("Failed to retrieve attendees", zap.Error(err))
+	} else {
+		response.Attendees = make([]summaryDTO.Attendee, 0, len(participants))
+		for _, p := range participants {
+			if p.JoinedAt == nil {
+				continue
+			}
+			attendee := summaryDTO.Attendee{
+				ParticipantID: p.ID,
+				UserID:        p.UserID,
+				DisplayName:   p.DisplayName(),
+				Role:          string(p.Role),
+				IsGuest:       p.IsGuest(),
+				JoinedAt:      *p.JoinedAt,
+				LeftAt:        p.LeftAt,
+			}
+			if p.Duration != nil {
+				attendee.DurationSeconds = *p.Duration
+			}
+			response.Attendees = append(response.Attendees, attendee)
+		}
+	}
+
+	// Roll up the breakout rooms, each with its own recording and summary
+	response.Breakouts = h.buildBreakoutSummaries(ctx, summary.RoomID)
+
+	// Meeting timeline (hand raises, ...)
+	timeline, err := h.roomService.GetTimeline(ctx, summary.RoomID)
+	if err != nil {
+		h.logger.Warn("Failed to retrieve meeting timeline", zap.Error(err))
+	} else {
+		response.Timeline = make([]summaryDTO.TimelineEntry, len(timeline))
+		for i, entry := range timeline {
+			response.Timeline[i] = summaryDTO.TimelineEntry{
+				Type:          string(entry.Type),
+				At:            entry.At,
+				OffsetSeconds: entry.OffsetSeconds,
+				ParticipantID: entry.ParticipantID,
+				DisplayName:   entry.DisplayName,
+				Text:          entry.Text,
 			}
 		}
 	}
 
 	return response, nil
 }
+
+// buildBreakoutSummaries collects the summaries of a meeting's breakout rooms
+func (h *Room) buildBreakoutSummaries(ctx context.Context, roomID uuid.UUID) []summaryDTO.BreakoutSummary {
+	breakouts, _, err := h.roomService.ListRooms(ctx, domainrepo.RoomFilters{
+		ParentID:  &roomID,
+		SortBy:    "created_at",
+		SortOrder: "asc",
+	})
+	if err != nil {
+		h.logger.Warn("Failed to retrieve breakout rooms", zap.Error(err))
+		return nil
+	}
+
+	result := make([]summaryDTO.BreakoutSummary, 0, len(breakouts))
+	for _, b := range breakouts {
+		item := summaryDTO.BreakoutSummary{
+			RoomID: b.ID,
+			Name:   b.Name,
+			Status: "not_recorded",
+		}
+
+		if summary, err := h.summaryRepo.GetMeetingSummaryByRoom(ctx, b.ID); err == nil && summary != nil {
+			if item.Summary, err = h.buildSummaryResponse(ctx, summary); err != nil {
+				h.logger.Warn("Failed to build breakout summary",
+					zap.String("room_id", b.ID.String()),
+					zap.Error(err),
+				)
+			}
+			item.Status = string(entities.AIJobStatusCompleted)
+		} else if jobs, err := h.aiJobRepo.ListAIJobsByMeetingID(ctx, b.ID); err == nil && len(jobs) > 0 {
+			item.Status = string(jobs[0].Status) // Latest job (ordered by created_at DESC)
+		}
+
+		result = append(result, item)
+	}
+
+	return result
+}
@@ -13,30 +13,51 @@ import (
 
 // Router holds all handlers
 type Router struct {
-	cfg              *config.Config
-	authHandler      *Auth
-	roomHandler      *Room
-	webhookHandler   *WebhookHandler
-	aiWebhookHandler *AIWebhookHandler
-	aiController     *AIController
-	storageTest      *StorageTest
-	authMW           echo.MiddlewareFunc
+	cfg               *config.Config
+	authHandler       *Auth
+	roomHandler       *Room
+	seriesHandler     *Series
+	invitationHandler *Invitation
+	guestHandler      *Guest
+	breakoutHandler   *Breakout
+	orgHandler        *Organization
+	adminHandler      *Admin
+	quotaHandler      *Quota
+	recordingHandler  *Recording
+	webhookHandler    *WebhookHandler
+	aiWebhookHandler  *AIWebhookHandler
+	aiController      *AIController
+	storageTest       *StorageTest
+	authMW            echo.MiddlewareFunc
+	optionalAuthMW    echo.MiddlewareFunc
+	guestAuthMW       echo.MiddlewareFunc
+	adminMW           echo.MiddlewareFunc
 	// Add more handlers here as needed
-	// recordingHandler *Recording
 	// reportHandler *Report
 }
 
 // NewRouter creates a new router with all handlers
-func NewRouter(cfg *config.Config, authHandler *Auth, roomHandler *Room, webhookHandler *WebhookHandler, aiWebhookHandler *AIWebhookHandler, aiController *AIController, storageTest *StorageTest, authMW echo.MiddlewareFunc) *Router {
+func NewRouter(cfg *config.Config, authHandler *Auth, roomHandler *Room, seriesHandler *Series, invitationHandler *Invitation, guestHandler *Guest, breakoutHandler *Breakout, orgHandler *Organization, adminHandler *Admin, quotaHandler *Quota, recordingHandler *Recording, webhookHandler *WebhookHandler, aiWebhookHandler *AIWebhookHandler, aiController *AIController, storageTest *StorageTest, authMW, optionalAuthMW, guestAuthMW, adminMW echo.MiddlewareFunc) *Router {
 	return &Router{
-		cfg:              cfg,
-		authHandler:      authHandler,
-		roomHandler:      roomHandler,
-		webhookHandler:   webhookHandler,
-		aiWebhookHandler: aiWebhookHandler,
-		aiController:     aiController,
-		storageTest:      storageTest,
-		authMW:           authMW,
+		cfg:               cfg,
+		authHandler:       authHandler,
+		roomHandler:       roomHandler,
+		seriesHandler:     seriesHandler,
+		invitationHandler: invitationHandler,
+		guestHandler:      guestHandler,
+		breakoutHandler:   breakoutHandler,
+		orgHandler:        orgHandler,
+		adminHandler:      adminHandler,
+		quotaHandler:      quotaHandler,
+		recordingHandler:  recordingHandler,
+		webhookHandler:    webhookHandler,
+		aiWebhookHandler:  aiWebhookHandler,
+		aiController:      aiController,
+		storageTest:       storageTest,
+		authMW:            authMW,
+		optionalAuthMW:    optionalAuthMW,
+		guestAuthMW:       guestAuthMW,
+		adminMW:           adminMW,
 	}
 }
 
@@ -57,7 +78,13 @@ func (rt *Router) Setup(e *echo.Echo) {
 	// Setup route groups
 	rt.setupAuthRoutes(v1)
 	rt.setupRoomRoutes(v1)
+	rt.setupSeriesRoutes(v1)
 	rt.setupMeetingRoutes(v1)
+	rt.setupGuestRoutes(v1)
+	rt.setupOrganizationRoutes(v1)
+	rt.setupAdminRoutes(v1)
+	rt.setupQuotaRoutes(v1)
+	rt.setupRecordingRoutes(v1)
 	rt.setupInvitationRoutes(v1)
 	rt.setupTestRoutes(v1)
 	// AI endpoints
@@ -66,7 +93,6 @@ func (rt *Router) Setup(e *echo.Echo) {
 	} else {
 		v1.POST("/meetings/:id/process-ai", rt.notImplemented)
 	}
-	// rt.setupRecordingRoutes(v1)
 	// rt.setupReportRoutes(v1)
 }
 
@@ -122,40 +148,149 @@ func (rt *Router) setupRoomRoutes(g *echo.Group) {
 
 	if rt.roomHandler != nil {
 		// Room CRUD
-		roomGroup.POST("", rt.roomHandler.CreateRoom)   // Create room
-		roomGroup.GET("", rt.roomHandler.ListRooms)     // List rooms
-		roomGroup.GET("/:id", rt.roomHandler.GetRoom)   // Get room details
-		roomGroup.PATCH("/:id", rt.roomHandler.EndRoom) // End room (update status to ended)
+		roomGroup.POST("", rt.roomHandler.CreateRoom)                       // Create room
+		roomGroup.GET("", rt.roomHandler.ListRooms)                         // List rooms
+		roomGroup.GET("/:id", rt.roomHandler.GetRoom)                       // Get room details
+		roomGroup.PATCH("/:id", rt.roomHandler.EndRoom)                     // End room (update status to ended)
+		roomGroup.PATCH("/:id/settings", rt.roomHandler.UpdateRoomSettings) // Update room settings (host only)
+		roomGroup.PUT("/:id/passcode", rt.roomHandler.SetPasscode)          // Set or remove join passcode (host only)
+		roomGroup.POST("/:id/lock", rt.roomHandler.LockRoom)                // Lock room to new joins (moderators)
+		roomGroup.DELETE("/:id/lock", rt.roomHandler.UnlockRoom)            // Unlock room (moderators)
+		roomGroup.GET("/:id/audit-logs", rt.roomHandler.ListAuditLogs)      // Lock and passcode audit trail (moderators)
+		roomGroup.GET("/:id/attendance", rt.roomHandler.GetAttendance)      // Attendance report, downloadable as CSV/JSON (moderators)
+
+		// Recording (host, or participants allowed to record)
+		roomGroup.POST("/:id/recording/start", rt.roomHandler.StartRecording)   // Start recording
+		roomGroup.POST("/:id/recording/stop", rt.roomHandler.StopRecording)     // Stop recording
+		roomGroup.POST("/:id/recording/pause", rt.roomHandler.PauseRecording)   // Pause recording
+		roomGroup.POST("/:id/recording/resume", rt.roomHandler.ResumeRecording) // Resume a paused recording
+
+		// Recording consent (participants; the export is for the host and co-hosts)
+		roomGroup.GET("/:id/recording/consent", rt.roomHandler.GetRecordingConsent)      // My consent status
+		roomGroup.POST("/:id/recording/consent", rt.roomHandler.GiveRecordingConsent)    // Consent to be recorded
+		roomGroup.GET("/:id/recording/consents", rt.roomHandler.ExportRecordingConsents) // Consent records for audits (?format=csv|json)
 
 		// Participant management (RESTful)
 		roomGroup.POST("/:id/participants", rt.roomHandler.JoinRoom)                        // Join room (create participant)
 		roomGroup.DELETE("/:id/participants/me", rt.roomHandler.LeaveRoom)                  // Leave room (delete own participant)
 		roomGroup.GET("/:id/participants", rt.roomHandler.GetParticipants)                  // List participants
 		roomGroup.GET("/:id/participants/waiting", rt.roomHandler.GetWaitingParticipants)   // Get waiting participants
+		roomGroup.GET("/:id/participants/waitlist", rt.roomHandler.GetWaitlist)             // Get the waitlist of a full room
 		roomGroup.GET("/:id/participants/me/status", rt.roomHandler.GetMyParticipantStatus) // Poll participant status
+		roomGroup.GET("/:id/events", rt.roomHandler.StreamEvents)                           // Real-time room events (SSE)
 		roomGroup.POST("/:id/participants/:pid/admit", rt.roomHandler.AdmitParticipant)     // Admit participant
 		roomGroup.POST("/:id/participants/:pid/deny", rt.roomHandler.DenyParticipant)       // Deny participant (soft)
 		roomGroup.POST("/:id/participants/:pid/block", rt.roomHandler.BlockParticipant)     // Block participant (permanent)
 		roomGroup.DELETE("/:id/participants/:pid", rt.roomHandler.RemoveParticipant)        // Remove participant
+		roomGroup.PATCH("/:id/participants/:pid", rt.roomHandler.UpdateParticipant)         // Update participant permissions/metadata (moderators)
+		roomGroup.POST("/:id/participants/:pid/mute", rt.roomHandler.MuteParticipant)       // Mute/unmute participant tracks (moderators)
+		roomGroup.POST("/:id/participants/:pid/co-host", rt.roomHandler.PromoteCoHost)      // Promote to co-host (host only)
+		roomGroup.DELETE("/:id/participants/:pid/co-host", rt.roomHandler.DemoteCoHost)     // Demote co-host (host only)
 		roomGroup.PATCH("/:id/host", rt.roomHandler.TransferHost)                           // Transfer host
 
-		// Invitation routes
-		roomGroup.POST("/:id/invitations", rt.roomHandler.InviteByEmail)                 // Invite user by email
-		roomGroup.GET("/:id/invitations", rt.roomHandler.GetRoomInvitations)             // Get room invitations (host only)
-		roomGroup.POST("/:id/invitations/accept", rt.roomHandler.AcceptInvitationToRoom) // Accept invitation
-		roomGroup.POST("/:id/invitations/decline", rt.roomHandler.DeclineInvitation)     // Decline invitation
+		// Hand-raise queue
+		roomGroup.GET("/:id/hands", rt.roomHandler.GetHandQueue)                             // Raised hands in speaking order
+		roomGroup.DELETE("/:id/hands", rt.roomHandler.LowerAllHands)                         // Lower all hands (moderators)
+		roomGroup.PUT("/:id/hands/order", rt.roomHandler.ReorderHands)                       // Reorder speaking queue (moderators)
+		roomGroup.POST("/:id/participants/me/hand", rt.roomHandler.RaiseHand)                // Raise own hand
+		roomGroup.DELETE("/:id/participants/me/hand", rt.roomHandler.LowerHand)              // Lower own hand
+		roomGroup.DELETE("/:id/participants/:pid/hand", rt.roomHandler.LowerParticipantHand) // Lower a participant's hand (moderators)
+
+		// Meeting chat
+		roomGroup.GET("/:id/chat", rt.roomHandler.ListChatMessages)          // Page through the chat
+		roomGroup.POST("/:id/chat", rt.roomHandler.PostChatMessage)          // Post a chat message
+		roomGroup.PATCH("/:id/chat/:mid", rt.roomHandler.EditChatMessage)    // Edit own chat message
+		roomGroup.DELETE("/:id/chat/:mid", rt.roomHandler.DeleteChatMessage) // Delete a chat message (author, or moderators)
+
+		// Polls
+		roomGroup.GET("/:id/polls", rt.roomHandler.ListPolls)                 // Polls with live or final results
+		roomGroup.POST("/:id/polls", rt.roomHandler.CreatePoll)               // Open a poll (moderators)
+		roomGroup.GET("/:id/polls/:poll_id", rt.roomHandler.GetPoll)          // Poll with results and own vote
+		roomGroup.POST("/:id/polls/:poll_id/votes", rt.roomHandler.Vote)      // Vote (replaces a previous vote)
+		roomGroup.POST("/:id/polls/:poll_id/close", rt.roomHandler.ClosePoll) // Close a poll (moderators)
+
+		// Agenda
+		roomGroup.GET("/:id/agenda", rt.roomHandler.GetAgenda)                    // Agenda with the current item
+		roomGroup.PUT("/:id/agenda", rt.roomHandler.SetAgenda)                    // Replace the agenda (moderators)
+		roomGroup.PUT("/:id/agenda/current", rt.roomHandler.SetCurrentAgendaItem) // Move to an agenda item (moderators)
 	} else {
 		// Placeholder routes when handler is not initialized
 		roomGroup.POST("", rt.notImplemented)
 		roomGroup.GET("", rt.notImplemented)
 		roomGroup.GET("/:id", rt.notImplemented)
 		roomGroup.PATCH("/:id", rt.notImplemented)
+		roomGroup.PATCH("/:id/settings", rt.notImplemented)
 		roomGroup.POST("/:id/participants", rt.notImplemented)
 		roomGroup.DELETE("/:id/participants/me", rt.notImplemented)
 		roomGroup.GET("/:id/participants", rt.notImplemented)
 		roomGroup.DELETE("/:id/participants/:pid", rt.notImplemented)
 		roomGroup.PATCH("/:id/host", rt.notImplemented)
 	}
+
+	if rt.recordingHandler != nil {
+		roomGroup.GET("/:id/recordings", rt.recordingHandler.ListRoomRecordings) // Recordings of the meeting (participants and org members)
+	} else {
+		roomGroup.GET("/:id/recordings", rt.notImplemented)
+	}
+
+	if rt.invitationHandler != nil {
+		// Invitation routes
+		roomGroup.POST("/:id/invitations", rt.invitationHandler.CreateInvitation)                  // Invite user by email
+		roomGroup.GET("/:id/invitations", rt.invitationHandler.ListRoomInvitations)                // Get room invitations (host only)
+		roomGroup.POST("/:id/invitations/accept", rt.invitationHandler.AcceptRoomInvitation)       // Accept invitation
+		roomGroup.POST("/:id/invitations/decline", rt.invitationHandler.DeclineRoomInvitation)     // Decline invitation
+		roomGroup.DELETE("/:id/invitations/:invitation_id", rt.invitationHandler.RevokeInvitation) // Revoke invitation (host only)
+	} else {
+		roomGroup.POST("/:id/invitations", rt.notImplemented)
+		roomGroup.GET("/:id/invitations", rt.notImplemented)
+	}
+
+	if rt.breakoutHandler != nil {
+		// Breakout rooms
+		roomGroup.POST("/:id/breakouts", rt.breakoutHandler.CreateBreakouts)                   // Open breakout rooms (host only)
+		roomGroup.GET("/:id/breakouts", rt.breakoutHandler.ListBreakouts)                      // List breakout rooms (host only)
+		roomGroup.DELETE("/:id/breakouts", rt.breakoutHandler.CloseBreakouts)                  // Close breakout rooms, everyone back (host only)
+		roomGroup.PUT("/:id/breakouts/assignments", rt.breakoutHandler.AssignParticipants)     // Assign participants manually (host only)
+		roomGroup.POST("/:id/breakouts/assignments/random", rt.breakoutHandler.AssignRandomly) // Assign participants randomly (host only)
+		roomGroup.POST("/:id/breakouts/timer", rt.breakoutHandler.StartTimer)                  // Broadcast a countdown (host only)
+		roomGroup.GET("/:id/breakouts/me", rt.breakoutHandler.GetMyDestination)                // Room to connect to (for polling)
+	} else {
+		roomGroup.POST("/:id/breakouts", rt.notImplemented)
+		roomGroup.GET("/:id/breakouts", rt.notImplemented)
+	}
+}
+
+// setupSeriesRoutes configures recurring meeting series routes
+func (rt *Router) setupSeriesRoutes(g *echo.Group) {
+	seriesGroup := g.Group("/series")
+
+	if rt.authMW != nil {
+		seriesGroup.Use(rt.authMW)
+	}
+
+	if rt.seriesHandler != nil {
+		// Series CRUD
+		seriesGroup.POST("", rt.seriesHandler.CreateSeries)            // Create series
+		seriesGroup.GET("", rt.seriesHandler.ListSeries)               // List my series
+		seriesGroup.GET("/:id", rt.seriesHandler.GetSeries)            // Get series details
+		seriesGroup.POST("/:id/end", rt.seriesHandler.EndSeries)       // End series
+		seriesGroup.POST("/:id/expand", rt.seriesHandler.ExpandSeries) // Create rooms for upcoming occurrences
+
+		// Occurrences
+		seriesGroup.GET("/:id/occurrences", rt.seriesHandler.ListOccurrences)                  // List occurrences in a window
+		seriesGroup.POST("/:id/occurrences", rt.seriesHandler.MaterializeOccurrence)           // Create the room of an occurrence
+		seriesGroup.POST("/:id/occurrences/cancel", rt.seriesHandler.CancelOccurrence)         // Cancel an occurrence
+		seriesGroup.POST("/:id/occurrences/reschedule", rt.seriesHandler.RescheduleOccurrence) // Reschedule an occurrence
+
+		// Cross-occurrence AI results
+		seriesGroup.GET("/:id/summaries", rt.seriesHandler.GetSeriesSummaries)      // Summaries of all occurrences
+		seriesGroup.GET("/:id/action-items", rt.seriesHandler.GetSeriesActionItems) // Action items of all occurrences
+	} else {
+		seriesGroup.POST("", rt.notImplemented)
+		seriesGroup.GET("", rt.notImplemented)
+		seriesGroup.GET("/:id", rt.notImplemented)
+		seriesGroup.GET("/:id/occurrences", rt.notImplemented)
+	}
 }
 
 // setupMeetingRoutes configures meeting-related routes
@@ -175,16 +310,180 @@ func (rt *Router) setupMeetingRoutes(g *echo.Group) {
 	}
 }
 
+// setupGuestRoutes configures routes of guests joining without an account
+func (rt *Router) setupGuestRoutes(g *echo.Group) {
+	guestGroup := g.Group("/guest")
+
+	if rt.guestHandler == nil {
+		guestGroup.POST("/join", rt.notImplemented)
+		return
+	}
+
+	// Public: exchanges a display name and room slug or invitation token for a guest token
+	guestGroup.POST("/join", rt.guestHandler.Join)
+
+	// Authenticated with the guest token returned by /join
+	var mw []echo.MiddlewareFunc
+	if rt.guestAuthMW != nil {
+		mw = append(mw, rt.guestAuthMW)
+	}
+	guestGroup.GET("/me", rt.guestHandler.GetStatus, mw...)           // Poll status (LiveKit token once admitted)
+	guestGroup.DELETE("/me", rt.guestHandler.Leave, mw...)            // Leave room
+	guestGroup.GET("/me/events", rt.guestHandler.StreamEvents, mw...) // Real-time room events (SSE)
+	guestGroup.POST("/me/hand", rt.guestHandler.RaiseHand, mw...)     // Raise hand
+	guestGroup.DELETE("/me/hand", rt.guestHandler.LowerHand, mw...)   // Lower hand
+
+	// Recording consent
+	guestGroup.GET("/me/recording-consent", rt.guestHandler.GetRecordingConsent, mw...)   // My consent status
+	guestGroup.POST("/me/recording-consent", rt.guestHandler.GiveRecordingConsent, mw...) // Consent to be recorded
+
+	// Meeting chat
+	guestGroup.GET("/me/chat", rt.guestHandler.ListChatMessages, mw...)          // Page through the chat
+	guestGroup.POST("/me/chat", rt.guestHandler.PostChatMessage, mw...)          // Post a chat message
+	guestGroup.PATCH("/me/chat/:mid", rt.guestHandler.EditChatMessage, mw...)    // Edit own chat message
+	guestGroup.DELETE("/me/chat/:mid", rt.guestHandler.DeleteChatMessage, mw...) // Delete own chat message
+
+	// Polls
+	guestGroup.GET("/me/polls", rt.guestHandler.ListPolls, mw...)            // Polls with live or final results
+	guestGroup.POST("/me/polls/:poll_id/votes", rt.guestHandler.Vote, mw...) // Vote (replaces a previous vote)
+
+	if rt.breakoutHandler != nil {
+		guestGroup.GET("/me/breakout", rt.breakoutHandler.GetGuestDestination, mw...) // Room to connect to during breakouts
+	}
+}
+
+// setupOrganizationRoutes configures organization (tenant) routes
+func (rt *Router) setupOrganizationRoutes(g *echo.Group) {
+	orgGroup := g.Group("/organizations")
+
+	if rt.orgHandler == nil {
+		orgGroup.POST("", rt.notImplemented)
+		orgGroup.GET("", rt.notImplemented)
+		return
+	}
+
+	// Invite links are previewed before logging in, so this route is registered before the auth middleware
+	orgGroup.GET("/invites/:token", rt.orgHandler.GetInvite)
+
+	if rt.authMW != nil {
+		orgGroup.Use(rt.authMW)
+	}
+
+	// Organization CRUD
+	orgGroup.POST("", rt.orgHandler.CreateOrganization)       // Create organization
+	orgGroup.GET("", rt.orgHandler.ListMyOrganizations)       // List my organizations
+	orgGroup.GET("/:id", rt.orgHandler.GetOrganization)       // Get organization
+	orgGroup.PATCH("/:id", rt.orgHandler.UpdateOrganization)  // Rename or change settings (owners and admins)
+	orgGroup.DELETE("/:id", rt.orgHandler.DeleteOrganization) // Delete organization (owners only)
+
+	// Members
+	orgGroup.GET("/:id/members", rt.orgHandler.ListMembers)                 // List members
+	orgGroup.PATCH("/:id/members/:user_id", rt.orgHandler.UpdateMemberRole) // Change member role
+	orgGroup.DELETE("/:id/members/:user_id", rt.orgHandler.RemoveMember)    // Remove member or leave
+
+	// Invites
+	orgGroup.POST("/:id/invites", rt.orgHandler.CreateInvite)              // Invite by email (owners and admins)
+	orgGroup.GET("/:id/invites", rt.orgHandler.ListInvites)                // List invites (owners and admins)
+	orgGroup.DELETE("/:id/invites/:invite_id", rt.orgHandler.RevokeInvite) // Revoke invite (owners and admins)
+	orgGroup.POST("/invites/:token/accept", rt.orgHandler.AcceptInvite)    // Accept invite
+}
+
+// setupAdminRoutes configures platform administration routes (admins only)
+func (rt *Router) setupAdminRoutes(g *echo.Group) {
+	adminGroup := g.Group("/admin")
+
+	if rt.authMW != nil {
+		adminGroup.Use(rt.authMW)
+	}
+	if rt.adminMW != nil {
+		adminGroup.Use(rt.adminMW)
+	}
+
+	if rt.adminHandler == nil {
+		adminGroup.GET("/overview", rt.notImplemented)
+		return
+	}
+
+	adminGroup.GET("/overview", rt.adminHandler.GetOverview) // System overview
+
+	// Users
+	adminGroup.GET("/users", rt.adminHandler.ListUsers)                      // Search users
+	adminGroup.GET("/users/:id", rt.adminHandler.GetUser)                    // Get user
+	adminGroup.PATCH("/users/:id/role", rt.adminHandler.UpdateUserRole)      // Change role
+	adminGroup.PUT("/users/:id/plan", rt.adminHandler.AssignUserPlan)        // Change plan
+	adminGroup.POST("/users/:id/deactivate", rt.adminHandler.DeactivateUser) // Deactivate and revoke sessions
+	adminGroup.POST("/users/:id/reactivate", rt.adminHandler.ReactivateUser) // Reactivate
+
+	// Rooms
+	adminGroup.GET("/rooms", rt.adminHandler.ListRooms)             // List rooms of every tenant
+	adminGroup.POST("/rooms/:id/end", rt.adminHandler.ForceEndRoom) // Force-end room
+
+	// Plans
+	adminGroup.GET("/plans", rt.adminHandler.ListPlans)                               // List plans
+	adminGroup.PUT("/organizations/:id/plan", rt.adminHandler.AssignOrganizationPlan) // Change organization plan
+
+	// AI jobs
+	adminGroup.GET("/ai-jobs", rt.adminHandler.ListAIJobs)            // List AI jobs
+	adminGroup.GET("/ai-jobs/:id", rt.adminHandler.GetAIJob)          // Get AI job
+	adminGroup.POST("/ai-jobs/:id/retry", rt.adminHandler.RetryAIJob) // Retry failed AI job
+}
+
+// setupQuotaRoutes configures plan and usage routes
+func (rt *Router) setupQuotaRoutes(g *echo.Group) {
+	if rt.quotaHandler == nil {
+		g.GET("/usage", rt.notImplemented)
+		return
+	}
+
+	var mw []echo.MiddlewareFunc
+	if rt.authMW != nil {
+		mw = append(mw, rt.authMW)
+	}
+	g.GET("/usage", rt.quotaHandler.GetUsage, mw...)  // Plan and usage of my rooms (or of an organization)
+	g.GET("/plans", rt.quotaHandler.ListPlans, mw...) // Available plans
+}
+
+// setupRecordingRoutes configures recording playback and deletion routes
+func (rt *Router) setupRecordingRoutes(g *echo.Group) {
+	recordingGroup := g.Group("/recordings")
+
+	if rt.authMW != nil {
+		recordingGroup.Use(rt.authMW)
+	}
+
+	if rt.recordingHandler == nil {
+		recordingGroup.GET("/:id", rt.notImplemented)
+		recordingGroup.DELETE("/:id", rt.notImplemented)
+		return
+	}
+
+	recordingGroup.GET("/:id", rt.recordingHandler.GetRecording)       // Recording with presigned playback URLs
+	recordingGroup.DELETE("/:id", rt.recordingHandler.DeleteRecording) // Delete recording and its files
+}
+
 // setupInvitationRoutes configures invitation routes
 func (rt *Router) setupInvitationRoutes(g *echo.Group) {
+	// Invitation links are opened by users who may not be logged in yet,
+	// so this route is registered before the auth middleware below
+	if rt.invitationHandler != nil {
+		var mw []echo.MiddlewareFunc
+		if rt.optionalAuthMW != nil {
+			mw = append(mw, rt.optionalAuthMW)
+		}
+		g.GET("/invitations/:token", rt.invitationHandler.OpenInvitation, mw...) // One-click accept (preview when logged out)
+	} else {
+		g.GET("/invitations/:token", rt.notImplemented)
+	}
+
 	// Protect with auth middleware
 	if rt.authMW != nil {
 		g.Use(rt.authMW)
 	}
 
-	if rt.roomHandler != nil {
+	if rt.invitationHandler != nil {
 		// User's invitations
-		g.GET("/invitations/me", rt.roomHandler.GetMyInvitations) // Get my invitations
+		g.GET("/invitations/me", rt.invitationHandler.GetMyInvitations)                      // Get my invitations
+		g.POST("/invitations/:token/decline", rt.invitationHandler.DeclineInvitationByToken) // Decline invitation link
 	} else {
 		g.GET("/invitations/me", rt.notImplemented)
 	}
@@ -234,16 +533,6 @@ func (rt *Router) setupTestRoutes(g *echo.Group) {
 	}
 }
 
-// // setupRecordingRoutes configures recording routes
-// func (rt *Router) setupRecordingRoutes(g *echo.Group) {
-// 	recordingGroup := g.Group("/recordings")
-
-// 	// TODO: Add recording routes
-// 	recordingGroup.GET("", rt.notImplemented)
-// 	recordingGroup.GET("/:id", rt.notImplemented)
-// 	recordingGroup.POST("/:id/upload", rt.notImplemented)
-// }
-
 // // setupReportRoutes configures report routes
 // func (rt *Router) setupReportRoutes(g *echo.Group) {
 // 	reportGroup := g.Group("/reports")
@@ -0,0 +1,536 @@
+package handler
+
+import (
+	stdErrors "errors"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+
+	"github.com/johnquangdev/meeting-assistant/errors"
+	summaryDTO "github.com/johnquangdev/meeting-assistant/internal/adapter/dto"
+	seriesDTO "github.com/johnquangdev/meeting-assistant/internal/adapter/dto/series"
+	"github.com/johnquangdev/meeting-assistant/internal/adapter/presenter"
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+	usecaseErrors "github.com/johnquangdev/meeting-assistant/internal/usecase/errors"
+	seriesUsecase "github.com/johnquangdev/meeting-assistant/internal/usecase/series"
+)
+
+// defaultOccurrenceWindow is used when listing occurrences without an explicit end
+const defaultOccurrenceWindow = 30 * 24 * time.Hour
+
+// Series handles recurring meeting series HTTP requests
+type Series struct {
+	seriesService seriesUsecase.Service
+	logger        *zap.Logger
+}
+
+// NewSeriesHandler creates a new series handler
+func NewSeriesHandler(seriesService seriesUsecase.Service, logger *zap.Logger) *Series {
+	return &Series{
+		seriesService: seriesService,
+		logger:        logger,
+	}
+}
+
+// CreateSeries handles POST /series
+// @Summary      Create a recurring meeting series
+// @Description  Creates a series of meetings defined by an RFC 5545 RRULE (e.g. FREQ=WEEKLY;BYDAY=MO,WE).
+// @Description  Occurrences are materialized into regular rooms ahead of time by a background job.
+// @Tags         Series
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request  body      seriesDTO.CreateSeriesRequest  true  "Series creation request"
+// @Success      200      {object}  seriesDTO.SeriesResponse  "Series created successfully"
+// @Failure      400      {object}  map[string]interface{}  "Invalid request, recurrence rule or time zone"
+// @Failure      401      {object}  map[string]interface{}  "User not authenticated"
+// @Failure      500      {object}  map[string]interface{}  "Failed to create series"
+// @Router       /series [post]
+func (h *Series) CreateSeries(c echo.Context) error {
+	var req seriesDTO.CreateSeriesRequest
+	if err := c.Bind(&req); err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid request body"))
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Validation failed").WithDetail("error", err.Error()))
+	}
+
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return HandleError(h.logger, c, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated"))
+	}
+
+	roomType := entities.RoomTypeScheduled
+	if req.Type != "" {
+		roomType = entities.RoomType(req.Type)
+	}
+
+	input := seriesUsecase.CreateSeriesInput{
+		Name:            req.Name,
+		Description:     req.Description,
+		HostID:          userID,
+		RoomType:        roomType,
+		MaxParticipants: req.MaxParticipants,
+		Settings:        req.Settings,
+		RRule:           req.RRule,
+		Timezone:        req.Timezone,
+		StartTime:       req.StartTime,
+		DurationMinutes: req.DurationMinutes,
+	}
+
+	s, err := h.seriesService.CreateSeries(c.Request().Context(), input)
+	if err != nil {
+		return HandleError(h.logger, c, mapSeriesError(err))
+	}
+
+	return HandleSuccess(h.logger, c, presenter.ToSeriesResponse(s))
+}
+
+// ListSeries handles GET /series
+// @Summary      List my series
+// @Description  Gets the recurring meeting series hosted by the current user
+// @Tags         Series
+// @Produce      json
+// @Security     BearerAuth
+// @Param        page       query     int  false  "Page number (default: 1)"
+// @Param        page_size  query     int  false  "Items per page (default: 20)"
+// @Success      200        {object}  seriesDTO.SeriesListResponse  "List of series"
+// @Failure      401        {object}  map[string]interface{}  "User not authenticated"
+// @Failure      500        {object}  map[string]interface{}  "Failed to list series"
+// @Router       /series [get]
+func (h *Series) ListSeries(c echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return HandleError(h.logger, c, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated"))
+	}
+
+	page, pageSize := 1, 20
+	if p, err := strconv.Atoi(c.QueryParam("page")); err == nil && p > 0 {
+		page = p
+	}
+	if ps, err := strconv.Atoi(c.QueryParam("page_size")); err == nil && ps > 0 && ps <= 100 {
+		pageSize = ps
+	}
+
+	list, err := h.seriesService.ListSeries(c.Request().Context(), userID, pageSize, (page-1)*pageSize)
+	if err != nil {
+		return HandleError(h.logger, c, errors.ErrInternal(err))
+	}
+
+	return HandleSuccess(h.logger, c, presenter.ToSeriesListResponse(list))
+}
+
+// GetSeries handles GET /series/:id
+// @Summary      Get series details
+// @Description  Gets a recurring meeting series (host, occurrence participants and organization members)
+// @Tags         Series
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "Series ID (UUID)"
+// @Success      200  {object}  seriesDTO.SeriesResponse  "Series details"
+// @Failure      400  {object}  map[string]interface{}  "Invalid series ID"
+// @Failure      401  {object}  map[string]interface{}  "User not authenticated"
+// @Failure      404  {object}  map[string]interface{}  "Series not found"
+// @Router       /series/{id} [get]
+func (h *Series) GetSeries(c echo.Context) error {
+	seriesID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid series ID").WithDetail("error", "Series ID must be a valid UUID"))
+	}
+
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return HandleError(h.logger, c, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated"))
+	}
+
+	s, err := h.seriesService.GetSeries(c.Request().Context(), seriesID, userID)
+	if err != nil {
+		return HandleError(h.logger, c, mapSeriesError(err))
+	}
+
+	return HandleSuccess(h.logger, c, presenter.ToSeriesResponse(s))
+}
+
+// EndSeries handles POST /series/:id/end
+// @Summary      End a series
+// @Description  Stops a series from producing new occurrences and cancels rooms that have not started yet (host only)
+// @Tags         Series
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "Series ID (UUID)"
+// @Success      200  {object}  map[string]interface{}  "Series ended successfully"
+// @Failure      400  {object}  map[string]interface{}  "Invalid series ID"
+// @Failure      401  {object}  map[string]interface{}  "User not authenticated"
+// @Failure      403  {object}  map[string]interface{}  "User is not the host"
+// @Failure      404  {object}  map[string]interface{}  "Series not found"
+// @Router       /series/{id}/end [post]
+func (h *Series) EndSeries(c echo.Context) error {
+	seriesID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid series ID").WithDetail("error", "Series ID must be a valid UUID"))
+	}
+
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return HandleError(h.logger, c, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated"))
+	}
+
+	if err := h.seriesService.EndSeries(c.Request().Context(), seriesID, userID); err != nil {
+		return HandleError(h.logger, c, mapSeriesError(err))
+	}
+
+	return HandleSuccess(h.logger, c, map[string]string{"message": "series ended successfully"})
+}
+
+// ListOccurrences handles GET /series/:id/occurrences
+// @Summary      List occurrences
+// @Description  Expands the series rule in [from, to) merged with cancelled/rescheduled occurrences and generated rooms (host, occurrence participants and organization members)
+// @Tags         Series
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id    path      string  true   "Series ID (UUID)"
+// @Param        from  query     string  false  "Window start (RFC3339, default: now)"
+// @Param        to    query     string  false  "Window end (RFC3339, default: from + 30 days)"
+// @Success      200   {object}  seriesDTO.OccurrenceListResponse  "Occurrences in the window"
+// @Failure      400   {object}  map[string]interface{}  "Invalid series ID or time window"
+// @Failure      401   {object}  map[string]interface{}  "User not authenticated"
+// @Failure      404   {object}  map[string]interface{}  "Series not found"
+// @Router       /series/{id}/occurrences [get]
+func (h *Series) ListOccurrences(c echo.Context) error {
+	seriesID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid series ID").WithDetail("error", "Series ID must be a valid UUID"))
+	}
+
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return HandleError(h.logger, c, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated"))
+	}
+
+	var req seriesDTO.ListOccurrencesRequest
+	req.From = c.QueryParam("from")
+	req.To = c.QueryParam("to")
+
+	from := time.Now().UTC()
+	if req.From != "" {
+		if from, err = time.Parse(time.RFC3339, req.From); err != nil {
+			return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid from").WithDetail("error", "from must be an RFC3339 timestamp"))
+		}
+	}
+
+	to := from.Add(defaultOccurrenceWindow)
+	if req.To != "" {
+		if to, err = time.Parse(time.RFC3339, req.To); err != nil {
+			return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid to").WithDetail("error", "to must be an RFC3339 timestamp"))
+		}
+	}
+
+	if !to.After(from) {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid time window").WithDetail("error", "to must be after from"))
+	}
+
+	occurrences, err := h.seriesService.ListOccurrences(c.Request().Context(), seriesID, userID, from, to)
+	if err != nil {
+		return HandleError(h.logger, c, mapSeriesError(err))
+	}
+
+	response := &seriesDTO.OccurrenceListResponse{
+		SeriesID:    seriesID.String(),
+		From:        from,
+		To:          to,
+		Occurrences: make([]*seriesDTO.OccurrenceResponse, len(occurrences)),
+	}
+	for i, o := range occurrences {
+		response.Occurrences[i] = presenter.ToOccurrenceResponse(o)
+	}
+
+	return HandleSuccess(h.logger, c, response)
+}
+
+// MaterializeOccurrence handles POST /series/:id/occurrences
+// @Summary      Create the room of an occurrence
+// @Description  Creates (or returns) the room generated for a single occurrence ahead of the background job (host only)
+// @Tags         Series
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id       path      string                                  true  "Series ID (UUID)"
+// @Param        request  body      seriesDTO.MaterializeOccurrenceRequest  true  "Occurrence to materialize"
+// @Success      200      {object}  room.RoomResponse  "Occurrence room"
+// @Failure      400      {object}  map[string]interface{}  "Invalid request or time is not an occurrence of the series"
+// @Failure      401      {object}  map[string]interface{}  "User not authenticated"
+// @Failure      403      {object}  map[string]interface{}  "User is not the host"
+// @Failure      404      {object}  map[string]interface{}  "Series not found"
+// @Failure      409      {object}  map[string]interface{}  "Series ended or occurrence cancelled"
+// @Router       /series/{id}/occurrences [post]
+func (h *Series) MaterializeOccurrence(c echo.Context) error {
+	seriesID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid series ID").WithDetail("error", "Series ID must be a valid UUID"))
+	}
+
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return HandleError(h.logger, c, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated"))
+	}
+
+	var req seriesDTO.MaterializeOccurrenceRequest
+	if err := c.Bind(&req); err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid request body"))
+	}
+	if err := c.Validate(&req); err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Validation failed").WithDetail("error", err.Error()))
+	}
+
+	r, err := h.seriesService.MaterializeOccurrence(c.Request().Context(), seriesID, userID, req.OccurrenceStartTime)
+	if err != nil {
+		return HandleError(h.logger, c, mapSeriesError(err))
+	}
+
+	return HandleSuccess(h.logger, c, presenter.ToRoomResponse(r))
+}
+
+// ExpandSeries handles POST /series/:id/expand
+// @Summary      Create rooms for upcoming occurrences
+// @Description  Creates rooms for every upcoming occurrence up to the given time, capped at 90 days ahead (host only)
+// @Tags         Series
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id       path      string                         true  "Series ID (UUID)"
+// @Param        request  body      seriesDTO.ExpandSeriesRequest  true  "Expansion horizon"
+// @Success      200      {object}  room.RoomListResponse  "Rooms created"
+// @Failure      400      {object}  map[string]interface{}  "Invalid request"
+// @Failure      401      {object}  map[string]interface{}  "User not authenticated"
+// @Failure      403      {object}  map[string]interface{}  "User is not the host"
+// @Failure      404      {object}  map[string]interface{}  "Series not found"
+// @Failure      409      {object}  map[string]interface{}  "Series ended"
+// @Router       /series/{id}/expand [post]
+func (h *Series) ExpandSeries(c echo.Context) error {
+	seriesID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid series ID").WithDetail("error", "Series ID must be a valid UUID"))
+	}
+
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return HandleError(h.logger, c, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated"))
+	}
+
+	var req seriesDTO.ExpandSeriesRequest
+	if err := c.Bind(&req); err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid request body"))
+	}
+	if err := c.Validate(&req); err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Validation failed").WithDetail("error", err.Error()))
+	}
+
+	rooms, err := h.seriesService.ExpandSeries(c.Request().Context(), seriesID, userID, req.Until)
+	if err != nil {
+		return HandleError(h.logger, c, mapSeriesError(err))
+	}
+
+	return HandleSuccess(h.logger, c, presenter.ToRoomListResponse(rooms, int64(len(rooms)), 1, len(rooms)))
+}
+
+// CancelOccurrence handles POST /series/:id/occurrences/cancel
+// @Summary      Cancel an occurrence
+// @Description  Cancels a single occurrence of the series. Its room is cancelled if it was already created (host only)
+// @Tags         Series
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id       path      string                             true  "Series ID (UUID)"
+// @Param        request  body      seriesDTO.CancelOccurrenceRequest  true  "Occurrence to cancel"
+// @Success      200      {object}  map[string]interface{}  "Occurrence cancelled successfully"
+// @Failure      400      {object}  map[string]interface{}  "Invalid request or time is not an occurrence of the series"
+// @Failure      401      {object}  map[string]interface{}  "User not authenticated"
+// @Failure      403      {object}  map[string]interface{}  "User is not the host"
+// @Failure      404      {object}  map[string]interface{}  "Series not found"
+// @Failure      409      {object}  map[string]interface{}  "Occurrence already started"
+// @Router       /series/{id}/occurrences/cancel [post]
+func (h *Series) CancelOccurrence(c echo.Context) error {
+	seriesID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid series ID").WithDetail("error", "Series ID must be a valid UUID"))
+	}
+
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return HandleError(h.logger, c, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated"))
+	}
+
+	var req seriesDTO.CancelOccurrenceRequest
+	if err := c.Bind(&req); err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid request body"))
+	}
+	if err := c.Validate(&req); err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Validation failed").WithDetail("error", err.Error()))
+	}
+
+	if err := h.seriesService.CancelOccurrence(c.Request().Context(), seriesID, userID, req.OccurrenceStartTime, req.Reason); err != nil {
+		return HandleError(h.logger, c, mapSeriesError(err))
+	}
+
+	return HandleSuccess(h.logger, c, map[string]string{"message": "occurrence cancelled successfully"})
+}
+
+// RescheduleOccurrence handles POST /series/:id/occurrences/reschedule
+// @Summary      Reschedule an occurrence
+// @Description  Moves a single occurrence of the series to a new time. Its room is updated if it was already created (host only)
+// @Tags         Series
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id       path      string                                 true  "Series ID (UUID)"
+// @Param        request  body      seriesDTO.RescheduleOccurrenceRequest  true  "Occurrence to move and its new time"
+// @Success      200      {object}  seriesDTO.OccurrenceResponse  "Rescheduled occurrence"
+// @Failure      400      {object}  map[string]interface{}  "Invalid request or time is not an occurrence of the series"
+// @Failure      401      {object}  map[string]interface{}  "User not authenticated"
+// @Failure      403      {object}  map[string]interface{}  "User is not the host"
+// @Failure      404      {object}  map[string]interface{}  "Series not found"
+// @Failure      409      {object}  map[string]interface{}  "Occurrence cancelled or already started"
+// @Router       /series/{id}/occurrences/reschedule [post]
+func (h *Series) RescheduleOccurrence(c echo.Context) error {
+	seriesID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid series ID").WithDetail("error", "Series ID must be a valid UUID"))
+	}
+
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return HandleError(h.logger, c, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated"))
+	}
+
+	var req seriesDTO.RescheduleOccurrenceRequest
+	if err := c.Bind(&req); err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid request body"))
+	}
+	if err := c.Validate(&req); err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Validation failed").WithDetail("error", err.Error()))
+	}
+
+	occ, err := h.seriesService.RescheduleOccurrence(c.Request().Context(), seriesUsecase.RescheduleOccurrenceInput{
+		SeriesID:        seriesID,
+		UserID:          userID,
+		OccurrenceStart: req.OccurrenceStartTime,
+		NewStartTime:    req.NewStartTime,
+		NewEndTime:      req.NewEndTime,
+		Reason:          req.Reason,
+	})
+	if err != nil {
+		return HandleError(h.logger, c, mapSeriesError(err))
+	}
+
+	return HandleSuccess(h.logger, c, presenter.ToOccurrenceResponse(occ))
+}
+
+// GetSeriesSummaries handles GET /series/:id/summaries
+// @Summary      Get series summaries
+// @Description  Gets the AI summaries of all occurrences of a series, oldest first (host, occurrence participants and organization members)
+// @Tags         Series
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "Series ID (UUID)"
+// @Success      200  {object}  seriesDTO.SeriesSummariesResponse  "Summaries per occurrence"
+// @Failure      400  {object}  map[string]interface{}  "Invalid series ID"
+// @Failure      401  {object}  map[string]interface{}  "User not authenticated"
+// @Failure      404  {object}  map[string]interface{}  "Series not found"
+// @Router       /series/{id}/summaries [get]
+func (h *Series) GetSeriesSummaries(c echo.Context) error {
+	seriesID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid series ID").WithDetail("error", "Series ID must be a valid UUID"))
+	}
+
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return HandleError(h.logger, c, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated"))
+	}
+
+	summaries, err := h.seriesService.GetSeriesSummaries(c.Request().Context(), seriesID, userID)
+	if err != nil {
+		return HandleError(h.logger, c, mapSeriesError(err))
+	}
+
+	response := &seriesDTO.SeriesSummariesResponse{
+		SeriesID:  seriesID.String(),
+		Summaries: make([]*seriesDTO.OccurrenceSummaryResponse, len(summaries)),
+	}
+	for i, s := range summaries {
+		response.Summaries[i] = presenter.ToOccurrenceSummaryResponse(s)
+	}
+
+	return HandleSuccess(h.logger, c, response)
+}
+
+// GetSeriesActionItems handles GET /series/:id/action-items
+// @Summary      Get series action items
+// @Description  Gets the action items extracted from all occurrences of a series (host, occurrence participants and organization members)
+// @Tags         Series
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "Series ID (UUID)"
+// @Success      200  {object}  seriesDTO.SeriesActionItemsResponse  "Action items across occurrences"
+// @Failure      400  {object}  map[string]interface{}  "Invalid series ID"
+// @Failure      401  {object}  map[string]interface{}  "User not authenticated"
+// @Failure      404  {object}  map[string]interface{}  "Series not found"
+// @Router       /series/{id}/action-items [get]
+func (h *Series) GetSeriesActionItems(c echo.Context) error {
+	seriesID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return HandleError(h.logger, c, errors.ErrInvalidArgument("Invalid series ID").WithDetail("error", "Series ID must be a valid UUID"))
+	}
+
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return HandleError(h.logger, c, errors.ErrUnauthenticated().WithDetail("error", "User not authenticated"))
+	}
+
+	items, err := h.seriesService.GetSeriesActionItems(c.Request().Context(), seriesID, userID)
+	if err != nil {
+		return HandleError(h.logger, c, mapSeriesError(err))
+	}
+
+	response := &seriesDTO.SeriesActionItemsResponse{
+		SeriesID:    seriesID.String(),
+		ActionItems: make([]summaryDTO.ActionItemDTO, len(items)),
+		Total:       len(items),
+	}
+	for i, item := range items {
+		response.ActionItems[i] = presenter.ToActionItemDTO(item)
+	}
+
+	return HandleSuccess(h.logger, c, response)
+}
+
+// mapSeriesError converts series use case errors into AppErrors
+func mapSeriesError(err error) error {
+	switch {
+	case stdErrors.Is(err, usecaseErrors.ErrSeriesNotFound):
+		return errors.ErrNotFound("Series")
+	case stdErrors.Is(err, usecaseErrors.ErrNotHost):
+		return errors.ErrNotHost()
+	case stdErrors.Is(err, usecaseErrors.ErrInvalidRecurrenceRule):
+		return errors.ErrInvalidArgument("Invalid recurrence rule").WithDetail("error", err.Error())
+	case stdErrors.Is(err, usecaseErrors.ErrInvalidTimezone):
+		return errors.ErrInvalidArgument("Invalid time zone").WithDetail("error", "Time zone must be a valid IANA name")
+	case stdErrors.Is(err, usecaseErrors.ErrInvalidMaxParticipants),
+		stdErrors.Is(err, usecaseErrors.ErrInvalidInput):
+		return errors.ErrInvalidArgument(err.Error())
+	case stdErrors.Is(err, usecaseErrors.ErrOccurrenceNotFound):
+		return errors.ErrInvalidArgument("Invalid occurrence").WithDetail("error", err.Error())
+	case stdErrors.Is(err, usecaseErrors.ErrSeriesEnded),
+		stdErrors.Is(err, usecaseErrors.ErrOccurrenceCancelled),
+		stdErrors.Is(err, usecaseErrors.ErrOccurrenceAlreadyLocked):
+		return errors.ErrFailedPrecondition(err.Error())
+	case stdErrors.Is(err, usecaseErrors.ErrQuotaExceeded):
+		return errors.ErrResourceExhausted(err.Error())
+	default:
+		return errors.ErrInternal(err)
+	}
+}
@@ -16,7 +16,7 @@ type WebhookHandler struct {
 	aiService     aiUsecase.Service
 	minioClient   *storage.MinIOClient
 	recordingRepo *repository.RecordingRepository
-	aiJobRepo     *repository.AIJobRepository
+	bucketName    string // Recordings bucket the egress uploads to
 	livekitAPIKey string
 	livekitSecret string
 	webhookSecret string
@@ -24,13 +24,13 @@ type WebhookHandler struct {
 }
 
 // NewWebhookHandler creates a new webhook handler
-func NewWebhookHandler(roomService roomUsecase.Service, aiService aiUsecase.Service, minioClient *storage.MinIOClient, recordingRepo *repository.RecordingRepository, aiJobRepo *repository.AIJobRepository, livekitAPIKey string, livekitSecret string, logger *zap.Logger) *WebhookHandler {
+func NewWebhookHandler(roomService roomUsecase.Service, aiService aiUsecase.Service, minioClient *storage.MinIOClient, recordingRepo *repository.RecordingRepository, bucketName string, livekitAPIKey string, livekitSecret string, logger *zap.Logger) *WebhookHandler {
 	return &WebhookHandler{
 		roomService:   roomService,
 		aiService:     aiService,
 		minioClient:   minioClient,
 		recordingRepo: recordingRepo,
-		aiJobRepo:     aiJobRepo,
+		bucketName:    bucketName,
 		livekitAPIKey: livekitAPIKey,
 		livekitSecret: livekitSecret,
 		//webhookSecret: webhookSecret,
@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"strconv"
 	"strings"
 	"time"
 
@@ -18,6 +19,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+	"github.com/johnquangdev/meeting-assistant/internal/infrastructure/storage"
 )
 
 // Helper function to extract keys from map for debugging
@@ -169,6 +171,8 @@ func (h *WebhookHandler) HandleLiveKitWebhookV2(c echo.Context) error {
 		return h.handleRoomStartedV2(c, event)
 	case "room_finished":
 		return h.handleRoomFinishedV2(c, event)
+	case "egress_started":
+		return h.handleEgressStartedV2(c, event)
 	case "egress_updated", "egress_ended", "egress_finished":
 		// Handles RoomCompositeEgress recording events
 		c.Logger().Infof("🎬 [WEBHOOK] Processing egress/recording event: %s", event.Event)
@@ -196,16 +200,27 @@ func (h *WebhookHandler) handleParticipantJoinedV2(c echo.Context, event *liveki
 
 	c.Logger().Infof("👤 [WEBHOOK] Participant joined: %s in room %s", participantIdentity, roomName)
 
-	userID, err := uuid.Parse(participantIdentity)
+	ctx := c.Request().Context()
+	roomEntity, err := h.roomService.GetRoomByLivekitName(ctx, roomName)
 	if err != nil {
-		h.logger.Error("failed to parse user id", zap.String("identity", participantIdentity), zap.Error(err))
+		h.logger.Error("failed to find room", zap.String("room_name", roomName), zap.Error(err))
 		return HandleSuccess(h.logger, c, map[string]interface{}{"status": "ok"})
 	}
 
-	ctx := c.Request().Context()
-	roomEntity, err := h.roomService.GetRoomByLivekitName(ctx, roomName)
+	// Every connection (rejoins included) is a new attendance session
+	joinedAt := webhookTime(event.Participant.JoinedAt, event.CreatedAt)
+	if err := h.roomService.RecordSessionStart(ctx, roomEntity.ID, participantIdentity, event.Participant.Sid, joinedAt); err != nil {
+		h.logger.Error("failed to record attendance session", zap.String("identity", participantIdentity), zap.Error(err))
+	}
+
+	// Guests are marked as joined when admitted; there is no user to update
+	if _, ok := entities.ParseGuestIdentity(participantIdentity); ok {
+		return HandleSuccess(h.logger, c, map[string]interface{}{"status": "ok", "event": "participant_joined"})
+	}
+
+	userID, err := uuid.Parse(participantIdentity)
 	if err != nil {
-		h.logger.Error("failed to find room", zap.String("room_name", roomName), zap.Error(err))
+		h.logger.Error("failed to parse user id", zap.String("identity", participantIdentity), zap.Error(err))
 		return HandleSuccess(h.logger, c, map[string]interface{}{"status": "ok"})
 	}
 
@@ -230,10 +245,16 @@ func (h *WebhookHandler) handleParticipantLeftV2(c echo.Context, event *livekit.
 
 	c.Logger().Infof("👋 [WEBHOOK] Participant left: %s from room %s", participantIdentity, roomName)
 
-	userID, err := uuid.Parse(participantIdentity)
-	if err != nil {
-		h.logger.Error("failed to parse user id", zap.String("identity", participantIdentity), zap.Error(err))
-		return HandleSuccess(h.logger, c, map[string]interface{}{"status": "ok"})
+	guestID, isGuest := entities.ParseGuestIdentity(participantIdentity)
+
+	var userID uuid.UUID
+	if !isGuest {
+		var err error
+		userID, err = uuid.Parse(participantIdentity)
+		if err != nil {
+			h.logger.Error("failed to parse user id", zap.String("identity", participantIdentity), zap.Error(err))
+			return HandleSuccess(h.logger, c, map[string]interface{}{"status": "ok"})
+		}
 	}
 
 	ctx := c.Request().Context()
@@ -243,13 +264,36 @@ func (h *WebhookHandler) handleParticipantLeftV2(c echo.Context, event *livekit.
 		return HandleSuccess(h.logger, c, map[string]interface{}{"status": "ok"})
 	}
 
-	if err := h.roomService.LeaveRoom(ctx, roomEntity.ID, userID); err != nil {
+	joinedAt := webhookTime(event.Participant.JoinedAt, event.CreatedAt)
+	leftAt := webhookTime(event.CreatedAt, 0)
+	if err := h.roomService.RecordSessionEnd(ctx, roomEntity.ID, participantIdentity, event.Participant.Sid, joinedAt, leftAt); err != nil {
+		h.logger.Error("failed to end attendance session", zap.String("identity", participantIdentity), zap.Error(err))
+	}
+
+	if isGuest {
+		err = h.roomService.LeaveRoomAsGuest(ctx, roomEntity.ID, guestID)
+	} else {
+		err = h.roomService.LeaveRoom(ctx, roomEntity.ID, userID)
+	}
+	if err != nil {
 		h.logger.Error("failed to auto-leave room", zap.Error(err))
 	}
 
 	return HandleSuccess(h.logger, c, map[string]interface{}{"status": "ok", "event": "participant_left"})
 }
 
+// webhookTime converts a LiveKit unix timestamp, falling back to another one and then to now when unset
+func webhookTime(unix, fallback int64) time.Time {
+	switch {
+	case unix > 0:
+		return time.Unix(unix, 0)
+	case fallback > 0:
+		return time.Unix(fallback, 0)
+	default:
+		return time.Now()
+	}
+}
+
 // handleRoomStartedV2 handles room_started event
 func (h *WebhookHandler) handleRoomStartedV2(c echo.Context, event *livekit.WebhookEvent) error {
 	c.Logger().Info("🔹 [WEBHOOK] Processing room_started")
@@ -296,8 +340,14 @@ func (h *WebhookHandler) handleRoomFinishedV2(c echo.Context, event *livekit.Web
 		return HandleSuccess(h.logger, c, map[string]interface{}{"status": "ok"})
 	}
 
-	if err := h.roomService.EndRoom(ctx, roomEntity.ID, roomEntity.HostID); err != nil {
-		h.logger.Error("failed to end room", zap.Error(err))
+	// Rooms ended from the API close their LiveKit room, which reports back here:
+	// their cleanup runs again to finish whatever failed when they ended
+	if !roomEntity.IsEnded() {
+		if err := h.roomService.EndRoom(ctx, roomEntity.ID, roomEntity.HostID); err != nil {
+			h.logger.Error("failed to end room", zap.Error(err))
+		}
+	} else if err := h.roomService.CloseEndedRoom(ctx, roomEntity.ID); err != nil {
+		h.logger.Error("failed to close ended room", zap.Error(err))
 	}
 
 	h.logger.Info("room finished - waiting for egress_ended webhook", zap.String("room_id", roomEntity.ID.String()))
@@ -348,26 +398,48 @@ func (h *WebhookHandler) handleEgressEndedV2(c echo.Context, event *livekit.Webh
 	// Get context early for MinIO operations
 	ctx := c.Request().Context()
 
+	if roomName == "" {
+		h.logger.Warn("room name not found in egress event", zap.String("egress_id", egressID))
+		return HandleSuccess(h.logger, c, map[string]interface{}{"status": "ok"})
+	}
+
+	roomEntity, err := h.roomService.GetRoomByLivekitName(ctx, roomName)
+	if err != nil {
+		h.logger.Error("failed to find room", zap.String("room_name", roomName), zap.Error(err))
+		return HandleSuccess(h.logger, c, map[string]interface{}{"status": "ok"})
+	}
+
+	recording := h.egressRecording(ctx, roomEntity, egressID)
+
+	// egress_updated is sent for every status change; only the final event ends the recording.
+	// The egress of a recording still running ended on its own (room closed, egress failure):
+	// recordings stopped or paused through the API told the room already.
+	if event.Event != "egress_updated" && recording != nil && recording.Status == entities.RecordingStatusRecording &&
+		recording.LivekitEgressID != nil && *recording.LivekitEgressID == egressID {
+		recording.Stop(time.Now())
+		if err := h.recordingRepo.Update(ctx, recording); err != nil {
+			h.logger.Error("failed to stop recording",
+				zap.String("recording_id", recording.ID.String()),
+				zap.Error(err))
+		}
+		h.publishRecordingEvent(ctx, recording, entities.RoomEventRecordingStopped)
+	}
+
 	// Extract recording URL từ fileResults
 	var recordingURL string
 	var filename string
+	var fileSize int64
 
 	// Thử extract từ file.location trước
 	if fileMap, ok := egressInfoMap["file"].(map[string]interface{}); ok {
 		if location, ok := fileMap["location"].(string); ok {
 			recordingURL = strings.TrimSpace(location) // Trim whitespace including \n
+			fileSize = egressFileSize(fileMap["size"])
 			// Extract filename/path từ URL - giữ nguyên path trong bucket
-			// VD: https://minio.infoquang.id.vn/meeting-recordings/recordings/2025-12-31T051604-room-xxx.mp4
+			// VD: https://minio.infoquang.id.vn/<bucket>/recordings/2025-12-31T051604-room-xxx.mp4
 			// → filename = recordings/2025-12-31T051604-room-xxx.mp4
-			if strings.Contains(recordingURL, "/meeting-recordings/") {
-				parts := strings.SplitN(recordingURL, "/meeting-recordings/", 2)
-				if len(parts) == 2 {
-					filename = parts[1] // Lấy path sau bucket name
-				}
-			} else if parts := strings.Split(recordingURL, "/"); len(parts) > 0 {
-				// Fallback: chỉ lấy tên file cuối
-				filename = parts[len(parts)-1]
-			}
+			fname, _ := fileMap["filename"].(string)
+			filename = h.recordingObjectKey(recordingURL, fname)
 			h.logger.Info("✅ Found recording URL in file.location",
 				zap.String("url", recordingURL),
 				zap.String("filename", filename))
@@ -406,15 +478,9 @@ func (h *WebhookHandler) handleEgressEndedV2(c echo.Context, event *livekit.Webh
 
 				if isAudioFile && location != "" {
 					recordingURL = strings.TrimSpace(location) // Trim whitespace including \n
+					fileSize = int64(size)
 					// Extract filename/path giữ nguyên structure trong bucket
-					if strings.Contains(recordingURL, "/meeting-recordings/") {
-						parts := strings.SplitN(recordingURL, "/meeting-recordings/", 2)
-						if len(parts) == 2 {
-							filename = parts[1]
-						}
-					} else {
-						filename = fname
-					}
+					filename = h.recordingObjectKey(recordingURL, fname)
 					h.logger.Info("✅ Selected audio file",
 						zap.String("filename", filename),
 						zap.String("location", recordingURL))
@@ -438,15 +504,9 @@ func (h *WebhookHandler) handleEgressEndedV2(c echo.Context, event *livekit.Webh
 
 				if isAudioFile && location != "" {
 					recordingURL = strings.TrimSpace(location) // Trim whitespace including \n
+					fileSize = egressFileSize(resultMap["size"])
 					// Extract filename/path giữ nguyên structure trong bucket
-					if strings.Contains(recordingURL, "/meeting-recordings/") {
-						parts := strings.SplitN(recordingURL, "/meeting-recordings/", 2)
-						if len(parts) == 2 {
-							filename = parts[1]
-						}
-					} else {
-						filename = fname
-					}
+					filename = h.recordingObjectKey(recordingURL, fname)
 					h.logger.Info("✅ Selected audio file from camelCase",
 						zap.String("filename", filename),
 						zap.String("location", location))
@@ -466,77 +526,152 @@ func (h *WebhookHandler) handleEgressEndedV2(c echo.Context, event *livekit.Webh
 		zap.String("url", recordingURL),
 		zap.String("filename", filename))
 
-	// Recording URL from LiveKit is already publicly accessible
-	// No need to generate presigned URL since bucket has public read policy
-	h.logger.Info("✅ Using public recording URL for AssemblyAI",
-		zap.String("url", recordingURL))
-
 	if recordingURL == "" {
 		h.logger.Warn("❌ recording URL not found in egress data", zap.String("egress_id", egressID))
 		return HandleSuccess(h.logger, c, map[string]interface{}{"status": "ok"})
 	}
 
-	if roomName == "" {
-		h.logger.Warn("room name not found in egress event", zap.String("egress_id", egressID))
+	h.logger.Info("✅ egress finished, storing recording file",
+		zap.String("room_id", roomEntity.ID.String()),
+		zap.String("room_name", roomName),
+		zap.String("egress_id", egressID),
+		zap.String("recording_url", recordingURL))
+
+	// Store the file on the recording of the egress
+	if recording != nil {
+		recording.AttachFile(egressID, filename, recordingURL, fileSize)
+		if err := h.recordingRepo.Update(ctx, recording); err != nil {
+			h.logger.Error("❌ failed to save recording to database",
+				zap.String("room_id", roomEntity.ID.String()),
+				zap.String("egress_id", egressID),
+				zap.Error(err))
+			// Continue anyway - don't block transcription
+		} else {
+			h.logger.Info("✅ Recording saved to database",
+				zap.String("recording_id", recording.ID.String()),
+				zap.String("status", string(recording.Status)))
+		}
+	}
+
+	// The recording is transcribed once, when the file of its last segment arrived
+	if recording != nil && recording.IsCompleted() {
+		if err := h.roomService.QueueTranscription(ctx, recording); err != nil {
+			h.logger.Error("❌ failed to queue transcription",
+				zap.String("room_id", roomEntity.ID.String()),
+				zap.String("recording_id", recording.ID.String()),
+				zap.Error(err))
+		}
+	}
+
+	return HandleSuccess(h.logger, c, map[string]interface{}{"status": "ok", "event": "egress_ended"})
+}
+
+// handleEgressStartedV2 handles egress_started event (recording began)
+func (h *WebhookHandler) handleEgressStartedV2(c echo.Context, event *livekit.WebhookEvent) error {
+	c.Logger().Info("🔹 [WEBHOOK] Processing egress_started")
+
+	if event.EgressInfo == nil {
+		h.logger.Warn("egress info missing in event")
 		return HandleSuccess(h.logger, c, map[string]interface{}{"status": "ok"})
 	}
 
-	roomEntity, err := h.roomService.GetRoomByLivekitName(ctx, roomName)
+	c.Logger().Infof("🎬 Egress started: %s (room: %s)", event.EgressInfo.EgressId, event.EgressInfo.RoomName)
+
+	ctx := c.Request().Context()
+	roomEntity, err := h.roomService.GetRoomByLivekitName(ctx, event.EgressInfo.RoomName)
 	if err != nil {
-		h.logger.Error("failed to find room", zap.String("room_name", roomName), zap.Error(err))
+		h.logger.Error("failed to find room", zap.String("room_name", event.EgressInfo.RoomName), zap.Error(err))
 		return HandleSuccess(h.logger, c, map[string]interface{}{"status": "ok"})
 	}
 
-	h.logger.Info("✅ egress finished, triggering AI processing",
-		zap.String("room_id", roomEntity.ID.String()),
-		zap.String("room_name", roomName),
-		zap.String("egress_id", egressID),
-		zap.String("recording_url", recordingURL))
+	// Recordings started through the API are tracked (and announced) already; auto-recordings start here
+	tracked, err := h.recordingRepo.FindByEgressID(ctx, event.EgressInfo.EgressId)
+	if err != nil {
+		h.logger.Error("failed to find recording", zap.String("egress_id", event.EgressInfo.EgressId), zap.Error(err))
+		return HandleSuccess(h.logger, c, map[string]interface{}{"status": "ok"})
+	}
+	if tracked == nil {
+		if recording := h.egressRecording(ctx, roomEntity, event.EgressInfo.EgressId); recording != nil {
+			h.publishRecordingEvent(ctx, recording, entities.RoomEventRecordingStarted)
+		}
+	}
+
+	return HandleSuccess(h.logger, c, map[string]interface{}{"status": "ok", "event": "egress_started"})
+}
+
+// egressRecording retrieves the recording of an egress, tracking egresses LiveKit started by itself
+// (room auto-recording). Nil when the recording is unknown or cannot be stored.
+func (h *WebhookHandler) egressRecording(ctx context.Context, roomEntity *entities.Room, egressID string) *entities.Recording {
+	recording, err := h.recordingRepo.FindByEgressID(ctx, egressID)
+	if err != nil {
+		h.logger.Error("failed to find recording", zap.String("egress_id", egressID), zap.Error(err))
+		return nil
+	}
+	if recording != nil {
+		return recording
+	}
 
-	// Create recording record in database for tracking
-	recording := &entities.Recording{
-		RoomID:          roomEntity.ID,
-		LivekitEgressID: &egressID,
-		Status:          entities.RecordingStatusCompleted,
-		FilePath:        &filename,
-		FileURL:         &recordingURL,
-		StartedAt:       time.Now(), // Ideally should be from egress info
+	// A recording started through the API stores its egress once LiveKit accepted it
+	active, err := h.recordingRepo.FindActiveByRoomID(ctx, roomEntity.ID)
+	if err != nil {
+		h.logger.Error("failed to find recording", zap.String("room_id", roomEntity.ID.String()), zap.Error(err))
+		return nil
+	}
+	if active != nil && active.LivekitEgressID == nil {
+		return nil
 	}
 
-	// Save recording to database
+	now := time.Now()
+	recording = &entities.Recording{
+		RoomID:         roomEntity.ID,
+		OrganizationID: roomEntity.OrganizationID,
+		Status:         entities.RecordingStatusRecording,
+		StartedAt:      now,
+	}
+	recording.StartSegment(egressID, now)
 	if err := h.recordingRepo.Create(ctx, recording); err != nil {
-		h.logger.Error("❌ failed to save recording to database",
+		h.logger.Error("failed to create recording",
 			zap.String("room_id", roomEntity.ID.String()),
 			zap.String("egress_id", egressID),
 			zap.Error(err))
-		// Continue anyway - don't block AI processing
-	} else {
-		h.logger.Info("✅ Recording saved to database",
-			zap.String("recording_id", recording.ID.String()))
+		return nil
 	}
 
-	// Trim recording URL to remove any newlines or spaces
-	recordingURL = strings.TrimSpace(recordingURL)
-
-	// Create AI job for worker pool to process
-	// Job created once here, worker will submit to AssemblyAI
-	go func() {
-		bgCtx := context.Background()
+	h.logger.Info("recording tracked for egress",
+		zap.String("recording_id", recording.ID.String()),
+		zap.String("egress_id", egressID))
+	return recording
+}
 
-		// Create AI job for tracking (only once)
-		aiJob := entities.NewAIJob(roomEntity.ID, entities.AIJobTypeTranscription, recordingURL)
-		if err := h.aiJobRepo.CreateAIJob(bgCtx, aiJob); err != nil {
-			h.logger.Error("❌ failed to create AI job",
-				zap.String("room_id", roomEntity.ID.String()),
-				zap.Error(err))
-			return
-		}
+// publishRecordingEvent tells everyone in the room that the recording started or stopped
+func (h *WebhookHandler) publishRecordingEvent(ctx context.Context, recording *entities.Recording, eventType entities.RoomEventType) {
+	data := map[string]interface{}{
+		"recording_id": recording.ID,
+		"status":       recording.Status,
+	}
+	if recording.LivekitEgressID != nil {
+		data["egress_id"] = *recording.LivekitEgressID
+	}
+	h.roomService.PublishEvent(ctx, entities.NewRoomEvent(recording.RoomID, eventType, data))
+}
 
-		h.logger.Info("✅ AI job created, worker will process it",
-			zap.String("job_id", aiJob.ID.String()),
-			zap.String("room_id", roomEntity.ID.String()),
-			zap.String("recording_url", recordingURL))
-	}()
+// egressFileSize reads the size of an egress file, which LiveKit encodes as a number or a string
+func egressFileSize(value interface{}) int64 {
+	switch v := value.(type) {
+	case float64:
+		return int64(v)
+	case string:
+		size, _ := strconv.ParseInt(v, 10, 64)
+		return size
+	}
+	return 0
+}
 
-	return HandleSuccess(h.logger, c, map[string]interface{}{"status": "ok", "event": "egress_ended"})
+// recordingObjectKey returns the object key of an egress file in the recordings bucket from the URL
+// the egress reports; for URLs of another form, the egress filename is the key it uploaded to
+func (h *WebhookHandler) recordingObjectKey(location, filename string) string {
+	if key, ok := storage.ObjectKey(h.bucketName, location); ok {
+		return key
+	}
+	return strings.TrimSpace(filename)
 }
@@ -0,0 +1,147 @@
+package presenter
+
+import (
+	adminDTO "github.com/johnquangdev/meeting-assistant/internal/adapter/dto/admin"
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+	adminUsecase "github.com/johnquangdev/meeting-assistant/internal/usecase/admin"
+)
+
+// ToAdminUserResponse converts a User entity to the administrators' UserResponse DTO
+func ToAdminUserResponse(u *entities.User) *adminDTO.UserResponse {
+	if u == nil {
+		return nil
+	}
+
+	response := &adminDTO.UserResponse{
+		ID:              u.ID.String(),
+		Email:           u.Email,
+		Name:            u.Name,
+		Role:            string(u.Role),
+		IsActive:        u.IsActive,
+		IsEmailVerified: u.IsEmailVerified,
+		LastLoginAt:     u.LastLoginAt,
+		LastActiveAt:    u.LastActiveAt,
+		CreatedAt:       u.CreatedAt,
+		UpdatedAt:       u.UpdatedAt,
+	}
+	if u.AvatarURL != nil {
+		response.AvatarURL = *u.AvatarURL
+	}
+	if u.OAuthProvider != nil {
+		response.OAuthProvider = *u.OAuthProvider
+	}
+	if u.PlanID != nil {
+		planID := u.PlanID.String()
+		response.PlanID = &planID
+	}
+	return response
+}
+
+// ToAdminUserListResponse converts a page of users to UserListResponse
+func ToAdminUserListResponse(users []*entities.User, total int64, page, pageSize int) *adminDTO.UserListResponse {
+	responses := make([]*adminDTO.UserResponse, len(users))
+	for i, u := range users {
+		responses[i] = ToAdminUserResponse(u)
+	}
+
+	return &adminDTO.UserListResponse{
+		Users:      responses,
+		Total:      total,
+		Page:       page,
+		PageSize:   pageSize,
+		TotalPages: totalPages(total, pageSize),
+	}
+}
+
+// ToAIJobResponse converts an AIJob entity to AIJobResponse DTO
+func ToAIJobResponse(j *entities.AIJob) *adminDTO.AIJobResponse {
+	if j == nil {
+		return nil
+	}
+
+	response := &adminDTO.AIJobResponse{
+		ID:              j.ID.String(),
+		MeetingID:       j.MeetingID.String(),
+		JobType:         string(j.JobType),
+		Status:          string(j.Status),
+		ExternalJobID:   j.ExternalJobID,
+		RecordingURL:    j.RecordingURL,
+		RetryCount:      j.RetryCount,
+		MaxRetries:      j.MaxRetries,
+		LastError:       j.LastError,
+		DurationSeconds: j.Metadata.DurationSeconds,
+		Language:        j.Metadata.Language,
+		StartedAt:       j.StartedAt,
+		CompletedAt:     j.CompletedAt,
+		CreatedAt:       j.CreatedAt,
+		UpdatedAt:       j.UpdatedAt,
+	}
+	if j.TranscriptID != nil {
+		transcriptID := j.TranscriptID.String()
+		response.TranscriptID = &transcriptID
+	}
+	return response
+}
+
+// ToAIJobListResponse converts a page of AI jobs to AIJobListResponse
+func ToAIJobListResponse(jobs []entities.AIJob, total int64, page, pageSize int) *adminDTO.AIJobListResponse {
+	responses := make([]*adminDTO.AIJobResponse, len(jobs))
+	for i := range jobs {
+		responses[i] = ToAIJobResponse(&jobs[i])
+	}
+
+	return &adminDTO.AIJobListResponse{
+		Jobs:       responses,
+		Total:      total,
+		Page:       page,
+		PageSize:   pageSize,
+		TotalPages: totalPages(total, pageSize),
+	}
+}
+
+// ToOverviewResponse converts the platform overview to OverviewResponse DTO
+func ToOverviewResponse(o *adminUsecase.Overview) *adminDTO.OverviewResponse {
+	if o == nil {
+		return nil
+	}
+
+	byStatus := make(map[string]int64, len(o.AIJobs.ByStatus))
+	for status, count := range o.AIJobs.ByStatus {
+		byStatus[string(status)] = count
+	}
+
+	return &adminDTO.OverviewResponse{
+		Users: adminDTO.UserStatsResponse{
+			Total:  o.Users.Total,
+			Active: o.Users.Active,
+			Admins: o.Users.Admins,
+		},
+		Rooms: adminDTO.RoomStatsResponse{
+			Active:             o.Rooms.Active,
+			Scheduled:          o.Rooms.Scheduled,
+			ParticipantsOnline: o.Rooms.ParticipantsOnline,
+		},
+		AIJobs: adminDTO.AIJobStatsResponse{
+			Queued:   o.AIJobs.Queued,
+			Failed:   o.AIJobs.Failed,
+			ByStatus: byStatus,
+		},
+		Storage: adminDTO.StorageStatsResponse{
+			Recordings: o.Storage.Recordings,
+			Bytes:      o.Storage.Bytes,
+		},
+		GeneratedAt: o.GeneratedAt,
+	}
+}
+
+// totalPages counts the pages needed for total items
+func totalPages(total int64, pageSize int) int {
+	if pageSize <= 0 {
+		return 0
+	}
+	pages := int(total) / pageSize
+	if int(total)%pageSize != 0 {
+		pages++
+	}
+	return pages
+}
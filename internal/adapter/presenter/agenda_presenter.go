@@ -0,0 +1,53 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/johnquangdev/meeting-assistant/internal/adapter/dto/room"
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+)
+
+// ToAgendaResponse converts the agenda of a room to AgendaResponse DTO
+func ToAgendaResponse(r *entities.Room, items []*entities.AgendaItem) *room.AgendaResponse {
+	now := time.Now()
+	response := &room.AgendaResponse{
+		RoomID: r.ID.String(),
+		Items:  make([]*room.AgendaItemResponse, len(items)),
+	}
+	if r.CurrentAgendaItemID != nil {
+		currentID := r.CurrentAgendaItemID.String()
+		response.CurrentItemID = &currentID
+	}
+
+	for i, item := range items {
+		status := "upcoming"
+		switch {
+		case r.CurrentAgendaItemID != nil && *r.CurrentAgendaItemID == item.ID:
+			status = "current"
+		case item.EndedAt != nil:
+			status = "done"
+		}
+
+		itemResponse := &room.AgendaItemResponse{
+			ID:              item.ID.String(),
+			Number:          item.Number(),
+			Title:           item.Title,
+			Description:     item.Description,
+			OwnerName:       item.OwnerName(),
+			DurationMinutes: item.DurationMinutes,
+			Status:          status,
+			StartedAt:       item.StartedAt,
+			EndedAt:         item.EndedAt,
+			Overtime:        item.IsOvertime(now),
+		}
+		if item.OwnerID != nil {
+			ownerID := item.OwnerID.String()
+			itemResponse.OwnerID = &ownerID
+		}
+
+		response.Items[i] = itemResponse
+		response.TotalMinutes += item.DurationMinutes
+	}
+
+	return response
+}
@@ -0,0 +1,53 @@
+package presenter
+
+import (
+	"github.com/johnquangdev/meeting-assistant/internal/adapter/dto/room"
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+	"github.com/johnquangdev/meeting-assistant/internal/usecase/breakout"
+)
+
+// ToBreakoutsResponse converts a breakout overview to BreakoutsResponse DTO
+func ToBreakoutsResponse(o *breakout.Overview) *room.BreakoutsResponse {
+	if o == nil {
+		return nil
+	}
+
+	response := &room.BreakoutsResponse{
+		ParentRoomID: o.Parent.ID.String(),
+		TimerEndsAt:  o.Parent.BreakoutEndsAt,
+		Breakouts:    make([]*room.BreakoutRoomResponse, len(o.Breakouts)),
+		Unassigned:   toParticipantResponses(o.Unassigned),
+	}
+	for i, b := range o.Breakouts {
+		response.Breakouts[i] = &room.BreakoutRoomResponse{
+			Room:         ToRoomResponse(b.Room),
+			Participants: toParticipantResponses(b.Participants),
+		}
+	}
+
+	return response
+}
+
+// ToBreakoutDestinationResponse converts a destination to BreakoutDestinationResponse DTO
+func ToBreakoutDestinationResponse(d *breakout.Destination, livekitURL string) *room.BreakoutDestinationResponse {
+	if d == nil {
+		return nil
+	}
+
+	return &room.BreakoutDestinationResponse{
+		InBreakout:   d.InBreakout,
+		Room:         ToRoomResponse(d.Room),
+		Participant:  ToParticipantResponse(d.Participant),
+		LivekitToken: d.LivekitToken,
+		LivekitURL:   livekitURL,
+		TimerEndsAt:  d.EndsAt,
+	}
+}
+
+func toParticipantResponses(participants []*entities.Participant) []*room.ParticipantResponse {
+	responses := make([]*room.ParticipantResponse, len(participants))
+	for i, p := range participants {
+		responses[i] = ToParticipantResponse(p)
+	}
+	return responses
+}
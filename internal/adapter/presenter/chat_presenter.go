@@ -0,0 +1,58 @@
+package presenter
+
+import (
+	"github.com/johnquangdev/meeting-assistant/internal/adapter/dto/room"
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+)
+
+// ToChatMessageResponse converts a ChatMessage entity to ChatMessageResponse DTO
+func ToChatMessageResponse(m *entities.ChatMessage) *room.ChatMessageResponse {
+	if m == nil {
+		return nil
+	}
+
+	response := &room.ChatMessageResponse{
+		ID:            m.ID.String(),
+		RoomID:        m.RoomID.String(),
+		ParticipantID: m.ParticipantID.String(),
+		DisplayName:   m.SenderName(),
+		IsGuest:       m.Participant != nil && m.Participant.IsGuest(),
+		IsEdited:      m.EditedAt != nil,
+		EditedAt:      m.EditedAt,
+		IsDeleted:     m.IsDeleted(),
+		DeletedAt:     m.DeletedAt,
+		Moderated:     m.IsModerated(),
+		CreatedAt:     m.CreatedAt,
+	}
+
+	// Content of deleted messages is kept for moderation only
+	if !m.IsDeleted() {
+		response.Content = m.Content
+	}
+
+	if m.ReplyToID != nil {
+		replyToID := m.ReplyToID.String()
+		response.ReplyToID = &replyToID
+	}
+
+	return response
+}
+
+// ToChatMessageListResponse converts a page of chat messages (oldest first) to ChatMessageListResponse
+func ToChatMessageListResponse(messages []*entities.ChatMessage, hasMore bool) *room.ChatMessageListResponse {
+	responses := make([]*room.ChatMessageResponse, len(messages))
+	for i, m := range messages {
+		responses[i] = ToChatMessageResponse(m)
+	}
+
+	response := &room.ChatMessageListResponse{
+		Messages: responses,
+		HasMore:  hasMore,
+	}
+	if hasMore && len(messages) > 0 {
+		oldest := messages[0].CreatedAt
+		response.NextBefore = &oldest
+	}
+
+	return response
+}
@@ -0,0 +1,126 @@
+package presenter
+
+import (
+	orgDTO "github.com/johnquangdev/meeting-assistant/internal/adapter/dto/organization"
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+)
+
+// ToOrganizationResponse converts an Organization entity to OrganizationResponse DTO.
+// member is the membership of the current user (nil to leave my_role out).
+func ToOrganizationResponse(o *entities.Organization, member *entities.OrganizationMember) *orgDTO.OrganizationResponse {
+	if o == nil {
+		return nil
+	}
+
+	settings := o.GetSettings()
+	response := &orgDTO.OrganizationResponse{
+		ID:   o.ID.String(),
+		Name: o.Name,
+		Slug: o.Slug,
+		Settings: orgDTO.OrganizationSettings{
+			RoomDefaults: settings.RoomDefaults,
+			AI: orgDTO.OrganizationAISettings{
+				TranscriptionLanguage: settings.AI.TranscriptionLanguage,
+				SummaryLanguage:       settings.AI.SummaryLanguage,
+			},
+		},
+		CreatedBy: o.CreatedBy.String(),
+		CreatedAt: o.CreatedAt,
+		UpdatedAt: o.UpdatedAt,
+	}
+	if member != nil {
+		response.MyRole = string(member.Role)
+	}
+	return response
+}
+
+// ToOrganizationListResponse converts the memberships of a user to OrganizationListResponse
+func ToOrganizationListResponse(members []*entities.OrganizationMember) *orgDTO.OrganizationListResponse {
+	responses := make([]*orgDTO.OrganizationResponse, 0, len(members))
+	for _, m := range members {
+		if m.Organization != nil {
+			responses = append(responses, ToOrganizationResponse(m.Organization, m))
+		}
+	}
+
+	return &orgDTO.OrganizationListResponse{
+		Organizations: responses,
+		Total:         len(responses),
+	}
+}
+
+// ToMemberResponse converts an OrganizationMember entity to MemberResponse DTO
+func ToMemberResponse(m *entities.OrganizationMember) *orgDTO.MemberResponse {
+	if m == nil {
+		return nil
+	}
+
+	response := &orgDTO.MemberResponse{
+		ID:       m.ID.String(),
+		UserID:   m.UserID.String(),
+		Role:     string(m.Role),
+		JoinedAt: m.JoinedAt,
+	}
+	if m.User != nil {
+		response.User = ToUserResponse(m.User)
+	}
+	return response
+}
+
+// ToMemberListResponse converts the members of an organization to MemberListResponse
+func ToMemberListResponse(members []*entities.OrganizationMember) *orgDTO.MemberListResponse {
+	responses := make([]*orgDTO.MemberResponse, len(members))
+	for i, m := range members {
+		responses[i] = ToMemberResponse(m)
+	}
+
+	return &orgDTO.MemberListResponse{
+		Members: responses,
+		Total:   len(responses),
+	}
+}
+
+// ToInviteResponse converts an OrganizationInvite entity to InviteResponse DTO.
+// inviteURL is only passed for owners and admins.
+func ToInviteResponse(i *entities.OrganizationInvite, inviteURL string) *orgDTO.InviteResponse {
+	if i == nil {
+		return nil
+	}
+
+	response := &orgDTO.InviteResponse{
+		ID:             i.ID.String(),
+		OrganizationID: i.OrganizationID.String(),
+		Email:          i.Email,
+		Role:           string(i.Role),
+		Status:         string(i.EffectiveStatus()),
+		InvitedBy:      i.InvitedBy.String(),
+		InviteURL:      inviteURL,
+		ExpiresAt:      i.ExpiresAt,
+		RespondedAt:    i.RespondedAt,
+		CreatedAt:      i.CreatedAt,
+	}
+	if i.Organization != nil {
+		response.OrganizationName = i.Organization.Name
+	}
+	if i.Inviter != nil {
+		response.InviterName = i.Inviter.Name
+	}
+	return response
+}
+
+// ToInviteListResponse converts the invites of an organization to InviteListResponse
+func ToInviteListResponse(invites []*entities.OrganizationInvite, inviteURL func(*entities.OrganizationInvite) string) *orgDTO.InviteListResponse {
+	responses := make([]*orgDTO.InviteResponse, len(invites))
+	for i, invite := range invites {
+		url := ""
+		if invite.EffectiveStatus() == entities.InvitationStatusPending {
+			url = inviteURL(invite)
+		}
+		responses[i] = ToInviteResponse(invite, url)
+	}
+
+	return &orgDTO.InviteListResponse{
+		Invites: responses,
+		Total:   len(responses),
+	}
+}
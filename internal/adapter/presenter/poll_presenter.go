@@ -0,0 +1,71 @@
+package presenter
+
+import (
+	"github.com/johnquangdev/meeting-assistant/internal/adapter/dto/room"
+	roomUsecase "github.com/johnquangdev/meeting-assistant/internal/usecase/room"
+)
+
+// ToPollResponse converts a poll and its results to PollResponse DTO
+func ToPollResponse(v *roomUsecase.PollView) *room.PollResponse {
+	if v == nil || v.Poll == nil {
+		return nil
+	}
+	p := v.Poll
+
+	response := &room.PollResponse{
+		ID:                p.ID.String(),
+		RoomID:            p.RoomID.String(),
+		Question:          p.Question,
+		Type:              string(p.Type),
+		Anonymous:         p.Anonymous,
+		Status:            string(p.Status),
+		ClosesAt:          p.ClosesAt,
+		CloseWhenAllVoted: p.CloseWhenAllVoted,
+		ClosedAt:          p.ClosedAt,
+		CloseReason:       string(p.CloseReason),
+		HasVoted:          len(v.MyVotes) > 0,
+		CreatedAt:         p.CreatedAt,
+	}
+	if p.Creator != nil {
+		response.CreatedBy = p.Creator.DisplayName()
+	}
+
+	if v.Results != nil {
+		response.TotalVoters = v.Results.TotalVoters
+		for _, o := range v.Results.Options {
+			response.Options = append(response.Options, &room.PollOptionResponse{
+				ID:         o.OptionID.String(),
+				Text:       o.Text,
+				Votes:      o.Votes,
+				Percentage: o.Percentage,
+				Voters:     o.Voters,
+			})
+		}
+		for _, a := range v.Results.Answers {
+			response.Answers = append(response.Answers, &room.PollAnswerResponse{Text: a.Text, Voter: a.Voter})
+		}
+	}
+
+	for _, vote := range v.MyVotes {
+		if vote.OptionID != nil {
+			response.MyOptionIDs = append(response.MyOptionIDs, vote.OptionID.String())
+		} else if vote.Text != nil {
+			response.MyAnswer = *vote.Text
+		}
+	}
+
+	return response
+}
+
+// ToPollListResponse converts the polls of a meeting to PollListResponse DTO
+func ToPollListResponse(views []*roomUsecase.PollView) *room.PollListResponse {
+	polls := make([]*room.PollResponse, len(views))
+	for i, v := range views {
+		polls[i] = ToPollResponse(v)
+	}
+
+	return &room.PollListResponse{
+		Polls: polls,
+		Total: len(polls),
+	}
+}
@@ -0,0 +1,51 @@
+package presenter
+
+import (
+	quotaDTO "github.com/johnquangdev/meeting-assistant/internal/adapter/dto/quota"
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+	quotaUsecase "github.com/johnquangdev/meeting-assistant/internal/usecase/quota"
+)
+
+// ToPlanResponse converts a Plan entity to PlanResponse DTO
+func ToPlanResponse(p *entities.Plan) *quotaDTO.PlanResponse {
+	if p == nil {
+		return nil
+	}
+
+	return &quotaDTO.PlanResponse{
+		ID:                    p.ID.String(),
+		Code:                  p.Code,
+		Name:                  p.Name,
+		MaxParticipants:       p.MaxParticipants,
+		MaxConcurrentRooms:    p.MaxConcurrentRooms,
+		MonthlyMeetingMinutes: p.MonthlyMeetingMinutes,
+		RecordingStorageBytes: p.RecordingStorageBytes,
+		MonthlyAIMinutes:      p.MonthlyAIMinutes,
+		IsDefault:             p.IsDefault,
+	}
+}
+
+// ToPlanResponses converts plans to PlanResponse DTOs
+func ToPlanResponses(plans []*entities.Plan) []*quotaDTO.PlanResponse {
+	responses := make([]*quotaDTO.PlanResponse, len(plans))
+	for i, p := range plans {
+		responses[i] = ToPlanResponse(p)
+	}
+	return responses
+}
+
+// ToUsageResponse converts a usage report to UsageResponse DTO
+func ToUsageResponse(report *quotaUsecase.Report) *quotaDTO.UsageResponse {
+	if report == nil || report.Usage == nil {
+		return nil
+	}
+
+	return &quotaDTO.UsageResponse{
+		Plan:                  ToPlanResponse(report.Plan),
+		PeriodStart:           report.Usage.PeriodStart,
+		ActiveRooms:           report.Usage.ActiveRooms,
+		MeetingMinutes:        report.Usage.MeetingMinutes(),
+		RecordingStorageBytes: report.Usage.RecordingBytes,
+		AIMinutes:             report.Usage.AIMinutes(),
+	}
+}
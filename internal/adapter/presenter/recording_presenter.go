@@ -0,0 +1,126 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/johnquangdev/meeting-assistant/internal/adapter/dto/room"
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+	recordingUsecase "github.com/johnquangdev/meeting-assistant/internal/usecase/recording"
+	roomUsecase "github.com/johnquangdev/meeting-assistant/internal/usecase/room"
+)
+
+// ToRecordingResponse converts a Recording entity to RecordingResponse DTO
+func ToRecordingResponse(r *entities.Recording) *room.RecordingResponse {
+	if r == nil {
+		return nil
+	}
+
+	response := &room.RecordingResponse{
+		ID:          r.ID.String(),
+		RoomID:      r.RoomID.String(),
+		Status:      string(r.Status),
+		StartedAt:   r.StartedAt,
+		CompletedAt: r.CompletedAt,
+		Duration:    r.Duration,
+		FileSize:    r.FileSize,
+		Segments:    []*room.RecordingSegmentResponse{},
+	}
+	if r.StartedBy != nil {
+		startedBy := r.StartedBy.String()
+		response.StartedBy = &startedBy
+	}
+	for _, segment := range r.Segments() {
+		response.Segments = append(response.Segments, &room.RecordingSegmentResponse{
+			StartedAt: segment.StartedAt,
+			EndedAt:   segment.EndedAt,
+		})
+	}
+
+	return response
+}
+
+// ToRecordingListResponse converts recordings to RecordingListResponse DTO
+func ToRecordingListResponse(recordings []*entities.Recording) *room.RecordingListResponse {
+	response := &room.RecordingListResponse{
+		Recordings: make([]*room.RecordingResponse, len(recordings)),
+		Total:      len(recordings),
+	}
+	for i, r := range recordings {
+		response.Recordings[i] = ToRecordingResponse(r)
+	}
+	return response
+}
+
+// ToRecordingPlaybackResponse converts a recording playback to RecordingPlaybackResponse DTO
+func ToRecordingPlaybackResponse(playback *recordingUsecase.Playback) *room.RecordingPlaybackResponse {
+	response := &room.RecordingPlaybackResponse{
+		Recording: ToRecordingResponse(playback.Recording),
+		URLs:      playback.URLs,
+	}
+	if len(playback.URLs) > 0 {
+		expiresAt := playback.ExpiresAt
+		response.ExpiresAt = &expiresAt
+	}
+	return response
+}
+
+// ToRecordingConsentResponse converts a participant's recording consent status to RecordingConsentResponse DTO
+func ToRecordingConsentResponse(status *roomUsecase.RecordingConsentStatus) *room.RecordingConsentResponse {
+	if status == nil {
+		return nil
+	}
+
+	response := &room.RecordingConsentResponse{
+		Required:      status.Required,
+		PolicyVersion: status.PolicyVersion,
+		Given:         status.Consent != nil,
+	}
+	if status.Consent != nil {
+		consentedAt := status.Consent.ConsentedAt
+		response.ConsentedAt = &consentedAt
+	}
+	return response
+}
+
+// ToRecordingConsentReportResponse converts the recording consents given in a room to RecordingConsentReportResponse DTO
+func ToRecordingConsentReportResponse(r *entities.Room, consents []*entities.RecordingConsent, policyVersion string, generatedAt time.Time) *room.RecordingConsentReportResponse {
+	records := make([]*room.RecordingConsentRecordResponse, len(consents))
+	for i, c := range consents {
+		record := &room.RecordingConsentRecordResponse{
+			ID:            c.ID.String(),
+			ParticipantID: c.ParticipantID.String(),
+			DisplayName:   c.DisplayName,
+			IsGuest:       c.UserID == nil,
+			PolicyVersion: c.PolicyVersion,
+			ConsentedAt:   c.ConsentedAt,
+		}
+		if c.UserID != nil {
+			record.UserID = c.UserID.String()
+		}
+		if p := c.Participant; p != nil {
+			record.IsGuest = p.IsGuest()
+			switch {
+			case p.User != nil:
+				record.Email = p.User.Email
+			case p.InvitedEmail != nil:
+				record.Email = *p.InvitedEmail
+			}
+		}
+		if c.IPAddress != nil {
+			record.IPAddress = *c.IPAddress
+		}
+		if c.UserAgent != nil {
+			record.UserAgent = *c.UserAgent
+		}
+		records[i] = record
+	}
+
+	return &room.RecordingConsentReportResponse{
+		RoomID:        r.ID.String(),
+		RoomName:      r.Name,
+		PolicyVersion: policyVersion,
+		Consents:      records,
+		Total:         len(records),
+		GeneratedAt:   generatedAt,
+	}
+}
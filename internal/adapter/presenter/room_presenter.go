@@ -2,6 +2,7 @@ package presenter
 
 import (
 	"encoding/json"
+	"time"
 
 	"github.com/johnquangdev/meeting-assistant/internal/adapter/dto/room"
 	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
@@ -13,11 +14,8 @@ func ToRoomResponse(r *entities.Room) *room.RoomResponse {
 		return nil
 	}
 
-	// Parse settings from JSON
-	var settings map[string]interface{}
-	if r.Settings != nil {
-		json.Unmarshal(r.Settings, &settings)
-	}
+	// Typed settings, with defaults for flags the room never stored
+	settings := r.GetSettings().ToMap()
 
 	response := &room.RoomResponse{
 		ID:                  r.ID.String(),
@@ -36,15 +34,40 @@ func ToRoomResponse(r *entities.Room) *room.RoomResponse {
 		StartedAt:           r.StartedAt,
 		EndedAt:             r.EndedAt,
 		Duration:            r.Duration,
+		IsLocked:            r.IsLocked(),
+		LockedAt:            r.LockedAt,
+		HasPasscode:         r.HasPasscode(),
 		CreatedAt:           r.CreatedAt,
 		UpdatedAt:           r.UpdatedAt,
 	}
+	if r.LockMode != nil {
+		lockMode := string(*r.LockMode)
+		response.LockMode = &lockMode
+	}
 
 	// Include host if loaded
 	if r.Host != nil {
 		response.Host = ToUserResponse(r.Host)
 	}
 
+	// Link occurrence rooms back to their series
+	if r.SeriesID != nil {
+		seriesID := r.SeriesID.String()
+		response.SeriesID = &seriesID
+		response.OccurrenceStartTime = r.OccurrenceStartTime
+	}
+
+	// Link breakout rooms back to their meeting
+	if r.ParentRoomID != nil {
+		parentRoomID := r.ParentRoomID.String()
+		response.ParentRoomID = &parentRoomID
+	}
+
+	if r.OrganizationID != nil {
+		organizationID := r.OrganizationID.String()
+		response.OrganizationID = &organizationID
+	}
+
 	return response
 }
 
@@ -69,6 +92,33 @@ func ToRoomListResponse(rooms []*entities.Room, total int64, page, pageSize int)
 	}
 }
 
+// ToAuditLogListResponse converts a page of a room's audit trail to AuditLogListResponse
+func ToAuditLogListResponse(logs []*entities.RoomAuditLog, total int64, page, pageSize int) *room.AuditLogListResponse {
+	entries := make([]*room.AuditLogResponse, len(logs))
+	for i, l := range logs {
+		entry := &room.AuditLogResponse{
+			ID:        l.ID.String(),
+			Action:    string(l.Action),
+			ActorIP:   l.ActorIP,
+			CreatedAt: l.CreatedAt,
+		}
+		if l.ActorID != nil {
+			entry.ActorID = l.ActorID.String()
+		}
+		if len(l.Details) > 0 {
+			json.Unmarshal(l.Details, &entry.Details)
+		}
+		entries[i] = entry
+	}
+
+	return &room.AuditLogListResponse{
+		Entries:  entries,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	}
+}
+
 // ToParticipantResponse converts a Participant entity to ParticipantResponse DTO
 func ToParticipantResponse(p *entities.Participant) *room.ParticipantResponse {
 	if p == nil {
@@ -78,18 +128,24 @@ func ToParticipantResponse(p *entities.Participant) *room.ParticipantResponse {
 	response := &room.ParticipantResponse{
 		ID:                p.ID.String(),
 		RoomID:            p.RoomID.String(),
+		DisplayName:       p.DisplayName(),
+		IsGuest:           p.IsGuest(),
 		Role:              string(p.Role),
 		Status:            string(p.Status),
 		InvitedEmail:      p.InvitedEmail,
 		InvitedAt:         p.InvitedAt,
+		WaitlistedAt:      p.WaitlistedAt,
 		JoinedAt:          p.JoinedAt,
 		LeftAt:            p.LeftAt,
 		Duration:          p.Duration,
 		CanShareScreen:    p.CanShareScreen,
+		CanPublish:        p.CanPublish,
 		CanRecord:         p.CanRecord,
 		CanMuteOthers:     p.CanMuteOthers,
 		IsMuted:           p.IsMuted,
 		IsHandRaised:      p.IsHandRaised,
+		HandRaisedAt:      p.HandRaisedAt,
+		HandQueuePosition: p.HandQueuePosition,
 		ConnectionQuality: p.ConnectionQuality,
 		CreatedAt:         p.CreatedAt,
 	}
@@ -106,6 +162,12 @@ func ToParticipantResponse(p *entities.Participant) *room.ParticipantResponse {
 		response.InvitedBy = &invitedByStr
 	}
 
+	// Breakout assignment of participants of the main room
+	if p.BreakoutRoomID != nil {
+		breakoutRoomID := p.BreakoutRoomID.String()
+		response.BreakoutRoomID = &breakoutRoomID
+	}
+
 	// Include user if loaded
 	if p.User != nil {
 		response.User = ToUserResponse(p.User)
@@ -127,38 +189,104 @@ func ToParticipantListResponse(participants []*entities.Participant) *room.Parti
 	}
 }
 
-// ToMyInvitationsResponse converts participant invitations to MyInvitationsResponse
-func ToMyInvitationsResponse(participants []*entities.Participant) *room.MyInvitationsResponse {
-	invitations := make([]room.InvitationItem, 0, len(participants))
+// ToInvitationResponse converts a RoomInvitation entity to InvitationResponse DTO.
+// inviteURL is only set for callers allowed to see the invitation link.
+func ToInvitationResponse(inv *entities.RoomInvitation, inviteURL string) *room.InvitationResponse {
+	if inv == nil {
+		return nil
+	}
 
-	for _, p := range participants {
-		if p.Room == nil {
-			continue
-		}
+	response := &room.InvitationResponse{
+		ID:           inv.ID.String(),
+		RoomID:       inv.RoomID.String(),
+		InviterID:    inv.InviterID.String(),
+		InviteeEmail: inv.InviteeEmail,
+		Status:       string(inv.EffectiveStatus()),
+		Message:      inv.Message,
+		InviteURL:    inviteURL,
+		ExpiresAt:    inv.ExpiresAt,
+		RespondedAt:  inv.RespondedAt,
+		CreatedAt:    inv.CreatedAt,
+	}
 
-		item := room.InvitationItem{
-			ParticipantID: p.ID.String(),
-			RoomID:        p.RoomID.String(),
-			RoomName:      p.Room.Name,
-			RoomType:      string(p.Room.Type),
-			InvitedAt:     *p.InvitedAt,
-		}
+	if inv.Room != nil {
+		response.RoomName = inv.Room.Name
+		response.RoomType = string(inv.Room.Type)
+	}
 
-		// Add inviter info if available
-		if p.InvitedBy != nil {
-			item.InviterID = p.InvitedBy.String()
-		}
+	if inv.Inviter != nil {
+		response.InviterName = inv.Inviter.Name
+	}
 
-		// Get inviter name from User relation if loaded
-		if p.User != nil {
-			item.InviterName = p.User.Name
-		}
+	return response
+}
 
-		invitations = append(invitations, item)
+// ToInvitationListResponse converts invitations to InvitationListResponse.
+// urlFor builds the invitation link; pass nil to omit links.
+func ToInvitationListResponse(invitations []*entities.RoomInvitation, urlFor func(*entities.RoomInvitation) string) *room.InvitationListResponse {
+	responses := make([]*room.InvitationResponse, len(invitations))
+	for i, inv := range invitations {
+		inviteURL := ""
+		if urlFor != nil {
+			inviteURL = urlFor(inv)
+		}
+		responses[i] = ToInvitationResponse(inv, inviteURL)
 	}
 
-	return &room.MyInvitationsResponse{
-		Invitations: invitations,
+	return &room.InvitationListResponse{
+		Invitations: responses,
 		Total:       len(invitations),
 	}
 }
+
+// ToAttendanceReportResponse converts the attendance of a room's participants to AttendanceReportResponse
+func ToAttendanceReportResponse(r *entities.Room, attendances []*entities.Attendance, generatedAt time.Time) *room.AttendanceReportResponse {
+	attendees := make([]*room.AttendeeResponse, len(attendances))
+	for i, a := range attendances {
+		p := a.Participant
+		attendee := &room.AttendeeResponse{
+			ParticipantID:     p.ID.String(),
+			DisplayName:       p.DisplayName(),
+			Role:              string(p.Role),
+			IsGuest:           p.IsGuest(),
+			Attended:          len(a.Sessions) > 0,
+			SessionCount:      len(a.Sessions),
+			FirstJoinedAt:     a.FirstJoinedAt,
+			LastLeftAt:        a.LastLeftAt,
+			TotalSeconds:      a.TotalSeconds,
+			LateSeconds:       a.LateSeconds,
+			EarlyLeaveSeconds: a.EarlySeconds,
+			Sessions:          make([]*room.AttendanceSessionResponse, len(a.Sessions)),
+		}
+		if p.UserID != nil {
+			attendee.UserID = p.UserID.String()
+		}
+		switch {
+		case p.User != nil:
+			attendee.Email = p.User.Email
+		case p.InvitedEmail != nil:
+			attendee.Email = *p.InvitedEmail
+		}
+		for j, s := range a.Sessions {
+			attendee.Sessions[j] = &room.AttendanceSessionResponse{
+				JoinedAt: s.JoinedAt,
+				LeftAt:   s.LeftAt,
+				Duration: s.Duration,
+			}
+		}
+		attendees[i] = attendee
+	}
+
+	return &room.AttendanceReportResponse{
+		RoomID:             r.ID.String(),
+		RoomName:           r.Name,
+		Status:             string(r.Status),
+		ScheduledStartTime: r.ScheduledStartTime,
+		ScheduledEndTime:   r.ScheduledEndTime,
+		StartedAt:          r.StartedAt,
+		EndedAt:            r.EndedAt,
+		Attendees:          attendees,
+		Total:              len(attendees),
+		GeneratedAt:        generatedAt,
+	}
+}
@@ -0,0 +1,128 @@
+package presenter
+
+import (
+	"encoding/json"
+
+	summaryDTO "github.com/johnquangdev/meeting-assistant/internal/adapter/dto"
+	seriesDTO "github.com/johnquangdev/meeting-assistant/internal/adapter/dto/series"
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+	"github.com/johnquangdev/meeting-assistant/internal/usecase/series"
+)
+
+// ToSeriesResponse converts a MeetingSeries entity to SeriesResponse DTO
+func ToSeriesResponse(s *entities.MeetingSeries) *seriesDTO.SeriesResponse {
+	if s == nil {
+		return nil
+	}
+
+	var settings map[string]interface{}
+	if s.Settings != nil {
+		json.Unmarshal(s.Settings, &settings)
+	}
+
+	return &seriesDTO.SeriesResponse{
+		ID:                s.ID.String(),
+		HostID:            s.HostID.String(),
+		Name:              s.Name,
+		Description:       s.Description,
+		Type:              string(s.RoomType),
+		MaxParticipants:   s.MaxParticipants,
+		Settings:          settings,
+		RRule:             s.RRule,
+		Timezone:          s.Timezone,
+		StartTime:         s.StartTime,
+		DurationMinutes:   s.DurationMinutes,
+		Status:            string(s.Status),
+		MaterializedUntil: s.MaterializedUntil,
+		CreatedAt:         s.CreatedAt,
+		UpdatedAt:         s.UpdatedAt,
+	}
+}
+
+// ToSeriesListResponse converts a slice of MeetingSeries entities to SeriesListResponse
+func ToSeriesListResponse(list []*entities.MeetingSeries) *seriesDTO.SeriesListResponse {
+	responses := make([]*seriesDTO.SeriesResponse, len(list))
+	for i, s := range list {
+		responses[i] = ToSeriesResponse(s)
+	}
+
+	return &seriesDTO.SeriesListResponse{
+		Series: responses,
+		Total:  len(responses),
+	}
+}
+
+// ToOccurrenceResponse converts an occurrence to OccurrenceResponse DTO
+func ToOccurrenceResponse(o *series.Occurrence) *seriesDTO.OccurrenceResponse {
+	if o == nil {
+		return nil
+	}
+
+	response := &seriesDTO.OccurrenceResponse{
+		OriginalStartTime: o.OriginalStartTime,
+		StartTime:         o.StartTime,
+		EndTime:           o.EndTime,
+		Status:            string(o.Status),
+		Room:              ToRoomResponse(o.Room),
+	}
+
+	if o.Exception != nil {
+		response.Rescheduled = o.Exception.Type == entities.SeriesExceptionRescheduled
+		response.Reason = o.Exception.Reason
+	}
+
+	return response
+}
+
+// ToOccurrenceSummaryResponse converts an occurrence summary to OccurrenceSummaryResponse DTO
+func ToOccurrenceSummaryResponse(o *series.OccurrenceSummary) *seriesDTO.OccurrenceSummaryResponse {
+	response := &seriesDTO.OccurrenceSummaryResponse{
+		Room: ToRoomResponse(o.Room),
+	}
+
+	// Summary is not generated yet
+	if o.Summary == nil {
+		return response
+	}
+
+	summaryID := o.Summary.ID.String()
+	response.SummaryID = &summaryID
+	response.ExecutiveSummary = &o.Summary.ExecutiveSummary
+	response.CreatedAt = &o.Summary.CreatedAt
+
+	if o.Summary.KeyPoints != nil {
+		json.Unmarshal(o.Summary.KeyPoints, &response.KeyPoints)
+	}
+	if o.Summary.Decisions != nil {
+		json.Unmarshal(o.Summary.Decisions, &response.Decisions)
+	}
+	if o.Summary.Topics != nil {
+		json.Unmarshal(o.Summary.Topics, &response.Topics)
+	}
+
+	return response
+}
+
+// ToActionItemDTO converts an ActionItem entity to ActionItemDTO
+func ToActionItemDTO(item *entities.ActionItem) summaryDTO.ActionItemDTO {
+	var assignedTo *string
+	if item.AssignedTo != nil {
+		assignedToStr := item.AssignedTo.String()
+		assignedTo = &assignedToStr
+	}
+
+	return summaryDTO.ActionItemDTO{
+		ID:                  item.ID,
+		Title:               item.Title,
+		Description:         item.Description,
+		AssignedTo:          assignedTo,
+		Type:                item.Type,
+		Priority:            item.Priority,
+		Status:              item.Status,
+		DueDate:             item.DueDate,
+		TranscriptReference: item.TranscriptReference,
+		TimestampInMeeting:  item.TimestampInMeeting,
+		AgendaItemID:        item.AgendaItemID,
+		CreatedAt:           item.CreatedAt,
+	}
+}
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+	"github.com/johnquangdev/meeting-assistant/internal/domain/repositories"
+)
+
+// agendaRepository implements the AgendaRepository interface
+type agendaRepository struct {
+	db *gorm.DB
+}
+
+// NewAgendaRepository creates a new agenda repository
+func NewAgendaRepository(db *gorm.DB) repositories.AgendaRepository {
+	return &agendaRepository{db: db}
+}
+
+// FindByRoomID retrieves the agenda of a room in order, with the item owners
+func (r *agendaRepository) FindByRoomID(ctx context.Context, roomID uuid.UUID) ([]*entities.AgendaItem, error) {
+	var items []*entities.AgendaItem
+	err := r.db.WithContext(ctx).
+		Preload("Owner").
+		Where("room_id = ?", roomID).
+		Order("position ASC").
+		Find(&items).Error
+	return items, err
+}
+
+// Replace stores the agenda of a room, deleting the items left out
+func (r *agendaRepository) Replace(ctx context.Context, roomID uuid.UUID, items []*entities.AgendaItem) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		keep := make([]uuid.UUID, 0, len(items))
+		for _, item := range items {
+			if item.ID != uuid.Nil {
+				keep = append(keep, item.ID)
+			}
+		}
+
+		// rooms.current_agenda_item_id is cleared by its foreign key when the current item goes
+		stale := tx.Where("room_id = ?", roomID)
+		if len(keep) > 0 {
+			stale = stale.Where("id NOT IN ?", keep)
+		}
+		if err := stale.Delete(&entities.AgendaItem{}).Error; err != nil {
+			return err
+		}
+
+		for _, item := range items {
+			item.RoomID = roomID
+			if item.ID == uuid.Nil {
+				if err := tx.Omit("Owner").Create(item).Error; err != nil {
+					return err
+				}
+				continue
+			}
+			if err := tx.Omit("Owner").Save(item).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Update stores the timing of an agenda item
+func (r *agendaRepository) Update(ctx context.Context, item *entities.AgendaItem) error {
+	return r.db.WithContext(ctx).
+		Model(&entities.AgendaItem{}).
+		Where("id = ?", item.ID).
+		Updates(map[string]interface{}{
+			"started_at": item.StartedAt,
+			"ended_at":   item.EndedAt,
+		}).Error
+}
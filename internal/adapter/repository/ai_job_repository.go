@@ -7,6 +7,7 @@ import (
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
 )
@@ -29,6 +30,18 @@ func (r *AIJobRepository) CreateAIJob(ctx context.Context, job *entities.AIJob)
 	return r.db.WithContext(ctx).Create(job).Error
 }
 
+// CreateRecordingJob creates the transcription job of a recording unless the recording has one already.
+// It reports whether the job was created.
+func (r *AIJobRepository) CreateRecordingJob(ctx context.Context, job *entities.AIJob) (bool, error) {
+	if job == nil || job.RecordingID == nil {
+		return false, errors.New("job must have a recording")
+	}
+	result := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(job)
+	return result.RowsAffected > 0, result.Error
+}
+
 // GetAIJobByID retrieves an AI job by ID
 func (r *AIJobRepository) GetAIJobByID(ctx context.Context, jobID uuid.UUID) (*entities.AIJob, error) {
 	var job entities.AIJob
@@ -97,6 +110,56 @@ func (r *AIJobRepository) ListAIJobsByStatus(ctx context.Context, status entitie
 	return jobs, nil
 }
 
+// ListAIJobs retrieves AI jobs, newest first, optionally filtered by status and meeting
+func (r *AIJobRepository) ListAIJobs(ctx context.Context, status entities.AIJobStatus, meetingID *uuid.UUID, limit, offset int) ([]entities.AIJob, int64, error) {
+	var jobs []entities.AIJob
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&entities.AIJob{})
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	if meetingID != nil {
+		query = query.Where("meeting_id = ?", *meetingID)
+	}
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if limit == 0 {
+		limit = 20
+	}
+	if err := query.
+		Order("created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&jobs).Error; err != nil {
+		return nil, 0, err
+	}
+	return jobs, total, nil
+}
+
+// CountAIJobsByStatus counts AI jobs per status
+func (r *AIJobRepository) CountAIJobsByStatus(ctx context.Context) (map[entities.AIJobStatus]int64, error) {
+	var rows []struct {
+		Status entities.AIJobStatus
+		Count  int64
+	}
+	if err := r.db.WithContext(ctx).
+		Model(&entities.AIJob{}).
+		Select("status, COUNT(*) AS count").
+		Group("status").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	counts := make(map[entities.AIJobStatus]int64, len(rows))
+	for _, row := range rows {
+		counts[row.Status] = row.Count
+	}
+	return counts, nil
+}
+
 // UpdateAIJobStatus updates the status of an AI job
 func (r *AIJobRepository) UpdateAIJobStatus(ctx context.Context, jobID uuid.UUID, status entities.AIJobStatus) error {
 	return r.db.WithContext(ctx).
@@ -111,8 +111,8 @@ func (r *aiRepository) SaveMeetingSummary(s *entities.MeetingSummary) error {
 		key_points, decisions, topics, open_questions, next_steps, 
 		overall_sentiment, sentiment_breakdown, 
 		total_speaking_time, participant_balance_score, engagement_score,
-		model_used, processing_time, metadata, created_at, updated_at
-	) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		model_used, processing_time, metadata, agenda_coverage, organization_id, created_at, updated_at
+	) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, (SELECT organization_id FROM rooms WHERE id = ?), ?, ?)
 	ON CONFLICT (room_id) DO UPDATE SET 
 		executive_summary = EXCLUDED.executive_summary,
 		key_points = EXCLUDED.key_points,
@@ -126,12 +126,13 @@ func (r *aiRepository) SaveMeetingSummary(s *entities.MeetingSummary) error {
 		participant_balance_score = EXCLUDED.participant_balance_score,
 		engagement_score = EXCLUDED.engagement_score,
 		processing_time = EXCLUDED.processing_time,
+		agenda_coverage = EXCLUDED.agenda_coverage,
 		updated_at = NOW()`,
 		s.ID, s.RoomID, s.TranscriptID, s.ExecutiveSummary,
 		s.KeyPoints, s.Decisions, s.Topics, s.OpenQuestions, s.NextSteps,
 		s.OverallSentiment, s.SentimentBreakdown,
 		s.TotalSpeakingTime, s.ParticipantBalance, s.EngagementScore,
-		s.ModelUsed, s.ProcessingTime, s.Metadata,
+		s.ModelUsed, s.ProcessingTime, s.Metadata, s.AgendaCoverage, s.RoomID,
 		time.Now(), time.Now(),
 	).Error
 }
@@ -170,9 +171,9 @@ func (r *aiRepository) GetActionItemsBySummary(ctx context.Context, summaryID uu
 func (r *aiRepository) SaveActionItems(items []*entities.ActionItem) error {
 	for _, it := range items {
 		// Basic insert
-		q := `INSERT INTO action_items (id, room_id, summary_id, assigned_to, created_by, title, description, type, priority, status, due_date, transcript_reference, timestamp_in_meeting, clickup_task_id, clickup_url, created_at)
-            VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?) ON CONFLICT (id) DO UPDATE SET status = EXCLUDED.status, assigned_to = EXCLUDED.assigned_to, clickup_task_id = EXCLUDED.clickup_task_id, clickup_url = EXCLUDED.clickup_url, updated_at = NOW()`
-		if err := r.db.Exec(q, it.ID, it.RoomID, it.SummaryID, it.AssignedTo, it.CreatedBy, it.Title, it.Description, it.Type, it.Priority, it.Status, it.DueDate, it.TranscriptReference, it.TimestampInMeeting, it.ClickupTaskID, it.ClickupURL, time.Now()).Error; err != nil {
+		q := `INSERT INTO action_items (id, room_id, organization_id, summary_id, agenda_item_id, assigned_to, created_by, title, description, type, priority, status, due_date, transcript_reference, timestamp_in_meeting, clickup_task_id, clickup_url, created_at)
+            VALUES (?, ?, (SELECT organization_id FROM rooms WHERE id = ?), ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?) ON CONFLICT (id) DO UPDATE SET status = EXCLUDED.status, assigned_to = EXCLUDED.assigned_to, clickup_task_id = EXCLUDED.clickup_task_id, clickup_url = EXCLUDED.clickup_url, updated_at = NOW()`
+		if err := r.db.Exec(q, it.ID, it.RoomID, it.RoomID, it.SummaryID, it.AgendaItemID, it.AssignedTo, it.CreatedBy, it.Title, it.Description, it.Type, it.Priority, it.Status, it.DueDate, it.TranscriptReference, it.TimestampInMeeting, it.ClickupTaskID, it.ClickupURL, time.Now()).Error; err != nil {
 			return err
 		}
 	}
@@ -180,7 +181,7 @@ func (r *aiRepository) SaveActionItems(items []*entities.ActionItem) error {
 }
 
 func (r *aiRepository) ListActionItemsByRoom(roomID string) ([]*entities.ActionItem, error) {
-	rows, err := r.db.Raw(`SELECT id, room_id, summary_id, assigned_to, created_by, title, description, type, priority, status, due_date, transcript_reference, timestamp_in_meeting, clickup_task_id, clickup_url, created_at FROM action_items WHERE room_id = ?`, roomID).Rows()
+	rows, err := r.db.Raw(`SELECT id, room_id, organization_id, summary_id, agenda_item_id, assigned_to, created_by, title, description, type, priority, status, due_date, transcript_reference, timestamp_in_meeting, clickup_task_id, clickup_url, created_at FROM action_items WHERE room_id = ?`, roomID).Rows()
 	if err != nil {
 		return nil, err
 	}
@@ -189,7 +190,7 @@ func (r *aiRepository) ListActionItemsByRoom(roomID string) ([]*entities.ActionI
 	for rows.Next() {
 		var it entities.ActionItem
 		var dueDate *time.Time
-		if err := rows.Scan(&it.ID, &it.RoomID, &it.SummaryID, &it.AssignedTo, &it.CreatedBy, &it.Title, &it.Description, &it.Type, &it.Priority, &it.Status, &dueDate, &it.TranscriptReference, &it.TimestampInMeeting, &it.ClickupTaskID, &it.ClickupURL, &it.CreatedAt); err != nil {
+		if err := rows.Scan(&it.ID, &it.RoomID, &it.OrganizationID, &it.SummaryID, &it.AgendaItemID, &it.AssignedTo, &it.CreatedBy, &it.Title, &it.Description, &it.Type, &it.Priority, &it.Status, &dueDate, &it.TranscriptReference, &it.TimestampInMeeting, &it.ClickupTaskID, &it.ClickupURL, &it.CreatedAt); err != nil {
 			return nil, err
 		}
 		it.DueDate = dueDate
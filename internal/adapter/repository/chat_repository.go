@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+	"github.com/johnquangdev/meeting-assistant/internal/domain/repositories"
+)
+
+// chatRepository implements the ChatRepository interface
+type chatRepository struct {
+	db *gorm.DB
+}
+
+// NewChatRepository creates a new chat repository
+func NewChatRepository(db *gorm.DB) repositories.ChatRepository {
+	return &chatRepository{db: db}
+}
+
+// Create stores a new chat message
+func (r *chatRepository) Create(ctx context.Context, message *entities.ChatMessage) error {
+	return r.db.WithContext(ctx).Create(message).Error
+}
+
+// FindByID retrieves a chat message with its author
+func (r *chatRepository) FindByID(ctx context.Context, id uuid.UUID) (*entities.ChatMessage, error) {
+	var message entities.ChatMessage
+	err := r.db.WithContext(ctx).
+		Preload("Participant.User").
+		Where("id = ?", id).
+		First(&message).Error
+	if err != nil {
+		return nil, err
+	}
+	return &message, nil
+}
+
+// Update stores the edit or deletion of a chat message
+func (r *chatRepository) Update(ctx context.Context, message *entities.ChatMessage) error {
+	return r.db.WithContext(ctx).
+		Model(&entities.ChatMessage{}).
+		Where("id = ?", message.ID).
+		Updates(map[string]interface{}{
+			"content":    message.Content,
+			"edited_at":  message.EditedAt,
+			"deleted_at": message.DeletedAt,
+			"deleted_by": message.DeletedBy,
+			"updated_at": time.Now(),
+		}).Error
+}
+
+// List retrieves a page of a room's chat, newest first
+func (r *chatRepository) List(ctx context.Context, roomID uuid.UUID, before *time.Time, limit int) ([]*entities.ChatMessage, error) {
+	query := r.db.WithContext(ctx).
+		Preload("Participant.User").
+		Where("room_id = ?", roomID)
+	if before != nil {
+		query = query.Where("created_at < ?", *before)
+	}
+
+	var messages []*entities.ChatMessage
+	err := query.Order("created_at DESC").Limit(limit).Find(&messages).Error
+	return messages, err
+}
+
+// FindVisibleByRoomID retrieves all messages of a room that were not deleted, oldest first
+func (r *chatRepository) FindVisibleByRoomID(ctx context.Context, roomID uuid.UUID) ([]*entities.ChatMessage, error) {
+	var messages []*entities.ChatMessage
+	err := r.db.WithContext(ctx).
+		Preload("Participant.User").
+		Where("room_id = ? AND deleted_at IS NULL", roomID).
+		Order("created_at ASC").
+		Find(&messages).Error
+	return messages, err
+}
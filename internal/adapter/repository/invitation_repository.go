@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+	"github.com/johnquangdev/meeting-assistant/internal/domain/repositories"
+)
+
+// invitationRepository implements the InvitationRepository interface
+type invitationRepository struct {
+	db *gorm.DB
+}
+
+// NewInvitationRepository creates a new invitation repository
+func NewInvitationRepository(db *gorm.DB) repositories.InvitationRepository {
+	return &invitationRepository{db: db}
+}
+
+// Create creates a new invitation
+func (r *invitationRepository) Create(ctx context.Context, invitation *entities.RoomInvitation) error {
+	return r.db.WithContext(ctx).Omit("Room", "Inviter").Create(invitation).Error
+}
+
+// FindByID retrieves an invitation by its ID
+func (r *invitationRepository) FindByID(ctx context.Context, id uuid.UUID) (*entities.RoomInvitation, error) {
+	var invitation entities.RoomInvitation
+	err := r.db.WithContext(ctx).
+		Where("id = ?", id).
+		First(&invitation).Error
+
+	if err != nil {
+		return nil, err
+	}
+	return &invitation, nil
+}
+
+// FindByToken retrieves an invitation by its token (with room and inviter)
+func (r *invitationRepository) FindByToken(ctx context.Context, token string) (*entities.RoomInvitation, error) {
+	var invitation entities.RoomInvitation
+	err := r.db.WithContext(ctx).
+		Preload("Room").
+		Preload("Inviter").
+		Where("token = ?", token).
+		First(&invitation).Error
+
+	if err != nil {
+		return nil, err
+	}
+	return &invitation, nil
+}
+
+// Update updates an existing invitation
+func (r *invitationRepository) Update(ctx context.Context, invitation *entities.RoomInvitation) error {
+	return r.db.WithContext(ctx).Model(invitation).Omit("Room", "Inviter").Updates(invitation).Error
+}
+
+// FindByRoomID retrieves all invitations of a room, newest first
+func (r *invitationRepository) FindByRoomID(ctx context.Context, roomID uuid.UUID) ([]*entities.RoomInvitation, error) {
+	var invitations []*entities.RoomInvitation
+	err := r.db.WithContext(ctx).
+		Preload("Inviter").
+		Where("room_id = ?", roomID).
+		Order("created_at DESC").
+		Find(&invitations).Error
+	return invitations, err
+}
+
+// FindPendingByInvitee retrieves unexpired pending invitations addressed to a user or email
+func (r *invitationRepository) FindPendingByInvitee(ctx context.Context, userID uuid.UUID, email string) ([]*entities.RoomInvitation, error) {
+	var invitations []*entities.RoomInvitation
+	err := r.pendingForInvitee(ctx, userID, email).
+		Preload("Room").
+		Preload("Inviter").
+		Order("created_at DESC").
+		Find(&invitations).Error
+	return invitations, err
+}
+
+// FindPendingByRoomAndInvitee retrieves the unexpired pending invitation of a user or email to a room
+func (r *invitationRepository) FindPendingByRoomAndInvitee(ctx context.Context, roomID, userID uuid.UUID, email string) (*entities.RoomInvitation, error) {
+	var invitation entities.RoomInvitation
+	err := r.pendingForInvitee(ctx, userID, email).
+		Where("room_id = ?", roomID).
+		Order("created_at DESC").
+		First(&invitation).Error
+
+	if err != nil {
+		return nil, err
+	}
+	return &invitation, nil
+}
+
+// ExpirePending marks pending invitations that expired before the given time as expired
+func (r *invitationRepository) ExpirePending(ctx context.Context, before time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Model(&entities.RoomInvitation{}).
+		Where("status = ? AND expires_at < ?", entities.InvitationStatusPending, before).
+		Update("status", entities.InvitationStatusExpired)
+	return result.RowsAffected, result.Error
+}
+
+// pendingForInvitee scopes a query to unexpired pending invitations of a user or email
+func (r *invitationRepository) pendingForInvitee(ctx context.Context, userID uuid.UUID, email string) *gorm.DB {
+	return r.db.WithContext(ctx).
+		Where("status = ? AND expires_at > ?", entities.InvitationStatusPending, time.Now()).
+		Where("invitee_id = ? OR LOWER(invitee_email) = ?", userID, strings.ToLower(email))
+}
@@ -0,0 +1,198 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+	"github.com/johnquangdev/meeting-assistant/internal/domain/repositories"
+)
+
+// organizationRepository implements the OrganizationRepository interface
+type organizationRepository struct {
+	db *gorm.DB
+}
+
+// NewOrganizationRepository creates a new organization repository
+func NewOrganizationRepository(db *gorm.DB) repositories.OrganizationRepository {
+	return &organizationRepository{db: db}
+}
+
+// Create creates an organization together with its first member (the owner)
+func (r *organizationRepository) Create(ctx context.Context, org *entities.Organization, owner *entities.OrganizationMember) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(org).Error; err != nil {
+			return err
+		}
+		owner.OrganizationID = org.ID
+		return tx.Omit("Organization", "User").Create(owner).Error
+	})
+}
+
+// FindByID retrieves an organization by its ID
+func (r *organizationRepository) FindByID(ctx context.Context, id uuid.UUID) (*entities.Organization, error) {
+	var org entities.Organization
+	err := r.db.WithContext(ctx).
+		Where("id = ?", id).
+		First(&org).Error
+
+	if err != nil {
+		return nil, err
+	}
+	return &org, nil
+}
+
+// FindBySlug retrieves an organization by its slug
+func (r *organizationRepository) FindBySlug(ctx context.Context, slug string) (*entities.Organization, error) {
+	var org entities.Organization
+	err := r.db.WithContext(ctx).
+		Where("slug = ?", slug).
+		First(&org).Error
+
+	if err != nil {
+		return nil, err
+	}
+	return &org, nil
+}
+
+// Update updates an existing organization
+func (r *organizationRepository) Update(ctx context.Context, org *entities.Organization) error {
+	return r.db.WithContext(ctx).Save(org).Error
+}
+
+// Delete deletes an organization with its members, invites and rooms
+func (r *organizationRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Delete(&entities.Organization{}, id).Error
+}
+
+// FindMember retrieves the membership of a user in an organization
+func (r *organizationRepository) FindMember(ctx context.Context, orgID, userID uuid.UUID) (*entities.OrganizationMember, error) {
+	var member entities.OrganizationMember
+	err := r.db.WithContext(ctx).
+		Preload("Organization").
+		Where("organization_id = ? AND user_id = ?", orgID, userID).
+		First(&member).Error
+
+	if err != nil {
+		return nil, err
+	}
+	return &member, nil
+}
+
+// ListMembers retrieves the members of an organization (with users), oldest first
+func (r *organizationRepository) ListMembers(ctx context.Context, orgID uuid.UUID) ([]*entities.OrganizationMember, error) {
+	var members []*entities.OrganizationMember
+	err := r.db.WithContext(ctx).
+		Preload("User").
+		Where("organization_id = ?", orgID).
+		Order("joined_at ASC").
+		Find(&members).Error
+	return members, err
+}
+
+// ListMemberships retrieves the memberships of a user (with organizations), oldest first
+func (r *organizationRepository) ListMemberships(ctx context.Context, userID uuid.UUID) ([]*entities.OrganizationMember, error) {
+	var members []*entities.OrganizationMember
+	err := r.db.WithContext(ctx).
+		Preload("Organization").
+		Where("user_id = ?", userID).
+		Order("joined_at ASC").
+		Find(&members).Error
+	return members, err
+}
+
+// AddMember adds a user to an organization
+func (r *organizationRepository) AddMember(ctx context.Context, member *entities.OrganizationMember) error {
+	return r.db.WithContext(ctx).Omit("Organization", "User").Create(member).Error
+}
+
+// UpdateMemberRole stores the role of a member
+func (r *organizationRepository) UpdateMemberRole(ctx context.Context, memberID uuid.UUID, role entities.OrgRole) error {
+	return r.db.WithContext(ctx).
+		Model(&entities.OrganizationMember{}).
+		Where("id = ?", memberID).
+		Update("role", role).Error
+}
+
+// RemoveMember removes a member from an organization
+func (r *organizationRepository) RemoveMember(ctx context.Context, memberID uuid.UUID) error {
+	return r.db.WithContext(ctx).Delete(&entities.OrganizationMember{}, memberID).Error
+}
+
+// CountMembersWithRole counts the members of an organization with a role
+func (r *organizationRepository) CountMembersWithRole(ctx context.Context, orgID uuid.UUID, role entities.OrgRole) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&entities.OrganizationMember{}).
+		Where("organization_id = ? AND role = ?", orgID, role).
+		Count(&count).Error
+	return count, err
+}
+
+// CreateInvite creates a new invite
+func (r *organizationRepository) CreateInvite(ctx context.Context, invite *entities.OrganizationInvite) error {
+	return r.db.WithContext(ctx).Omit("Organization", "Inviter").Create(invite).Error
+}
+
+// FindInviteByID retrieves an invite of an organization by its ID
+func (r *organizationRepository) FindInviteByID(ctx context.Context, orgID, id uuid.UUID) (*entities.OrganizationInvite, error) {
+	var invite entities.OrganizationInvite
+	err := r.db.WithContext(ctx).
+		Where("organization_id = ? AND id = ?", orgID, id).
+		First(&invite).Error
+
+	if err != nil {
+		return nil, err
+	}
+	return &invite, nil
+}
+
+// FindInviteByToken retrieves an invite by its token (with organization and inviter)
+func (r *organizationRepository) FindInviteByToken(ctx context.Context, token string) (*entities.OrganizationInvite, error) {
+	var invite entities.OrganizationInvite
+	err := r.db.WithContext(ctx).
+		Preload("Organization").
+		Preload("Inviter").
+		Where("token = ?", token).
+		First(&invite).Error
+
+	if err != nil {
+		return nil, err
+	}
+	return &invite, nil
+}
+
+// FindPendingInvite retrieves the unexpired pending invite of an email to an organization
+func (r *organizationRepository) FindPendingInvite(ctx context.Context, orgID uuid.UUID, email string) (*entities.OrganizationInvite, error) {
+	var invite entities.OrganizationInvite
+	err := r.db.WithContext(ctx).
+		Where("organization_id = ? AND LOWER(email) = ?", orgID, strings.ToLower(email)).
+		Where("status = ? AND expires_at > ?", entities.InvitationStatusPending, time.Now()).
+		Order("created_at DESC").
+		First(&invite).Error
+
+	if err != nil {
+		return nil, err
+	}
+	return &invite, nil
+}
+
+// ListInvites retrieves the invites of an organization (with inviters), newest first
+func (r *organizationRepository) ListInvites(ctx context.Context, orgID uuid.UUID) ([]*entities.OrganizationInvite, error) {
+	var invites []*entities.OrganizationInvite
+	err := r.db.WithContext(ctx).
+		Preload("Inviter").
+		Where("organization_id = ?", orgID).
+		Order("created_at DESC").
+		Find(&invites).Error
+	return invites, err
+}
+
+// UpdateInvite updates an existing invite
+func (r *organizationRepository) UpdateInvite(ctx context.Context, invite *entities.OrganizationInvite) error {
+	return r.db.WithContext(ctx).Model(invite).Omit("Organization", "Inviter").Updates(invite).Error
+}
@@ -2,9 +2,12 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
 	"github.com/johnquangdev/meeting-assistant/internal/domain/repositories"
@@ -221,6 +224,9 @@ func (r *participantRepository) UpdateRole(ctx context.Context, participantID uu
 	if role == entities.ParticipantRoleHost || role == entities.ParticipantRoleCoHost {
 		updates["can_record"] = true
 		updates["can_mute_others"] = true
+	} else {
+		updates["can_record"] = false
+		updates["can_mute_others"] = false
 	}
 
 	return r.db.WithContext(ctx).
@@ -241,6 +247,85 @@ func (r *participantRepository) FindWaitingByRoomID(ctx context.Context, roomID
 	return participants, err
 }
 
+// TakeSeat joins a participant (creating it when new) if its room has a free seat, or always when ignoreCapacity is set.
+// It returns false, leaving the participant untouched, when the room is full.
+func (r *participantRepository) TakeSeat(ctx context.Context, participant *entities.Participant, ignoreCapacity bool) (bool, error) {
+	seated := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockSeats(tx, participant.RoomID); err != nil {
+			return err
+		}
+
+		if !ignoreCapacity {
+			free, err := hasFreeSeat(tx, participant.RoomID, participant.ID)
+			if err != nil || !free {
+				return err
+			}
+		}
+
+		participant.Join()
+		if participant.ID == uuid.Nil {
+			if err := tx.Create(participant).Error; err != nil {
+				return err
+			}
+		} else if err := tx.Save(participant).Error; err != nil {
+			return err
+		}
+
+		seated = true
+		return syncParticipantCount(tx, participant.RoomID)
+	})
+	return seated, err
+}
+
+// SeatNextWaitlisted joins the first participant of a room's waitlist if a seat is free.
+// It returns nil when the room is full or nobody is waitlisted.
+func (r *participantRepository) SeatNextWaitlisted(ctx context.Context, roomID uuid.UUID) (*entities.Participant, error) {
+	var seated *entities.Participant
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockSeats(tx, roomID); err != nil {
+			return err
+		}
+
+		free, err := hasFreeSeat(tx, roomID, uuid.Nil)
+		if err != nil || !free {
+			return err
+		}
+
+		var next entities.Participant
+		err = tx.Preload("User").
+			Where("room_id = ? AND status = ?", roomID, entities.ParticipantStatusWaitlisted).
+			Order("waitlisted_at ASC").
+			First(&next).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		next.Join()
+		if err := tx.Save(&next).Error; err != nil {
+			return err
+		}
+
+		seated = &next
+		return syncParticipantCount(tx, roomID)
+	})
+	return seated, err
+}
+
+// FindWaitlistedByRoomID retrieves the waitlist of a room, first in line first
+func (r *participantRepository) FindWaitlistedByRoomID(ctx context.Context, roomID uuid.UUID) ([]*entities.Participant, error) {
+	var participants []*entities.Participant
+	err := r.db.WithContext(ctx).
+		Preload("User").
+		Where("room_id = ? AND status = ?", roomID, entities.ParticipantStatusWaitlisted).
+		Order("waitlisted_at ASC").
+		Find(&participants).Error
+	return participants, err
+}
+
 // FindByInvitedEmail retrieves participants invited with a specific email
 func (r *participantRepository) FindByInvitedEmail(ctx context.Context, email string) ([]*entities.Participant, error) {
 	var participants []*entities.Participant
@@ -277,3 +362,276 @@ func (r *participantRepository) FindByRoomAndEmail(ctx context.Context, roomID u
 	}
 	return &participant, nil
 }
+
+// FindGuestByInvitation retrieves the guest participant that joined through an invitation
+func (r *participantRepository) FindGuestByInvitation(ctx context.Context, roomID, invitationID uuid.UUID) (*entities.Participant, error) {
+	var participant entities.Participant
+	err := r.db.WithContext(ctx).
+		Where("room_id = ? AND user_id IS NULL AND metadata->>'invitation_id' = ?", roomID, invitationID.String()).
+		First(&participant).Error
+
+	if err != nil {
+		return nil, err
+	}
+	return &participant, nil
+}
+
+// FindBreakoutGuest retrieves the guest participant of a breakout room created for a parent room participant
+func (r *participantRepository) FindBreakoutGuest(ctx context.Context, breakoutRoomID, parentParticipantID uuid.UUID) (*entities.Participant, error) {
+	var participant entities.Participant
+	err := r.db.WithContext(ctx).
+		Where("room_id = ? AND user_id IS NULL AND metadata->>'parent_participant_id' = ?", breakoutRoomID, parentParticipantID.String()).
+		First(&participant).Error
+
+	if err != nil {
+		return nil, err
+	}
+	return &participant, nil
+}
+
+// ClearBreakoutAssignments unassigns every participant of a parent room from its breakout rooms
+func (r *participantRepository) ClearBreakoutAssignments(ctx context.Context, parentRoomID uuid.UUID) error {
+	return r.db.WithContext(ctx).
+		Model(&entities.Participant{}).
+		Where("room_id = ? AND breakout_room_id IS NOT NULL", parentRoomID).
+		Update("breakout_room_id", nil).
+		Error
+}
+
+// RaiseHand puts a participant at the end of its room's speaking queue and records the raise
+func (r *participantRepository) RaiseHand(ctx context.Context, participant *entities.Participant) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockHandQueue(tx, participant.RoomID); err != nil {
+			return err
+		}
+
+		var last int
+		if err := tx.Model(&entities.Participant{}).
+			Where("room_id = ? AND is_hand_raised = ?", participant.RoomID, true).
+			Select("COALESCE(MAX(hand_queue_position), 0)").
+			Scan(&last).Error; err != nil {
+			return err
+		}
+
+		now := time.Now()
+		position := last + 1
+		if err := tx.Model(&entities.Participant{}).
+			Where("id = ?", participant.ID).
+			Updates(map[string]interface{}{
+				"is_hand_raised":      true,
+				"hand_raised_at":      now,
+				"hand_queue_position": position,
+			}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Create(&entities.HandRaise{
+			RoomID:        participant.RoomID,
+			ParticipantID: participant.ID,
+			RaisedAt:      now,
+		}).Error; err != nil {
+			return err
+		}
+
+		participant.IsHandRaised = true
+		participant.HandRaisedAt = &now
+		participant.HandQueuePosition = &position
+		return nil
+	})
+}
+
+// LowerHands takes participants out of the speaking queue (every raised hand of the room when participantIDs is empty)
+// and returns the participants whose hand was lowered
+func (r *participantRepository) LowerHands(ctx context.Context, roomID uuid.UUID, participantIDs []uuid.UUID, loweredBy *uuid.UUID) ([]uuid.UUID, error) {
+	var lowered []uuid.UUID
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockHandQueue(tx, roomID); err != nil {
+			return err
+		}
+
+		query := tx.Model(&entities.Participant{}).Where("room_id = ? AND is_hand_raised = ?", roomID, true)
+		if len(participantIDs) > 0 {
+			query = query.Where("id IN ?", participantIDs)
+		}
+		if err := query.Pluck("id", &lowered).Error; err != nil {
+			return err
+		}
+		if len(lowered) == 0 {
+			return nil
+		}
+
+		if err := tx.Model(&entities.Participant{}).
+			Where("id IN ?", lowered).
+			Updates(map[string]interface{}{
+				"is_hand_raised":      false,
+				"hand_raised_at":      nil,
+				"hand_queue_position": nil,
+			}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Model(&entities.HandRaise{}).
+			Where("participant_id IN ? AND lowered_at IS NULL", lowered).
+			Updates(map[string]interface{}{
+				"lowered_at": time.Now(),
+				"lowered_by": loweredBy,
+			}).Error; err != nil {
+			return err
+		}
+
+		// Close the gaps left in the speaking order
+		return tx.Exec(`
+			UPDATE participants p SET hand_queue_position = q.position
+			FROM (
+				SELECT id, ROW_NUMBER() OVER (ORDER BY hand_queue_position, hand_raised_at) AS position
+				FROM participants
+				WHERE room_id = ? AND is_hand_raised = TRUE
+			) q
+			WHERE p.id = q.id`, roomID).Error
+	})
+	return lowered, err
+}
+
+// ReorderHands rewrites the speaking order of the raised hands of a room.
+// Hands missing from order keep their relative order after the listed ones.
+func (r *participantRepository) ReorderHands(ctx context.Context, roomID uuid.UUID, order []uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockHandQueue(tx, roomID); err != nil {
+			return err
+		}
+
+		var current []uuid.UUID
+		if err := tx.Model(&entities.Participant{}).
+			Where("room_id = ? AND is_hand_raised = ?", roomID, true).
+			Order("hand_queue_position ASC, hand_raised_at ASC").
+			Pluck("id", &current).Error; err != nil {
+			return err
+		}
+
+		listed := make(map[uuid.UUID]bool, len(order))
+		final := make([]uuid.UUID, 0, len(current))
+		for _, id := range order {
+			if !listed[id] {
+				listed[id] = true
+				final = append(final, id)
+			}
+		}
+		for _, id := range current {
+			if !listed[id] {
+				final = append(final, id)
+			}
+		}
+
+		for i, id := range final {
+			if err := tx.Model(&entities.Participant{}).
+				Where("id = ? AND room_id = ? AND is_hand_raised = ?", id, roomID, true).
+				Update("hand_queue_position", i+1).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// FindRaisedHands retrieves the raised hands of a room in speaking order
+func (r *participantRepository) FindRaisedHands(ctx context.Context, roomID uuid.UUID) ([]*entities.Participant, error) {
+	var participants []*entities.Participant
+	err := r.db.WithContext(ctx).
+		Preload("User").
+		Where("room_id = ? AND is_hand_raised = ?", roomID, true).
+		Order("hand_queue_position ASC, hand_raised_at ASC").
+		Find(&participants).Error
+	return participants, err
+}
+
+// FindHandRaises retrieves the hand-raise history of a room
+func (r *participantRepository) FindHandRaises(ctx context.Context, roomID uuid.UUID) ([]*entities.HandRaise, error) {
+	var raises []*entities.HandRaise
+	err := r.db.WithContext(ctx).
+		Preload("Participant.User").
+		Where("room_id = ?", roomID).
+		Order("raised_at ASC").
+		Find(&raises).Error
+	return raises, err
+}
+
+// StartSession records a LiveKit session of a participant (ignored when the session is already recorded)
+func (r *participantRepository) StartSession(ctx context.Context, session *entities.AttendanceSession) error {
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(session).Error
+}
+
+// EndSession closes the open session of a room with the given LiveKit SID and returns false when there is none
+func (r *participantRepository) EndSession(ctx context.Context, roomID uuid.UUID, sessionSID string, leftAt time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&entities.AttendanceSession{}).
+		Where("room_id = ? AND session_sid = ? AND left_at IS NULL", roomID, sessionSID).
+		Updates(endSessionColumns(leftAt))
+	return result.RowsAffected > 0, result.Error
+}
+
+// EndOpenSessions closes the open sessions of a room (only those of participantID when it is set)
+func (r *participantRepository) EndOpenSessions(ctx context.Context, roomID uuid.UUID, participantID *uuid.UUID, leftAt time.Time) error {
+	query := r.db.WithContext(ctx).
+		Model(&entities.AttendanceSession{}).
+		Where("room_id = ? AND left_at IS NULL", roomID)
+	if participantID != nil {
+		query = query.Where("participant_id = ?", *participantID)
+	}
+	return query.Updates(endSessionColumns(leftAt)).Error
+}
+
+// FindSessions retrieves the attendance sessions of a room, oldest first
+func (r *participantRepository) FindSessions(ctx context.Context, roomID uuid.UUID) ([]*entities.AttendanceSession, error) {
+	var sessions []*entities.AttendanceSession
+	err := r.db.WithContext(ctx).
+		Where("room_id = ?", roomID).
+		Order("joined_at ASC").
+		Find(&sessions).Error
+	return sessions, err
+}
+
+// endSessionColumns sets the end and the duration of attendance sessions
+func endSessionColumns(leftAt time.Time) map[string]interface{} {
+	return map[string]interface{}{
+		"left_at":  leftAt,
+		"duration": gorm.Expr("GREATEST(EXTRACT(EPOCH FROM (?::timestamp - joined_at))::INT, 0)", leftAt),
+	}
+}
+
+// lockSeats serializes changes to the seats of a room until the transaction ends
+func lockSeats(tx *gorm.DB, roomID uuid.UUID) error {
+	return tx.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", "seats:"+roomID.String()).Error
+}
+
+// hasFreeSeat checks if a room has fewer joined participants (other than participantID) than it allows
+func hasFreeSeat(tx *gorm.DB, roomID, participantID uuid.UUID) (bool, error) {
+	var room entities.Room
+	if err := tx.Select("max_participants").Where("id = ?", roomID).First(&room).Error; err != nil {
+		return false, err
+	}
+
+	var joined int64
+	if err := tx.Model(&entities.Participant{}).
+		Where("room_id = ? AND id <> ? AND status = ? AND left_at IS NULL", roomID, participantID, entities.ParticipantStatusJoined).
+		Count(&joined).Error; err != nil {
+		return false, err
+	}
+	return joined < int64(room.MaxParticipants), nil
+}
+
+// syncParticipantCount recounts rooms.current_participants from the joined participants of the room
+func syncParticipantCount(tx *gorm.DB, roomID uuid.UUID) error {
+	return tx.Exec(`
+		UPDATE rooms SET current_participants = (
+			SELECT COUNT(*) FROM participants
+			WHERE room_id = ? AND status = ? AND left_at IS NULL
+		), updated_at = NOW()
+		WHERE id = ?`, roomID, entities.ParticipantStatusJoined, roomID).Error
+}
+
+// lockHandQueue serializes changes to the speaking queue of a room until the transaction ends
+func lockHandQueue(tx *gorm.DB, roomID uuid.UUID) error {
+	return tx.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", "hand_queue:"+roomID.String()).Error
+}
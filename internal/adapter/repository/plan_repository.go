@@ -0,0 +1,172 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+	"github.com/johnquangdev/meeting-assistant/internal/domain/repositories"
+)
+
+// planRepository implements the PlanRepository interface
+type planRepository struct {
+	db *gorm.DB
+}
+
+// NewPlanRepository creates a new plan repository
+func NewPlanRepository(db *gorm.DB) repositories.PlanRepository {
+	return &planRepository{db: db}
+}
+
+// List retrieves all plans, smallest first
+func (r *planRepository) List(ctx context.Context) ([]*entities.Plan, error) {
+	var plans []*entities.Plan
+	err := r.db.WithContext(ctx).
+		Order("max_participants ASC, code ASC").
+		Find(&plans).Error
+
+	if err != nil {
+		return nil, err
+	}
+	return plans, nil
+}
+
+// FindByCode retrieves a plan by its code
+func (r *planRepository) FindByCode(ctx context.Context, code string) (*entities.Plan, error) {
+	var plan entities.Plan
+	err := r.db.WithContext(ctx).
+		Where("code = ?", code).
+		First(&plan).Error
+
+	if err != nil {
+		return nil, err
+	}
+	return &plan, nil
+}
+
+// FindUserPlan retrieves the plan of a user, or the default plan (nil when there is none)
+func (r *planRepository) FindUserPlan(ctx context.Context, userID uuid.UUID) (*entities.Plan, error) {
+	return r.findAssignedPlan(ctx, "SELECT plan_id FROM users WHERE id = ?", userID)
+}
+
+// FindOrganizationPlan retrieves the plan of an organization, or the default plan (nil when there is none)
+func (r *planRepository) FindOrganizationPlan(ctx context.Context, orgID uuid.UUID) (*entities.Plan, error) {
+	return r.findAssignedPlan(ctx, "SELECT plan_id FROM organizations WHERE id = ?", orgID)
+}
+
+// findAssignedPlan retrieves the plan selected by a subquery, falling back to the default plan
+func (r *planRepository) findAssignedPlan(ctx context.Context, planIDQuery string, ownerID uuid.UUID) (*entities.Plan, error) {
+	var plan entities.Plan
+	err := r.db.WithContext(ctx).
+		Where("id = ("+planIDQuery+")", ownerID).
+		First(&plan).Error
+
+	if err == nil {
+		return &plan, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	err = r.db.WithContext(ctx).
+		Where("is_default = ?", true).
+		First(&plan).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &plan, nil
+}
+
+// SetUserPlan assigns a plan to a user (nil = default plan)
+func (r *planRepository) SetUserPlan(ctx context.Context, userID uuid.UUID, planID *uuid.UUID) error {
+	result := r.db.WithContext(ctx).
+		Model(&entities.User{}).
+		Where("id = ?", userID).
+		Updates(map[string]interface{}{
+			"plan_id":    planID,
+			"updated_at": time.Now(),
+		})
+
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// SetOrganizationPlan assigns a plan to an organization (nil = default plan)
+func (r *planRepository) SetOrganizationPlan(ctx context.Context, orgID uuid.UUID, planID *uuid.UUID) error {
+	result := r.db.WithContext(ctx).
+		Model(&entities.Organization{}).
+		Where("id = ?", orgID).
+		Updates(map[string]interface{}{
+			"plan_id":    planID,
+			"updated_at": time.Now(),
+		})
+
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// GetUsage meters the rooms, recordings and AI jobs of a scope; monthly figures count from since
+func (r *planRepository) GetUsage(ctx context.Context, scope repositories.UsageScope, since time.Time) (*entities.Usage, error) {
+	db := r.db.WithContext(ctx)
+	usage := &entities.Usage{PeriodStart: since}
+
+	if err := r.scopeRooms(db.Table("rooms"), scope).
+		Where("rooms.status = ? AND rooms.parent_room_id IS NULL", entities.RoomStatusActive).
+		Count(&usage.ActiveRooms).Error; err != nil {
+		return nil, err
+	}
+
+	// Ended rooms count their recorded duration, ongoing ones the time elapsed so far
+	if err := r.scopeRooms(db.Table("rooms"), scope).
+		Select(`COALESCE(SUM(CASE
+			WHEN rooms.ended_at IS NOT NULL THEN COALESCE(rooms.duration, 0)
+			ELSE GREATEST(EXTRACT(EPOCH FROM (NOW() - rooms.started_at)), 0)
+		END), 0)::BIGINT`).
+		Where("rooms.started_at >= ?", since).
+		Scan(&usage.MeetingSeconds).Error; err != nil {
+		return nil, err
+	}
+
+	if err := r.scopeRooms(db.Table("recordings").Joins("JOIN rooms ON rooms.id = recordings.room_id"), scope).
+		Select("COALESCE(SUM(recordings.file_size), 0)").
+		Where("recordings.status <> ?", entities.RecordingStatusDeleted).
+		Scan(&usage.RecordingBytes).Error; err != nil {
+		return nil, err
+	}
+
+	// Only completed jobs know the duration of the audio they processed
+	if err := r.scopeRooms(db.Table("ai_jobs").Joins("JOIN rooms ON rooms.id = ai_jobs.meeting_id"), scope).
+		Select("COALESCE(SUM(COALESCE((ai_jobs.metadata->>'duration_seconds')::BIGINT, 0)), 0)").
+		Where("ai_jobs.status = ? AND ai_jobs.completed_at >= ?", entities.AIJobStatusCompleted, since).
+		Scan(&usage.AISeconds).Error; err != nil {
+		return nil, err
+	}
+
+	return usage, nil
+}
+
+// scopeRooms restricts a query joined with rooms to the rooms of a scope
+func (r *planRepository) scopeRooms(query *gorm.DB, scope repositories.UsageScope) *gorm.DB {
+	if scope.OrganizationID != nil {
+		return query.Where("rooms.organization_id = ?", *scope.OrganizationID)
+	}
+	return query.Where("rooms.host_id = ? AND rooms.organization_id IS NULL", scope.HostID)
+}
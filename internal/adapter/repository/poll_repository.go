@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+	"github.com/johnquangdev/meeting-assistant/internal/domain/repositories"
+)
+
+// pollRepository implements the PollRepository interface
+type pollRepository struct {
+	db *gorm.DB
+}
+
+// NewPollRepository creates a new poll repository
+func NewPollRepository(db *gorm.DB) repositories.PollRepository {
+	return &pollRepository{db: db}
+}
+
+// Create stores a new poll with its options
+func (r *pollRepository) Create(ctx context.Context, poll *entities.Poll) error {
+	return r.db.WithContext(ctx).Create(poll).Error
+}
+
+// FindByID retrieves a poll with its options and creator
+func (r *pollRepository) FindByID(ctx context.Context, id uuid.UUID) (*entities.Poll, error) {
+	var poll entities.Poll
+	err := r.withDetails(ctx).
+		Where("id = ?", id).
+		First(&poll).Error
+	if err != nil {
+		return nil, err
+	}
+	return &poll, nil
+}
+
+// FindByRoomID retrieves the polls of a room, oldest first
+func (r *pollRepository) FindByRoomID(ctx context.Context, roomID uuid.UUID) ([]*entities.Poll, error) {
+	var polls []*entities.Poll
+	err := r.withDetails(ctx).
+		Where("room_id = ?", roomID).
+		Order("created_at ASC").
+		Find(&polls).Error
+	return polls, err
+}
+
+// FindDue retrieves the open polls whose closing time passed
+func (r *pollRepository) FindDue(ctx context.Context, now time.Time) ([]*entities.Poll, error) {
+	var polls []*entities.Poll
+	err := r.withDetails(ctx).
+		Where("status = ? AND closes_at IS NOT NULL AND closes_at <= ?", entities.PollStatusOpen, now).
+		Find(&polls).Error
+	return polls, err
+}
+
+// Close stores the closing of a poll with its final results, unless it was already closed
+func (r *pollRepository) Close(ctx context.Context, poll *entities.Poll) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&entities.Poll{}).
+		Where("id = ? AND status = ?", poll.ID, entities.PollStatusOpen).
+		Updates(map[string]interface{}{
+			"status":       poll.Status,
+			"closed_at":    poll.ClosedAt,
+			"closed_by":    poll.ClosedBy,
+			"close_reason": poll.CloseReason,
+			"results":      poll.Results,
+			"updated_at":   time.Now(),
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// ReplaceVotes stores the votes of a participant, replacing the ones it cast before
+func (r *pollRepository) ReplaceVotes(ctx context.Context, pollID, participantID uuid.UUID, votes []*entities.PollVote) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("poll_id = ? AND participant_id = ?", pollID, participantID).
+			Delete(&entities.PollVote{}).Error; err != nil {
+			return err
+		}
+		if len(votes) == 0 {
+			return nil
+		}
+		return tx.Create(&votes).Error
+	})
+}
+
+// FindVotes retrieves the votes of a poll with their voters
+func (r *pollRepository) FindVotes(ctx context.Context, pollID uuid.UUID) ([]*entities.PollVote, error) {
+	var votes []*entities.PollVote
+	err := r.db.WithContext(ctx).
+		Preload("Participant.User").
+		Where("poll_id = ?", pollID).
+		Order("created_at ASC").
+		Find(&votes).Error
+	return votes, err
+}
+
+// withDetails preloads the options (in order) and the creator of polls
+func (r *pollRepository) withDetails(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx).
+		Preload("Options", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position ASC")
+		}).
+		Preload("Creator.User")
+}
@@ -2,10 +2,12 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
 )
@@ -52,11 +54,31 @@ func (r *RecordingRepository) FindByRoomID(ctx context.Context, roomID uuid.UUID
 	return recordings, nil
 }
 
-// FindByEgressID retrieves a recording by LiveKit egress ID
+// FindByEgressID retrieves a recording by LiveKit egress ID, the current one or that of an earlier segment
 func (r *RecordingRepository) FindByEgressID(ctx context.Context, egressID string) (*entities.Recording, error) {
+	segment, err := json.Marshal([]map[string]string{{"egress_id": egressID}})
+	if err != nil {
+		return nil, err
+	}
+
+	var recording entities.Recording
+	if err := r.db.WithContext(ctx).
+		Where("livekit_egress_id = ? OR metadata->'segments' @> ?::jsonb", egressID, string(segment)).
+		First(&recording).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &recording, nil
+}
+
+// FindActiveByRoomID retrieves the recording running or paused in a room
+func (r *RecordingRepository) FindActiveByRoomID(ctx context.Context, roomID uuid.UUID) (*entities.Recording, error) {
 	var recording entities.Recording
 	if err := r.db.WithContext(ctx).
-		Where("livekit_egress_id = ?", egressID).
+		Where("room_id = ? AND status IN ?", roomID, []entities.RecordingStatus{entities.RecordingStatusRecording, entities.RecordingStatusPaused}).
+		Order("started_at DESC").
 		First(&recording).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
@@ -99,3 +121,72 @@ func (r *RecordingRepository) FindPendingProcessing(ctx context.Context) ([]*ent
 	}
 	return recordings, nil
 }
+
+// StorageUsage counts the stored recordings and the bytes they take (deleted recordings excluded)
+func (r *RecordingRepository) StorageUsage(ctx context.Context) (int64, int64, error) {
+	var usage struct {
+		Count int64
+		Bytes int64
+	}
+	if err := r.db.WithContext(ctx).
+		Model(&entities.Recording{}).
+		Select("COUNT(*) AS count, COALESCE(SUM(file_size), 0) AS bytes").
+		Where("status <> ?", entities.RecordingStatusDeleted).
+		Scan(&usage).Error; err != nil {
+		return 0, 0, err
+	}
+	return usage.Count, usage.Bytes, nil
+}
+
+// CreateConsent records a recording consent; consenting again to the same policy version keeps the first consent
+func (r *RecordingRepository) CreateConsent(ctx context.Context, consent *entities.RecordingConsent) error {
+	if consent == nil {
+		return errors.New("consent cannot be nil")
+	}
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "participant_id"}, {Name: "policy_version"}},
+			DoNothing: true,
+		}).
+		Create(consent).Error
+}
+
+// FindConsent retrieves the consent of a participant to a policy version
+func (r *RecordingRepository) FindConsent(ctx context.Context, participantID uuid.UUID, policyVersion string) (*entities.RecordingConsent, error) {
+	var consent entities.RecordingConsent
+	if err := r.db.WithContext(ctx).
+		Where("participant_id = ? AND policy_version = ?", participantID, policyVersion).
+		First(&consent).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &consent, nil
+}
+
+// FindConsentsByRoomID retrieves the recording consents given in a room (all policy versions), oldest first
+func (r *RecordingRepository) FindConsentsByRoomID(ctx context.Context, roomID uuid.UUID) ([]*entities.RecordingConsent, error) {
+	var consents []*entities.RecordingConsent
+	if err := r.db.WithContext(ctx).
+		Preload("Participant").
+		Preload("Participant.User").
+		Where("room_id = ?", roomID).
+		Order("consented_at ASC").
+		Find(&consents).Error; err != nil {
+		return nil, err
+	}
+	return consents, nil
+}
+
+// FindConsentedParticipantIDs retrieves the participants of a room that consented to a policy version
+func (r *RecordingRepository) FindConsentedParticipantIDs(ctx context.Context, roomID uuid.UUID, policyVersion string) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	if err := r.db.WithContext(ctx).
+		Model(&entities.RecordingConsent{}).
+		Where("room_id = ? AND policy_version = ?", roomID, policyVersion).
+		Pluck("participant_id", &ids).Error; err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -71,8 +72,9 @@ func (r *roomRepository) FindByLivekitName(ctx context.Context, livekitName stri
 
 // Update updates an existing room
 func (r *roomRepository) Update(ctx context.Context, room *entities.Room) error {
-	// Use Updates to avoid zero-value issues and association problems
-	return r.db.WithContext(ctx).Model(room).Updates(room).Error
+	// Use Updates to avoid zero-value issues and association problems.
+	// The seat count is only kept by the seat queries (under the room's lock), never from a loaded room.
+	return r.db.WithContext(ctx).Model(room).Omit("current_participants").Updates(room).Error
 }
 
 // Delete soft deletes a room
@@ -97,6 +99,22 @@ func (r *roomRepository) List(ctx context.Context, filters repositories.RoomFilt
 	if filters.HostID != nil {
 		query = query.Where("host_id = ?", *filters.HostID)
 	}
+	if filters.SeriesID != nil {
+		query = query.Where("series_id = ?", *filters.SeriesID)
+	}
+	if filters.ParentID != nil {
+		query = query.Where("parent_room_id = ?", *filters.ParentID)
+	} else {
+		query = query.Where("parent_room_id IS NULL")
+	}
+	// Tenant isolation: breakouts share the organization of their parent
+	switch {
+	case filters.AllOrganizations || filters.ParentID != nil:
+	case filters.OrganizationID != nil:
+		query = query.Where("organization_id = ?", *filters.OrganizationID)
+	default:
+		query = query.Where("organization_id IS NULL")
+	}
 	if filters.Search != "" {
 		searchPattern := fmt.Sprintf("%%%s%%", filters.Search)
 		query = query.Where("name ILIKE ? OR description ILIKE ?", searchPattern, searchPattern)
@@ -173,61 +191,218 @@ func (r *roomRepository) FindScheduledRooms(ctx context.Context) ([]*entities.Ro
 	return rooms, err
 }
 
-// IncrementParticipantCount increases the participant count
-func (r *roomRepository) IncrementParticipantCount(ctx context.Context, roomID uuid.UUID) error {
-	// Update current_participants and ensure updated_at is set
+// SyncParticipantCount recounts the current participants of a room from its joined participants
+func (r *roomRepository) SyncParticipantCount(ctx context.Context, roomID uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockSeats(tx, roomID); err != nil {
+			return err
+		}
+		return syncParticipantCount(tx, roomID)
+	})
+}
+
+// UpdateStatus updates the room status
+func (r *roomRepository) UpdateStatus(ctx context.Context, roomID uuid.UUID, status entities.RoomStatus) error {
 	return r.db.WithContext(ctx).
 		Model(&entities.Room{}).
 		Where("id = ?", roomID).
-		UpdateColumns(map[string]interface{}{
-			"current_participants": gorm.Expr("current_participants + 1"),
-			"updated_at":           gorm.Expr("NOW()"),
-		}).
+		Update("status", status).
 		Error
 }
 
-// DecrementParticipantCount decreases the participant count
-func (r *roomRepository) DecrementParticipantCount(ctx context.Context, roomID uuid.UUID) error {
+// MarkStarted opens a room that is not running yet.
+// It returns false when the room is already running, ended or cancelled.
+func (r *roomRepository) MarkStarted(ctx context.Context, roomID uuid.UUID, startedAt time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&entities.Room{}).
+		Where("id = ? AND status NOT IN ?", roomID, []entities.RoomStatus{entities.RoomStatusActive, entities.RoomStatusEnded, entities.RoomStatusCancelled}).
+		Updates(map[string]interface{}{
+			"status":     entities.RoomStatusActive,
+			"started_at": startedAt,
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+// EndRoom marks a room as ended and calculates duration.
+// It returns false when the room was already ended or cancelled.
+func (r *roomRepository) EndRoom(ctx context.Context, roomID uuid.UUID) (bool, error) {
+	// When ending a room, also calculate duration on the application side
+	// because DB triggers may be removed. Calculate duration in seconds
+	// only if started_at is not null.
+	result := r.db.WithContext(ctx).
+		Model(&entities.Room{}).
+		Where("id = ? AND status NOT IN ?", roomID, []entities.RoomStatus{entities.RoomStatusEnded, entities.RoomStatusCancelled}).
+		Updates(map[string]interface{}{
+			"status":   entities.RoomStatusEnded,
+			"ended_at": gorm.Expr("NOW()"),
+			"duration": gorm.Expr("CASE WHEN started_at IS NOT NULL THEN EXTRACT(EPOCH FROM (NOW() - started_at))::INT ELSE NULL END"),
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+// CancelRoom cancels a room that has not started.
+// It returns false when the room is no longer scheduled.
+func (r *roomRepository) CancelRoom(ctx context.Context, roomID uuid.UUID) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&entities.Room{}).
+		Where("id = ? AND status = ?", roomID, entities.RoomStatusScheduled).
+		Update("status", entities.RoomStatusCancelled)
+	return result.RowsAffected > 0, result.Error
+}
+
+// UpdateHostID updates the room's host ID
+func (r *roomRepository) UpdateHostID(ctx context.Context, roomID, newHostID uuid.UUID) error {
 	return r.db.WithContext(ctx).
 		Model(&entities.Room{}).
-		Where("id = ? AND current_participants > 0", roomID).
-		UpdateColumns(map[string]interface{}{
-			"current_participants": gorm.Expr("current_participants - 1"),
-			"updated_at":           gorm.Expr("NOW()"),
-		}).
+		Where("id = ?", roomID).
+		Update("host_id", newHostID).
 		Error
 }
 
-// UpdateStatus updates the room status
-func (r *roomRepository) UpdateStatus(ctx context.Context, roomID uuid.UUID, status entities.RoomStatus) error {
+// MarkReminderSent records that the reminder of a scheduled room was sent.
+// It returns false when another worker already marked it.
+func (r *roomRepository) MarkReminderSent(ctx context.Context, roomID uuid.UUID, sentAt time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&entities.Room{}).
+		Where("id = ? AND reminder_sent_at IS NULL", roomID).
+		Update("reminder_sent_at", sentAt)
+	return result.RowsAffected > 0, result.Error
+}
+
+// ResetReminder clears the reminder mark so a rescheduled room is reminded again
+func (r *roomRepository) ResetReminder(ctx context.Context, roomID uuid.UUID) error {
 	return r.db.WithContext(ctx).
 		Model(&entities.Room{}).
 		Where("id = ?", roomID).
-		Update("status", status).
+		Update("reminder_sent_at", nil).
 		Error
 }
 
-// EndRoom marks a room as ended and calculates duration
-func (r *roomRepository) EndRoom(ctx context.Context, roomID uuid.UUID) error {
-	// When ending a room, also calculate duration on the application side
-	// because DB triggers may be removed. Calculate duration in seconds
-	// only if started_at is not null.
+// MarkEndWarningSent records that an overdue room was warned of its forced end.
+// It returns false when another worker already marked it.
+func (r *roomRepository) MarkEndWarningSent(ctx context.Context, roomID uuid.UUID, sentAt time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&entities.Room{}).
+		Where("id = ? AND end_warning_sent_at IS NULL", roomID).
+		Update("end_warning_sent_at", sentAt)
+	return result.RowsAffected > 0, result.Error
+}
+
+// ResetEndWarning clears the end warning mark so the room is warned again
+func (r *roomRepository) ResetEndWarning(ctx context.Context, roomID uuid.UUID) error {
 	return r.db.WithContext(ctx).
 		Model(&entities.Room{}).
 		Where("id = ?", roomID).
+		Update("end_warning_sent_at", nil).
+		Error
+}
+
+// FindBreakouts retrieves the breakout rooms of a parent room, oldest first
+func (r *roomRepository) FindBreakouts(ctx context.Context, parentRoomID uuid.UUID) ([]*entities.Room, error) {
+	var rooms []*entities.Room
+	err := r.db.WithContext(ctx).
+		Where("parent_room_id = ?", parentRoomID).
+		Order("created_at ASC").
+		Find(&rooms).Error
+	return rooms, err
+}
+
+// SetBreakoutTimer sets (or clears with nil) the end of the breakout timer of a parent room
+func (r *roomRepository) SetBreakoutTimer(ctx context.Context, roomID uuid.UUID, endsAt *time.Time) error {
+	return r.db.WithContext(ctx).
+		Model(&entities.Room{}).
+		Where("id = ?", roomID).
+		Update("breakout_ends_at", endsAt).
+		Error
+}
+
+// SetCurrentAgendaItem sets (or clears with nil) the agenda item a room is discussing
+func (r *roomRepository) SetCurrentAgendaItem(ctx context.Context, roomID uuid.UUID, itemID *uuid.UUID) error {
+	return r.db.WithContext(ctx).
+		Model(&entities.Room{}).
+		Where("id = ?", roomID).
+		Update("current_agenda_item_id", itemID).
+		Error
+}
+
+// UpdateLock stores the lock state (lock mode, locked at/by) of a room, including unlocking
+func (r *roomRepository) UpdateLock(ctx context.Context, room *entities.Room) error {
+	return r.db.WithContext(ctx).
+		Model(&entities.Room{}).
+		Where("id = ?", room.ID).
 		Updates(map[string]interface{}{
-			"status":   entities.RoomStatusEnded,
-			"ended_at": gorm.Expr("NOW()"),
-			"duration": gorm.Expr("CASE WHEN started_at IS NOT NULL THEN EXTRACT(EPOCH FROM (NOW() - started_at))::INT ELSE NULL END"),
+			"lock_mode": room.LockMode,
+			"locked_at": room.LockedAt,
+			"locked_by": room.LockedBy,
 		}).
 		Error
 }
 
-// UpdateHostID updates the room's host ID
-func (r *roomRepository) UpdateHostID(ctx context.Context, roomID, newHostID uuid.UUID) error {
+// UpdatePasscode stores (or clears with nil) the passcode hash of a room
+func (r *roomRepository) UpdatePasscode(ctx context.Context, roomID uuid.UUID, passcodeHash *string) error {
 	return r.db.WithContext(ctx).
 		Model(&entities.Room{}).
 		Where("id = ?", roomID).
-		Update("host_id", newHostID).
+		Update("passcode_hash", passcodeHash).
 		Error
 }
+
+// CreateAuditLog records an access control change of a room
+func (r *roomRepository) CreateAuditLog(ctx context.Context, log *entities.RoomAuditLog) error {
+	return r.db.WithContext(ctx).Create(log).Error
+}
+
+// ListAuditLogs retrieves the audit trail of a room, newest first
+func (r *roomRepository) ListAuditLogs(ctx context.Context, roomID uuid.UUID, limit, offset int) ([]*entities.RoomAuditLog, int64, error) {
+	var logs []*entities.RoomAuditLog
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&entities.RoomAuditLog{}).Where("room_id = ?", roomID)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.Order("created_at DESC").Limit(limit).Offset(offset).Find(&logs).Error
+	return logs, total, err
+}
+
+// RecordPasscodeFailure records a failed passcode attempt of its actor unless limit attempts were already
+// recorded since a time. An advisory lock per room and actor makes concurrent attempts count one by one.
+func (r *roomRepository) RecordPasscodeFailure(ctx context.Context, log *entities.RoomAuditLog, since time.Time, limit int64) (bool, error) {
+	actor := "ip:" + log.ActorIP
+	if log.ActorID != nil {
+		actor = "user:" + log.ActorID.String()
+	}
+
+	recorded := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", "passcode:"+log.RoomID.String()+":"+actor).Error; err != nil {
+			return err
+		}
+
+		query := tx.Model(&entities.RoomAuditLog{}).
+			Where("room_id = ? AND action = ? AND created_at > ?", log.RoomID, entities.RoomAuditPasscodeFailed, since)
+		if log.ActorID != nil {
+			query = query.Where("actor_id = ?", *log.ActorID)
+		} else {
+			query = query.Where("actor_id IS NULL AND actor_ip = ?", log.ActorIP)
+		}
+
+		var count int64
+		if err := query.Count(&count).Error; err != nil || count >= limit {
+			return err
+		}
+
+		if err := tx.Create(log).Error; err != nil {
+			return err
+		}
+		recorded = true
+		return nil
+	})
+	return recorded, err
+}
+
+// DeleteAuditLog deletes an audit log entry
+func (r *roomRepository) DeleteAuditLog(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Delete(&entities.RoomAuditLog{}, "id = ?", id).Error
+}
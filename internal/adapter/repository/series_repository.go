@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+	"github.com/johnquangdev/meeting-assistant/internal/domain/repositories"
+)
+
+// seriesRepository implements the SeriesRepository interface
+type seriesRepository struct {
+	db *gorm.DB
+}
+
+// NewSeriesRepository creates a new series repository
+func NewSeriesRepository(db *gorm.DB) repositories.SeriesRepository {
+	return &seriesRepository{db: db}
+}
+
+// Create creates a new series
+func (r *seriesRepository) Create(ctx context.Context, series *entities.MeetingSeries) error {
+	return r.db.WithContext(ctx).Create(series).Error
+}
+
+// FindByID retrieves a series by its ID
+func (r *seriesRepository) FindByID(ctx context.Context, id uuid.UUID) (*entities.MeetingSeries, error) {
+	var series entities.MeetingSeries
+	err := r.db.WithContext(ctx).
+		Preload("Host").
+		Where("id = ?", id).
+		First(&series).Error
+
+	if err != nil {
+		return nil, err
+	}
+	return &series, nil
+}
+
+// Update updates an existing series
+func (r *seriesRepository) Update(ctx context.Context, series *entities.MeetingSeries) error {
+	series.UpdatedAt = time.Now()
+	return r.db.WithContext(ctx).Model(series).Omit("Host").Updates(series).Error
+}
+
+// FindByHostID retrieves all series hosted by a user
+func (r *seriesRepository) FindByHostID(ctx context.Context, hostID uuid.UUID, limit, offset int) ([]*entities.MeetingSeries, error) {
+	var series []*entities.MeetingSeries
+	query := r.db.WithContext(ctx).
+		Where("host_id = ?", hostID).
+		Order("created_at DESC")
+
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+
+	err := query.Find(&series).Error
+	return series, err
+}
+
+// FindActive retrieves all series that still produce occurrences
+func (r *seriesRepository) FindActive(ctx context.Context) ([]*entities.MeetingSeries, error) {
+	var series []*entities.MeetingSeries
+	err := r.db.WithContext(ctx).
+		Where("status = ?", entities.SeriesStatusActive).
+		Find(&series).Error
+	return series, err
+}
+
+// SaveException creates or replaces the exception for an occurrence
+func (r *seriesRepository) SaveException(ctx context.Context, exception *entities.SeriesException) error {
+	exception.UpdatedAt = time.Now()
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "series_id"}, {Name: "original_start_time"}},
+			DoUpdates: clause.AssignmentColumns([]string{"type", "new_start_time", "new_end_time", "reason", "created_by", "updated_at"}),
+		}).
+		Create(exception).Error
+}
+
+// FindExceptions retrieves all exceptions of a series
+func (r *seriesRepository) FindExceptions(ctx context.Context, seriesID uuid.UUID) ([]*entities.SeriesException, error) {
+	var exceptions []*entities.SeriesException
+	err := r.db.WithContext(ctx).
+		Where("series_id = ?", seriesID).
+		Order("original_start_time ASC").
+		Find(&exceptions).Error
+	return exceptions, err
+}
+
+// FindOccurrenceRooms retrieves rooms generated from a series, ordered by occurrence time
+func (r *seriesRepository) FindOccurrenceRooms(ctx context.Context, seriesID uuid.UUID, from, to *time.Time) ([]*entities.Room, error) {
+	var rooms []*entities.Room
+	query := r.db.WithContext(ctx).Where("series_id = ?", seriesID)
+
+	if from != nil {
+		query = query.Where("occurrence_start_time >= ?", *from)
+	}
+	if to != nil {
+		query = query.Where("occurrence_start_time < ?", *to)
+	}
+
+	err := query.Order("occurrence_start_time ASC").Find(&rooms).Error
+	return rooms, err
+}
+
+// FindOccurrenceRoom retrieves the room generated for a single occurrence
+func (r *seriesRepository) FindOccurrenceRoom(ctx context.Context, seriesID uuid.UUID, occurrenceStart time.Time) (*entities.Room, error) {
+	var room entities.Room
+	err := r.db.WithContext(ctx).
+		Where("series_id = ? AND occurrence_start_time = ?", seriesID, occurrenceStart).
+		First(&room).Error
+
+	if err != nil {
+		return nil, err
+	}
+	return &room, nil
+}
@@ -9,6 +9,7 @@ import (
 	"gorm.io/gorm"
 
 	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+	"github.com/johnquangdev/meeting-assistant/internal/domain/repositories"
 )
 
 // UserRepository implements the user repository interface using GORM
@@ -112,14 +113,35 @@ func (r *UserRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
-// List lists users with pagination
-func (r *UserRepository) List(ctx context.Context, limit, offset int) ([]*entities.User, error) {
+// List lists users with filters and pagination
+func (r *UserRepository) List(ctx context.Context, filters repositories.UserFilters) ([]*entities.User, int64, error) {
 	var users []*entities.User
-	if err := r.db.WithContext(ctx).
-		Limit(limit).
-		Offset(offset).
-		Find(&users).Error; err != nil {
-		return nil, fmt.Errorf("failed to list users: %w", err)
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&entities.User{})
+	if filters.Search != "" {
+		searchPattern := fmt.Sprintf("%%%s%%", filters.Search)
+		query = query.Where("email ILIKE ? OR name ILIKE ?", searchPattern, searchPattern)
+	}
+	if filters.Role != nil {
+		query = query.Where("role = ?", *filters.Role)
+	}
+	if filters.IsActive != nil {
+		query = query.Where("is_active = ?", *filters.IsActive)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count users: %w", err)
+	}
+
+	if filters.Limit > 0 {
+		query = query.Limit(filters.Limit)
+	}
+	if filters.Offset > 0 {
+		query = query.Offset(filters.Offset)
+	}
+	if err := query.Order("created_at DESC").Find(&users).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to list users: %w", err)
 	}
-	return users, nil
+	return users, total, nil
 }
@@ -0,0 +1,80 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxAgendaItems is the most items an agenda may have
+const MaxAgendaItems = 50
+
+// AgendaItem is a topic on the agenda of a meeting, with an optional owner and time box
+type AgendaItem struct {
+	ID              uuid.UUID  `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	RoomID          uuid.UUID  `gorm:"type:uuid;not null;index" json:"room_id"`
+	Position        int        `gorm:"not null" json:"position"` // 0-based order on the agenda
+	Title           string     `gorm:"type:varchar(255);not null" json:"title"`
+	Description     *string    `gorm:"type:text" json:"description,omitempty"`
+	OwnerID         *uuid.UUID `gorm:"type:uuid" json:"owner_id,omitempty"` // User presenting the item
+	Owner           *User      `gorm:"foreignKey:OwnerID" json:"owner,omitempty"`
+	DurationMinutes int        `gorm:"default:0" json:"duration_minutes"` // Time box (0 = none)
+	StartedAt       *time.Time `json:"started_at,omitempty"`              // First time the item became current
+	EndedAt         *time.Time `json:"ended_at,omitempty"`                // Last time the meeting moved on from the item
+	CreatedAt       time.Time  `gorm:"default:now()" json:"created_at"`
+	UpdatedAt       time.Time  `gorm:"default:now()" json:"updated_at"`
+}
+
+// TableName specifies the table name for AgendaItem
+func (AgendaItem) TableName() string {
+	return "agenda_items"
+}
+
+// Number is the 1-based number of the item on the agenda, as shown to people and to the AI
+func (a *AgendaItem) Number() int {
+	return a.Position + 1
+}
+
+// OwnerName returns the display name of the owner (empty without owner)
+func (a *AgendaItem) OwnerName() string {
+	if a.Owner == nil {
+		return ""
+	}
+	return a.Owner.Name
+}
+
+// Start makes the item current. Revisiting an item keeps its first start.
+func (a *AgendaItem) Start(now time.Time) {
+	if a.StartedAt == nil {
+		a.StartedAt = &now
+	}
+	a.EndedAt = nil
+}
+
+// End records that the meeting moved on from the item
+func (a *AgendaItem) End(now time.Time) {
+	if a.StartedAt != nil {
+		a.EndedAt = &now
+	}
+}
+
+// IsOvertime checks if the item ran past its time box
+func (a *AgendaItem) IsOvertime(now time.Time) bool {
+	if a.DurationMinutes <= 0 || a.StartedAt == nil {
+		return false
+	}
+	end := now
+	if a.EndedAt != nil {
+		end = *a.EndedAt
+	}
+	return end.Sub(*a.StartedAt) > time.Duration(a.DurationMinutes)*time.Minute
+}
+
+// AgendaCoverage tells how an agenda item was covered in a meeting, as found by the AI analysis
+type AgendaCoverage struct {
+	AgendaItem       int    `json:"agenda_item"` // 1-based number of the item
+	Title            string `json:"title"`
+	Covered          bool   `json:"covered"`
+	Summary          string `json:"summary,omitempty"`
+	TimestampSeconds int    `json:"timestamp_seconds,omitempty"` // Where the discussion of the item starts
+}
@@ -38,7 +38,8 @@ type AIJob struct {
 	JobType       AIJobType   `json:"job_type" gorm:"type:varchar(50);not null;index"`
 	Status        AIJobStatus `json:"status" gorm:"type:varchar(50);not null;index;default:'pending'"`
 	ExternalJobID *string     `json:"external_job_id,omitempty" gorm:"type:varchar(255);index"` // AssemblyAI transcript ID (nullable)
-	RecordingURL  string      `json:"recording_url" gorm:"type:text;not null"`
+	RecordingURL  string      `json:"recording_url" gorm:"type:text;not null"`                  // Object key in the recordings bucket, or a URL for files stored elsewhere
+	RecordingID   *uuid.UUID  `json:"recording_id,omitempty" gorm:"type:uuid"`                  // Recording transcribed (one job per recording)
 	TranscriptID  *uuid.UUID  `json:"transcript_id,omitempty" gorm:"type:uuid;index"`
 
 	// Processing details
@@ -63,6 +64,10 @@ type AIJobMetadata struct {
 	ProcessingTimeMs int64                  `json:"processing_time_ms,omitempty"`
 	ErrorDetails     map[string]interface{} `json:"error_details,omitempty"`
 	WebhookAttempts  int                    `json:"webhook_attempts,omitempty"`
+
+	// Recordings paused and resumed have a file per segment, transcribed one after the other into one transcript
+	RecordingKeys       []string `json:"recording_keys,omitempty"`
+	SegmentsTranscribed int      `json:"segments_transcribed,omitempty"`
 }
 
 // Scan implements sql.Scanner interface for GORM
@@ -80,13 +85,13 @@ func (m AIJobMetadata) Value() (driver.Value, error) {
 }
 
 // NewAIJob creates a new AI job
-func NewAIJob(meetingID uuid.UUID, jobType AIJobType, recordingURL string) *AIJob {
+func NewAIJob(meetingID uuid.UUID, jobType AIJobType, recordingKey string) *AIJob {
 	return &AIJob{
 		ID:           uuid.New(),
 		MeetingID:    meetingID,
 		JobType:      jobType,
 		Status:       AIJobStatusPending,
-		RecordingURL: recordingURL,
+		RecordingURL: recordingKey,
 		RetryCount:   0,
 		MaxRetries:   3,
 		CreatedAt:    time.Now(),
@@ -94,6 +99,47 @@ func NewAIJob(meetingID uuid.UUID, jobType AIJobType, recordingURL string) *AIJo
 	}
 }
 
+// NewRecordingAIJob creates the transcription job of a completed recording, covering all its segments
+func NewRecordingAIJob(recording *Recording) *AIJob {
+	keys := recording.ObjectNames()
+	if len(keys) == 0 {
+		return nil
+	}
+
+	job := NewAIJob(recording.RoomID, AIJobTypeTranscription, keys[0])
+	job.RecordingID = &recording.ID
+	job.Metadata.RecordingKeys = keys
+	return job
+}
+
+// RecordingKey returns the file to transcribe next: the first segment not transcribed yet
+func (j *AIJob) RecordingKey() string {
+	if j.Metadata.SegmentsTranscribed < len(j.Metadata.RecordingKeys) {
+		return j.Metadata.RecordingKeys[j.Metadata.SegmentsTranscribed]
+	}
+	return j.RecordingURL
+}
+
+// TranscriptComplete checks if every segment of the recording is in the transcript
+func (j *AIJob) TranscriptComplete() bool {
+	return j.TranscriptID != nil && j.Metadata.SegmentsTranscribed >= len(j.Metadata.RecordingKeys)
+}
+
+// SegmentTranscribed records that the current segment was added to the transcript. The job is then
+// ready for the summary, or back in the queue to submit the next segment.
+func (j *AIJob) SegmentTranscribed(transcriptID uuid.UUID, audioSeconds int) {
+	j.TranscriptID = &transcriptID
+	j.Metadata.SegmentsTranscribed++
+	j.Metadata.DurationSeconds += audioSeconds
+	if j.TranscriptComplete() {
+		j.Status = AIJobStatusTranscriptReady
+	} else {
+		j.Status = AIJobStatusPending
+		j.ExternalJobID = nil // A repeated webhook of the previous segment no longer matches the job
+	}
+	j.UpdatedAt = time.Now()
+}
+
 // IsRetryable checks if job can be retried
 func (j *AIJob) IsRetryable() bool {
 	return j.RetryCount < j.MaxRetries && j.Status == AIJobStatusFailed
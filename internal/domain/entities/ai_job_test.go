@@ -0,0 +1,84 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestNewRecordingAIJob_TranscribesEverySegment(t *testing.T) {
+	start := time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+	recording := &Recording{ID: uuid.New(), RoomID: uuid.New(), StartedAt: start}
+	recording.StartSegment("EG_1", start)
+	recording.Pause(start.Add(5 * time.Minute))
+	recording.StartSegment("EG_2", start.Add(10*time.Minute))
+	recording.Stop(start.Add(20 * time.Minute))
+	recording.AttachFile("EG_1", "rooms/a/EG_1.mp4", "https://storage/rooms/a/EG_1.mp4", 100)
+	recording.AttachFile("EG_2", "rooms/a/EG_2.mp4", "https://storage/rooms/a/EG_2.mp4", 50)
+
+	job := NewRecordingAIJob(recording)
+	if job == nil || job.RecordingID == nil || *job.RecordingID != recording.ID {
+		t.Fatalf("expected a job for recording %s, got %+v", recording.ID, job)
+	}
+	if job.MeetingID != recording.RoomID || job.Status != AIJobStatusPending {
+		t.Fatalf("meeting = %s, status = %s, want %s pending", job.MeetingID, job.Status, recording.RoomID)
+	}
+
+	transcriptID := uuid.New()
+	externalID := "tr_1"
+	for i, key := range []string{"rooms/a/EG_1.mp4", "rooms/a/EG_2.mp4"} {
+		if got := job.RecordingKey(); got != key {
+			t.Fatalf("segment %d: recording key = %q, want %q", i, got, key)
+		}
+		if job.TranscriptComplete() {
+			t.Fatalf("segment %d: transcript complete before every segment was transcribed", i)
+		}
+		job.MarkAsSubmitted(externalID)
+		job.SegmentTranscribed(transcriptID, 300)
+	}
+
+	if !job.TranscriptComplete() || job.Status != AIJobStatusTranscriptReady {
+		t.Fatalf("status = %s, want transcript ready", job.Status)
+	}
+	if job.Metadata.DurationSeconds != 600 {
+		t.Fatalf("duration = %d, want 600", job.Metadata.DurationSeconds)
+	}
+}
+
+func TestAIJob_SegmentTranscribedQueuesNextSegment(t *testing.T) {
+	job := NewAIJob(uuid.New(), AIJobTypeTranscription, "rooms/a/EG_1.mp4")
+	job.Metadata.RecordingKeys = []string{"rooms/a/EG_1.mp4", "rooms/a/EG_2.mp4"}
+	job.MarkAsSubmitted("tr_1")
+
+	job.SegmentTranscribed(uuid.New(), 120)
+	if job.Status != AIJobStatusPending {
+		t.Fatalf("status = %s, want pending for the next segment", job.Status)
+	}
+	// The transcript ID of the first segment must not match a repeated webhook
+	if job.ExternalJobID != nil {
+		t.Fatalf("external job ID = %s, want cleared", *job.ExternalJobID)
+	}
+}
+
+func TestAIJob_WithoutRecordingKeys(t *testing.T) {
+	job := NewAIJob(uuid.New(), AIJobTypeTranscription, "rooms/a/legacy.mp4")
+
+	if got := job.RecordingKey(); got != "rooms/a/legacy.mp4" {
+		t.Fatalf("recording key = %q, want the recording URL", got)
+	}
+	if job.TranscriptComplete() {
+		t.Fatalf("transcript complete without a transcript")
+	}
+
+	job.SegmentTranscribed(uuid.New(), 60)
+	if !job.TranscriptComplete() || job.Status != AIJobStatusTranscriptReady {
+		t.Fatalf("status = %s, want transcript ready", job.Status)
+	}
+}
+
+func TestNewRecordingAIJob_WithoutFiles(t *testing.T) {
+	if job := NewRecordingAIJob(&Recording{ID: uuid.New()}); job != nil {
+		t.Fatalf("expected no job for a recording without files, got %+v", job)
+	}
+}
@@ -19,6 +19,7 @@ type AnalysisResult struct {
 	SpeakerSentiment   map[string]float64            `json:"speaker_sentiment"`
 	EngagementScore    float64                       `json:"engagement_score"`
 	ParticipantBalance map[string]ParticipantMetrics `json:"participant_balance"`
+	AgendaCoverage     []AgendaCoverage              `json:"agenda_coverage,omitempty"` // Only when the meeting has an agenda
 }
 
 // KeyPoint represents a key point discussed in the meeting
@@ -26,7 +27,8 @@ type KeyPoint struct {
 	Text               string `json:"text"`
 	TimestampSeconds   int    `json:"timestamp_seconds"`
 	MentionedBySpeaker string `json:"mentioned_by_speaker"`
-	Importance         string `json:"importance"` // low, medium, high
+	Importance         string `json:"importance"`            // low, medium, high
+	AgendaItem         int    `json:"agenda_item,omitempty"` // 1-based agenda item discussed (0 = none)
 }
 
 // Decision represents a decision made during the meeting
@@ -34,7 +36,8 @@ type Decision struct {
 	DecisionText     string `json:"decision_text"`
 	Owner            string `json:"owner"`
 	TimestampSeconds int    `json:"timestamp_seconds"`
-	Impact           string `json:"impact"` // low, medium, high
+	Impact           string `json:"impact"`                // low, medium, high
+	AgendaItem       int    `json:"agenda_item,omitempty"` // 1-based agenda item decided on (0 = none)
 }
 
 // NextStep represents a next step or follow-up action
@@ -54,6 +57,7 @@ type ActionItemExtracted struct {
 	Priority            string `json:"priority"` // low, medium, high, urgent
 	TranscriptReference string `json:"transcript_reference"`
 	TimestampInMeeting  int    `json:"timestamp_in_meeting"`
+	AgendaItem          int    `json:"agenda_item,omitempty"` // 1-based agenda item it came from (0 = none)
 }
 
 // ParticipantMetrics represents engagement metrics for a participant
@@ -67,25 +71,27 @@ type ParticipantMetrics struct {
 
 // MeetingSummary represents the complete analysis of a meeting
 type MeetingSummary struct {
-	ID                 uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
-	RoomID             uuid.UUID `json:"room_id" gorm:"type:uuid;not null;uniqueIndex"`
-	TranscriptID       uuid.UUID `json:"transcript_id" gorm:"type:uuid;index"`
-	ExecutiveSummary   string    `json:"executive_summary" gorm:"type:text;not null"`
-	KeyPoints          []byte    `json:"key_points,omitempty" gorm:"type:jsonb"`
-	Decisions          []byte    `json:"decisions,omitempty" gorm:"type:jsonb"`
-	Topics             []byte    `json:"topics,omitempty" gorm:"type:jsonb"`
-	OpenQuestions      []byte    `json:"open_questions,omitempty" gorm:"type:jsonb"`
-	NextSteps          []byte    `json:"next_steps,omitempty" gorm:"type:jsonb"`
-	OverallSentiment   float64   `json:"overall_sentiment,omitempty"`
-	SentimentBreakdown []byte    `json:"sentiment_breakdown,omitempty" gorm:"type:jsonb"`
-	TotalSpeakingTime  int       `json:"total_speaking_time,omitempty"`
-	ParticipantBalance float64   `json:"participant_balance_score,omitempty"`
-	EngagementScore    float64   `json:"engagement_score,omitempty"`
-	ModelUsed          string    `json:"model_used,omitempty" gorm:"type:varchar(50)"`
-	ProcessingTime     int       `json:"processing_time,omitempty"` // in milliseconds
-	Metadata           []byte    `json:"metadata,omitempty" gorm:"type:jsonb"`
-	CreatedAt          time.Time `json:"created_at" gorm:"autoCreateTime"`
-	UpdatedAt          time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+	ID                 uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	RoomID             uuid.UUID  `json:"room_id" gorm:"type:uuid;not null;uniqueIndex"`
+	OrganizationID     *uuid.UUID `json:"organization_id,omitempty" gorm:"type:uuid;index"` // Copied from the room
+	TranscriptID       uuid.UUID  `json:"transcript_id" gorm:"type:uuid;index"`
+	ExecutiveSummary   string     `json:"executive_summary" gorm:"type:text;not null"`
+	KeyPoints          []byte     `json:"key_points,omitempty" gorm:"type:jsonb"`
+	Decisions          []byte     `json:"decisions,omitempty" gorm:"type:jsonb"`
+	Topics             []byte     `json:"topics,omitempty" gorm:"type:jsonb"`
+	OpenQuestions      []byte     `json:"open_questions,omitempty" gorm:"type:jsonb"`
+	NextSteps          []byte     `json:"next_steps,omitempty" gorm:"type:jsonb"`
+	OverallSentiment   float64    `json:"overall_sentiment,omitempty"`
+	SentimentBreakdown []byte     `json:"sentiment_breakdown,omitempty" gorm:"type:jsonb"`
+	TotalSpeakingTime  int        `json:"total_speaking_time,omitempty"`
+	ParticipantBalance float64    `json:"participant_balance_score,omitempty"`
+	EngagementScore    float64    `json:"engagement_score,omitempty"`
+	ModelUsed          string     `json:"model_used,omitempty" gorm:"type:varchar(50)"`
+	ProcessingTime     int        `json:"processing_time,omitempty"` // in milliseconds
+	Metadata           []byte     `json:"metadata,omitempty" gorm:"type:jsonb"`
+	AgendaCoverage     []byte     `json:"agenda_coverage,omitempty" gorm:"type:jsonb"` // []AgendaCoverage
+	CreatedAt          time.Time  `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt          time.Time  `json:"updated_at" gorm:"autoUpdateTime"`
 }
 
 // TableName specifies the table name for MeetingSummary
@@ -107,7 +113,9 @@ func NewMeetingSummary(roomID, transcriptID uuid.UUID) *MeetingSummary {
 type ActionItem struct {
 	ID                  uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
 	RoomID              uuid.UUID  `json:"room_id" gorm:"type:uuid;not null;index"`
+	OrganizationID      *uuid.UUID `json:"organization_id,omitempty" gorm:"type:uuid;index"` // Copied from the room
 	SummaryID           *uuid.UUID `json:"summary_id,omitempty" gorm:"type:uuid;index"`
+	AgendaItemID        *uuid.UUID `json:"agenda_item_id,omitempty" gorm:"type:uuid"`
 	AssignedTo          *uuid.UUID `json:"assigned_to,omitempty" gorm:"type:uuid"`
 	CreatedBy           *uuid.UUID `json:"created_by,omitempty" gorm:"type:uuid"`
 	Title               string     `json:"title" gorm:"type:varchar(500);not null"`
@@ -0,0 +1,118 @@
+package entities
+
+import (
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AttendanceSession records one LiveKit session of a participant, from connecting to disconnecting.
+// Someone who drops and rejoins gets a session per connection.
+type AttendanceSession struct {
+	ID            uuid.UUID    `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	RoomID        uuid.UUID    `gorm:"type:uuid;not null;index" json:"room_id"`
+	ParticipantID uuid.UUID    `gorm:"type:uuid;not null;index" json:"participant_id"`
+	Participant   *Participant `gorm:"foreignKey:ParticipantID" json:"participant,omitempty"`
+	Identity      string       `gorm:"type:varchar(255);not null" json:"identity"`              // LiveKit identity
+	SessionSID    string       `gorm:"column:session_sid;type:varchar(255)" json:"session_sid"` // LiveKit participant SID of the connection
+	JoinedAt      time.Time    `gorm:"not null" json:"joined_at"`
+	LeftAt        *time.Time   `json:"left_at,omitempty"`
+	Duration      *int         `json:"duration,omitempty"` // seconds
+	CreatedAt     time.Time    `gorm:"default:now()" json:"created_at"`
+}
+
+// TableName specifies the table name for AttendanceSession
+func (AttendanceSession) TableName() string {
+	return "attendance_sessions"
+}
+
+// IsOpen checks if the participant is still connected in this session
+func (s *AttendanceSession) IsOpen() bool {
+	return s.LeftAt == nil
+}
+
+// Attendance sums up the sessions of a participant in a meeting
+type Attendance struct {
+	Participant   *Participant
+	Sessions      []*AttendanceSession
+	FirstJoinedAt *time.Time
+	LastLeftAt    *time.Time // nil while still connected
+	TotalSeconds  int        // Time connected, overlapping sessions (e.g. two tabs) counted once
+	LateSeconds   int        // First join after the scheduled (or actual) start
+	EarlySeconds  int        // Last leave before the scheduled (or actual) end
+}
+
+// SummarizeAttendance computes the attendance of a participant from its sessions.
+// Open sessions count until the room ended, or until now while it is running.
+func SummarizeAttendance(room *Room, participant *Participant, sessions []*AttendanceSession, now time.Time) *Attendance {
+	attendance := &Attendance{Participant: participant, Sessions: sessions}
+	if len(sessions) == 0 {
+		return attendance
+	}
+
+	end := now
+	if room.EndedAt != nil {
+		end = *room.EndedAt
+	}
+
+	type interval struct{ from, to time.Time }
+	intervals := make([]interval, 0, len(sessions))
+	open := false
+	for _, s := range sessions {
+		to := end
+		if s.LeftAt != nil {
+			to = *s.LeftAt
+		} else {
+			open = true
+		}
+		if to.Before(s.JoinedAt) {
+			to = s.JoinedAt
+		}
+		intervals = append(intervals, interval{s.JoinedAt, to})
+	}
+	sort.Slice(intervals, func(i, j int) bool { return intervals[i].from.Before(intervals[j].from) })
+
+	firstJoined := intervals[0].from
+	lastLeft := intervals[0].to
+	var total time.Duration
+	current := intervals[0]
+	for _, iv := range intervals[1:] {
+		if iv.to.After(lastLeft) {
+			lastLeft = iv.to
+		}
+		if iv.from.After(current.to) {
+			total += current.to.Sub(current.from)
+			current = iv
+			continue
+		}
+		if iv.to.After(current.to) {
+			current.to = iv.to
+		}
+	}
+	total += current.to.Sub(current.from)
+
+	attendance.FirstJoinedAt = &firstJoined
+	attendance.TotalSeconds = int(total.Seconds())
+	if !open {
+		attendance.LastLeftAt = &lastLeft
+	}
+
+	start := room.ScheduledStartTime
+	if start == nil {
+		start = room.StartedAt
+	}
+	if start != nil && firstJoined.After(*start) {
+		attendance.LateSeconds = int(firstJoined.Sub(*start).Seconds())
+	}
+
+	scheduledEnd := room.ScheduledEndTime
+	if scheduledEnd == nil {
+		scheduledEnd = room.EndedAt
+	}
+	if scheduledEnd != nil && !open && lastLeft.Before(*scheduledEnd) {
+		attendance.EarlySeconds = int(scheduledEnd.Sub(lastLeft).Seconds())
+	}
+
+	return attendance
+}
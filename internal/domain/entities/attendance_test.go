@@ -0,0 +1,78 @@
+package entities
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSummarizeAttendance_MergesOverlappingSessions(t *testing.T) {
+	start := time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+	at := func(minutes int) time.Time { return start.Add(time.Duration(minutes) * time.Minute) }
+	left := func(minutes int) *time.Time { t := at(minutes); return &t }
+
+	scheduledEnd := at(60)
+	room := &Room{ScheduledStartTime: &start, ScheduledEndTime: &scheduledEnd}
+
+	// Joined 5 minutes late, opened a second tab, dropped and came back, left 10 minutes early
+	sessions := []*AttendanceSession{
+		{JoinedAt: at(30), LeftAt: left(50)},
+		{JoinedAt: at(5), LeftAt: left(20)},
+		{JoinedAt: at(10), LeftAt: left(25)},
+		{JoinedAt: at(45), LeftAt: left(40)}, // Left before joining (clock skew), counted as zero
+	}
+
+	attendance := SummarizeAttendance(room, &Participant{}, sessions, at(120))
+
+	if got, want := attendance.TotalSeconds, 40*60; got != want {
+		t.Fatalf("total = %ds, want %ds", got, want)
+	}
+	if attendance.FirstJoinedAt == nil || !attendance.FirstJoinedAt.Equal(at(5)) {
+		t.Fatalf("first joined = %v, want %v", attendance.FirstJoinedAt, at(5))
+	}
+	if attendance.LastLeftAt == nil || !attendance.LastLeftAt.Equal(at(50)) {
+		t.Fatalf("last left = %v, want %v", attendance.LastLeftAt, at(50))
+	}
+	if got, want := attendance.LateSeconds, 5*60; got != want {
+		t.Fatalf("late = %ds, want %ds", got, want)
+	}
+	if got, want := attendance.EarlySeconds, 10*60; got != want {
+		t.Fatalf("early = %ds, want %ds", got, want)
+	}
+}
+
+func TestSummarizeAttendance_OpenSessions(t *testing.T) {
+	start := time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+	at := func(minutes int) time.Time { return start.Add(time.Duration(minutes) * time.Minute) }
+	ended := at(45)
+
+	tests := []struct {
+		name  string
+		room  *Room
+		total int
+	}{
+		{name: "running room counts until now", room: &Room{StartedAt: &start}, total: 30 * 60},
+		{name: "ended room counts until the end", room: &Room{StartedAt: &start, EndedAt: &ended}, total: 45 * 60},
+	}
+
+	for _, tt := range tests {
+		sessions := []*AttendanceSession{{JoinedAt: start}}
+		attendance := SummarizeAttendance(tt.room, &Participant{}, sessions, at(30))
+
+		if attendance.TotalSeconds != tt.total {
+			t.Fatalf("%s: total = %ds, want %ds", tt.name, attendance.TotalSeconds, tt.total)
+		}
+		if attendance.LastLeftAt != nil {
+			t.Fatalf("%s: last left = %v, want nil while connected", tt.name, attendance.LastLeftAt)
+		}
+		if attendance.LateSeconds != 0 || attendance.EarlySeconds != 0 {
+			t.Fatalf("%s: late = %ds, early = %ds, want 0", tt.name, attendance.LateSeconds, attendance.EarlySeconds)
+		}
+	}
+}
+
+func TestSummarizeAttendance_NoSessions(t *testing.T) {
+	attendance := SummarizeAttendance(&Room{}, &Participant{}, nil, time.Now())
+	if attendance.FirstJoinedAt != nil || attendance.TotalSeconds != 0 {
+		t.Fatalf("expected empty attendance, got %+v", attendance)
+	}
+}
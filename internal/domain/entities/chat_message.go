@@ -0,0 +1,62 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxChatMessageLength is the longest chat message accepted, in characters
+const MaxChatMessageLength = 4000
+
+// ChatMessage is a message posted in the chat of a meeting, kept after the LiveKit room closes
+type ChatMessage struct {
+	ID            uuid.UUID    `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	RoomID        uuid.UUID    `gorm:"type:uuid;not null;index" json:"room_id"`
+	ParticipantID uuid.UUID    `gorm:"type:uuid;not null;index" json:"participant_id"` // Author
+	Participant   *Participant `gorm:"foreignKey:ParticipantID" json:"participant,omitempty"`
+	Content       string       `gorm:"type:text;not null" json:"content"`
+	ReplyToID     *uuid.UUID   `gorm:"type:uuid" json:"reply_to_id,omitempty"` // Message this one answers
+	EditedAt      *time.Time   `json:"edited_at,omitempty"`
+	DeletedAt     *time.Time   `json:"deleted_at,omitempty"`
+	DeletedBy     *uuid.UUID   `gorm:"type:uuid" json:"deleted_by,omitempty"` // Participant who deleted the message (author or moderator)
+	CreatedAt     time.Time    `gorm:"default:now()" json:"created_at"`
+	UpdatedAt     time.Time    `gorm:"default:now()" json:"updated_at"`
+}
+
+// TableName specifies the table name for ChatMessage
+func (ChatMessage) TableName() string {
+	return "chat_messages"
+}
+
+// IsDeleted checks if the message was deleted by its author or a moderator
+func (m *ChatMessage) IsDeleted() bool {
+	return m.DeletedAt != nil
+}
+
+// IsModerated checks if the message was deleted by someone other than its author
+func (m *ChatMessage) IsModerated() bool {
+	return m.DeletedBy != nil && *m.DeletedBy != m.ParticipantID
+}
+
+// Edit replaces the content of the message
+func (m *ChatMessage) Edit(content string) {
+	now := time.Now()
+	m.Content = content
+	m.EditedAt = &now
+}
+
+// Delete marks the message as deleted by a participant
+func (m *ChatMessage) Delete(by uuid.UUID) {
+	now := time.Now()
+	m.DeletedAt = &now
+	m.DeletedBy = &by
+}
+
+// SenderName returns the display name of the author
+func (m *ChatMessage) SenderName() string {
+	if m.Participant == nil {
+		return "Participant"
+	}
+	return m.Participant.DisplayName()
+}
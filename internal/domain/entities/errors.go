@@ -11,6 +11,7 @@ var (
 	ErrInvalidName       = errors.New("invalid name")
 	ErrInvalidRole       = errors.New("invalid role")
 	ErrInvalidPassword   = errors.New("invalid password")
+	ErrUserDeactivated   = errors.New("user account is deactivated")
 
 	// OAuth errors
 	ErrOAuthProviderNotSupported = errors.New("oauth provider not supported")
@@ -0,0 +1,24 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// HandRaise records one raised hand of a participant, kept for the meeting timeline
+type HandRaise struct {
+	ID            uuid.UUID    `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	RoomID        uuid.UUID    `gorm:"type:uuid;not null;index" json:"room_id"`
+	ParticipantID uuid.UUID    `gorm:"type:uuid;not null;index" json:"participant_id"`
+	Participant   *Participant `gorm:"foreignKey:ParticipantID" json:"participant,omitempty"`
+	RaisedAt      time.Time    `gorm:"not null" json:"raised_at"`
+	LoweredAt     *time.Time   `json:"lowered_at,omitempty"`
+	LoweredBy     *uuid.UUID   `gorm:"type:uuid" json:"lowered_by,omitempty"` // Moderator who lowered the hand (nil = participant or system)
+	CreatedAt     time.Time    `gorm:"default:now()" json:"created_at"`
+}
+
+// TableName specifies the table name for HandRaise
+func (HandRaise) TableName() string {
+	return "hand_raises"
+}
@@ -0,0 +1,85 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+// SeriesStatus represents the lifecycle state of a recurring meeting series
+type SeriesStatus string
+
+const (
+	SeriesStatusActive SeriesStatus = "active"
+	SeriesStatusEnded  SeriesStatus = "ended"
+)
+
+// SeriesExceptionType describes how a single occurrence deviates from the rule
+type SeriesExceptionType string
+
+const (
+	SeriesExceptionCancelled   SeriesExceptionType = "cancelled"
+	SeriesExceptionRescheduled SeriesExceptionType = "rescheduled"
+)
+
+// MeetingSeries represents a recurring meeting defined by an RRULE.
+// Occurrences are materialized as regular rooms linked through Room.SeriesID.
+type MeetingSeries struct {
+	ID                uuid.UUID      `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	HostID            uuid.UUID      `gorm:"type:uuid;not null;index" json:"host_id"`
+	Host              *User          `gorm:"foreignKey:HostID" json:"host,omitempty"`
+	Name              string         `gorm:"type:varchar(255);not null" json:"name"`
+	Description       *string        `gorm:"type:text" json:"description,omitempty"`
+	RoomType          RoomType       `gorm:"type:varchar(20);not null;default:'scheduled'" json:"room_type"`
+	MaxParticipants   int            `gorm:"default:10" json:"max_participants"`
+	Settings          datatypes.JSON `gorm:"type:jsonb;default:'{}'" json:"settings"`
+	RRule             string         `gorm:"column:rrule;type:text;not null" json:"rrule"`
+	Timezone          string         `gorm:"type:varchar(50);not null;default:'UTC'" json:"timezone"`
+	StartTime         time.Time      `gorm:"not null" json:"start_time"` // DTSTART (first occurrence)
+	DurationMinutes   int            `gorm:"not null;default:60" json:"duration_minutes"`
+	Status            SeriesStatus   `gorm:"type:varchar(20);not null;default:'active';index" json:"status"`
+	MaterializedUntil *time.Time     `json:"materialized_until,omitempty"`
+	CreatedAt         time.Time      `gorm:"default:now()" json:"created_at"`
+	UpdatedAt         time.Time      `gorm:"default:now()" json:"updated_at"`
+}
+
+// TableName specifies the table name for MeetingSeries
+func (MeetingSeries) TableName() string {
+	return "meeting_series"
+}
+
+// IsActive checks if the series still produces occurrences
+func (s *MeetingSeries) IsActive() bool {
+	return s.Status == SeriesStatusActive
+}
+
+// Duration returns the length of a single occurrence
+func (s *MeetingSeries) Duration() time.Duration {
+	return time.Duration(s.DurationMinutes) * time.Minute
+}
+
+// SeriesException records a cancelled or rescheduled occurrence of a series.
+// OriginalStartTime identifies the occurrence as generated by the rule.
+type SeriesException struct {
+	ID                uuid.UUID           `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	SeriesID          uuid.UUID           `gorm:"type:uuid;not null;index" json:"series_id"`
+	OriginalStartTime time.Time           `gorm:"not null" json:"original_start_time"`
+	Type              SeriesExceptionType `gorm:"type:varchar(20);not null" json:"type"`
+	NewStartTime      *time.Time          `json:"new_start_time,omitempty"`
+	NewEndTime        *time.Time          `json:"new_end_time,omitempty"`
+	Reason            *string             `gorm:"type:text" json:"reason,omitempty"`
+	CreatedBy         uuid.UUID           `gorm:"type:uuid;not null" json:"created_by"`
+	CreatedAt         time.Time           `gorm:"default:now()" json:"created_at"`
+	UpdatedAt         time.Time           `gorm:"default:now()" json:"updated_at"`
+}
+
+// TableName specifies the table name for SeriesException
+func (SeriesException) TableName() string {
+	return "meeting_series_exceptions"
+}
+
+// IsCancelled checks if the exception cancels the occurrence
+func (e *SeriesException) IsCancelled() bool {
+	return e.Type == SeriesExceptionCancelled
+}
@@ -0,0 +1,221 @@
+package entities
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+// OrgRole represents the role of a member in an organization
+type OrgRole string
+
+const (
+	OrgRoleOwner  OrgRole = "owner"  // Everything, including deleting the organization and managing owners
+	OrgRoleAdmin  OrgRole = "admin"  // Settings, members and invites
+	OrgRoleMember OrgRole = "member" // Rooms of the organization
+)
+
+// IsValid checks if the role is a known organization role
+func (r OrgRole) IsValid() bool {
+	return r == OrgRoleOwner || r == OrgRoleAdmin || r == OrgRoleMember
+}
+
+// CanManage checks if the role may change settings, members and invites
+func (r OrgRole) CanManage() bool {
+	return r == OrgRoleOwner || r == OrgRoleAdmin
+}
+
+// Organization is a workspace whose members share rooms, recordings, summaries and action items.
+// Rooms without organization are personal and live outside every organization.
+type Organization struct {
+	ID        uuid.UUID      `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	Name      string         `gorm:"type:varchar(255);not null" json:"name"`
+	Slug      string         `gorm:"type:varchar(100);uniqueIndex;not null" json:"slug"`
+	Settings  datatypes.JSON `gorm:"type:jsonb;default:'{}'" json:"settings"`
+	PlanID    *uuid.UUID     `gorm:"type:uuid" json:"plan_id,omitempty"` // nil = default plan
+	CreatedBy uuid.UUID      `gorm:"type:uuid;not null" json:"created_by"`
+	CreatedAt time.Time      `gorm:"default:now()" json:"created_at"`
+	UpdatedAt time.Time      `gorm:"default:now()" json:"updated_at"`
+}
+
+// TableName specifies the table name for Organization
+func (Organization) TableName() string {
+	return "organizations"
+}
+
+// OrganizationMember is the membership of a user in an organization
+type OrganizationMember struct {
+	ID             uuid.UUID     `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	OrganizationID uuid.UUID     `gorm:"type:uuid;not null;uniqueIndex:idx_organization_members_org_user" json:"organization_id"`
+	Organization   *Organization `gorm:"foreignKey:OrganizationID" json:"organization,omitempty"`
+	UserID         uuid.UUID     `gorm:"type:uuid;not null;uniqueIndex:idx_organization_members_org_user" json:"user_id"`
+	User           *User         `gorm:"foreignKey:UserID" json:"user,omitempty"`
+	Role           OrgRole       `gorm:"type:varchar(20);not null;default:'member'" json:"role"`
+	JoinedAt       time.Time     `gorm:"not null;default:now()" json:"joined_at"`
+}
+
+// TableName specifies the table name for OrganizationMember
+func (OrganizationMember) TableName() string {
+	return "organization_members"
+}
+
+// OrganizationInvite invites someone to an organization by email.
+// It follows the lifecycle of room invitations (pending, accepted, declined, expired, revoked).
+type OrganizationInvite struct {
+	ID             uuid.UUID        `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	OrganizationID uuid.UUID        `gorm:"type:uuid;not null;index" json:"organization_id"`
+	Organization   *Organization    `gorm:"foreignKey:OrganizationID" json:"organization,omitempty"`
+	Email          string           `gorm:"type:varchar(255);not null" json:"email"`
+	Role           OrgRole          `gorm:"type:varchar(20);not null;default:'member'" json:"role"` // Role given on acceptance
+	Token          string           `gorm:"type:varchar(255);uniqueIndex;not null" json:"-"`
+	Status         InvitationStatus `gorm:"type:varchar(20);not null;default:'pending'" json:"status"`
+	InvitedBy      uuid.UUID        `gorm:"type:uuid;not null" json:"invited_by"`
+	Inviter        *User            `gorm:"foreignKey:InvitedBy" json:"inviter,omitempty"`
+	ExpiresAt      time.Time        `gorm:"not null" json:"expires_at"`
+	RespondedAt    *time.Time       `json:"responded_at,omitempty"`
+	CreatedAt      time.Time        `gorm:"default:now()" json:"created_at"`
+}
+
+// TableName specifies the table name for OrganizationInvite
+func (OrganizationInvite) TableName() string {
+	return "organization_invites"
+}
+
+// IsPending checks if the invite can still be accepted
+func (i *OrganizationInvite) IsPending() bool {
+	return i.Status == InvitationStatusPending && time.Now().Before(i.ExpiresAt)
+}
+
+// EffectiveStatus returns the status, reporting stale pending invites as expired
+func (i *OrganizationInvite) EffectiveStatus() InvitationStatus {
+	if i.Status == InvitationStatusPending && !time.Now().Before(i.ExpiresAt) {
+		return InvitationStatusExpired
+	}
+	return i.Status
+}
+
+// IsFor checks if the invite was sent to the given email address
+func (i *OrganizationInvite) IsFor(email string) bool {
+	return email != "" && strings.EqualFold(i.Email, email)
+}
+
+// Accept marks the invite as accepted
+func (i *OrganizationInvite) Accept() {
+	now := time.Now()
+	i.Status = InvitationStatusAccepted
+	i.RespondedAt = &now
+}
+
+// Revoke cancels the invite so its token can no longer be used
+func (i *OrganizationInvite) Revoke() {
+	i.Status = InvitationStatusRevoked
+}
+
+// Languages the AI pipeline can be told to use
+var organizationLanguages = map[string]bool{"vi": true, "en": true}
+
+// OrganizationSettings holds the organization-wide defaults stored in Organization.Settings
+type OrganizationSettings struct {
+	RoomDefaults map[string]interface{} `json:"room_defaults,omitempty"` // Room settings applied to new rooms (requests may still override them)
+	AI           OrganizationAISettings `json:"ai"`
+}
+
+// OrganizationAISettings tells the AI pipeline how to process the organization's meetings
+type OrganizationAISettings struct {
+	TranscriptionLanguage string `json:"transcription_language"`     // Spoken language of the recordings
+	SummaryLanguage       string `json:"summary_language,omitempty"` // Language of summaries (empty = language of the transcript)
+}
+
+// DefaultOrganizationSettings returns the settings of new organizations
+func DefaultOrganizationSettings() OrganizationSettings {
+	return OrganizationSettings{
+		AI: OrganizationAISettings{TranscriptionLanguage: "vi"},
+	}
+}
+
+// Validate checks the room defaults and AI languages
+func (s OrganizationSettings) Validate() error {
+	if _, err := DefaultRoomSettings().Merge(s.RoomDefaults); err != nil {
+		return fmt.Errorf("invalid room defaults: %w", err)
+	}
+	if !organizationLanguages[s.AI.TranscriptionLanguage] {
+		return fmt.Errorf("unsupported transcription language %q", s.AI.TranscriptionLanguage)
+	}
+	if s.AI.SummaryLanguage != "" && !organizationLanguages[s.AI.SummaryLanguage] {
+		return fmt.Errorf("unsupported summary language %q", s.AI.SummaryLanguage)
+	}
+	return nil
+}
+
+// Merge applies a partial settings map on top of s (room_defaults is replaced as a whole).
+// Unknown keys and values of the wrong type are rejected.
+func (s OrganizationSettings) Merge(changes map[string]interface{}) (OrganizationSettings, error) {
+	if len(changes) == 0 {
+		return s, nil
+	}
+
+	raw, err := json.Marshal(changes)
+	if err != nil {
+		return s, fmt.Errorf("failed to encode settings: %w", err)
+	}
+
+	merged := s
+	if _, ok := changes["room_defaults"]; ok {
+		merged.RoomDefaults = nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&merged); err != nil {
+		return s, err
+	}
+
+	return merged, merged.Validate()
+}
+
+// GetSettings returns the typed organization settings, filling unset values with defaults
+func (o *Organization) GetSettings() OrganizationSettings {
+	settings := DefaultOrganizationSettings()
+	if len(o.Settings) > 0 {
+		json.Unmarshal(o.Settings, &settings)
+	}
+	return settings
+}
+
+// SetSettings stores the typed settings into Organization.Settings
+func (o *Organization) SetSettings(settings OrganizationSettings) error {
+	raw, err := json.Marshal(settings)
+	if err != nil {
+		return fmt.Errorf("failed to encode settings: %w", err)
+	}
+	o.Settings = datatypes.JSON(raw)
+	return nil
+}
+
+// RoomSettings returns the settings new rooms of the organization start from
+func (o *Organization) RoomSettings() RoomSettings {
+	settings, err := DefaultRoomSettings().Merge(o.GetSettings().RoomDefaults)
+	if err != nil {
+		return DefaultRoomSettings()
+	}
+	return settings
+}
+
+var organizationSlugPattern = regexp.MustCompile(`[^a-z0-9]+`)
+
+// NewOrganizationSlug derives a URL-friendly slug from an organization name
+func NewOrganizationSlug(name string) string {
+	slug := strings.Trim(organizationSlugPattern.ReplaceAllString(strings.ToLower(name), "-"), "-")
+	if len(slug) > 60 {
+		slug = strings.TrimRight(slug[:60], "-")
+	}
+	if slug == "" {
+		slug = "org"
+	}
+	return slug
+}
@@ -1,6 +1,7 @@
 package entities
 
 import (
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -21,37 +22,122 @@ const (
 type ParticipantStatus string
 
 const (
-	ParticipantStatusInvited  ParticipantStatus = "invited"
-	ParticipantStatusWaiting  ParticipantStatus = "waiting"
-	ParticipantStatusJoined   ParticipantStatus = "joined"
-	ParticipantStatusLeft     ParticipantStatus = "left"
-	ParticipantStatusRemoved  ParticipantStatus = "removed"
-	ParticipantStatusDeclined ParticipantStatus = "declined"
-	ParticipantStatusDenied   ParticipantStatus = "denied" // Reserved for future "block" feature - currently unused (deny = delete record)
+	ParticipantStatusInvited    ParticipantStatus = "invited"
+	ParticipantStatusWaiting    ParticipantStatus = "waiting"
+	ParticipantStatusWaitlisted ParticipantStatus = "waitlisted" // Allowed in, waiting for a free seat of a full room
+	ParticipantStatusJoined     ParticipantStatus = "joined"
+	ParticipantStatusLeft       ParticipantStatus = "left"
+	ParticipantStatusRemoved    ParticipantStatus = "removed"
+	ParticipantStatusDeclined   ParticipantStatus = "declined"
+	ParticipantStatusDenied     ParticipantStatus = "denied" // Reserved for future "block" feature - currently unused (deny = delete record)
 )
 
+// RoomAction is a privileged action in a room.
+// The room host may perform every action; others only what the permission matrix grants them.
+type RoomAction string
+
+const (
+	RoomActionAdmit           RoomAction = "admit"            // Admit or deny people in the waiting room
+	RoomActionBlock           RoomAction = "block"            // Permanently block a participant
+	RoomActionRemove          RoomAction = "remove"           // Remove a participant from the meeting
+	RoomActionInvite          RoomAction = "invite"           // Invite people and manage invitations
+	RoomActionManageBreakouts RoomAction = "manage_breakouts" // Open, assign and close breakout rooms
+	RoomActionMute            RoomAction = "mute"             // Mute others and change their media permissions
+	RoomActionEnd             RoomAction = "end"              // End the meeting for everyone
+	RoomActionUpdateSettings  RoomAction = "update_settings"  // Change the room settings
+	RoomActionManageCoHosts   RoomAction = "manage_co_hosts"  // Promote/demote co-hosts and grant permissions
+	RoomActionManageHands     RoomAction = "manage_hands"     // Lower and reorder raised hands
+	RoomActionLock            RoomAction = "lock"             // Lock and unlock the room to new joins
+	RoomActionViewAttendance  RoomAction = "view_attendance"  // See and export who attended and for how long
+	RoomActionModerateChat    RoomAction = "moderate_chat"    // Delete chat messages of others
+	RoomActionManagePolls     RoomAction = "manage_polls"     // Create and close polls
+	RoomActionManageAgenda    RoomAction = "manage_agenda"    // Edit the agenda and move through its items
+	RoomActionRecord          RoomAction = "record"           // Start, stop, pause and resume the recording
+	RoomActionViewConsents    RoomAction = "view_consents"    // See and export who consented to be recorded
+	RoomActionDeleteRecording RoomAction = "delete_recording" // Delete a recording and its files
+)
+
+// coHostActions is the permission matrix of co-hosts; actions missing here are host-only.
+//
+//	action             host  co-host  participant
+//	admit / deny        yes    yes      no
+//	block               yes    yes      no
+//	remove              yes    yes      no
+//	invite              yes    yes      no
+//	manage breakouts    yes    yes      no
+//	manage hands        yes    yes      no
+//	lock / unlock       yes    yes      no
+//	view attendance     yes    yes      no
+//	moderate chat       yes    yes      no
+//	manage polls        yes    yes      no
+//	manage agenda       yes    yes      no
+//	view consents       yes    yes      no
+//	mute                yes    CanMuteOthers
+//	record              yes    CanRecord
+//	end                 yes    no       no
+//	update settings     yes    no       no
+//	manage co-hosts     yes    no       no
+//	delete recording    yes    no       no
+//
+// Co-hosts never act on the host or on other co-hosts.
+var coHostActions = map[RoomAction]bool{
+	RoomActionAdmit:           true,
+	RoomActionBlock:           true,
+	RoomActionRemove:          true,
+	RoomActionInvite:          true,
+	RoomActionManageBreakouts: true,
+	RoomActionManageHands:     true,
+	RoomActionLock:            true,
+	RoomActionViewAttendance:  true,
+	RoomActionModerateChat:    true,
+	RoomActionManagePolls:     true,
+	RoomActionManageAgenda:    true,
+	RoomActionViewConsents:    true,
+}
+
+// orgManagerActions are also granted to the owners and admins of the room's organization
+var orgManagerActions = map[RoomAction]bool{
+	RoomActionDeleteRecording: true,
+}
+
+// GrantedToOrgManagers checks if owners and admins of the room's organization may perform the action
+func (a RoomAction) GrantedToOrgManagers() bool {
+	return orgManagerActions[a]
+}
+
+// GuestIdentityPrefix prefixes the LiveKit identity of guests, who have no user ID
+const GuestIdentityPrefix = "guest_"
+
 // Participant represents a user's participation in a room
 type Participant struct {
-	ID     uuid.UUID         `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
-	RoomID uuid.UUID         `gorm:"type:uuid;not null;index" json:"room_id"`
-	Room   *Room             `gorm:"foreignKey:RoomID" json:"room,omitempty"`
-	UserID *uuid.UUID        `gorm:"type:uuid;index" json:"user_id,omitempty"`
-	User   *User             `gorm:"foreignKey:UserID" json:"user,omitempty"`
-	Role   ParticipantRole   `gorm:"type:varchar(20);default:'participant'" json:"role"`
-	Status ParticipantStatus `gorm:"type:varchar(20);default:'invited';index" json:"status"`
+	ID        uuid.UUID         `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	RoomID    uuid.UUID         `gorm:"type:uuid;not null;index" json:"room_id"`
+	Room      *Room             `gorm:"foreignKey:RoomID" json:"room,omitempty"`
+	UserID    *uuid.UUID        `gorm:"type:uuid;index" json:"user_id,omitempty"`
+	User      *User             `gorm:"foreignKey:UserID" json:"user,omitempty"`
+	GuestName *string           `gorm:"type:varchar(100)" json:"guest_name,omitempty"` // Display name of participants without an account
+	Role      ParticipantRole   `gorm:"type:varchar(20);default:'participant'" json:"role"`
+	Status    ParticipantStatus `gorm:"type:varchar(20);default:'invited';index" json:"status"`
+
+	// Breakout room the participant is currently assigned to (set on participants of the parent room)
+	BreakoutRoomID *uuid.UUID `gorm:"type:uuid;index" json:"breakout_room_id,omitempty"`
 
 	// Invitation fields
 	InvitedEmail      *string        `gorm:"type:varchar(255);index" json:"invited_email,omitempty"`
 	InvitedBy         *uuid.UUID     `gorm:"type:uuid;index" json:"invited_by,omitempty"`
 	InvitedAt         *time.Time     `json:"invited_at,omitempty"`
+	WaitlistedAt      *time.Time     `gorm:"index" json:"waitlisted_at,omitempty"` // Place in the waitlist of a full room (first come, first seated)
 	JoinedAt          *time.Time     `gorm:"index" json:"joined_at,omitempty"`
 	LeftAt            *time.Time     `json:"left_at,omitempty"`
 	Duration          *int           `json:"duration,omitempty"` // seconds in meeting
 	CanShareScreen    bool           `gorm:"default:true" json:"can_share_screen"`
+	CanPublish        bool           `gorm:"default:true" json:"can_publish"`
 	CanRecord         bool           `gorm:"default:false" json:"can_record"`
 	CanMuteOthers     bool           `gorm:"default:false" json:"can_mute_others"`
 	IsMuted           bool           `gorm:"default:false" json:"is_muted"`
 	IsHandRaised      bool           `gorm:"default:false" json:"is_hand_raised"`
+	HandRaisedAt      *time.Time     `json:"hand_raised_at,omitempty"`
+	HandQueuePosition *int           `json:"hand_queue_position,omitempty"` // Speaking order among raised hands (1 = next)
 	IsRemoved         bool           `gorm:"default:false" json:"is_removed"`
 	RemovedBy         *uuid.UUID     `gorm:"type:uuid" json:"removed_by,omitempty"`
 	RemovalReason     *string        `gorm:"type:text" json:"removal_reason,omitempty"`
@@ -72,16 +158,78 @@ func (p *Participant) IsHost() bool {
 	return p.Role == ParticipantRoleHost || p.Role == ParticipantRoleCoHost
 }
 
+// IsGuest checks if the participant joined without an account
+func (p *Participant) IsGuest() bool {
+	return p.UserID == nil && p.GuestName != nil
+}
+
+// Identity returns the LiveKit identity of the participant:
+// the user ID for registered users, GuestIdentityPrefix + participant ID for guests
+func (p *Participant) Identity() string {
+	if p.UserID != nil {
+		return p.UserID.String()
+	}
+	return GuestIdentityPrefix + p.ID.String()
+}
+
+// DisplayName returns the name shown for the participant in rooms and reports
+func (p *Participant) DisplayName() string {
+	switch {
+	case p.User != nil && p.User.Name != "":
+		return p.User.Name
+	case p.GuestName != nil:
+		return *p.GuestName
+	case p.InvitedEmail != nil:
+		return *p.InvitedEmail
+	default:
+		return "Participant"
+	}
+}
+
+// ParseGuestIdentity extracts the participant ID from a guest LiveKit identity
+func ParseGuestIdentity(identity string) (uuid.UUID, bool) {
+	raw, ok := strings.CutPrefix(identity, GuestIdentityPrefix)
+	if !ok {
+		return uuid.Nil, false
+	}
+	id, err := uuid.Parse(raw)
+	if err != nil {
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
 // IsActive checks if the participant is currently in the room
 func (p *Participant) IsActive() bool {
 	return p.Status == ParticipantStatusJoined && p.LeftAt == nil
 }
 
-// Join marks the participant as joined
+// WasAdmitted checks if the participant was let into the room before: it joined (and maybe left since)
+// or waits for a free seat. Invited, waiting and declined participants were never let in.
+func (p *Participant) WasAdmitted() bool {
+	switch p.Status {
+	case ParticipantStatusJoined, ParticipantStatusWaitlisted:
+		return true
+	case ParticipantStatusLeft:
+		return p.JoinedAt != nil
+	}
+	return false
+}
+
+// Join marks the participant as joined (again, when it left before)
 func (p *Participant) Join() {
 	now := time.Now()
 	p.Status = ParticipantStatusJoined
 	p.JoinedAt = &now
+	p.LeftAt = nil
+	p.WaitlistedAt = nil
+}
+
+// Waitlist puts the participant at the end of the waitlist of its (full) room
+func (p *Participant) Waitlist() {
+	now := time.Now()
+	p.Status = ParticipantStatusWaitlisted
+	p.WaitlistedAt = &now
 }
 
 // Leave marks the participant as left and calculates duration
@@ -89,6 +237,7 @@ func (p *Participant) Leave() {
 	now := time.Now()
 	p.Status = ParticipantStatusLeft
 	p.LeftAt = &now
+	p.LowerHand()
 
 	if p.JoinedAt != nil {
 		duration := int(now.Sub(*p.JoinedAt).Seconds())
@@ -104,6 +253,7 @@ func (p *Participant) Remove(removedBy uuid.UUID, reason string) {
 	p.RemovedBy = &removedBy
 	p.RemovalReason = &reason
 	p.LeftAt = &now
+	p.LowerHand()
 
 	if p.JoinedAt != nil {
 		duration := int(now.Sub(*p.JoinedAt).Seconds())
@@ -111,6 +261,28 @@ func (p *Participant) Remove(removedBy uuid.UUID, reason string) {
 	}
 }
 
+// LowerHand takes the participant out of the speaking queue
+func (p *Participant) LowerHand() {
+	p.IsHandRaised = false
+	p.HandRaisedAt = nil
+	p.HandQueuePosition = nil
+}
+
+// Can checks if the participant may perform a privileged action in its room.
+// The room host is authorized by Room.HostID, not by this check.
+func (p *Participant) Can(action RoomAction) bool {
+	if p.IsRemoved || p.IsGuest() {
+		return false
+	}
+	switch action {
+	case RoomActionMute:
+		return p.CanMuteOthers
+	case RoomActionRecord:
+		return p.CanRecord
+	}
+	return p.IsHost() && coHostActions[action]
+}
+
 // PromoteToHost promotes the participant to host role
 func (p *Participant) PromoteToHost() {
 	p.Role = ParticipantRoleHost
@@ -124,3 +296,10 @@ func (p *Participant) PromoteToCoHost() {
 	p.CanRecord = true
 	p.CanMuteOthers = true
 }
+
+// DemoteToParticipant takes the host or co-host role and its permissions away
+func (p *Participant) DemoteToParticipant() {
+	p.Role = ParticipantRoleParticipant
+	p.CanRecord = false
+	p.CanMuteOthers = false
+}
@@ -0,0 +1,73 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Plan caps what a user (for personal rooms) or an organization (for its rooms) may use.
+// A limit of 0 means unlimited; monthly limits reset on the first day of each month (UTC).
+type Plan struct {
+	ID                    uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	Code                  string    `gorm:"type:varchar(50);uniqueIndex;not null" json:"code"`
+	Name                  string    `gorm:"type:varchar(100);not null" json:"name"`
+	MaxParticipants       int       `gorm:"not null;default:10" json:"max_participants"`
+	MaxConcurrentRooms    int       `gorm:"not null;default:0" json:"max_concurrent_rooms"`
+	MonthlyMeetingMinutes int       `gorm:"not null;default:0" json:"monthly_meeting_minutes"`
+	RecordingStorageBytes int64     `gorm:"not null;default:0" json:"recording_storage_bytes"`
+	MonthlyAIMinutes      int       `gorm:"column:monthly_ai_minutes;not null;default:0" json:"monthly_ai_minutes"`
+	IsDefault             bool      `gorm:"not null;default:false" json:"is_default"`
+	CreatedAt             time.Time `gorm:"default:now()" json:"created_at"`
+	UpdatedAt             time.Time `gorm:"default:now()" json:"updated_at"`
+}
+
+// TableName specifies the table name for Plan
+func (Plan) TableName() string {
+	return "plans"
+}
+
+// Usage is what a user or an organization uses against its plan
+type Usage struct {
+	ActiveRooms    int64     // Rooms in progress (breakout rooms excluded)
+	MeetingSeconds int64     // Time spent in rooms started since PeriodStart, ongoing meetings included
+	RecordingBytes int64     // Size of the recordings kept in storage
+	AISeconds      int64     // Audio processed by AI jobs completed since PeriodStart
+	PeriodStart    time.Time // Start of the current month (UTC)
+}
+
+// MeetingMinutes returns the meeting time used, in whole minutes
+func (u *Usage) MeetingMinutes() int64 {
+	return u.MeetingSeconds / 60
+}
+
+// AIMinutes returns the audio processed by AI, in whole minutes
+func (u *Usage) AIMinutes() int64 {
+	return u.AISeconds / 60
+}
+
+// UsagePeriodStart returns the start of the month metered usage belongs to
+func UsagePeriodStart(now time.Time) time.Time {
+	now = now.UTC()
+	return time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// MeetingMinutesExhausted checks if the monthly meeting minutes are used up
+func (p *Plan) MeetingMinutesExhausted(u *Usage) bool {
+	return p.MonthlyMeetingMinutes > 0 && u.MeetingSeconds >= int64(p.MonthlyMeetingMinutes)*60
+}
+
+// ConcurrentRoomsReached checks if no other room may start while the active ones are in progress
+func (p *Plan) ConcurrentRoomsReached(u *Usage) bool {
+	return p.MaxConcurrentRooms > 0 && u.ActiveRooms >= int64(p.MaxConcurrentRooms)
+}
+
+// RecordingStorageFull checks if the recordings use up the storage of the plan
+func (p *Plan) RecordingStorageFull(u *Usage) bool {
+	return p.RecordingStorageBytes > 0 && u.RecordingBytes >= p.RecordingStorageBytes
+}
+
+// AIMinutesExhausted checks if the monthly AI audio minutes are used up
+func (p *Plan) AIMinutesExhausted(u *Usage) bool {
+	return p.MonthlyAIMinutes > 0 && u.AISeconds >= int64(p.MonthlyAIMinutes)*60
+}
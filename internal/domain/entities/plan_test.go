@@ -0,0 +1,54 @@
+package entities
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPlan_QuotaChecks(t *testing.T) {
+	plan := &Plan{
+		MaxConcurrentRooms:    2,
+		MonthlyMeetingMinutes: 100,
+		RecordingStorageBytes: 1 << 30,
+		MonthlyAIMinutes:      60,
+	}
+	unlimited := &Plan{}
+
+	tests := []struct {
+		name     string
+		plan     *Plan
+		usage    *Usage
+		meetings bool
+		rooms    bool
+		storage  bool
+		ai       bool
+	}{
+		{name: "below every limit", plan: plan, usage: &Usage{ActiveRooms: 1, MeetingSeconds: 100*60 - 1, RecordingBytes: 1<<30 - 1, AISeconds: 60*60 - 1}},
+		{name: "at every limit", plan: plan, usage: &Usage{ActiveRooms: 2, MeetingSeconds: 100 * 60, RecordingBytes: 1 << 30, AISeconds: 60 * 60}, meetings: true, rooms: true, storage: true, ai: true},
+		{name: "unlimited plan", plan: unlimited, usage: &Usage{ActiveRooms: 50, MeetingSeconds: 1e9, RecordingBytes: 1 << 40, AISeconds: 1e9}},
+	}
+
+	for _, tt := range tests {
+		if got := tt.plan.MeetingMinutesExhausted(tt.usage); got != tt.meetings {
+			t.Fatalf("%s: meeting minutes exhausted = %v, want %v", tt.name, got, tt.meetings)
+		}
+		if got := tt.plan.ConcurrentRoomsReached(tt.usage); got != tt.rooms {
+			t.Fatalf("%s: concurrent rooms reached = %v, want %v", tt.name, got, tt.rooms)
+		}
+		if got := tt.plan.RecordingStorageFull(tt.usage); got != tt.storage {
+			t.Fatalf("%s: recording storage full = %v, want %v", tt.name, got, tt.storage)
+		}
+		if got := tt.plan.AIMinutesExhausted(tt.usage); got != tt.ai {
+			t.Fatalf("%s: AI minutes exhausted = %v, want %v", tt.name, got, tt.ai)
+		}
+	}
+}
+
+func TestUsagePeriodStart(t *testing.T) {
+	// 00:30 on April 1st in Hanoi is still March in UTC
+	now := time.Date(2025, 4, 1, 0, 30, 0, 0, time.FixedZone("ICT", 7*3600))
+
+	if got, want := UsagePeriodStart(now), time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Fatalf("period start = %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,265 @@
+package entities
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+// PollType is the kind of answer a poll asks for
+type PollType string
+
+const (
+	PollTypeSingleChoice   PollType = "single_choice"   // Pick one option
+	PollTypeMultipleChoice PollType = "multiple_choice" // Pick one or more options
+	PollTypeFreeText       PollType = "free_text"       // Type an answer
+)
+
+// PollStatus represents whether a poll accepts votes
+type PollStatus string
+
+const (
+	PollStatusOpen   PollStatus = "open"
+	PollStatusClosed PollStatus = "closed"
+)
+
+// PollCloseReason explains why a poll was closed
+type PollCloseReason string
+
+const (
+	PollCloseManual    PollCloseReason = "manual"     // Closed by a moderator
+	PollCloseDeadline  PollCloseReason = "deadline"   // ClosesAt passed
+	PollCloseAllVoted  PollCloseReason = "all_voted"  // Everyone in the meeting voted (CloseWhenAllVoted)
+	PollCloseRoomEnded PollCloseReason = "room_ended" // The meeting ended
+)
+
+// MaxPollAnswerLength is the longest free-text answer accepted, in characters
+const MaxPollAnswerLength = 1000
+
+// Poll is a question put to the participants of a meeting
+type Poll struct {
+	ID                uuid.UUID       `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	RoomID            uuid.UUID       `gorm:"type:uuid;not null;index" json:"room_id"`
+	CreatedBy         uuid.UUID       `gorm:"type:uuid;not null" json:"created_by"` // Participant who created the poll
+	Creator           *Participant    `gorm:"foreignKey:CreatedBy" json:"creator,omitempty"`
+	Question          string          `gorm:"type:text;not null" json:"question"`
+	Type              PollType        `gorm:"type:varchar(20);not null" json:"type"`
+	Anonymous         bool            `gorm:"default:false" json:"anonymous"` // Voters are never shown, not even to moderators
+	Options           []PollOption    `gorm:"foreignKey:PollID" json:"options,omitempty"`
+	Status            PollStatus      `gorm:"type:varchar(20);not null;default:'open'" json:"status"`
+	ClosesAt          *time.Time      `json:"closes_at,omitempty"`                       // Closed automatically at this time
+	CloseWhenAllVoted bool            `gorm:"default:false" json:"close_when_all_voted"` // Closed once everyone in the meeting voted
+	ClosedAt          *time.Time      `json:"closed_at,omitempty"`
+	ClosedBy          *uuid.UUID      `gorm:"type:uuid" json:"closed_by,omitempty"` // Moderator who closed the poll (nil when closed by a rule)
+	CloseReason       PollCloseReason `gorm:"type:varchar(20)" json:"close_reason,omitempty"`
+	Results           datatypes.JSON  `gorm:"type:jsonb" json:"results,omitempty"` // Final PollResults, stored when the poll closes
+	CreatedAt         time.Time       `gorm:"default:now()" json:"created_at"`
+	UpdatedAt         time.Time       `gorm:"default:now()" json:"updated_at"`
+}
+
+// TableName specifies the table name for Poll
+func (Poll) TableName() string {
+	return "polls"
+}
+
+// PollOption is a choice of a single- or multiple-choice poll
+type PollOption struct {
+	ID       uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	PollID   uuid.UUID `gorm:"type:uuid;not null;index" json:"poll_id"`
+	Position int       `gorm:"not null" json:"position"`
+	Text     string    `gorm:"type:varchar(255);not null" json:"text"`
+}
+
+// TableName specifies the table name for PollOption
+func (PollOption) TableName() string {
+	return "poll_options"
+}
+
+// PollVote is a chosen option (or free-text answer) of a participant.
+// Multiple-choice votes have a row per chosen option.
+type PollVote struct {
+	ID            uuid.UUID    `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	PollID        uuid.UUID    `gorm:"type:uuid;not null;index" json:"poll_id"`
+	ParticipantID uuid.UUID    `gorm:"type:uuid;not null" json:"participant_id"`
+	Participant   *Participant `gorm:"foreignKey:ParticipantID" json:"participant,omitempty"`
+	OptionID      *uuid.UUID   `gorm:"type:uuid" json:"option_id,omitempty"`
+	Text          *string      `gorm:"type:text" json:"text,omitempty"` // Free-text answer
+	CreatedAt     time.Time    `gorm:"default:now()" json:"created_at"`
+}
+
+// TableName specifies the table name for PollVote
+func (PollVote) TableName() string {
+	return "poll_votes"
+}
+
+// IsOpen checks if the poll still accepts votes
+func (p *Poll) IsOpen() bool {
+	return p.Status == PollStatusOpen
+}
+
+// IsDue checks if an open poll passed its closing time
+func (p *Poll) IsDue(now time.Time) bool {
+	return p.IsOpen() && p.ClosesAt != nil && !now.Before(*p.ClosesAt)
+}
+
+// HasOption checks if an option belongs to the poll
+func (p *Poll) HasOption(optionID uuid.UUID) bool {
+	for _, o := range p.Options {
+		if o.ID == optionID {
+			return true
+		}
+	}
+	return false
+}
+
+// Close stops the poll and stores its final results
+func (p *Poll) Close(reason PollCloseReason, closedBy *uuid.UUID, results *PollResults) {
+	now := time.Now()
+	p.Status = PollStatusClosed
+	p.ClosedAt = &now
+	p.ClosedBy = closedBy
+	p.CloseReason = reason
+	if raw, err := json.Marshal(results); err == nil {
+		p.Results = datatypes.JSON(raw)
+	}
+}
+
+// FinalResults returns the results stored when the poll closed (nil while it is open)
+func (p *Poll) FinalResults() *PollResults {
+	if len(p.Results) == 0 {
+		return nil
+	}
+	var results PollResults
+	if err := json.Unmarshal(p.Results, &results); err != nil {
+		return nil
+	}
+	return &results
+}
+
+// PollResults is the tally of a poll
+type PollResults struct {
+	TotalVoters int                `json:"total_voters"`
+	Options     []PollOptionResult `json:"options,omitempty"`
+	Answers     []PollAnswer       `json:"answers,omitempty"` // Free-text polls
+}
+
+// PollOptionResult is the tally of an option
+type PollOptionResult struct {
+	OptionID   uuid.UUID `json:"option_id"`
+	Text       string    `json:"text"`
+	Votes      int       `json:"votes"`
+	Percentage float64   `json:"percentage"`       // Share of the voters who chose the option
+	Voters     []string  `json:"voters,omitempty"` // Named polls only
+}
+
+// PollAnswer is a free-text answer
+type PollAnswer struct {
+	Text  string `json:"text"`
+	Voter string `json:"voter,omitempty"` // Named polls only
+}
+
+// TallyPoll counts the votes of a poll. Voter names are left out of anonymous polls.
+func TallyPoll(poll *Poll, votes []*PollVote) *PollResults {
+	results := &PollResults{}
+
+	voters := make(map[uuid.UUID]bool)
+	byOption := make(map[uuid.UUID][]*PollVote)
+	for _, v := range votes {
+		voters[v.ParticipantID] = true
+		if v.OptionID != nil {
+			byOption[*v.OptionID] = append(byOption[*v.OptionID], v)
+		} else if v.Text != nil {
+			answer := PollAnswer{Text: *v.Text}
+			if !poll.Anonymous {
+				answer.Voter = voterName(v)
+			}
+			results.Answers = append(results.Answers, answer)
+		}
+	}
+	results.TotalVoters = len(voters)
+
+	for _, o := range poll.Options {
+		result := PollOptionResult{OptionID: o.ID, Text: o.Text, Votes: len(byOption[o.ID])}
+		if results.TotalVoters > 0 {
+			result.Percentage = float64(result.Votes) * 100 / float64(results.TotalVoters)
+		}
+		if !poll.Anonymous {
+			for _, v := range byOption[o.ID] {
+				result.Voters = append(result.Voters, voterName(v))
+			}
+		}
+		results.Options = append(results.Options, result)
+	}
+
+	return results
+}
+
+// Leaders returns the options with the most votes (several on a tie, none without votes)
+func (r *PollResults) Leaders() []PollOptionResult {
+	var leaders []PollOptionResult
+	top := 0
+	for _, o := range r.Options {
+		switch {
+		case o.Votes > top:
+			top = o.Votes
+			leaders = []PollOptionResult{o}
+		case o.Votes == top && top > 0:
+			leaders = append(leaders, o)
+		}
+	}
+	return leaders
+}
+
+// Outcome describes the final result of a poll in one sentence, for the meeting summary
+func (p *Poll) Outcome(results *PollResults) string {
+	if results == nil || results.TotalVoters == 0 {
+		return fmt.Sprintf("Poll %q closed without votes", p.Question)
+	}
+
+	if p.Type == PollTypeFreeText {
+		answers := make([]string, 0, len(results.Answers))
+		for _, a := range results.Answers {
+			answers = append(answers, a.Text)
+		}
+		return fmt.Sprintf("Poll %q (%d answers): %s", p.Question, results.TotalVoters, strings.Join(answers, "; "))
+	}
+
+	if p.Type == PollTypeMultipleChoice {
+		chosen := make([]PollOptionResult, 0, len(results.Options))
+		for _, o := range results.Options {
+			if o.Votes > 0 {
+				chosen = append(chosen, o)
+			}
+		}
+		sort.SliceStable(chosen, func(i, j int) bool { return chosen[i].Votes > chosen[j].Votes })
+		parts := make([]string, len(chosen))
+		for i, o := range chosen {
+			parts[i] = fmt.Sprintf("%q %d", o.Text, o.Votes)
+		}
+		return fmt.Sprintf("Poll %q (%d voters, several choices each): %s", p.Question, results.TotalVoters, strings.Join(parts, ", "))
+	}
+
+	leaders := results.Leaders()
+	if len(leaders) == 1 {
+		return fmt.Sprintf("Poll %q decided %q with %d of %d votes (%.0f%%)",
+			p.Question, leaders[0].Text, leaders[0].Votes, results.TotalVoters, leaders[0].Percentage)
+	}
+	tied := make([]string, len(leaders))
+	for i, o := range leaders {
+		tied[i] = fmt.Sprintf("%q", o.Text)
+	}
+	return fmt.Sprintf("Poll %q tied between %s with %d of %d votes each",
+		p.Question, strings.Join(tied, " and "), leaders[0].Votes, results.TotalVoters)
+}
+
+func voterName(v *PollVote) string {
+	if v.Participant == nil {
+		return "Participant"
+	}
+	return v.Participant.DisplayName()
+}
@@ -0,0 +1,110 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestTallyPoll_Options(t *testing.T) {
+	yes, no, maybe := uuid.New(), uuid.New(), uuid.New()
+	poll := &Poll{
+		Type:    PollTypeMultipleChoice,
+		Options: []PollOption{{ID: yes, Text: "Yes"}, {ID: no, Text: "No"}, {ID: maybe, Text: "Maybe"}},
+	}
+
+	alice := &Participant{ID: uuid.New(), User: &User{Name: "Alice"}}
+	bob := &Participant{ID: uuid.New(), User: &User{Name: "Bob"}}
+	vote := func(p *Participant, option uuid.UUID) *PollVote {
+		return &PollVote{ParticipantID: p.ID, Participant: p, OptionID: &option}
+	}
+	// Alice picked two options: she counts once in the voters but in both options
+	votes := []*PollVote{vote(alice, yes), vote(alice, maybe), vote(bob, yes)}
+
+	results := TallyPoll(poll, votes)
+	if results.TotalVoters != 2 {
+		t.Fatalf("total voters = %d, want 2", results.TotalVoters)
+	}
+
+	want := []struct {
+		votes      int
+		percentage float64
+		voters     []string
+	}{
+		{2, 100, []string{"Alice", "Bob"}},
+		{0, 0, nil},
+		{1, 50, []string{"Alice"}},
+	}
+	if len(results.Options) != len(want) {
+		t.Fatalf("expected %d options, got %d", len(want), len(results.Options))
+	}
+	for i, o := range results.Options {
+		if o.Votes != want[i].votes || o.Percentage != want[i].percentage {
+			t.Fatalf("option %q = %d votes (%.0f%%), want %d (%.0f%%)", o.Text, o.Votes, o.Percentage, want[i].votes, want[i].percentage)
+		}
+		if len(o.Voters) != len(want[i].voters) {
+			t.Fatalf("option %q voters = %v, want %v", o.Text, o.Voters, want[i].voters)
+		}
+		for j := range o.Voters {
+			if o.Voters[j] != want[i].voters[j] {
+				t.Fatalf("option %q voters = %v, want %v", o.Text, o.Voters, want[i].voters)
+			}
+		}
+	}
+
+	if leaders := results.Leaders(); len(leaders) != 1 || leaders[0].OptionID != yes {
+		t.Fatalf("unexpected leaders %v", leaders)
+	}
+}
+
+func TestTallyPoll_AnonymousHidesVoters(t *testing.T) {
+	option := uuid.New()
+	answer := "Next sprint"
+	voter := &Participant{ID: uuid.New(), User: &User{Name: "Alice"}}
+
+	tests := []struct {
+		name  string
+		poll  *Poll
+		votes []*PollVote
+	}{
+		{
+			name:  "choice",
+			poll:  &Poll{Type: PollTypeSingleChoice, Anonymous: true, Options: []PollOption{{ID: option, Text: "Yes"}}},
+			votes: []*PollVote{{ParticipantID: voter.ID, Participant: voter, OptionID: &option}},
+		},
+		{
+			name:  "free text",
+			poll:  &Poll{Type: PollTypeFreeText, Anonymous: true},
+			votes: []*PollVote{{ParticipantID: voter.ID, Participant: voter, Text: &answer}},
+		},
+	}
+
+	for _, tt := range tests {
+		results := TallyPoll(tt.poll, tt.votes)
+		if results.TotalVoters != 1 {
+			t.Fatalf("%s: total voters = %d, want 1", tt.name, results.TotalVoters)
+		}
+		for _, o := range results.Options {
+			if o.Votes != 1 || len(o.Voters) != 0 {
+				t.Fatalf("%s: option %q = %d votes, voters %v", tt.name, o.Text, o.Votes, o.Voters)
+			}
+		}
+		for _, a := range results.Answers {
+			if a.Text != answer || a.Voter != "" {
+				t.Fatalf("%s: unexpected answer %+v", tt.name, a)
+			}
+		}
+	}
+}
+
+func TestTallyPoll_NoVotes(t *testing.T) {
+	poll := &Poll{Type: PollTypeSingleChoice, Options: []PollOption{{ID: uuid.New(), Text: "Yes"}}}
+
+	results := TallyPoll(poll, nil)
+	if results.TotalVoters != 0 || results.Options[0].Percentage != 0 {
+		t.Fatalf("unexpected results %+v", results)
+	}
+	if leaders := results.Leaders(); len(leaders) != 0 {
+		t.Fatalf("expected no leaders, got %v", leaders)
+	}
+}
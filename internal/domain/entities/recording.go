@@ -1,6 +1,7 @@
 package entities
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
@@ -12,16 +13,21 @@ type RecordingStatus string
 
 const (
 	RecordingStatusRecording  RecordingStatus = "recording"
+	RecordingStatusPaused     RecordingStatus = "paused"
 	RecordingStatusProcessing RecordingStatus = "processing"
 	RecordingStatusCompleted  RecordingStatus = "completed"
 	RecordingStatusFailed     RecordingStatus = "failed"
 	RecordingStatusDeleted    RecordingStatus = "deleted"
 )
 
+// RecordingPauseReasonConsent is the pause reason of recordings paused until everyone in the meeting consented
+const RecordingPauseReasonConsent = "consent"
+
 // Recording represents a meeting recording
 type Recording struct {
 	ID                    uuid.UUID       `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
 	RoomID                uuid.UUID       `json:"room_id" gorm:"type:uuid;not null;index"`
+	OrganizationID        *uuid.UUID      `json:"organization_id,omitempty" gorm:"type:uuid;index"` // Copied from the room
 	StartedBy             *uuid.UUID      `json:"started_by,omitempty" gorm:"type:uuid"`
 	LivekitRecordingID    *string         `json:"livekit_recording_id,omitempty" gorm:"type:varchar(255);unique"`
 	LivekitEgressID       *string         `json:"livekit_egress_id,omitempty" gorm:"type:varchar(255)"`
@@ -50,6 +56,32 @@ func (Recording) TableName() string {
 	return "recordings"
 }
 
+// RecordingSegment is one egress of a recording.
+// LiveKit cannot pause an egress: pausing ends the current segment and resuming starts a new one.
+type RecordingSegment struct {
+	EgressID  string     `json:"egress_id"`
+	StartedAt time.Time  `json:"started_at"`
+	EndedAt   *time.Time `json:"ended_at,omitempty"`
+	FilePath  string     `json:"file_path,omitempty"`
+	FileURL   string     `json:"file_url,omitempty"`
+	FileSize  int64      `json:"file_size,omitempty"`
+}
+
+// IsActive checks if the recording is running or paused
+func (r *Recording) IsActive() bool {
+	return r.Status == RecordingStatusRecording || r.Status == RecordingStatusPaused
+}
+
+// IsDeleted checks if recording was deleted
+func (r *Recording) IsDeleted() bool {
+	return r.Status == RecordingStatusDeleted
+}
+
+// IsPaused checks if recording is paused
+func (r *Recording) IsPaused() bool {
+	return r.Status == RecordingStatusPaused
+}
+
 // IsCompleted checks if recording is completed
 func (r *Recording) IsCompleted() bool {
 	return r.Status == RecordingStatusCompleted
@@ -82,3 +114,160 @@ func (r *Recording) MarkAsFailed(errorMsg string) {
 	now := time.Now()
 	r.ProcessingCompletedAt = &now
 }
+
+// Segments returns the egress segments of the recording, stored in Metadata
+func (r *Recording) Segments() []RecordingSegment {
+	var metadata struct {
+		Segments []RecordingSegment `json:"segments"`
+	}
+	if len(r.Metadata) > 0 {
+		json.Unmarshal(r.Metadata, &metadata)
+	}
+	return metadata.Segments
+}
+
+// setSegments stores the segments in Metadata, keeping its other keys
+func (r *Recording) setSegments(segments []RecordingSegment) {
+	r.setMetadata("segments", segments)
+}
+
+// PauseReason returns why the recording was paused automatically; empty when someone paused it
+func (r *Recording) PauseReason() string {
+	var metadata struct {
+		PauseReason string `json:"pause_reason"`
+	}
+	if len(r.Metadata) > 0 {
+		json.Unmarshal(r.Metadata, &metadata)
+	}
+	return metadata.PauseReason
+}
+
+// setMetadata stores a key in Metadata, keeping its other keys; a nil value removes the key
+func (r *Recording) setMetadata(key string, value interface{}) {
+	metadata := make(map[string]json.RawMessage)
+	if len(r.Metadata) > 0 {
+		json.Unmarshal(r.Metadata, &metadata)
+	}
+	if value == nil {
+		delete(metadata, key)
+	} else {
+		raw, err := json.Marshal(value)
+		if err != nil {
+			return
+		}
+		metadata[key] = raw
+	}
+	if raw, err := json.Marshal(metadata); err == nil {
+		r.Metadata = datatypes.JSON(raw)
+	}
+}
+
+// ObjectNames returns the storage keys of the recording files, in order
+func (r *Recording) ObjectNames() []string {
+	var names []string
+	for _, segment := range r.Segments() {
+		if segment.FilePath != "" {
+			names = append(names, segment.FilePath)
+		}
+	}
+	// Recordings from before segments were tracked have a single file
+	if len(names) == 0 && r.FilePath != nil && *r.FilePath != "" {
+		names = append(names, *r.FilePath)
+	}
+	return names
+}
+
+// StartSegment records a new egress as the current segment of the recording
+func (r *Recording) StartSegment(egressID string, at time.Time) {
+	segments := append(r.Segments(), RecordingSegment{EgressID: egressID, StartedAt: at})
+	r.setSegments(segments)
+	r.setMetadata("pause_reason", nil)
+	r.LivekitEgressID = &egressID
+	r.Status = RecordingStatusRecording
+}
+
+// Pause ends the current segment; the recording continues with the next StartSegment
+func (r *Recording) Pause(at time.Time) {
+	r.endCurrentSegment(at)
+	r.Status = RecordingStatusPaused
+}
+
+// PauseForConsent pauses the recording until everyone in the meeting consented to be recorded
+func (r *Recording) PauseForConsent(at time.Time) {
+	r.Pause(at)
+	r.setMetadata("pause_reason", RecordingPauseReasonConsent)
+}
+
+// Stop ends the recording; it stays processing until the egress delivered its files
+func (r *Recording) Stop(at time.Time) {
+	r.endCurrentSegment(at)
+	r.Status = RecordingStatusProcessing
+	r.CompletedAt = &at
+
+	duration := 0
+	for _, segment := range r.Segments() {
+		if segment.EndedAt != nil {
+			duration += int(segment.EndedAt.Sub(segment.StartedAt).Seconds())
+		}
+	}
+	r.Duration = &duration
+	r.completeIfDelivered()
+}
+
+// AttachFile stores the file an egress delivered. The recording points at the file of its latest segment
+// and completes once it is stopped and every segment delivered its file.
+func (r *Recording) AttachFile(egressID, filePath, fileURL string, fileSize int64) {
+	segments := r.Segments()
+	found := false
+	for i := range segments {
+		if segments[i].EgressID == egressID {
+			segments[i].FilePath = filePath
+			segments[i].FileURL = fileURL
+			segments[i].FileSize = fileSize
+			found = true
+		}
+	}
+	if !found {
+		// Egress not started through the API (e.g. room auto-recording)
+		segments = append(segments, RecordingSegment{EgressID: egressID, StartedAt: r.StartedAt, FilePath: filePath, FileURL: fileURL, FileSize: fileSize})
+	}
+	r.setSegments(segments)
+
+	var totalSize int64
+	for _, segment := range segments {
+		totalSize += segment.FileSize
+	}
+	r.FileSize = &totalSize
+	if last := segments[len(segments)-1]; last.EgressID == egressID {
+		r.FilePath = &filePath
+		r.FileURL = &fileURL
+	}
+	r.completeIfDelivered()
+}
+
+// completeIfDelivered completes a stopped recording once the files of all its segments arrived
+func (r *Recording) completeIfDelivered() {
+	if r.Status != RecordingStatusProcessing {
+		return
+	}
+	for _, segment := range r.Segments() {
+		if segment.FileURL == "" {
+			return
+		}
+	}
+	r.Status = RecordingStatusCompleted
+}
+
+// endCurrentSegment closes the segment of the current egress
+func (r *Recording) endCurrentSegment(at time.Time) {
+	if r.LivekitEgressID == nil {
+		return
+	}
+	segments := r.Segments()
+	for i := range segments {
+		if segments[i].EgressID == *r.LivekitEgressID && segments[i].EndedAt == nil {
+			segments[i].EndedAt = &at
+		}
+	}
+	r.setSegments(segments)
+}
@@ -0,0 +1,28 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RecordingConsent records that a participant agreed to be recorded under a version of the recording policy.
+// Consents are kept for audits, also after the participant left or the room ended.
+type RecordingConsent struct {
+	ID            uuid.UUID    `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	RoomID        uuid.UUID    `gorm:"type:uuid;not null;index" json:"room_id"`
+	ParticipantID uuid.UUID    `gorm:"type:uuid;not null;index" json:"participant_id"`
+	Participant   *Participant `gorm:"foreignKey:ParticipantID" json:"participant,omitempty"`
+	UserID        *uuid.UUID   `gorm:"type:uuid;index" json:"user_id,omitempty"`       // Empty for guests
+	DisplayName   string       `gorm:"type:varchar(255);not null" json:"display_name"` // Name shown in the meeting when consenting
+	PolicyVersion string       `gorm:"type:varchar(50);not null" json:"policy_version"`
+	ConsentedAt   time.Time    `gorm:"not null" json:"consented_at"`
+	IPAddress     *string      `gorm:"type:varchar(64)" json:"ip_address,omitempty"`
+	UserAgent     *string      `gorm:"type:text" json:"user_agent,omitempty"`
+	CreatedAt     time.Time    `gorm:"default:now()" json:"created_at"`
+}
+
+// TableName specifies the table name for RecordingConsent
+func (RecordingConsent) TableName() string {
+	return "recording_consents"
+}
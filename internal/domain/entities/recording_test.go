@@ -0,0 +1,93 @@
+package entities
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRecording_PauseResumeStop(t *testing.T) {
+	start := time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+	recording := &Recording{StartedAt: start}
+
+	recording.StartSegment("EG_1", start)
+	recording.PauseForConsent(start.Add(10 * time.Minute))
+	if !recording.IsPaused() || recording.PauseReason() != RecordingPauseReasonConsent {
+		t.Fatalf("status = %s, pause reason = %q, want paused for consent", recording.Status, recording.PauseReason())
+	}
+
+	recording.StartSegment("EG_2", start.Add(15*time.Minute))
+	if recording.Status != RecordingStatusRecording || recording.PauseReason() != "" {
+		t.Fatalf("status = %s, pause reason = %q, want recording without reason", recording.Status, recording.PauseReason())
+	}
+	if recording.LivekitEgressID == nil || *recording.LivekitEgressID != "EG_2" {
+		t.Fatalf("current egress = %v, want EG_2", recording.LivekitEgressID)
+	}
+
+	recording.Pause(start.Add(20 * time.Minute))
+	if !recording.IsPaused() || recording.PauseReason() != "" {
+		t.Fatalf("status = %s, pause reason = %q, want paused by someone", recording.Status, recording.PauseReason())
+	}
+
+	recording.StartSegment("EG_3", start.Add(30*time.Minute))
+	recording.Stop(start.Add(40 * time.Minute))
+
+	segments := recording.Segments()
+	if len(segments) != 3 {
+		t.Fatalf("expected 3 segments, got %d", len(segments))
+	}
+	for i, s := range segments {
+		if s.EndedAt == nil {
+			t.Fatalf("segment %d (%s) not ended", i, s.EgressID)
+		}
+	}
+	if recording.Duration == nil || *recording.Duration != 25*60 {
+		t.Fatalf("duration = %v, want %d", recording.Duration, 25*60)
+	}
+	if recording.Status != RecordingStatusProcessing {
+		t.Fatalf("status = %s, want processing until every file arrived", recording.Status)
+	}
+}
+
+func TestRecording_CompletesOnceEverySegmentDelivered(t *testing.T) {
+	start := time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+	recording := &Recording{StartedAt: start}
+	recording.StartSegment("EG_1", start)
+	recording.Pause(start.Add(5 * time.Minute))
+	recording.StartSegment("EG_2", start.Add(10*time.Minute))
+
+	// The first egress delivers while the meeting is still recorded
+	recording.AttachFile("EG_1", "rooms/a/EG_1.mp4", "https://storage/rooms/a/EG_1.mp4", 100)
+	if recording.Status != RecordingStatusRecording {
+		t.Fatalf("status = %s, want recording", recording.Status)
+	}
+
+	recording.Stop(start.Add(20 * time.Minute))
+	if recording.Status != RecordingStatusProcessing {
+		t.Fatalf("status = %s, want processing", recording.Status)
+	}
+
+	recording.AttachFile("EG_2", "rooms/a/EG_2.mp4", "https://storage/rooms/a/EG_2.mp4", 50)
+	if !recording.IsCompleted() {
+		t.Fatalf("status = %s, want completed", recording.Status)
+	}
+	if recording.FileSize == nil || *recording.FileSize != 150 {
+		t.Fatalf("file size = %v, want 150", recording.FileSize)
+	}
+	if recording.FilePath == nil || *recording.FilePath != "rooms/a/EG_2.mp4" {
+		t.Fatalf("file path = %v, want the latest segment", recording.FilePath)
+	}
+
+	names := recording.ObjectNames()
+	if len(names) != 2 || names[0] != "rooms/a/EG_1.mp4" || names[1] != "rooms/a/EG_2.mp4" {
+		t.Fatalf("unexpected object names %v", names)
+	}
+}
+
+func TestRecording_ObjectNamesWithoutSegments(t *testing.T) {
+	path := "rooms/a/legacy.mp4"
+	recording := &Recording{FilePath: &path}
+
+	if names := recording.ObjectNames(); len(names) != 1 || names[0] != path {
+		t.Fatalf("unexpected object names %v", names)
+	}
+}
@@ -1,6 +1,8 @@
 package entities
 
 import (
+	"crypto/rand"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -26,6 +28,14 @@ const (
 	RoomStatusCancelled RoomStatus = "cancelled"
 )
 
+// RoomLockMode tells what happens to new joins while a room is locked
+type RoomLockMode string
+
+const (
+	RoomLockModeWaitingRoom RoomLockMode = "waiting_room" // New joins wait in the lobby until admitted
+	RoomLockModeReject      RoomLockMode = "reject"       // New joins are turned away
+)
+
 // Room represents a meeting room
 type Room struct {
 	ID                  uuid.UUID      `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
@@ -34,12 +44,13 @@ type Room struct {
 	Slug                *string        `gorm:"type:varchar(100);unique" json:"slug,omitempty"`
 	HostID              uuid.UUID      `gorm:"type:uuid;not null;index" json:"host_id"`
 	Host                *User          `gorm:"foreignKey:HostID" json:"host,omitempty"`
+	OrganizationID      *uuid.UUID     `gorm:"type:uuid;index" json:"organization_id,omitempty"` // nil = personal room
 	Type                RoomType       `gorm:"type:varchar(20);not null;default:'public';index" json:"type"`
 	Status              RoomStatus     `gorm:"type:varchar(20);not null;default:'scheduled';index" json:"status"`
 	LivekitRoomName     string         `gorm:"type:varchar(255);unique;not null" json:"livekit_room_name"`
 	LivekitRoomID       *string        `gorm:"type:varchar(255)" json:"livekit_room_id,omitempty"`
 	MaxParticipants     int            `gorm:"default:10;check:max_participants >= 2 AND max_participants <= 100" json:"max_participants"`
-	CurrentParticipants int            `gorm:"default:0" json:"current_participants"`
+	CurrentParticipants int            `gorm:"default:0" json:"current_participants"` // Joined participants, recounted from the participant rows
 	Settings            datatypes.JSON `gorm:"type:jsonb;default:'{}'" json:"settings"`
 	ScheduledStartTime  *time.Time     `gorm:"index" json:"scheduled_start_time,omitempty"`
 	ScheduledEndTime    *time.Time     `json:"scheduled_end_time,omitempty"`
@@ -48,10 +59,34 @@ type Room struct {
 	Duration            *int           `json:"duration,omitempty"` // seconds
 	Tags                datatypes.JSON `gorm:"type:jsonb;default:'[]'" json:"tags,omitempty"`
 	Metadata            datatypes.JSON `gorm:"type:jsonb;default:'{}'" json:"metadata,omitempty"`
+	SeriesID            *uuid.UUID     `gorm:"type:uuid;index" json:"series_id,omitempty"`
+	OccurrenceStartTime *time.Time     `json:"occurrence_start_time,omitempty"` // original slot in the series rule
+	ReminderSentAt      *time.Time     `json:"reminder_sent_at,omitempty"`      // when the pre-start reminder email went out
+	EndWarningSentAt    *time.Time     `json:"end_warning_sent_at,omitempty"`   // when overdue participants were warned of the forced end
+	ParentRoomID        *uuid.UUID     `gorm:"type:uuid;index" json:"parent_room_id,omitempty"`
+	BreakoutEndsAt      *time.Time     `json:"breakout_ends_at,omitempty"`
+	LockMode            *RoomLockMode  `gorm:"type:varchar(20)" json:"lock_mode,omitempty"` // nil = unlocked
+	LockedAt            *time.Time     `json:"locked_at,omitempty"`
+	LockedBy            *uuid.UUID     `gorm:"type:uuid" json:"locked_by,omitempty"`
+	PasscodeHash        *string        `gorm:"type:varchar(255)" json:"-"` // bcrypt hash, nil = no passcode
+	CurrentAgendaItemID *uuid.UUID     `gorm:"type:uuid" json:"current_agenda_item_id,omitempty"`
 	CreatedAt           time.Time      `gorm:"default:now()" json:"created_at"`
 	UpdatedAt           time.Time      `gorm:"default:now()" json:"updated_at"`
 }
 
+// NewRoomSlug generates a short, shareable room code such as "abc-defg-hij"
+func NewRoomSlug() (string, error) {
+	const letters = "abcdefghijklmnopqrstuvwxyz"
+	b := make([]byte, 10)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate room slug: %w", err)
+	}
+	for i := range b {
+		b[i] = letters[int(b[i])%len(letters)]
+	}
+	return fmt.Sprintf("%s-%s-%s", b[:3], b[3:7], b[7:]), nil
+}
+
 // TableName specifies the table name for Room
 func (Room) TableName() string {
 	return "rooms"
@@ -59,18 +94,7 @@ func (Room) TableName() string {
 
 // DefaultSettings returns default room settings
 func DefaultSettings() map[string]interface{} {
-	return map[string]interface{}{
-		"enable_recording":      true,
-		"enable_chat":           true,
-		"enable_screen_share":   true,
-		"require_approval":      false,
-		"allow_guests":          false,
-		"mute_on_join":          false,
-		"disable_video_on_join": false,
-		"enable_waiting_room":   false,
-		"auto_record":           false,
-		"enable_transcription":  true,
-	}
+	return DefaultRoomSettings().ToMap()
 }
 
 // IsActive checks if the room is currently active
@@ -83,11 +107,41 @@ func (r *Room) IsEnded() bool {
 	return r.Status == RoomStatusEnded
 }
 
+// IsBreakout checks if the room is a breakout room of another meeting
+func (r *Room) IsBreakout() bool {
+	return r.ParentRoomID != nil
+}
+
 // IsFull checks if the room has reached max capacity
 func (r *Room) IsFull() bool {
 	return r.CurrentParticipants >= r.MaxParticipants
 }
 
+// IsLocked checks if the room is closed to new joins
+func (r *Room) IsLocked() bool {
+	return r.LockMode != nil
+}
+
+// Lock closes the room to new joins
+func (r *Room) Lock(mode RoomLockMode, lockedBy uuid.UUID) {
+	now := time.Now()
+	r.LockMode = &mode
+	r.LockedAt = &now
+	r.LockedBy = &lockedBy
+}
+
+// Unlock opens the room to new joins again
+func (r *Room) Unlock() {
+	r.LockMode = nil
+	r.LockedAt = nil
+	r.LockedBy = nil
+}
+
+// HasPasscode checks if joining the room requires a passcode
+func (r *Room) HasPasscode() bool {
+	return r.PasscodeHash != nil && *r.PasscodeHash != ""
+}
+
 // CanJoin checks if a user can join this room
 func (r *Room) CanJoin() bool {
 	return r.IsActive() && !r.IsFull()
@@ -111,15 +165,3 @@ func (r *Room) End() {
 		r.Duration = &duration
 	}
 }
-
-// IncrementParticipants increases the participant count
-func (r *Room) IncrementParticipants() {
-	r.CurrentParticipants++
-}
-
-// DecrementParticipants decreases the participant count
-func (r *Room) DecrementParticipants() {
-	if r.CurrentParticipants > 0 {
-		r.CurrentParticipants--
-	}
-}
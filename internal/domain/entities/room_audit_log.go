@@ -0,0 +1,37 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+// RoomAuditAction identifies a security-relevant change to a room
+type RoomAuditAction string
+
+const (
+	RoomAuditLocked          RoomAuditAction = "room.locked"
+	RoomAuditUnlocked        RoomAuditAction = "room.unlocked"
+	RoomAuditPasscodeSet     RoomAuditAction = "passcode.set"
+	RoomAuditPasscodeCleared RoomAuditAction = "passcode.cleared"
+	RoomAuditPasscodeFailed  RoomAuditAction = "passcode.failed"
+	RoomAuditForceEnded      RoomAuditAction = "room.force_ended"
+)
+
+// RoomAuditLog records who changed the access controls of a room, failed passcode attempts
+// and rooms ended by an administrator
+type RoomAuditLog struct {
+	ID        uuid.UUID       `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	RoomID    uuid.UUID       `gorm:"type:uuid;not null;index" json:"room_id"`
+	ActorID   *uuid.UUID      `gorm:"type:uuid" json:"actor_id,omitempty"`        // User behind the action (nil for guests)
+	ActorIP   string          `gorm:"type:varchar(64)" json:"actor_ip,omitempty"` // Client IP of the request
+	Action    RoomAuditAction `gorm:"type:varchar(50);not null" json:"action"`
+	Details   datatypes.JSON  `gorm:"type:jsonb;default:'{}'" json:"details,omitempty"`
+	CreatedAt time.Time       `gorm:"default:now()" json:"created_at"`
+}
+
+// TableName specifies the table name for RoomAuditLog
+func (RoomAuditLog) TableName() string {
+	return "room_audit_logs"
+}
@@ -0,0 +1,111 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RoomEventType identifies what happened in a room
+type RoomEventType string
+
+const (
+	RoomEventParticipantWaiting    RoomEventType = "participant.waiting"
+	RoomEventParticipantWaitlisted RoomEventType = "participant.waitlisted"
+	RoomEventParticipantAdmitted   RoomEventType = "participant.admitted"
+	RoomEventParticipantDenied     RoomEventType = "participant.denied"
+	RoomEventParticipantRemoved    RoomEventType = "participant.removed"
+	RoomEventHostTransferred       RoomEventType = "host.transferred"
+	RoomEventRecordingStarted      RoomEventType = "recording.started"
+	RoomEventRecordingStopped      RoomEventType = "recording.stopped"
+	RoomEventRecordingPaused       RoomEventType = "recording.paused"
+	RoomEventRecordingResumed      RoomEventType = "recording.resumed"
+	RoomEventRecordingConsent      RoomEventType = "recording.consent_required"
+	RoomEventRoomEnded             RoomEventType = "room.ended"
+	RoomEventSummaryProgress       RoomEventType = "summary.progress"
+	RoomEventHandRaised            RoomEventType = "hand.raised"
+	RoomEventHandLowered           RoomEventType = "hand.lowered"
+	RoomEventHandQueueUpdated      RoomEventType = "hand.queue_updated"
+	RoomEventRoomLocked            RoomEventType = "room.locked"
+	RoomEventRoomUnlocked          RoomEventType = "room.unlocked"
+	RoomEventChatMessage           RoomEventType = "chat.message"
+	RoomEventChatMessageEdited     RoomEventType = "chat.message_edited"
+	RoomEventChatMessageDeleted    RoomEventType = "chat.message_deleted"
+	RoomEventPollOpened            RoomEventType = "poll.opened"
+	RoomEventPollResults           RoomEventType = "poll.results"
+	RoomEventPollClosed            RoomEventType = "poll.closed"
+	RoomEventAgendaUpdated         RoomEventType = "agenda.updated"
+	RoomEventAgendaItemStarted     RoomEventType = "agenda.item_started"
+)
+
+// RoomEventAudience restricts who receives a room event
+type RoomEventAudience string
+
+const (
+	RoomEventAudienceEveryone   RoomEventAudience = "everyone"   // Everyone subscribed to the room
+	RoomEventAudienceModerators RoomEventAudience = "moderators" // Host, co-hosts and the participant the event is about
+)
+
+// RoomEvent is a real-time notification delivered on a room's event stream
+type RoomEvent struct {
+	ID            uuid.UUID              `json:"id"`
+	Type          RoomEventType          `json:"type"`
+	RoomID        uuid.UUID              `json:"room_id"`
+	ParticipantID *uuid.UUID             `json:"participant_id,omitempty"` // Participant the event is about
+	Audience      RoomEventAudience      `json:"audience"`
+	Data          map[string]interface{} `json:"data,omitempty"`
+	CreatedAt     time.Time              `json:"created_at"`
+}
+
+// NewRoomEvent creates an event visible to everyone in the room
+func NewRoomEvent(roomID uuid.UUID, eventType RoomEventType, data map[string]interface{}) *RoomEvent {
+	return &RoomEvent{
+		ID:        uuid.New(),
+		Type:      eventType,
+		RoomID:    roomID,
+		Audience:  RoomEventAudienceEveryone,
+		Data:      data,
+		CreatedAt: time.Now(),
+	}
+}
+
+// NewParticipantEvent creates an event about a participant.
+// Lobby events (waiting, waitlisted, admitted, denied) only go to moderators and the participant concerned.
+func NewParticipantEvent(eventType RoomEventType, participant *Participant, data map[string]interface{}) *RoomEvent {
+	if data == nil {
+		data = make(map[string]interface{})
+	}
+	data["participant_id"] = participant.ID
+	data["display_name"] = participant.DisplayName()
+	data["role"] = participant.Role
+	if participant.UserID != nil {
+		data["user_id"] = *participant.UserID
+	}
+
+	event := NewRoomEvent(participant.RoomID, eventType, data).About(participant.ID)
+	switch eventType {
+	case RoomEventParticipantWaiting, RoomEventParticipantWaitlisted, RoomEventParticipantAdmitted, RoomEventParticipantDenied:
+		event.ForModerators()
+	}
+	return event
+}
+
+// About marks the participant the event is about
+func (e *RoomEvent) About(participantID uuid.UUID) *RoomEvent {
+	e.ParticipantID = &participantID
+	return e
+}
+
+// ForModerators restricts the event to moderators and the participant it is about
+func (e *RoomEvent) ForModerators() *RoomEvent {
+	e.Audience = RoomEventAudienceModerators
+	return e
+}
+
+// VisibleTo reports whether a subscriber may receive the event
+func (e *RoomEvent) VisibleTo(participantID uuid.UUID, moderator bool) bool {
+	if e.Audience == RoomEventAudienceEveryone || moderator {
+		return true
+	}
+	return e.ParticipantID != nil && *e.ParticipantID == participantID
+}
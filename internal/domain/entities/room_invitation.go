@@ -0,0 +1,112 @@
+package entities
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// InvitationStatus represents the lifecycle state of a room invitation
+type InvitationStatus string
+
+const (
+	InvitationStatusPending  InvitationStatus = "pending"
+	InvitationStatusAccepted InvitationStatus = "accepted"
+	InvitationStatusDeclined InvitationStatus = "declined"
+	InvitationStatusExpired  InvitationStatus = "expired"
+	InvitationStatusRevoked  InvitationStatus = "revoked"
+)
+
+// invitationTokenBytes is the amount of randomness in an invitation token (256 bits)
+const invitationTokenBytes = 32
+
+// RoomInvitation represents an invitation link to a room.
+// The invitee is identified by user ID, email, or both.
+type RoomInvitation struct {
+	ID           uuid.UUID        `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	RoomID       uuid.UUID        `gorm:"type:uuid;not null;index" json:"room_id"`
+	Room         *Room            `gorm:"foreignKey:RoomID" json:"room,omitempty"`
+	InviterID    uuid.UUID        `gorm:"type:uuid;not null;index" json:"inviter_id"`
+	Inviter      *User            `gorm:"foreignKey:InviterID" json:"inviter,omitempty"`
+	InviteeID    *uuid.UUID       `gorm:"type:uuid;index" json:"invitee_id,omitempty"`
+	InviteeEmail *string          `gorm:"type:varchar(255);index" json:"invitee_email,omitempty"`
+	Token        string           `gorm:"type:varchar(255);uniqueIndex;not null" json:"-"`
+	Status       InvitationStatus `gorm:"type:varchar(20);default:'pending'" json:"status"`
+	Message      *string          `gorm:"type:text" json:"message,omitempty"`
+	ExpiresAt    time.Time        `gorm:"not null" json:"expires_at"`
+	RespondedAt  *time.Time       `json:"responded_at,omitempty"`
+	CreatedAt    time.Time        `gorm:"default:now()" json:"created_at"`
+}
+
+// TableName specifies the table name for RoomInvitation
+func (RoomInvitation) TableName() string {
+	return "room_invitations"
+}
+
+// NewInvitationToken generates an unguessable, URL-safe invitation token
+func NewInvitationToken() (string, error) {
+	b := make([]byte, invitationTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate invitation token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// IsExpired checks if the invitation is past its expiry time
+func (i *RoomInvitation) IsExpired() bool {
+	return i.Status == InvitationStatusExpired || time.Now().After(i.ExpiresAt)
+}
+
+// IsPending checks if the invitation can still be accepted or declined
+func (i *RoomInvitation) IsPending() bool {
+	return i.Status == InvitationStatusPending && !i.IsExpired()
+}
+
+// EffectiveStatus returns the status, reporting stale pending invitations as expired
+// before the expiry job has caught up with them
+func (i *RoomInvitation) EffectiveStatus() InvitationStatus {
+	if i.Status == InvitationStatusPending && i.IsExpired() {
+		return InvitationStatusExpired
+	}
+	return i.Status
+}
+
+// IsFor checks if the invitation was issued to the given user
+func (i *RoomInvitation) IsFor(userID uuid.UUID, email string) bool {
+	if i.InviteeID != nil && *i.InviteeID == userID {
+		return true
+	}
+	return i.InviteeEmail != nil && email != "" && strings.EqualFold(*i.InviteeEmail, email)
+}
+
+// Accept marks the invitation as accepted by a user
+func (i *RoomInvitation) Accept(userID uuid.UUID) {
+	now := time.Now()
+	i.Status = InvitationStatusAccepted
+	i.InviteeID = &userID
+	i.RespondedAt = &now
+}
+
+// AcceptAsGuest marks the invitation as accepted by an invitee joining without an account
+func (i *RoomInvitation) AcceptAsGuest() {
+	now := time.Now()
+	i.Status = InvitationStatusAccepted
+	i.RespondedAt = &now
+}
+
+// Decline marks the invitation as declined by a user
+func (i *RoomInvitation) Decline(userID uuid.UUID) {
+	now := time.Now()
+	i.Status = InvitationStatusDeclined
+	i.InviteeID = &userID
+	i.RespondedAt = &now
+}
+
+// Revoke cancels the invitation so its token can no longer be used
+func (i *RoomInvitation) Revoke() {
+	i.Status = InvitationStatusRevoked
+}
@@ -0,0 +1,102 @@
+package entities
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"gorm.io/datatypes"
+)
+
+// RoomSettings holds the per-room feature flags stored in Room.Settings
+type RoomSettings struct {
+	EnableRecording     bool `json:"enable_recording"`      // Recording may be started in this room
+	EnableChat          bool `json:"enable_chat"`           // Participants may publish data (chat) messages
+	EnableScreenShare   bool `json:"enable_screen_share"`   // Participants may publish a screen share track
+	RequireApproval     bool `json:"require_approval"`      // Host must admit every participant
+	AllowGuests         bool `json:"allow_guests"`          // Uninvited users may request to join private/scheduled rooms
+	MuteOnJoin          bool `json:"mute_on_join"`          // Participants join with microphone muted
+	DisableVideoOnJoin  bool `json:"disable_video_on_join"` // Participants join with camera off
+	EnableWaitingRoom   bool `json:"enable_waiting_room"`   // Participants wait in the lobby until admitted
+	AutoRecord          bool `json:"auto_record"`           // Recording starts automatically with the room
+	EnableTranscription bool `json:"enable_transcription"`  // Recordings are sent to the AI pipeline
+
+	RequireRecordingConsent bool `json:"require_recording_consent"` // Nobody is recorded before consenting to the recording policy
+}
+
+// DefaultRoomSettings returns the settings applied to new rooms
+func DefaultRoomSettings() RoomSettings {
+	return RoomSettings{
+		EnableRecording:     true,
+		EnableChat:          true,
+		EnableScreenShare:   true,
+		RequireApproval:     false,
+		AllowGuests:         false,
+		MuteOnJoin:          false,
+		DisableVideoOnJoin:  false,
+		EnableWaitingRoom:   false,
+		AutoRecord:          false,
+		EnableTranscription: true,
+
+		RequireRecordingConsent: true,
+	}
+}
+
+// RequiresAdmission checks if non-host participants must be admitted by the host
+func (s RoomSettings) RequiresAdmission() bool {
+	return s.RequireApproval || s.EnableWaitingRoom
+}
+
+// ShouldAutoRecord checks if the room egress should start with the room
+func (s RoomSettings) ShouldAutoRecord() bool {
+	return s.EnableRecording && s.AutoRecord
+}
+
+// Merge applies a partial settings map on top of s.
+// Unknown keys and values of the wrong type are rejected.
+func (s RoomSettings) Merge(changes map[string]interface{}) (RoomSettings, error) {
+	if len(changes) == 0 {
+		return s, nil
+	}
+
+	raw, err := json.Marshal(changes)
+	if err != nil {
+		return s, fmt.Errorf("failed to encode settings: %w", err)
+	}
+
+	merged := s
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&merged); err != nil {
+		return s, err
+	}
+
+	return merged, nil
+}
+
+// ToMap converts the settings to a generic map (for responses and metadata)
+func (s RoomSettings) ToMap() map[string]interface{} {
+	raw, _ := json.Marshal(s)
+	var m map[string]interface{}
+	json.Unmarshal(raw, &m)
+	return m
+}
+
+// GetSettings returns the typed room settings, filling unset flags with defaults
+func (r *Room) GetSettings() RoomSettings {
+	settings := DefaultRoomSettings()
+	if len(r.Settings) > 0 {
+		json.Unmarshal(r.Settings, &settings)
+	}
+	return settings
+}
+
+// SetSettings stores the typed settings into Room.Settings
+func (r *Room) SetSettings(settings RoomSettings) error {
+	raw, err := json.Marshal(settings)
+	if err != nil {
+		return fmt.Errorf("failed to encode settings: %w", err)
+	}
+	r.Settings = datatypes.JSON(raw)
+	return nil
+}
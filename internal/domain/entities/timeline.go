@@ -0,0 +1,27 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TimelineEntryType identifies what happened at a point of the meeting timeline
+type TimelineEntryType string
+
+const (
+	TimelineEntryHandRaised  TimelineEntryType = "hand_raised"
+	TimelineEntryHandLowered TimelineEntryType = "hand_lowered"
+	TimelineEntryChatMessage TimelineEntryType = "chat_message"
+	TimelineEntryAgendaItem  TimelineEntryType = "agenda_item"
+)
+
+// TimelineEntry is something that happened during a meeting, shown next to its summary
+type TimelineEntry struct {
+	Type          TimelineEntryType `json:"type"`
+	At            time.Time         `json:"at"`
+	OffsetSeconds int               `json:"offset_seconds"` // Seconds since the meeting started
+	ParticipantID *uuid.UUID        `json:"participant_id,omitempty"`
+	DisplayName   string            `json:"display_name,omitempty"`
+	Text          string            `json:"text"`
+}
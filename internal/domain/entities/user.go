@@ -16,6 +16,9 @@ type User struct {
 	Role     UserRole  `json:"role" gorm:"type:varchar(50);default:'participant';not null"`
 	IsActive bool      `json:"is_active" gorm:"default:true;not null"`
 
+	// Plan of the personal rooms (nil = default plan)
+	PlanID *uuid.UUID `json:"plan_id,omitempty" gorm:"type:uuid"`
+
 	// OAuth fields
 	OAuthProvider     *string `json:"oauth_provider,omitempty" gorm:"column:oauth_provider;type:varchar(50);index:idx_oauth"`
 	OAuthID           *string `json:"oauth_id,omitempty" gorm:"column:oauth_id;type:varchar(255);index:idx_oauth"`
@@ -0,0 +1,21 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+)
+
+// AgendaRepository defines the interface for meeting agenda data access
+type AgendaRepository interface {
+	// FindByRoomID retrieves the agenda of a room in order, with the item owners
+	FindByRoomID(ctx context.Context, roomID uuid.UUID) ([]*entities.AgendaItem, error)
+
+	// Replace stores the agenda of a room: items with an ID are updated, new ones created
+	// and items left out deleted, in a single transaction
+	Replace(ctx context.Context, roomID uuid.UUID, items []*entities.AgendaItem) error
+
+	// Update stores the timing of an agenda item
+	Update(ctx context.Context, item *entities.AgendaItem) error
+}
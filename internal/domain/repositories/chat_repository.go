@@ -0,0 +1,28 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+)
+
+// ChatRepository defines the interface for meeting chat data access
+type ChatRepository interface {
+	// Create stores a new chat message
+	Create(ctx context.Context, message *entities.ChatMessage) error
+
+	// FindByID retrieves a chat message with its author
+	FindByID(ctx context.Context, id uuid.UUID) (*entities.ChatMessage, error)
+
+	// Update stores the edit or deletion of a chat message
+	Update(ctx context.Context, message *entities.ChatMessage) error
+
+	// List retrieves a page of a room's chat, newest first, with messages posted before a time
+	// (all when before is nil). Deleted messages are included so clients can show placeholders.
+	List(ctx context.Context, roomID uuid.UUID, before *time.Time, limit int) ([]*entities.ChatMessage, error)
+
+	// FindVisibleByRoomID retrieves all messages of a room that were not deleted, oldest first
+	FindVisibleByRoomID(ctx context.Context, roomID uuid.UUID) ([]*entities.ChatMessage, error)
+}
@@ -0,0 +1,36 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+)
+
+// InvitationRepository defines the interface for room invitation data access
+type InvitationRepository interface {
+	// Create creates a new invitation
+	Create(ctx context.Context, invitation *entities.RoomInvitation) error
+
+	// FindByID retrieves an invitation by its ID
+	FindByID(ctx context.Context, id uuid.UUID) (*entities.RoomInvitation, error)
+
+	// FindByToken retrieves an invitation by its token (with room and inviter)
+	FindByToken(ctx context.Context, token string) (*entities.RoomInvitation, error)
+
+	// Update updates an existing invitation
+	Update(ctx context.Context, invitation *entities.RoomInvitation) error
+
+	// FindByRoomID retrieves all invitations of a room, newest first
+	FindByRoomID(ctx context.Context, roomID uuid.UUID) ([]*entities.RoomInvitation, error)
+
+	// FindPendingByInvitee retrieves unexpired pending invitations addressed to a user or email
+	FindPendingByInvitee(ctx context.Context, userID uuid.UUID, email string) ([]*entities.RoomInvitation, error)
+
+	// FindPendingByRoomAndInvitee retrieves the unexpired pending invitation of a user or email to a room
+	FindPendingByRoomAndInvitee(ctx context.Context, roomID, userID uuid.UUID, email string) (*entities.RoomInvitation, error)
+
+	// ExpirePending marks pending invitations that expired before the given time as expired
+	ExpirePending(ctx context.Context, before time.Time) (int64, error)
+}
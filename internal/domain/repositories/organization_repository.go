@@ -0,0 +1,65 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+)
+
+// OrganizationRepository defines the interface for organization, membership and invite data access
+type OrganizationRepository interface {
+	// Create creates an organization together with its first member (the owner)
+	Create(ctx context.Context, org *entities.Organization, owner *entities.OrganizationMember) error
+
+	// FindByID retrieves an organization by its ID
+	FindByID(ctx context.Context, id uuid.UUID) (*entities.Organization, error)
+
+	// FindBySlug retrieves an organization by its slug
+	FindBySlug(ctx context.Context, slug string) (*entities.Organization, error)
+
+	// Update updates an existing organization
+	Update(ctx context.Context, org *entities.Organization) error
+
+	// Delete deletes an organization with its members, invites and rooms
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// FindMember retrieves the membership of a user in an organization
+	FindMember(ctx context.Context, orgID, userID uuid.UUID) (*entities.OrganizationMember, error)
+
+	// ListMembers retrieves the members of an organization (with users), oldest first
+	ListMembers(ctx context.Context, orgID uuid.UUID) ([]*entities.OrganizationMember, error)
+
+	// ListMemberships retrieves the memberships of a user (with organizations), oldest first
+	ListMemberships(ctx context.Context, userID uuid.UUID) ([]*entities.OrganizationMember, error)
+
+	// AddMember adds a user to an organization
+	AddMember(ctx context.Context, member *entities.OrganizationMember) error
+
+	// UpdateMemberRole stores the role of a member
+	UpdateMemberRole(ctx context.Context, memberID uuid.UUID, role entities.OrgRole) error
+
+	// RemoveMember removes a member from an organization
+	RemoveMember(ctx context.Context, memberID uuid.UUID) error
+
+	// CountMembersWithRole counts the members of an organization with a role
+	CountMembersWithRole(ctx context.Context, orgID uuid.UUID, role entities.OrgRole) (int64, error)
+
+	// CreateInvite creates a new invite
+	CreateInvite(ctx context.Context, invite *entities.OrganizationInvite) error
+
+	// FindInviteByID retrieves an invite of an organization by its ID
+	FindInviteByID(ctx context.Context, orgID, id uuid.UUID) (*entities.OrganizationInvite, error)
+
+	// FindInviteByToken retrieves an invite by its token (with organization and inviter)
+	FindInviteByToken(ctx context.Context, token string) (*entities.OrganizationInvite, error)
+
+	// FindPendingInvite retrieves the unexpired pending invite of an email to an organization
+	FindPendingInvite(ctx context.Context, orgID uuid.UUID, email string) (*entities.OrganizationInvite, error)
+
+	// ListInvites retrieves the invites of an organization (with inviters), newest first
+	ListInvites(ctx context.Context, orgID uuid.UUID) ([]*entities.OrganizationInvite, error)
+
+	// UpdateInvite updates an existing invite
+	UpdateInvite(ctx context.Context, invite *entities.OrganizationInvite) error
+}
@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
@@ -66,6 +67,18 @@ type ParticipantRepository interface {
 	// FindWaitingByRoomID retrieves all waiting participants in a room
 	FindWaitingByRoomID(ctx context.Context, roomID uuid.UUID) ([]*entities.Participant, error)
 
+	// Capacity methods
+	// TakeSeat joins a participant (creating it when new) if its room has a free seat, or always when ignoreCapacity is set.
+	// It returns false, leaving the participant untouched, when the room is full.
+	TakeSeat(ctx context.Context, participant *entities.Participant, ignoreCapacity bool) (bool, error)
+
+	// SeatNextWaitlisted joins the first participant of a room's waitlist if a seat is free.
+	// It returns nil when the room is full or nobody is waitlisted.
+	SeatNextWaitlisted(ctx context.Context, roomID uuid.UUID) (*entities.Participant, error)
+
+	// FindWaitlistedByRoomID retrieves the waitlist of a room, first in line first
+	FindWaitlistedByRoomID(ctx context.Context, roomID uuid.UUID) ([]*entities.Participant, error)
+
 	// Invitation methods
 	// FindByInvitedEmail retrieves participants invited with a specific email
 	FindByInvitedEmail(ctx context.Context, email string) ([]*entities.Participant, error)
@@ -75,4 +88,44 @@ type ParticipantRepository interface {
 
 	// FindByRoomAndEmail retrieves a participant by room and invited email
 	FindByRoomAndEmail(ctx context.Context, roomID uuid.UUID, email string) (*entities.Participant, error)
+
+	// FindGuestByInvitation retrieves the guest participant that joined through an invitation
+	FindGuestByInvitation(ctx context.Context, roomID, invitationID uuid.UUID) (*entities.Participant, error)
+
+	// Breakout methods
+	// FindBreakoutGuest retrieves the guest participant of a breakout room created for a parent room participant
+	FindBreakoutGuest(ctx context.Context, breakoutRoomID, parentParticipantID uuid.UUID) (*entities.Participant, error)
+
+	// ClearBreakoutAssignments unassigns every participant of a parent room from its breakout rooms
+	ClearBreakoutAssignments(ctx context.Context, parentRoomID uuid.UUID) error
+
+	// Hand-raise methods
+	// RaiseHand puts a participant at the end of its room's speaking queue and records the raise
+	RaiseHand(ctx context.Context, participant *entities.Participant) error
+
+	// LowerHands takes participants out of the speaking queue (every raised hand of the room when participantIDs is empty)
+	// and returns the participants whose hand was lowered
+	LowerHands(ctx context.Context, roomID uuid.UUID, participantIDs []uuid.UUID, loweredBy *uuid.UUID) ([]uuid.UUID, error)
+
+	// ReorderHands rewrites the speaking order of the raised hands of a room
+	ReorderHands(ctx context.Context, roomID uuid.UUID, order []uuid.UUID) error
+
+	// FindRaisedHands retrieves the raised hands of a room in speaking order
+	FindRaisedHands(ctx context.Context, roomID uuid.UUID) ([]*entities.Participant, error)
+
+	// FindHandRaises retrieves the hand-raise history of a room
+	FindHandRaises(ctx context.Context, roomID uuid.UUID) ([]*entities.HandRaise, error)
+
+	// Attendance methods
+	// StartSession records a LiveKit session of a participant (ignored when the session is already recorded)
+	StartSession(ctx context.Context, session *entities.AttendanceSession) error
+
+	// EndSession closes the open session of a room with the given LiveKit SID and returns false when there is none
+	EndSession(ctx context.Context, roomID uuid.UUID, sessionSID string, leftAt time.Time) (bool, error)
+
+	// EndOpenSessions closes the open sessions of a room (only those of participantID when it is set)
+	EndOpenSessions(ctx context.Context, roomID uuid.UUID, participantID *uuid.UUID, leftAt time.Time) error
+
+	// FindSessions retrieves the attendance sessions of a room, oldest first
+	FindSessions(ctx context.Context, roomID uuid.UUID) ([]*entities.AttendanceSession, error)
 }
@@ -0,0 +1,39 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+)
+
+// PlanRepository defines the interface for plan data access and usage metering
+type PlanRepository interface {
+	// List retrieves all plans, smallest first
+	List(ctx context.Context) ([]*entities.Plan, error)
+
+	// FindByCode retrieves a plan by its code
+	FindByCode(ctx context.Context, code string) (*entities.Plan, error)
+
+	// FindUserPlan retrieves the plan of a user, or the default plan (nil when there is none)
+	FindUserPlan(ctx context.Context, userID uuid.UUID) (*entities.Plan, error)
+
+	// FindOrganizationPlan retrieves the plan of an organization, or the default plan (nil when there is none)
+	FindOrganizationPlan(ctx context.Context, orgID uuid.UUID) (*entities.Plan, error)
+
+	// SetUserPlan assigns a plan to a user (nil = default plan); gorm.ErrRecordNotFound if the user does not exist
+	SetUserPlan(ctx context.Context, userID uuid.UUID, planID *uuid.UUID) error
+
+	// SetOrganizationPlan assigns a plan to an organization (nil = default plan); gorm.ErrRecordNotFound if it does not exist
+	SetOrganizationPlan(ctx context.Context, orgID uuid.UUID, planID *uuid.UUID) error
+
+	// GetUsage meters the rooms, recordings and AI jobs of a scope; monthly figures count from since
+	GetUsage(ctx context.Context, scope UsageScope, since time.Time) (*entities.Usage, error)
+}
+
+// UsageScope selects the rooms usage is metered on
+type UsageScope struct {
+	OrganizationID *uuid.UUID // Rooms of the organization
+	HostID         uuid.UUID  // Personal rooms of the host, when OrganizationID is nil
+}
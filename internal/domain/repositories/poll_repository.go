@@ -0,0 +1,34 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+)
+
+// PollRepository defines the interface for poll data access
+type PollRepository interface {
+	// Create stores a new poll with its options
+	Create(ctx context.Context, poll *entities.Poll) error
+
+	// FindByID retrieves a poll with its options and creator
+	FindByID(ctx context.Context, id uuid.UUID) (*entities.Poll, error)
+
+	// FindByRoomID retrieves the polls of a room, oldest first
+	FindByRoomID(ctx context.Context, roomID uuid.UUID) ([]*entities.Poll, error)
+
+	// FindDue retrieves the open polls whose closing time passed
+	FindDue(ctx context.Context, now time.Time) ([]*entities.Poll, error)
+
+	// Close stores the closing of a poll with its final results.
+	// It returns false when the poll was already closed.
+	Close(ctx context.Context, poll *entities.Poll) (bool, error)
+
+	// ReplaceVotes stores the votes of a participant, replacing the ones it cast before
+	ReplaceVotes(ctx context.Context, pollID, participantID uuid.UUID, votes []*entities.PollVote) error
+
+	// FindVotes retrieves the votes of a poll with their voters
+	FindVotes(ctx context.Context, pollID uuid.UUID) ([]*entities.PollVote, error)
+}
@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
@@ -21,7 +22,7 @@ type RoomRepository interface {
 	// FindByLivekitName retrieves a room by its LiveKit room name
 	FindByLivekitName(ctx context.Context, livekitName string) (*entities.Room, error)
 
-	// Update updates an existing room
+	// Update updates an existing room; the participant count is left to the seat queries
 	Update(ctx context.Context, room *entities.Room) error
 
 	// Delete soft deletes a room
@@ -39,31 +40,85 @@ type RoomRepository interface {
 	// FindScheduledRooms retrieves all scheduled rooms
 	FindScheduledRooms(ctx context.Context) ([]*entities.Room, error)
 
-	// IncrementParticipantCount increases the participant count
-	IncrementParticipantCount(ctx context.Context, roomID uuid.UUID) error
-
-	// DecrementParticipantCount decreases the participant count
-	DecrementParticipantCount(ctx context.Context, roomID uuid.UUID) error
+	// SyncParticipantCount recounts the current participants of a room from its joined participants
+	SyncParticipantCount(ctx context.Context, roomID uuid.UUID) error
 
 	// UpdateStatus updates the room status
 	UpdateStatus(ctx context.Context, roomID uuid.UUID, status entities.RoomStatus) error
 
-	// EndRoom marks a room as ended and calculates duration
-	EndRoom(ctx context.Context, roomID uuid.UUID) error
+	// MarkStarted opens a room that is not running yet.
+	// It returns false when the room is already running, ended or cancelled (another worker got there first).
+	MarkStarted(ctx context.Context, roomID uuid.UUID, startedAt time.Time) (bool, error)
+
+	// EndRoom marks a room as ended and calculates duration.
+	// It returns false when the room was already ended or cancelled.
+	EndRoom(ctx context.Context, roomID uuid.UUID) (bool, error)
+
+	// CancelRoom cancels a room that has not started.
+	// It returns false when the room is no longer scheduled.
+	CancelRoom(ctx context.Context, roomID uuid.UUID) (bool, error)
 
 	// UpdateHostID updates the room's host ID
 	UpdateHostID(ctx context.Context, roomID, newHostID uuid.UUID) error
+
+	// MarkReminderSent records that the reminder of a scheduled room was sent.
+	// It returns false when another worker already marked it.
+	MarkReminderSent(ctx context.Context, roomID uuid.UUID, sentAt time.Time) (bool, error)
+
+	// ResetReminder clears the reminder mark so a rescheduled room is reminded again
+	ResetReminder(ctx context.Context, roomID uuid.UUID) error
+
+	// MarkEndWarningSent records that an overdue room was warned of its forced end.
+	// It returns false when another worker already marked it.
+	MarkEndWarningSent(ctx context.Context, roomID uuid.UUID, sentAt time.Time) (bool, error)
+
+	// ResetEndWarning clears the end warning mark so the room is warned again
+	ResetEndWarning(ctx context.Context, roomID uuid.UUID) error
+
+	// FindBreakouts retrieves the breakout rooms of a parent room, oldest first
+	FindBreakouts(ctx context.Context, parentRoomID uuid.UUID) ([]*entities.Room, error)
+
+	// SetBreakoutTimer sets (or clears with nil) the end of the breakout timer of a parent room
+	SetBreakoutTimer(ctx context.Context, roomID uuid.UUID, endsAt *time.Time) error
+
+	// SetCurrentAgendaItem sets (or clears with nil) the agenda item a room is discussing
+	SetCurrentAgendaItem(ctx context.Context, roomID uuid.UUID, itemID *uuid.UUID) error
+
+	// UpdateLock stores the lock state (lock mode, locked at/by) of a room, including unlocking
+	UpdateLock(ctx context.Context, room *entities.Room) error
+
+	// UpdatePasscode stores (or clears with nil) the passcode hash of a room
+	UpdatePasscode(ctx context.Context, roomID uuid.UUID, passcodeHash *string) error
+
+	// CreateAuditLog records an access control change of a room
+	CreateAuditLog(ctx context.Context, log *entities.RoomAuditLog) error
+
+	// ListAuditLogs retrieves the audit trail of a room, newest first
+	ListAuditLogs(ctx context.Context, roomID uuid.UUID, limit, offset int) ([]*entities.RoomAuditLog, int64, error)
+
+	// RecordPasscodeFailure records a failed passcode attempt of a user (or of an IP when ActorID is nil) unless
+	// limit attempts were already recorded since a time. Counting and recording are atomic per room and actor.
+	RecordPasscodeFailure(ctx context.Context, log *entities.RoomAuditLog, since time.Time, limit int64) (bool, error)
+
+	// DeleteAuditLog deletes an audit log entry
+	DeleteAuditLog(ctx context.Context, id uuid.UUID) error
 }
 
-// RoomFilters represents filter options for listing rooms
+// RoomFilters represents filter options for listing rooms.
+// Listings are confined to one tenant: the rooms of OrganizationID, or personal rooms when it is nil.
 type RoomFilters struct {
 	Type      *entities.RoomType
 	Status    *entities.RoomStatus
 	HostID    *uuid.UUID
+	SeriesID  *uuid.UUID
 	Search    string // Search in name, description
 	Tags      []string
+	ParentID  *uuid.UUID // Breakout rooms of a parent room (breakouts are excluded otherwise)
 	Limit     int
 	Offset    int
 	SortBy    string // "created_at", "started_at", "name"
 	SortOrder string // "asc", "desc"
+
+	OrganizationID   *uuid.UUID // Tenant of the rooms (nil = personal rooms)
+	AllOrganizations bool       // Lift the tenant scope (system-wide listings only)
 }
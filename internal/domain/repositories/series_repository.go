@@ -0,0 +1,40 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+)
+
+// SeriesRepository defines the interface for recurring meeting series data access
+type SeriesRepository interface {
+	// Create creates a new series
+	Create(ctx context.Context, series *entities.MeetingSeries) error
+
+	// FindByID retrieves a series by its ID
+	FindByID(ctx context.Context, id uuid.UUID) (*entities.MeetingSeries, error)
+
+	// Update updates an existing series
+	Update(ctx context.Context, series *entities.MeetingSeries) error
+
+	// FindByHostID retrieves all series hosted by a user
+	FindByHostID(ctx context.Context, hostID uuid.UUID, limit, offset int) ([]*entities.MeetingSeries, error)
+
+	// FindActive retrieves all series that still produce occurrences
+	FindActive(ctx context.Context) ([]*entities.MeetingSeries, error)
+
+	// SaveException creates or replaces the exception for an occurrence
+	SaveException(ctx context.Context, exception *entities.SeriesException) error
+
+	// FindExceptions retrieves all exceptions of a series
+	FindExceptions(ctx context.Context, seriesID uuid.UUID) ([]*entities.SeriesException, error)
+
+	// FindOccurrenceRooms retrieves rooms generated from a series, ordered by occurrence time.
+	// from/to filter on the original occurrence start time when set.
+	FindOccurrenceRooms(ctx context.Context, seriesID uuid.UUID, from, to *time.Time) ([]*entities.Room, error)
+
+	// FindOccurrenceRoom retrieves the room generated for a single occurrence
+	FindOccurrenceRoom(ctx context.Context, seriesID uuid.UUID, occurrenceStart time.Time) (*entities.Room, error)
+}
@@ -33,6 +33,15 @@ type UserRepository interface {
 	// Delete soft deletes a user (sets is_active to false)
 	Delete(ctx context.Context, id uuid.UUID) error
 
-	// List returns a paginated list of users
-	List(ctx context.Context, limit, offset int) ([]*entities.User, error)
+	// List retrieves users with filters and pagination, newest first
+	List(ctx context.Context, filters UserFilters) ([]*entities.User, int64, error)
+}
+
+// UserFilters represents filter options for listing users
+type UserFilters struct {
+	Search   string // Search in email, name
+	Role     *entities.UserRole
+	IsActive *bool
+	Limit    int
+	Offset   int
 }
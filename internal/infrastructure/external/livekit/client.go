@@ -3,6 +3,10 @@ package livekit
 import (
 	"context"
 	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
@@ -15,9 +19,16 @@ import (
 type Client interface {
 	CreateRoom(ctx context.Context, name string, options *CreateRoomOptions) (*RoomInfo, error)
 	DeleteRoom(ctx context.Context, roomName string) error
+	ListRooms(ctx context.Context, names []string) ([]*RoomInfo, error)
 	GenerateToken(userID, roomName, participantName string, options *TokenOptions) (string, error)
 	ListParticipants(ctx context.Context, roomName string) ([]*ParticipantInfo, error)
+	GetParticipant(ctx context.Context, roomName, identity string) (*ParticipantInfo, error)
 	RemoveParticipant(ctx context.Context, roomName, identity string) error
+	MutePublishedTrack(ctx context.Context, roomName, identity, trackSID string, muted bool) (*TrackInfo, error)
+	UpdateParticipant(ctx context.Context, roomName, identity string, options *UpdateParticipantOptions) (*ParticipantInfo, error)
+	SendData(ctx context.Context, roomName string, data []byte, topic string) error
+	StartRoomCompositeEgress(ctx context.Context, request *livekit.RoomCompositeEgressRequest) (string, error)
+	StopEgress(ctx context.Context, egressID string) error
 }
 
 // CreateRoomOptions holds options for creating a room
@@ -37,8 +48,37 @@ type TokenOptions struct {
 	CanPublishData bool
 	RoomJoin       bool
 	RoomAdmin      bool
+	// CanPublishSources restricts which track sources may be published (nil = all)
+	CanPublishSources []string
+	// Attributes are exposed to clients as participant attributes
+	Attributes map[string]string
 }
 
+// UpdateParticipantOptions holds the changes applied to a connected participant.
+// Empty fields are left unchanged; Permission replaces the whole permission set.
+type UpdateParticipantOptions struct {
+	Metadata   string
+	Permission *ParticipantPermission
+	Attributes map[string]string
+}
+
+// ParticipantPermission holds the live permissions of a connected participant
+type ParticipantPermission struct {
+	CanPublish     bool
+	CanSubscribe   bool
+	CanPublishData bool
+	// CanPublishSources restricts which track sources may be published (nil = all)
+	CanPublishSources []string
+}
+
+// Track sources accepted in TokenOptions.CanPublishSources and reported in TrackInfo.Source
+const (
+	SourceCamera           = "camera"
+	SourceMicrophone       = "microphone"
+	SourceScreenShare      = "screen_share"
+	SourceScreenShareAudio = "screen_share_audio"
+)
+
 // RoomInfo holds room information
 type RoomInfo struct {
 	Name            string
@@ -56,6 +96,15 @@ type ParticipantInfo struct {
 	Name     string
 	Metadata string
 	JoinedAt time.Time
+	Tracks   []*TrackInfo
+}
+
+// TrackInfo holds information about a published track
+type TrackInfo struct {
+	SID    string
+	Name   string
+	Source string // one of the Source* constants, empty if unknown
+	Muted  bool
 }
 
 // realClient is the real LiveKit client implementation
@@ -70,11 +119,7 @@ type realClient struct {
 // NewClient creates a new LiveKit client
 func NewClient(url, apiKey, apiSecret string, useMock bool) Client {
 	if useMock {
-		return &mockClient{
-			url:       url,
-			apiKey:    apiKey,
-			apiSecret: apiSecret,
-		}
+		return NewMockClient(url, apiKey, apiSecret)
 	}
 
 	roomClient := lksdk.NewRoomServiceClient(url, apiKey, apiSecret)
@@ -133,6 +178,30 @@ func (c *realClient) DeleteRoom(ctx context.Context, roomName string) error {
 	return nil
 }
 
+// ListRooms lists the open rooms with the given names (every open room when names is empty)
+func (c *realClient) ListRooms(ctx context.Context, names []string) ([]*RoomInfo, error) {
+	resp, err := c.roomClient.ListRooms(ctx, &livekit.ListRoomsRequest{
+		Names: names,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list rooms: %w", err)
+	}
+
+	rooms := make([]*RoomInfo, 0, len(resp.Rooms))
+	for _, room := range resp.Rooms {
+		rooms = append(rooms, &RoomInfo{
+			Name:            room.Name,
+			SID:             room.Sid,
+			CreationTime:    time.Unix(room.CreationTime, 0),
+			MaxParticipants: int32(room.MaxParticipants),
+			NumParticipants: int32(room.NumParticipants),
+			Metadata:        room.Metadata,
+		})
+	}
+
+	return rooms, nil
+}
+
 // RemoveParticipant removes a participant from a room
 func (c *realClient) RemoveParticipant(ctx context.Context, roomName, identity string) error {
 	_, err := c.roomClient.RemoveParticipant(ctx, &livekit.RoomParticipantIdentity{
@@ -145,6 +214,40 @@ func (c *realClient) RemoveParticipant(ctx context.Context, roomName, identity s
 	return nil
 }
 
+// SendData broadcasts a reliable data message to every participant in a room
+func (c *realClient) SendData(ctx context.Context, roomName string, data []byte, topic string) error {
+	_, err := c.roomClient.SendData(ctx, &livekit.SendDataRequest{
+		Room:  roomName,
+		Data:  data,
+		Kind:  livekit.DataPacket_RELIABLE,
+		Topic: &topic,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send data: %w", err)
+	}
+	return nil
+}
+
+// StartRoomCompositeEgress starts recording a room and returns the egress ID
+func (c *realClient) StartRoomCompositeEgress(ctx context.Context, request *livekit.RoomCompositeEgressRequest) (string, error) {
+	info, err := c.egressClient.StartRoomCompositeEgress(ctx, request)
+	if err != nil {
+		return "", fmt.Errorf("failed to start egress: %w", err)
+	}
+	return info.EgressId, nil
+}
+
+// StopEgress stops an ongoing egress
+func (c *realClient) StopEgress(ctx context.Context, egressID string) error {
+	_, err := c.egressClient.StopEgress(ctx, &livekit.StopEgressRequest{
+		EgressId: egressID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to stop egress: %w", err)
+	}
+	return nil
+}
+
 // GenerateToken generates an access token for joining a room
 func (c *realClient) GenerateToken(userID, roomName, participantName string, options *TokenOptions) (string, error) {
 	if options == nil {
@@ -170,11 +273,17 @@ func (c *realClient) GenerateToken(userID, roomName, participantName string, opt
 	if options.RoomAdmin {
 		grant.RoomAdmin = true
 	}
+	if options.CanPublishSources != nil {
+		grant.CanPublishSources = options.CanPublishSources
+	}
 
 	at.AddGrant(grant).
 		SetIdentity(userID).
 		SetName(participantName).
 		SetValidFor(options.ValidFor)
+	if len(options.Attributes) > 0 {
+		at.SetAttributes(options.Attributes)
+	}
 
 	token, err := at.ToJWT()
 	if err != nil {
@@ -195,27 +304,165 @@ func (c *realClient) ListParticipants(ctx context.Context, roomName string) ([]*
 
 	participants := make([]*ParticipantInfo, 0, len(resp.Participants))
 	for _, p := range resp.Participants {
-		participants = append(participants, &ParticipantInfo{
-			SID:      p.Sid,
-			Identity: p.Identity,
-			Name:     p.Name,
-			Metadata: p.Metadata,
-			JoinedAt: time.Unix(p.JoinedAt, 0),
-		})
+		participants = append(participants, toParticipantInfo(p))
 	}
 
 	return participants, nil
 }
 
-// mockClient is a mock implementation for testing
-type mockClient struct {
+// GetParticipant gets a connected participant and its published tracks
+func (c *realClient) GetParticipant(ctx context.Context, roomName, identity string) (*ParticipantInfo, error) {
+	p, err := c.roomClient.GetParticipant(ctx, &livekit.RoomParticipantIdentity{
+		Room:     roomName,
+		Identity: identity,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get participant: %w", err)
+	}
+	return toParticipantInfo(p), nil
+}
+
+// MutePublishedTrack mutes or unmutes a track published by a participant.
+// Unmuting requires enable_remote_unmute in the LiveKit server config.
+func (c *realClient) MutePublishedTrack(ctx context.Context, roomName, identity, trackSID string, muted bool) (*TrackInfo, error) {
+	resp, err := c.roomClient.MutePublishedTrack(ctx, &livekit.MuteRoomTrackRequest{
+		Room:     roomName,
+		Identity: identity,
+		TrackSid: trackSID,
+		Muted:    muted,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to mute track: %w", err)
+	}
+	return toTrackInfo(resp.Track), nil
+}
+
+// UpdateParticipant updates the metadata, attributes or permissions of a connected participant
+func (c *realClient) UpdateParticipant(ctx context.Context, roomName, identity string, options *UpdateParticipantOptions) (*ParticipantInfo, error) {
+	if options == nil {
+		options = &UpdateParticipantOptions{}
+	}
+
+	req := &livekit.UpdateParticipantRequest{
+		Room:       roomName,
+		Identity:   identity,
+		Metadata:   options.Metadata,
+		Attributes: options.Attributes,
+	}
+	if perm := options.Permission; perm != nil {
+		req.Permission = &livekit.ParticipantPermission{
+			CanPublish:     perm.CanPublish,
+			CanSubscribe:   perm.CanSubscribe,
+			CanPublishData: perm.CanPublishData,
+		}
+		for _, source := range perm.CanPublishSources {
+			if v, ok := livekit.TrackSource_value[strings.ToUpper(source)]; ok {
+				req.Permission.CanPublishSources = append(req.Permission.CanPublishSources, livekit.TrackSource(v))
+			}
+		}
+	}
+
+	p, err := c.roomClient.UpdateParticipant(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update participant: %w", err)
+	}
+	return toParticipantInfo(p), nil
+}
+
+// toParticipantInfo converts a LiveKit participant
+func toParticipantInfo(p *livekit.ParticipantInfo) *ParticipantInfo {
+	info := &ParticipantInfo{
+		SID:      p.Sid,
+		Identity: p.Identity,
+		Name:     p.Name,
+		Metadata: p.Metadata,
+		JoinedAt: time.Unix(p.JoinedAt, 0),
+		Tracks:   make([]*TrackInfo, 0, len(p.Tracks)),
+	}
+	for _, t := range p.Tracks {
+		info.Tracks = append(info.Tracks, toTrackInfo(t))
+	}
+	return info
+}
+
+// toTrackInfo converts a LiveKit track
+func toTrackInfo(t *livekit.TrackInfo) *TrackInfo {
+	if t == nil {
+		return nil
+	}
+	info := &TrackInfo{
+		SID:   t.Sid,
+		Name:  t.Name,
+		Muted: t.Muted,
+	}
+	if t.Source != livekit.TrackSource_UNKNOWN {
+		info.Source = strings.ToLower(t.Source.String())
+	}
+	return info
+}
+
+// MockClient is a mock implementation for testing. It keeps the rooms and the connected
+// participants in memory, so tests can simulate people connecting and dropping out of LiveKit.
+type MockClient struct {
 	url       string
 	apiKey    string
 	apiSecret string
+
+	mu           sync.Mutex
+	rooms        map[string]*RoomInfo
+	participants map[string]map[string]*ParticipantInfo // room name -> identity -> participant
+}
+
+// NewMockClient creates a mock LiveKit client without rooms
+func NewMockClient(url, apiKey, apiSecret string) *MockClient {
+	return &MockClient{
+		url:          url,
+		apiKey:       apiKey,
+		apiSecret:    apiSecret,
+		rooms:        make(map[string]*RoomInfo),
+		participants: make(map[string]map[string]*ParticipantInfo),
+	}
+}
+
+// ConnectParticipant (mock) simulates a participant connecting to a room.
+// Like LiveKit, the room is created when it does not exist yet.
+func (m *MockClient) ConnectParticipant(roomName string, participant *ParticipantInfo) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.rooms[roomName]; !ok {
+		m.rooms[roomName] = &RoomInfo{
+			Name:         roomName,
+			SID:          "mock-sid-" + uuid.New().String(),
+			CreationTime: time.Now(),
+		}
+	}
+	if m.participants[roomName] == nil {
+		m.participants[roomName] = make(map[string]*ParticipantInfo)
+	}
+	if participant.SID == "" {
+		participant.SID = "PA_mock_" + participant.Identity
+	}
+	if participant.JoinedAt.IsZero() {
+		participant.JoinedAt = time.Now()
+	}
+	m.participants[roomName][participant.Identity] = participant
+	m.rooms[roomName].NumParticipants = int32(len(m.participants[roomName]))
+}
+
+// DisconnectParticipant (mock) simulates a participant dropping out of a room
+func (m *MockClient) DisconnectParticipant(roomName, identity string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.participants[roomName], identity)
+	if room, ok := m.rooms[roomName]; ok {
+		room.NumParticipants = int32(len(m.participants[roomName]))
+	}
 }
 
 // CreateRoom (mock) simulates room creation
-func (m *mockClient) CreateRoom(ctx context.Context, name string, options *CreateRoomOptions) (*RoomInfo, error) {
+func (m *MockClient) CreateRoom(ctx context.Context, name string, options *CreateRoomOptions) (*RoomInfo, error) {
 	if options == nil {
 		options = &CreateRoomOptions{
 			MaxParticipants: 10,
@@ -223,24 +470,53 @@ func (m *mockClient) CreateRoom(ctx context.Context, name string, options *Creat
 		}
 	}
 
-	return &RoomInfo{
+	room := &RoomInfo{
 		Name:            name,
 		SID:             "mock-sid-" + uuid.New().String(),
 		CreationTime:    time.Now(),
 		MaxParticipants: options.MaxParticipants,
 		NumParticipants: 0,
 		Metadata:        options.Metadata,
-	}, nil
+	}
+
+	m.mu.Lock()
+	m.rooms[name] = room
+	m.mu.Unlock()
+
+	info := *room
+	return &info, nil
 }
 
-// DeleteRoom (mock) simulates room deletion
-func (m *mockClient) DeleteRoom(ctx context.Context, roomName string) error {
-	// Mock: always succeed
+// DeleteRoom (mock) simulates room deletion, disconnecting everyone in it
+func (m *MockClient) DeleteRoom(ctx context.Context, roomName string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.rooms, roomName)
+	delete(m.participants, roomName)
 	return nil
 }
 
+// ListRooms (mock) lists the rooms created or connected to and not deleted since
+func (m *MockClient) ListRooms(ctx context.Context, names []string) ([]*RoomInfo, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	rooms := make([]*RoomInfo, 0, len(m.rooms))
+	for name, room := range m.rooms {
+		if len(names) > 0 && !slices.Contains(names, name) {
+			continue
+		}
+		info := *room
+		rooms = append(rooms, &info)
+	}
+	sort.Slice(rooms, func(i, j int) bool { return rooms[i].Name < rooms[j].Name })
+
+	return rooms, nil
+}
+
 // GenerateToken (mock) generates a mock token
-func (m *mockClient) GenerateToken(userID, roomName, participantName string, options *TokenOptions) (string, error) {
+func (m *MockClient) GenerateToken(userID, roomName, participantName string, options *TokenOptions) (string, error) {
 	if options == nil {
 		options = &TokenOptions{
 			ValidFor:       24 * time.Hour,
@@ -264,11 +540,17 @@ func (m *mockClient) GenerateToken(userID, roomName, participantName string, opt
 	if options.RoomAdmin {
 		grant.RoomAdmin = true
 	}
+	if options.CanPublishSources != nil {
+		grant.CanPublishSources = options.CanPublishSources
+	}
 
 	at.AddGrant(grant).
 		SetIdentity(userID).
 		SetName(participantName).
 		SetValidFor(options.ValidFor)
+	if len(options.Attributes) > 0 {
+		at.SetAttributes(options.Attributes)
+	}
 
 	token, err := at.ToJWT()
 	if err != nil {
@@ -278,19 +560,79 @@ func (m *mockClient) GenerateToken(userID, roomName, participantName string, opt
 	return token, nil
 }
 
-// ListParticipants (mock) returns empty list
-func (m *mockClient) ListParticipants(ctx context.Context, roomName string) ([]*ParticipantInfo, error) {
-	return []*ParticipantInfo{}, nil
+// ListParticipants (mock) lists the participants connected to a room
+func (m *MockClient) ListParticipants(ctx context.Context, roomName string) ([]*ParticipantInfo, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	participants := make([]*ParticipantInfo, 0, len(m.participants[roomName]))
+	for _, p := range m.participants[roomName] {
+		participants = append(participants, p)
+	}
+	sort.Slice(participants, func(i, j int) bool { return participants[i].JoinedAt.Before(participants[j].JoinedAt) })
+
+	return participants, nil
+}
+
+// GetParticipant (mock) returns the connected participant, or one without published tracks
+func (m *MockClient) GetParticipant(ctx context.Context, roomName, identity string) (*ParticipantInfo, error) {
+	m.mu.Lock()
+	p, ok := m.participants[roomName][identity]
+	m.mu.Unlock()
+	if ok {
+		return p, nil
+	}
+
+	return &ParticipantInfo{
+		SID:      "PA_mock_" + identity,
+		Identity: identity,
+		JoinedAt: time.Now(),
+		Tracks:   []*TrackInfo{},
+	}, nil
+}
+
+// MutePublishedTrack (mock) simulates muting a track
+func (m *MockClient) MutePublishedTrack(ctx context.Context, roomName, identity, trackSID string, muted bool) (*TrackInfo, error) {
+	return &TrackInfo{
+		SID:   trackSID,
+		Muted: muted,
+	}, nil
+}
+
+// UpdateParticipant (mock) simulates updating a participant
+func (m *MockClient) UpdateParticipant(ctx context.Context, roomName, identity string, options *UpdateParticipantOptions) (*ParticipantInfo, error) {
+	info := &ParticipantInfo{
+		SID:      "PA_mock_" + identity,
+		Identity: identity,
+		JoinedAt: time.Now(),
+		Tracks:   []*TrackInfo{},
+	}
+	if options != nil {
+		info.Metadata = options.Metadata
+	}
+	return info, nil
 }
 
 // RemoveParticipant (mock) simulates participant removal
-func (m *mockClient) RemoveParticipant(ctx context.Context, roomName, identity string) error {
+func (m *MockClient) RemoveParticipant(ctx context.Context, roomName, identity string) error {
+	m.DisconnectParticipant(roomName, identity)
+	return nil
+}
+
+// SendData (mock) simulates sending a data message
+func (m *MockClient) SendData(ctx context.Context, roomName string, data []byte, topic string) error {
 	// Mock: always succeed
 	return nil
 }
 
 // StartRoomCompositeEgress (mock) simulates starting recording
-func (m *mockClient) StartRoomCompositeEgress(ctx context.Context, roomName, outputDir string) (string, error) {
+func (m *MockClient) StartRoomCompositeEgress(ctx context.Context, request *livekit.RoomCompositeEgressRequest) (string, error) {
 	// Mock: return fake egress ID
 	return "EG_mock_" + uuid.New().String(), nil
 }
+
+// StopEgress (mock) simulates stopping a recording
+func (m *MockClient) StopEgress(ctx context.Context, egressID string) error {
+	// Mock: always succeed
+	return nil
+}
@@ -0,0 +1,116 @@
+package mail
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+)
+
+//go:embed templates
+var templateFS embed.FS
+
+// Template names a message template; every template has a .txt and .html
+// variant per language, and the .txt file defines the "subject" block.
+type Template string
+
+const (
+	TemplateInvitation         Template = "invitation"
+	TemplateMeetingReminder    Template = "reminder"
+	TemplateSummaryReady       Template = "summary_ready"
+	TemplateOrganizationInvite Template = "organization_invite"
+)
+
+// DefaultLanguage is used when the recipient's language has no templates
+const DefaultLanguage = "en"
+
+var (
+	supportedLanguages = []string{"en", "vi"}
+	allTemplates       = []Template{TemplateInvitation, TemplateMeetingReminder, TemplateSummaryReady, TemplateOrganizationInvite}
+)
+
+// Renderer renders the embedded email templates
+type Renderer struct {
+	text map[string]*texttemplate.Template
+	html map[string]*htmltemplate.Template
+}
+
+// NewRenderer parses all embedded templates up front so broken templates fail at startup
+func NewRenderer() (*Renderer, error) {
+	r := &Renderer{
+		text: make(map[string]*texttemplate.Template),
+		html: make(map[string]*htmltemplate.Template),
+	}
+
+	for _, lang := range supportedLanguages {
+		for _, name := range allTemplates {
+			key := templateKey(name, lang)
+
+			txt, err := texttemplate.ParseFS(templateFS, fmt.Sprintf("templates/%s/%s.txt", lang, name))
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse %s text template: %w", key, err)
+			}
+			if txt.Lookup("subject") == nil {
+				return nil, fmt.Errorf("template %s has no subject block", key)
+			}
+
+			html, err := htmltemplate.ParseFS(templateFS, fmt.Sprintf("templates/%s/%s.html", lang, name))
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse %s html template: %w", key, err)
+			}
+
+			r.text[key] = txt
+			r.html[key] = html
+		}
+	}
+
+	return r, nil
+}
+
+// Render renders a template in the given language (falling back to English).
+// The returned message has no recipients set.
+func (r *Renderer) Render(name Template, lang string, data interface{}) (*Message, error) {
+	key := templateKey(name, NormalizeLanguage(lang))
+
+	txt, ok := r.text[key]
+	if !ok {
+		return nil, fmt.Errorf("unknown mail template %q", name)
+	}
+
+	var subject, text, html bytes.Buffer
+	if err := txt.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return nil, fmt.Errorf("failed to render %s subject: %w", key, err)
+	}
+	if err := txt.Execute(&text, data); err != nil {
+		return nil, fmt.Errorf("failed to render %s text: %w", key, err)
+	}
+	if err := r.html[key].Execute(&html, data); err != nil {
+		return nil, fmt.Errorf("failed to render %s html: %w", key, err)
+	}
+
+	return &Message{
+		Subject: strings.TrimSpace(subject.String()),
+		Text:    strings.TrimSpace(text.String()) + "\n",
+		HTML:    html.String(),
+	}, nil
+}
+
+// NormalizeLanguage maps a user language ("vi", "vi-VN", "EN_us") to a supported template language
+func NormalizeLanguage(lang string) string {
+	lang = strings.ToLower(strings.TrimSpace(lang))
+	if i := strings.IndexAny(lang, "-_"); i >= 0 {
+		lang = lang[:i]
+	}
+	for _, supported := range supportedLanguages {
+		if lang == supported {
+			return lang
+		}
+	}
+	return DefaultLanguage
+}
+
+func templateKey(name Template, lang string) string {
+	return lang + "/" + string(name)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
 	"github.com/johnquangdev/meeting-assistant/internal/usecase/auth"
+	"github.com/johnquangdev/meeting-assistant/pkg/jwt"
 	"github.com/labstack/echo/v4"
 )
 
@@ -107,58 +108,147 @@ func GetUserFromContext(ctx context.Context) (*entities.User, bool) {
 func EchoAuth(oauthService *auth.OAuthService) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
-			// Extract token from Authorization header or cookie
-			authHeader := c.Request().Header.Get("Authorization")
-			token := ""
-			if authHeader != "" {
-				parts := strings.Split(authHeader, " ")
-				if len(parts) == 2 && strings.ToLower(parts[0]) == "bearer" {
-					token = parts[1]
-				}
+			user, err := authenticateEcho(c, oauthService)
+			if err != nil {
+				return err
 			}
-			// If a session_id cookie exists, prefer server-side session validation
-			if token == "" {
-				if cookie, err := c.Cookie("session_id"); err == nil && cookie.Value != "" {
-					// validate session id
-					if sid, err := uuid.Parse(cookie.Value); err == nil {
-						user, err := oauthService.ValidateSessionByID(c.Request().Context(), sid)
-						if err == nil {
-							c.Set("user", user)
-							c.Set("user_id", user.ID)
-							c.Set("user_email", user.Email)
-							return next(c)
-						}
-					}
-				}
-				// fallback to access_token cookie
-				if token == "" {
-					if cookie, err := c.Cookie("access_token"); err == nil {
-						token = cookie.Value
-					}
+
+			// set into echo context: user, user_id, and user_email
+			setEchoUser(c, user)
+
+			return next(c)
+		}
+	}
+}
+
+// EchoOptionalAuth returns an Echo middleware that sets the user into Echo context
+// when a valid token or session is present, and lets anonymous requests through
+func EchoOptionalAuth(oauthService *auth.OAuthService) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if user, err := authenticateEcho(c, oauthService); err == nil {
+				setEchoUser(c, user)
+			}
+			return next(c)
+		}
+	}
+}
+
+// EchoRequireRole returns an Echo middleware that only lets users with one of the roles through.
+// It runs after EchoAuth, which sets "user" into Echo context.
+func EchoRequireRole(roles ...entities.UserRole) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			user, ok := c.Get("user").(*entities.User)
+			if !ok || user == nil {
+				return echo.NewHTTPError(http.StatusUnauthorized, "User not authenticated")
+			}
+
+			for _, role := range roles {
+				if user.Role == role {
+					return next(c)
 				}
 			}
+			return echo.NewHTTPError(http.StatusForbidden, "Insufficient permissions")
+		}
+	}
+}
 
+// EchoGuestAuth returns an Echo middleware that validates a guest token from the
+// Authorization header (or the event stream query token) and sets "guest_participant_id", "guest_room_id" (uuid.UUID)
+// and "guest_name" (string) into Echo context
+func EchoGuestAuth(jwtManager *jwt.Manager) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			token := ""
+			parts := strings.Split(c.Request().Header.Get("Authorization"), " ")
+			if len(parts) == 2 && strings.ToLower(parts[0]) == "bearer" {
+				token = parts[1]
+			}
+			if token == "" {
+				token = eventStreamToken(c)
+			}
 			if token == "" {
-				return echo.NewHTTPError(http.StatusUnauthorized, "Missing authorization token")
+				return echo.NewHTTPError(http.StatusUnauthorized, "Missing guest token")
 			}
 
-			user, err := oauthService.ValidateSession(c.Request().Context(), token)
+			claims, err := jwtManager.ValidateGuestToken(token)
 			if err != nil {
-				return echo.NewHTTPError(http.StatusUnauthorized, "Invalid or expired token")
+				return echo.NewHTTPError(http.StatusUnauthorized, "Invalid or expired guest token")
 			}
 
-			// set into echo context: user, user_id, and user_email
-			c.Set("user", user)
-			c.Set("user_id", user.ID)
-			c.Set("user_email", user.Email)
+			c.Set("guest_participant_id", claims.ParticipantID)
+			c.Set("guest_room_id", claims.RoomID)
+			c.Set("guest_name", claims.Name)
 
 			return next(c)
 		}
 	}
 }
 
+// authenticateEcho resolves the user from the Authorization header or auth cookies
+func authenticateEcho(c echo.Context, oauthService *auth.OAuthService) (*entities.User, error) {
+	// Extract token from Authorization header or cookie
+	authHeader := c.Request().Header.Get("Authorization")
+	token := ""
+	if authHeader != "" {
+		parts := strings.Split(authHeader, " ")
+		if len(parts) == 2 && strings.ToLower(parts[0]) == "bearer" {
+			token = parts[1]
+		}
+	}
+	// If a session_id cookie exists, prefer server-side session validation
+	if token == "" {
+		if cookie, err := c.Cookie("session_id"); err == nil && cookie.Value != "" {
+			// validate session id
+			if sid, err := uuid.Parse(cookie.Value); err == nil {
+				user, err := oauthService.ValidateSessionByID(c.Request().Context(), sid)
+				if err == nil {
+					return user, nil
+				}
+			}
+		}
+		// fallback to access_token cookie
+		if token == "" {
+			if cookie, err := c.Cookie("access_token"); err == nil {
+				token = cookie.Value
+			}
+		}
+		// fallback to the query token of event streams
+		if token == "" {
+			token = eventStreamToken(c)
+		}
+	}
+
+	if token == "" {
+		return nil, echo.NewHTTPError(http.StatusUnauthorized, "Missing authorization token")
+	}
+
+	user, err := oauthService.ValidateSession(c.Request().Context(), token)
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusUnauthorized, "Invalid or expired token")
+	}
+
+	return user, nil
+}
+
+func setEchoUser(c echo.Context, user *entities.User) {
+	c.Set("user", user)
+	c.Set("user_id", user.ID)
+	c.Set("user_email", user.Email)
+}
+
 // Helper functions
 
+// eventStreamToken returns the access_token query parameter of Server-Sent Events requests.
+// EventSource cannot set headers, so streams may pass the token in the URL; other requests may not.
+func eventStreamToken(c echo.Context) string {
+	if !strings.Contains(c.Request().Header.Get(echo.HeaderAccept), "text/event-stream") {
+		return ""
+	}
+	return c.QueryParam("access_token")
+}
+
 func extractToken(r *http.Request) string {
 	// Try Authorization header first
 	authHeader := r.Header.Get("Authorization")
@@ -0,0 +1,209 @@
+package pubsub
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+)
+
+// channelPrefix is the Redis channel prefix of room events (one channel per room)
+const channelPrefix = "room_events:"
+
+// subscriberBuffer is how many events a slow subscriber may lag behind before events are dropped
+const subscriberBuffer = 32
+
+// Broker fans out room events to the event streams of every API instance
+type Broker interface {
+	// Publish delivers an event to every subscriber of its room (best effort, never blocks)
+	Publish(ctx context.Context, event *entities.RoomEvent)
+
+	// Subscribe returns the events of a room and a function that ends the subscription
+	Subscribe(roomID uuid.UUID) (<-chan *entities.RoomEvent, func())
+
+	// Close stops the broker and closes every subscription
+	Close() error
+}
+
+// hub delivers events to the subscribers connected to this instance
+type hub struct {
+	mu          sync.RWMutex
+	subscribers map[uuid.UUID]map[chan *entities.RoomEvent]struct{}
+	closed      bool
+}
+
+func newHub() *hub {
+	return &hub{subscribers: make(map[uuid.UUID]map[chan *entities.RoomEvent]struct{})}
+}
+
+func (h *hub) subscribe(roomID uuid.UUID) (<-chan *entities.RoomEvent, func()) {
+	ch := make(chan *entities.RoomEvent, subscriberBuffer)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		close(ch)
+		return ch, func() {}
+	}
+
+	if h.subscribers[roomID] == nil {
+		h.subscribers[roomID] = make(map[chan *entities.RoomEvent]struct{})
+	}
+	h.subscribers[roomID][ch] = struct{}{}
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			h.mu.Lock()
+			defer h.mu.Unlock()
+
+			if _, ok := h.subscribers[roomID][ch]; !ok {
+				return // Already closed by Close
+			}
+			delete(h.subscribers[roomID], ch)
+			if len(h.subscribers[roomID]) == 0 {
+				delete(h.subscribers, roomID)
+			}
+			close(ch)
+		})
+	}
+}
+
+func (h *hub) deliver(event *entities.RoomEvent) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for ch := range h.subscribers[event.RoomID] {
+		select {
+		case ch <- event:
+		default:
+			log.Printf("[RoomEvents] ⚠️ Dropping %s event for slow subscriber of room %s", event.Type, event.RoomID)
+		}
+	}
+}
+
+func (h *hub) close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.closed = true
+	for roomID, subscribers := range h.subscribers {
+		for ch := range subscribers {
+			close(ch)
+		}
+		delete(h.subscribers, roomID)
+	}
+}
+
+// LocalBroker delivers events only to subscribers of this instance (single instance deployments)
+type LocalBroker struct {
+	hub *hub
+}
+
+// NewLocalBroker creates an in-process broker
+func NewLocalBroker() *LocalBroker {
+	return &LocalBroker{hub: newHub()}
+}
+
+// Publish delivers an event to the subscribers of its room
+func (b *LocalBroker) Publish(_ context.Context, event *entities.RoomEvent) {
+	b.hub.deliver(event)
+}
+
+// Subscribe returns the events of a room
+func (b *LocalBroker) Subscribe(roomID uuid.UUID) (<-chan *entities.RoomEvent, func()) {
+	return b.hub.subscribe(roomID)
+}
+
+// Close closes every subscription
+func (b *LocalBroker) Close() error {
+	b.hub.close()
+	return nil
+}
+
+// RedisBroker publishes events on Redis so subscribers on every instance receive them
+type RedisBroker struct {
+	client *redis.Client
+	pubsub *redis.PubSub
+	hub    *hub
+	done   chan struct{}
+}
+
+// NewRedisBroker creates a broker fanning out events through Redis pub/sub
+func NewRedisBroker(ctx context.Context, client *redis.Client) (*RedisBroker, error) {
+	pubsub := client.PSubscribe(ctx, channelPrefix+"*")
+	// Wait for the subscription to be confirmed so the connection is known to work
+	if _, err := pubsub.Receive(ctx); err != nil {
+		pubsub.Close()
+		return nil, err
+	}
+
+	b := &RedisBroker{
+		client: client,
+		pubsub: pubsub,
+		hub:    newHub(),
+		done:   make(chan struct{}),
+	}
+	go b.listen()
+
+	return b, nil
+}
+
+// Publish publishes an event on the room's Redis channel
+func (b *RedisBroker) Publish(ctx context.Context, event *entities.RoomEvent) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		log.Printf("[RoomEvents] ❌ Failed to encode %s event: %v", event.Type, err)
+		return
+	}
+
+	if err := b.client.Publish(ctx, channelPrefix+event.RoomID.String(), payload).Err(); err != nil {
+		// Keep local subscribers informed even when Redis is unavailable
+		log.Printf("[RoomEvents] ⚠️ Failed to publish %s event to Redis, delivering locally: %v", event.Type, err)
+		b.hub.deliver(event)
+	}
+}
+
+// Subscribe returns the events of a room
+func (b *RedisBroker) Subscribe(roomID uuid.UUID) (<-chan *entities.RoomEvent, func()) {
+	return b.hub.subscribe(roomID)
+}
+
+// Close stops listening to Redis and closes every subscription
+func (b *RedisBroker) Close() error {
+	err := b.pubsub.Close()
+	<-b.done
+	b.hub.close()
+	return err
+}
+
+// listen forwards events received from Redis to local subscribers
+func (b *RedisBroker) listen() {
+	defer close(b.done)
+
+	for msg := range b.pubsub.Channel() {
+		if !strings.HasPrefix(msg.Channel, channelPrefix) {
+			continue
+		}
+
+		var event entities.RoomEvent
+		if err := json.Unmarshal([]byte(msg.Payload), &event); err != nil {
+			log.Printf("[RoomEvents] ⚠️ Ignoring malformed event on %s: %v", msg.Channel, err)
+			continue
+		}
+		b.hub.deliver(&event)
+	}
+}
+
+// Ensure brokers implement Broker interface
+var (
+	_ Broker = (*LocalBroker)(nil)
+	_ Broker = (*RedisBroker)(nil)
+)
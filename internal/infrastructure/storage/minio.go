@@ -5,6 +5,8 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/johnquangdev/meeting-assistant/pkg/config"
@@ -14,9 +16,9 @@ import (
 
 // MinIOClient wraps MinIO operations
 type MinIOClient struct {
-	client    *minio.Client
-	bucket    string
-	publicURL string // Public URL for generating accessible URLs (e.g., https://minio.example.com)
+	client        *minio.Client
+	presignClient *minio.Client // Signs URLs for the public host, since the host is part of the signature
+	bucket        string
 }
 
 // NewMinIOClient creates a new MinIO client
@@ -31,21 +33,56 @@ func NewMinIOClient(cfg *config.StorageConfig) (*MinIOClient, error) {
 	}
 
 	client := &MinIOClient{
-		client:    minioClient,
-		bucket:    cfg.BucketName,
-		publicURL: cfg.PublicURL,
+		client:        minioClient,
+		presignClient: minioClient,
+		bucket:        cfg.BucketName,
 	}
 
-	// Initialize bucket with public read policy
+	// Initialize private bucket
 	ctx := context.Background()
 	if err := client.ensureBucketWithPolicy(ctx); err != nil {
 		return nil, fmt.Errorf("failed to initialize bucket: %w", err)
 	}
 
+	// Presigned URLs must be signed for the host they are opened on
+	if cfg.PublicURL != "" {
+		presignClient, err := newPresignClient(ctx, minioClient, cfg)
+		if err != nil {
+			return nil, err
+		}
+		client.presignClient = presignClient
+	}
+
 	return client, nil
 }
 
-// ensureBucketWithPolicy ensures bucket exists and has public read policy
+// newPresignClient creates a client for the public URL (e.g., https://minio.example.com).
+// Signing is done locally, so the client never has to reach the public host.
+func newPresignClient(ctx context.Context, minioClient *minio.Client, cfg *config.StorageConfig) (*minio.Client, error) {
+	publicURL, err := url.Parse(cfg.PublicURL)
+	if err != nil || publicURL.Host == "" {
+		return nil, fmt.Errorf("invalid MinIO public URL %q", cfg.PublicURL)
+	}
+
+	// Without a region the client would look it up on the public host for every URL
+	region, err := minioClient.GetBucketLocation(ctx, cfg.BucketName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bucket location: %w", err)
+	}
+
+	presignClient, err := minio.New(publicURL.Host, &minio.Options{
+		Creds:  credentials.NewStaticV4(cfg.AccessKeyID, cfg.SecretAccessKey, ""),
+		Secure: publicURL.Scheme == "https",
+		Region: region,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create MinIO presign client: %w", err)
+	}
+	return presignClient, nil
+}
+
+// ensureBucketWithPolicy ensures bucket exists and is private.
+// Recordings are only readable through presigned URLs.
 func (m *MinIOClient) ensureBucketWithPolicy(ctx context.Context) error {
 	// Check if bucket exists
 	exists, err := m.client.BucketExists(ctx, m.bucket)
@@ -61,23 +98,15 @@ func (m *MinIOClient) ensureBucketWithPolicy(ctx context.Context) error {
 		}
 	}
 
-	// Set public read policy for the bucket
-	// This allows presigned URLs to work and enables AssemblyAI to download files
-	policy := fmt.Sprintf(`{
-		"Version": "2012-10-17",
-		"Statement": [
-			{
-				"Effect": "Allow",
-				"Principal": {"AWS": ["*"]},
-				"Action": ["s3:GetObject"],
-				"Resource": ["arn:aws:s3:::%s/*"]
-			}
-		]
-	}`, m.bucket)
-
-	err = m.client.SetBucketPolicy(ctx, m.bucket, policy)
+	// Remove the public read policy earlier versions set on the bucket
+	policy, err := m.client.GetBucketPolicy(ctx, m.bucket)
 	if err != nil {
-		return fmt.Errorf("failed to set bucket policy: %w", err)
+		return fmt.Errorf("failed to get bucket policy: %w", err)
+	}
+	if policy != "" {
+		if err := m.client.SetBucketPolicy(ctx, m.bucket, ""); err != nil {
+			return fmt.Errorf("failed to remove bucket policy: %w", err)
+		}
 	}
 
 	return nil
@@ -102,24 +131,45 @@ func (m *MinIOClient) UploadText(ctx context.Context, objectName string, content
 	return m.UploadFile(ctx, objectName, reader, int64(len(content)), "text/plain")
 }
 
-// GetFileURL gets a public URL for accessing a file
-// Since the bucket has public read policy, we return direct URL without signature
+// GetFileURL gets a presigned URL for accessing a file, valid for expiry
 func (m *MinIOClient) GetFileURL(ctx context.Context, objectName string, expiry time.Duration) (string, error) {
-	// If public URL is configured, return direct public URL (no signature needed)
-	// This is useful when MinIO is behind a reverse proxy with public bucket policy
-	if m.publicURL != "" {
-		// Format: https://minio.infoquang.id.vn/bucket-name/object-path
-		publicURL := fmt.Sprintf("%s/%s/%s", m.publicURL, m.bucket, objectName)
-		return publicURL, nil
-	}
-
-	// Fallback to standard presigned URL
-	url, err := m.client.PresignedGetObject(ctx, m.bucket, objectName, expiry, nil)
+	presignedURL, err := m.presignClient.PresignedGetObject(ctx, m.bucket, objectName, expiry, nil)
 	if err != nil {
 		return "", fmt.Errorf("failed to generate presigned URL: %w", err)
 	}
 
-	return url.String(), nil
+	return presignedURL.String(), nil
+}
+
+// ObjectKey returns the key of a file of the bucket from its URL, as storage and egress report it
+func (m *MinIOClient) ObjectKey(fileURL string) (string, bool) {
+	return ObjectKey(m.bucket, fileURL)
+}
+
+// ObjectKey returns the key of a file of a bucket from its path-style (https://host/<bucket>/<key>)
+// or virtual-hosted (https://<bucket>.host/<key>) URL; ok is false for URLs of files elsewhere.
+func ObjectKey(bucket, fileURL string) (key string, ok bool) {
+	u, err := url.Parse(strings.TrimSpace(fileURL))
+	if err != nil || bucket == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		return "", false
+	}
+
+	path := strings.TrimPrefix(u.Path, "/")
+	if key, found := strings.CutPrefix(path, bucket+"/"); found && key != "" {
+		return key, true
+	}
+	if strings.HasPrefix(u.Hostname(), bucket+".") && path != "" {
+		return path, true
+	}
+	return "", false
+}
+
+// DeleteFile removes a file; removing a file that does not exist succeeds
+func (m *MinIOClient) DeleteFile(ctx context.Context, objectName string) error {
+	if err := m.client.RemoveObject(ctx, m.bucket, objectName, minio.RemoveObjectOptions{}); err != nil {
+		return fmt.Errorf("failed to delete file: %w", err)
+	}
+	return nil
 }
 
 // ListFiles lists all files in the bucket
@@ -0,0 +1,30 @@
+package storage
+
+import "testing"
+
+func TestObjectKey(t *testing.T) {
+	tests := []struct {
+		name   string
+		bucket string
+		url    string
+		key    string
+		ok     bool
+	}{
+		{name: "path-style", bucket: "recordings", url: "https://minio.example.com/recordings/rooms/a/EG_1.mp4", key: "rooms/a/EG_1.mp4", ok: true},
+		{name: "trailing newline", bucket: "recordings", url: "http://minio:9000/recordings/EG_1.mp4\n", key: "EG_1.mp4", ok: true},
+		{name: "escaped key", bucket: "recordings", url: "https://minio.example.com/recordings/rooms/my%20room.mp4", key: "rooms/my room.mp4", ok: true},
+		{name: "virtual-hosted", bucket: "recordings", url: "https://recordings.s3.amazonaws.com/rooms/a/EG_1.mp4", key: "rooms/a/EG_1.mp4", ok: true},
+		{name: "other bucket", bucket: "recordings", url: "https://minio.example.com/meeting-recordings/EG_1.mp4"},
+		{name: "bucket without key", bucket: "recordings", url: "https://minio.example.com/recordings/"},
+		{name: "other host", bucket: "recordings", url: "https://cdn.example.com/EG_1.mp4"},
+		{name: "bare key", bucket: "recordings", url: "recordings/EG_1.mp4"},
+		{name: "no bucket configured", url: "https://minio.example.com/recordings/EG_1.mp4"},
+	}
+
+	for _, tt := range tests {
+		key, ok := ObjectKey(tt.bucket, tt.url)
+		if key != tt.key || ok != tt.ok {
+			t.Fatalf("%s: ObjectKey(%q) = %q, %v, want %q, %v", tt.name, tt.url, key, ok, tt.key, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,313 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/johnquangdev/meeting-assistant/internal/adapter/repository"
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+	"github.com/johnquangdev/meeting-assistant/internal/domain/repositories"
+	aiUsecase "github.com/johnquangdev/meeting-assistant/internal/usecase/ai"
+	usecaseErrors "github.com/johnquangdev/meeting-assistant/internal/usecase/errors"
+	quotaUsecase "github.com/johnquangdev/meeting-assistant/internal/usecase/quota"
+	roomUsecase "github.com/johnquangdev/meeting-assistant/internal/usecase/room"
+)
+
+// queuedJobStatuses are the AI job statuses still waiting for (or going through) processing
+var queuedJobStatuses = []entities.AIJobStatus{
+	entities.AIJobStatusPending,
+	entities.AIJobStatusSubmitted,
+	entities.AIJobStatusTranscriptReady,
+	entities.AIJobStatusSummarizing,
+	entities.AIJobStatusProcessing,
+}
+
+// AdminService implements platform administration
+type AdminService struct {
+	userRepo      repositories.UserRepository
+	sessionRepo   repositories.SessionRepository
+	roomRepo      repositories.RoomRepository
+	aiJobRepo     *repository.AIJobRepository
+	recordingRepo *repository.RecordingRepository
+	roomService   roomUsecase.Service
+	aiService     aiUsecase.Service
+	quotaService  quotaUsecase.Service
+}
+
+// NewAdminService creates a new admin service
+func NewAdminService(
+	userRepo repositories.UserRepository,
+	sessionRepo repositories.SessionRepository,
+	roomRepo repositories.RoomRepository,
+	aiJobRepo *repository.AIJobRepository,
+	recordingRepo *repository.RecordingRepository,
+	roomService roomUsecase.Service,
+	aiService aiUsecase.Service,
+	quotaService quotaUsecase.Service,
+) *AdminService {
+	return &AdminService{
+		userRepo:      userRepo,
+		sessionRepo:   sessionRepo,
+		roomRepo:      roomRepo,
+		aiJobRepo:     aiJobRepo,
+		recordingRepo: recordingRepo,
+		roomService:   roomService,
+		aiService:     aiService,
+		quotaService:  quotaService,
+	}
+}
+
+// AIJobFilters represents filter options for listing AI jobs
+type AIJobFilters struct {
+	Status    entities.AIJobStatus // Empty = any status
+	MeetingID *uuid.UUID
+	Limit     int
+	Offset    int
+}
+
+// Overview reports the state of the platform
+type Overview struct {
+	Users       UserStats
+	Rooms       RoomStats
+	AIJobs      AIJobStats
+	Storage     StorageStats
+	GeneratedAt time.Time
+}
+
+// UserStats counts users
+type UserStats struct {
+	Total  int64
+	Active int64
+	Admins int64
+}
+
+// RoomStats counts rooms and the people in them
+type RoomStats struct {
+	Active             int64
+	Scheduled          int64
+	ParticipantsOnline int64
+}
+
+// AIJobStats counts AI jobs
+type AIJobStats struct {
+	Queued   int64 // Waiting for or going through processing
+	Failed   int64
+	ByStatus map[entities.AIJobStatus]int64
+}
+
+// StorageStats reports the space taken by recordings
+type StorageStats struct {
+	Recordings int64
+	Bytes      int64
+}
+
+// ListUsers searches users with filters and pagination
+func (s *AdminService) ListUsers(ctx context.Context, filters repositories.UserFilters) ([]*entities.User, int64, error) {
+	users, total, err := s.userRepo.List(ctx, filters)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list users: %w", err)
+	}
+	return users, total, nil
+}
+
+// GetUser retrieves a user
+func (s *AdminService) GetUser(ctx context.Context, userID uuid.UUID) (*entities.User, error) {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, entities.ErrUserNotFound) {
+			return nil, usecaseErrors.ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	return user, nil
+}
+
+// UpdateUserRole changes the platform role of a user. Admins cannot change their own role,
+// so the platform always keeps the admin making the change.
+func (s *AdminService) UpdateUserRole(ctx context.Context, adminID, userID uuid.UUID, role entities.UserRole) (*entities.User, error) {
+	if !role.IsValid() {
+		return nil, usecaseErrors.ErrInvalidUserRole
+	}
+	if adminID == userID {
+		return nil, usecaseErrors.ErrCannotModifySelf
+	}
+
+	user, err := s.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.Role == role {
+		return user, nil
+	}
+
+	previous := user.Role
+	user.Role = role
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		return nil, fmt.Errorf("failed to update user role: %w", err)
+	}
+
+	log.Printf("[Admin] 👤 User role changed: user=%s, role=%s->%s, by=%s", userID, previous, role, adminID)
+
+	return user, nil
+}
+
+// AssignUserPlan changes the plan of the personal rooms of a user (empty code = default plan)
+func (s *AdminService) AssignUserPlan(ctx context.Context, adminID, userID uuid.UUID, code string) (*entities.User, error) {
+	if err := s.quotaService.AssignUserPlan(ctx, userID, code); err != nil {
+		return nil, err
+	}
+
+	log.Printf("[Admin] 📦 User plan changed: user=%s, plan=%q, by=%s", userID, code, adminID)
+
+	return s.GetUser(ctx, userID)
+}
+
+// AssignOrganizationPlan changes the plan of an organization (empty code = default plan)
+func (s *AdminService) AssignOrganizationPlan(ctx context.Context, adminID, orgID uuid.UUID, code string) error {
+	if err := s.quotaService.AssignOrganizationPlan(ctx, orgID, code); err != nil {
+		return err
+	}
+
+	log.Printf("[Admin] 📦 Organization plan changed: organization=%s, plan=%q, by=%s", orgID, code, adminID)
+
+	return nil
+}
+
+// ListPlans retrieves all plans
+func (s *AdminService) ListPlans(ctx context.Context) ([]*entities.Plan, error) {
+	return s.quotaService.ListPlans(ctx)
+}
+
+// SetUserActive deactivates or reactivates a user. Deactivation revokes every session of the user, and
+// with it the session's refresh token, so no token can be refreshed. Access tokens already issued stay
+// valid JWTs, but requests are rejected right away because the user's active flag is checked on each one.
+func (s *AdminService) SetUserActive(ctx context.Context, adminID, userID uuid.UUID, active bool) (*entities.User, error) {
+	if adminID == userID {
+		return nil, usecaseErrors.ErrCannotModifySelf
+	}
+
+	user, err := s.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.IsActive != active {
+		user.IsActive = active
+		if err := s.userRepo.Update(ctx, user); err != nil {
+			return nil, fmt.Errorf("failed to update user: %w", err)
+		}
+	}
+
+	// Revoke again even when already inactive, in case a session slipped through
+	if !active {
+		if err := s.sessionRepo.RevokeAllByUserID(ctx, userID); err != nil {
+			return nil, fmt.Errorf("failed to revoke sessions: %w", err)
+		}
+	}
+
+	log.Printf("[Admin] 🔒 User active=%t: user=%s, by=%s", active, userID, adminID)
+
+	return user, nil
+}
+
+// ListRooms lists the rooms of every tenant
+func (s *AdminService) ListRooms(ctx context.Context, filters repositories.RoomFilters) ([]*entities.Room, int64, error) {
+	if filters.OrganizationID == nil {
+		filters.AllOrganizations = true
+	}
+	return s.roomService.ListRooms(ctx, filters)
+}
+
+// ForceEndRoom ends any room, whoever hosts it
+func (s *AdminService) ForceEndRoom(ctx context.Context, adminID, roomID uuid.UUID) error {
+	return s.roomService.ForceEndRoom(ctx, roomID, adminID)
+}
+
+// ListAIJobs lists AI jobs, optionally filtered by status and meeting
+func (s *AdminService) ListAIJobs(ctx context.Context, filters AIJobFilters) ([]entities.AIJob, int64, error) {
+	jobs, total, err := s.aiJobRepo.ListAIJobs(ctx, filters.Status, filters.MeetingID, filters.Limit, filters.Offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list AI jobs: %w", err)
+	}
+	return jobs, total, nil
+}
+
+// GetAIJob retrieves an AI job
+func (s *AdminService) GetAIJob(ctx context.Context, jobID uuid.UUID) (*entities.AIJob, error) {
+	job, err := s.aiJobRepo.GetAIJobByID(ctx, jobID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get AI job: %w", err)
+	}
+	if job == nil {
+		return nil, usecaseErrors.ErrAIJobNotFound
+	}
+	return job, nil
+}
+
+// RetryAIJob puts a failed or cancelled AI job back in the queue
+func (s *AdminService) RetryAIJob(ctx context.Context, adminID, jobID uuid.UUID) (*entities.AIJob, error) {
+	job, err := s.aiService.RetryJob(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[Admin] 🔁 AI job retried: job=%s, meeting=%s, by=%s", jobID, job.MeetingID, adminID)
+
+	return job, nil
+}
+
+// GetOverview reports the state of the platform
+func (s *AdminService) GetOverview(ctx context.Context) (*Overview, error) {
+	overview := &Overview{GeneratedAt: time.Now()}
+
+	// Users
+	active := true
+	admin := entities.RoleAdmin
+	var err error
+	if _, overview.Users.Total, err = s.userRepo.List(ctx, repositories.UserFilters{Limit: 1}); err != nil {
+		return nil, fmt.Errorf("failed to count users: %w", err)
+	}
+	if _, overview.Users.Active, err = s.userRepo.List(ctx, repositories.UserFilters{IsActive: &active, Limit: 1}); err != nil {
+		return nil, fmt.Errorf("failed to count active users: %w", err)
+	}
+	if _, overview.Users.Admins, err = s.userRepo.List(ctx, repositories.UserFilters{Role: &admin, Limit: 1}); err != nil {
+		return nil, fmt.Errorf("failed to count admins: %w", err)
+	}
+
+	// Rooms
+	activeRooms, err := s.roomRepo.FindActiveRooms(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active rooms: %w", err)
+	}
+	overview.Rooms.Active = int64(len(activeRooms))
+	for _, r := range activeRooms {
+		overview.Rooms.ParticipantsOnline += int64(r.CurrentParticipants)
+	}
+	scheduled := entities.RoomStatusScheduled
+	if _, overview.Rooms.Scheduled, err = s.roomRepo.List(ctx, repositories.RoomFilters{Status: &scheduled, AllOrganizations: true, Limit: 1}); err != nil {
+		return nil, fmt.Errorf("failed to count scheduled rooms: %w", err)
+	}
+
+	// AI jobs
+	byStatus, err := s.aiJobRepo.CountAIJobsByStatus(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count AI jobs: %w", err)
+	}
+	overview.AIJobs.ByStatus = byStatus
+	for _, status := range queuedJobStatuses {
+		overview.AIJobs.Queued += byStatus[status]
+	}
+	overview.AIJobs.Failed = byStatus[entities.AIJobStatusFailed]
+
+	// Storage
+	if overview.Storage.Recordings, overview.Storage.Bytes, err = s.recordingRepo.StorageUsage(ctx); err != nil {
+		return nil, fmt.Errorf("failed to get storage usage: %w", err)
+	}
+
+	return overview, nil
+}
@@ -0,0 +1,54 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+	"github.com/johnquangdev/meeting-assistant/internal/domain/repositories"
+)
+
+// Service defines the interface for platform administration (admins only; the routes enforce the role)
+type Service interface {
+	// ListUsers searches users with filters and pagination
+	ListUsers(ctx context.Context, filters repositories.UserFilters) ([]*entities.User, int64, error)
+
+	// GetUser retrieves a user
+	GetUser(ctx context.Context, userID uuid.UUID) (*entities.User, error)
+
+	// UpdateUserRole changes the platform role of a user
+	UpdateUserRole(ctx context.Context, adminID, userID uuid.UUID, role entities.UserRole) (*entities.User, error)
+
+	// SetUserActive deactivates (revoking all sessions) or reactivates a user
+	SetUserActive(ctx context.Context, adminID, userID uuid.UUID, active bool) (*entities.User, error)
+
+	// AssignUserPlan changes the plan of the personal rooms of a user (empty code = default plan)
+	AssignUserPlan(ctx context.Context, adminID, userID uuid.UUID, code string) (*entities.User, error)
+
+	// AssignOrganizationPlan changes the plan of an organization (empty code = default plan)
+	AssignOrganizationPlan(ctx context.Context, adminID, orgID uuid.UUID, code string) error
+
+	// ListPlans retrieves all plans
+	ListPlans(ctx context.Context) ([]*entities.Plan, error)
+
+	// ListRooms lists the rooms of every tenant
+	ListRooms(ctx context.Context, filters repositories.RoomFilters) ([]*entities.Room, int64, error)
+
+	// ForceEndRoom ends any room, whoever hosts it
+	ForceEndRoom(ctx context.Context, adminID, roomID uuid.UUID) error
+
+	// ListAIJobs lists AI jobs, optionally filtered by status and meeting
+	ListAIJobs(ctx context.Context, filters AIJobFilters) ([]entities.AIJob, int64, error)
+
+	// GetAIJob retrieves an AI job
+	GetAIJob(ctx context.Context, jobID uuid.UUID) (*entities.AIJob, error)
+
+	// RetryAIJob puts a failed or cancelled AI job back in the queue
+	RetryAIJob(ctx context.Context, adminID, jobID uuid.UUID) (*entities.AIJob, error)
+
+	// GetOverview reports the state of the platform
+	GetOverview(ctx context.Context) (*Overview, error)
+}
+
+// Ensure AdminService implements Service interface
+var _ Service = (*AdminService)(nil)
@@ -0,0 +1,98 @@
+package ai
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+	pkgai "github.com/johnquangdev/meeting-assistant/pkg/ai"
+)
+
+// meetingAgenda retrieves the agenda of a meeting (nil when it has none)
+func (s *aiService) meetingAgenda(ctx context.Context, meetingID uuid.UUID) ([]*entities.AgendaItem, error) {
+	if s.agendaRepo == nil {
+		return nil, nil
+	}
+	items, err := s.agendaRepo.FindByRoomID(ctx, meetingID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get agenda: %w", err)
+	}
+	return items, nil
+}
+
+// analysisAgenda converts the agenda for the analysis prompt, with the times the host moved
+// through it positioned on the transcript timeline
+func (s *aiService) analysisAgenda(ctx context.Context, meetingID uuid.UUID, agenda []*entities.AgendaItem) ([]pkgai.AgendaItem, error) {
+	if len(agenda) == 0 {
+		return nil, nil
+	}
+
+	start, err := s.timelineStart(ctx, meetingID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]pkgai.AgendaItem, len(agenda))
+	for i, a := range agenda {
+		items[i] = pkgai.AgendaItem{
+			Number:          a.Number(),
+			Title:           a.Title,
+			Owner:           a.OwnerName(),
+			DurationMinutes: a.DurationMinutes,
+		}
+		if a.Description != nil {
+			items[i].Description = *a.Description
+		}
+		if !start.IsZero() && a.StartedAt != nil {
+			startSeconds := max(int(a.StartedAt.Sub(start).Seconds()), 0)
+			items[i].StartSeconds = &startSeconds
+			if a.EndedAt != nil {
+				endSeconds := max(int(a.EndedAt.Sub(start).Seconds()), 0)
+				items[i].EndSeconds = &endSeconds
+			}
+		}
+	}
+	return items, nil
+}
+
+// normalizeAgendaCoverage makes sure the coverage lists every agenda item exactly once, in agenda
+// order and with its real title. Items the analysis left out are flagged as not covered.
+func normalizeAgendaCoverage(agenda []*entities.AgendaItem, result *entities.AnalysisResult) {
+	if len(agenda) == 0 {
+		result.AgendaCoverage = nil
+		return
+	}
+
+	found := make(map[int]entities.AgendaCoverage, len(result.AgendaCoverage))
+	for _, c := range result.AgendaCoverage {
+		if _, ok := found[c.AgendaItem]; !ok {
+			found[c.AgendaItem] = c
+		}
+	}
+
+	coverage := make([]entities.AgendaCoverage, len(agenda))
+	for i, item := range agenda {
+		c, ok := found[item.Number()]
+		if !ok {
+			c = entities.AgendaCoverage{AgendaItem: item.Number()}
+		}
+		c.Title = item.Title
+		if !c.Covered {
+			c.Summary = ""
+			c.TimestampSeconds = 0
+		}
+		coverage[i] = c
+	}
+	result.AgendaCoverage = coverage
+}
+
+// agendaItemID resolves the 1-based agenda item number used by the analysis (nil for 0 or unknown numbers)
+func agendaItemID(agenda []*entities.AgendaItem, number int) *uuid.UUID {
+	if number < 1 || number > len(agenda) {
+		return nil
+	}
+	id := agenda[number-1].ID
+	return &id
+}
@@ -0,0 +1,104 @@
+package ai
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// transcriptLine is a line of the meeting timeline sent to Groq: a spoken utterance or a chat message
+type transcriptLine struct {
+	offset  float64 // Seconds since the start of the recording
+	speaker string
+	text    string
+}
+
+// chatTranscriptLines formats the chat of a meeting as timeline lines, positioned relative to the start
+// of the recording (or of the meeting when it has none) so they line up with the utterances.
+// Deleted messages are left out.
+func (s *aiService) chatTranscriptLines(ctx context.Context, meetingID uuid.UUID) ([]transcriptLine, error) {
+	if s.chatRepo == nil {
+		return nil, nil
+	}
+
+	messages, err := s.chatRepo.FindVisibleByRoomID(ctx, meetingID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chat messages: %w", err)
+	}
+	if len(messages) == 0 {
+		return nil, nil
+	}
+
+	start, err := s.timelineStart(ctx, meetingID)
+	if err != nil {
+		return nil, err
+	}
+	if start.IsZero() {
+		start = messages[0].CreatedAt
+	}
+
+	lines := make([]transcriptLine, 0, len(messages))
+	for _, m := range messages {
+		offset := m.CreatedAt.Sub(start).Seconds()
+		if offset < 0 {
+			offset = 0
+		}
+		lines = append(lines, transcriptLine{
+			offset:  offset,
+			speaker: m.SenderName() + " (chat)",
+			text:    m.Content,
+		})
+	}
+	return lines, nil
+}
+
+// timelineStart returns when the transcribed audio begins: the start of the first recording,
+// or of the meeting when it was not recorded (zero when neither is known)
+func (s *aiService) timelineStart(ctx context.Context, meetingID uuid.UUID) (time.Time, error) {
+	if s.recordingRepo != nil {
+		recordings, err := s.recordingRepo.FindByRoomID(ctx, meetingID)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("failed to get recordings: %w", err)
+		}
+		// Recordings come newest first
+		if len(recordings) > 0 {
+			return recordings[len(recordings)-1].StartedAt, nil
+		}
+	}
+
+	if s.roomRepo != nil {
+		room, err := s.roomRepo.FindByID(ctx, meetingID)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("failed to get room: %w", err)
+		}
+		if room.StartedAt != nil {
+			return *room.StartedAt, nil
+		}
+	}
+
+	return time.Time{}, nil
+}
+
+// mergeTranscriptLines interleaves utterances and chat messages in chronological order
+func mergeTranscriptLines(utterances, chat []transcriptLine) []transcriptLine {
+	lines := append(utterances, chat...)
+	sort.SliceStable(lines, func(i, j int) bool {
+		return lines[i].offset < lines[j].offset
+	})
+	return lines
+}
+
+// formatTranscriptLines renders timeline lines as "[MM:SS Speaker]: text"
+func formatTranscriptLines(lines []transcriptLine) string {
+	var sb strings.Builder
+	for _, line := range lines {
+		minutes := int(line.offset) / 60
+		seconds := int(line.offset) % 60
+		sb.WriteString(fmt.Sprintf("[%02d:%02d %s]: %s\n", minutes, seconds, line.speaker, line.text))
+	}
+	return sb.String()
+}
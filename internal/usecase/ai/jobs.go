@@ -0,0 +1,67 @@
+package ai
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+	usecaseErrors "github.com/johnquangdev/meeting-assistant/internal/usecase/errors"
+)
+
+// RetryJob puts a failed or cancelled job back in the queue with a fresh retry budget.
+// Jobs whose transcript is complete only redo the summary; others submit their next segment to AssemblyAI again.
+func (s *aiService) RetryJob(ctx context.Context, jobID uuid.UUID) (*entities.AIJob, error) {
+	job, err := s.aiJobRepo.GetAIJobByID(ctx, jobID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get AI job: %w", err)
+	}
+	if job == nil {
+		return nil, usecaseErrors.ErrAIJobNotFound
+	}
+	if job.Status != entities.AIJobStatusFailed && job.Status != entities.AIJobStatusCancelled {
+		return nil, usecaseErrors.ErrAIJobNotRetryable
+	}
+
+	status := entities.AIJobStatusPending
+	if job.TranscriptComplete() {
+		status = entities.AIJobStatusTranscriptReady
+	}
+
+	// Only claim the job when nobody retried it in the meantime
+	now := time.Now()
+	result := s.aiJobRepo.GetDB().WithContext(ctx).
+		Model(&entities.AIJob{}).
+		Where("id = ? AND status = ?", job.ID, job.Status).
+		Updates(map[string]interface{}{
+			"status":       status,
+			"retry_count":  0,
+			"completed_at": nil,
+			"updated_at":   now,
+		})
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to retry AI job: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, usecaseErrors.ErrAIJobNotRetryable
+	}
+
+	if s.logger != nil {
+		s.logger.Info("🔁 AI job queued for retry",
+			zap.String("job_id", job.ID.String()),
+			zap.String("meeting_id", job.MeetingID.String()),
+			zap.String("status", string(status)),
+		)
+	}
+
+	job.Status = status
+	job.RetryCount = 0
+	job.CompletedAt = nil
+	job.UpdatedAt = now
+	s.publishJobProgress(ctx, job.ID)
+
+	return job, nil
+}
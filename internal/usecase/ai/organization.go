@@ -0,0 +1,36 @@
+package ai
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+)
+
+// aiSettings returns the AI settings of the organization owning a meeting
+// (the defaults for personal rooms or when they cannot be read)
+func (s *aiService) aiSettings(ctx context.Context, meetingID uuid.UUID) entities.OrganizationAISettings {
+	defaults := entities.DefaultOrganizationSettings().AI
+	if s.roomRepo == nil || s.orgRepo == nil {
+		return defaults
+	}
+
+	room, err := s.roomRepo.FindByID(ctx, meetingID)
+	if err != nil || room.OrganizationID == nil {
+		return defaults
+	}
+
+	org, err := s.orgRepo.FindByID(ctx, *room.OrganizationID)
+	if err != nil {
+		if s.logger != nil {
+			s.logger.Warn("⚠️ Failed to load organization AI settings, using defaults",
+				zap.String("meeting_id", meetingID.String()),
+				zap.Error(err),
+			)
+		}
+		return defaults
+	}
+	return org.GetSettings().AI
+}
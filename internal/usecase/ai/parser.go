@@ -36,8 +36,9 @@ func (p *Parser) ParseGroqJSONResponse(jsonString string) (*entities.AnalysisRes
 	return &result, nil
 }
 
-// ExtractActionItems converts analysis result action items to ActionItem entities
-func (p *Parser) ExtractActionItems(ctx context.Context, roomID uuid.UUID, summaryID uuid.UUID, analysisResult *entities.AnalysisResult) ([]*entities.ActionItem, error) {
+// ExtractActionItems converts analysis result action items to ActionItem entities,
+// linked to the agenda items the analysis assigned them to
+func (p *Parser) ExtractActionItems(ctx context.Context, roomID uuid.UUID, summaryID uuid.UUID, analysisResult *entities.AnalysisResult, agenda []*entities.AgendaItem) ([]*entities.ActionItem, error) {
 	if analysisResult == nil {
 		return nil, fmt.Errorf("analysis result is nil")
 	}
@@ -54,6 +55,7 @@ func (p *Parser) ExtractActionItems(ctx context.Context, roomID uuid.UUID, summa
 		actionItem.Status = entities.ActionItemStatusPending
 		actionItem.TranscriptReference = item.TranscriptReference
 		actionItem.TimestampInMeeting = item.TimestampInMeeting
+		actionItem.AgendaItemID = agendaItemID(agenda, item.AgendaItem)
 
 		// TODO: Map assigned_to speaker label to actual user UUID
 		// This requires participant tracking and speaker identification
@@ -88,6 +90,7 @@ func (p *Parser) ExtractActionItems(ctx context.Context, roomID uuid.UUID, summa
 		actionItem.Status = entities.ActionItemStatusCompleted // Decisions are already made
 		actionItem.Description = fmt.Sprintf("Owner: %s\nImpact: %s", decision.Owner, decision.Impact)
 		actionItem.TimestampInMeeting = decision.TimestampSeconds
+		actionItem.AgendaItemID = agendaItemID(agenda, decision.AgendaItem)
 
 		actionItems = append(actionItems, actionItem)
 	}
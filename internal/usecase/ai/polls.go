@@ -0,0 +1,50 @@
+package ai
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+)
+
+// appendPollDecisions adds the final result of every closed poll of a meeting to the decisions
+// of the analysis, positioned on the timeline where the poll closed
+func (s *aiService) appendPollDecisions(ctx context.Context, meetingID uuid.UUID, result *entities.AnalysisResult) error {
+	if s.pollRepo == nil {
+		return nil
+	}
+
+	polls, err := s.pollRepo.FindByRoomID(ctx, meetingID)
+	if err != nil {
+		return fmt.Errorf("failed to get polls: %w", err)
+	}
+	if len(polls) == 0 {
+		return nil
+	}
+
+	timelineStart, err := s.timelineStart(ctx, meetingID)
+	if err != nil {
+		return err
+	}
+
+	for _, poll := range polls {
+		if poll.IsOpen() || poll.ClosedAt == nil {
+			continue
+		}
+
+		decision := entities.Decision{
+			DecisionText: poll.Outcome(poll.FinalResults()),
+			Impact:       "medium",
+		}
+		if poll.Creator != nil {
+			decision.Owner = poll.Creator.DisplayName()
+		}
+		if !timelineStart.IsZero() && poll.ClosedAt.After(timelineStart) {
+			decision.TimestampSeconds = int(poll.ClosedAt.Sub(timelineStart).Seconds())
+		}
+		result.Decisions = append(result.Decisions, decision)
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package ai
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+	usecaseErrors "github.com/johnquangdev/meeting-assistant/internal/usecase/errors"
+)
+
+// jobWithinQuota checks a queued job against the AI minutes of its meeting's plan.
+// Jobs over quota are cancelled (an admin can retry them once minutes are available again);
+// when the quota cannot be checked, the job goes through.
+func (s *aiService) jobWithinQuota(ctx context.Context, job *entities.AIJob) bool {
+	if s.quotas == nil || s.roomRepo == nil {
+		return true
+	}
+
+	room, err := s.roomRepo.FindByID(ctx, job.MeetingID)
+	if err == nil {
+		err = s.quotas.CheckAIProcessing(ctx, room)
+	}
+	if err == nil {
+		return true
+	}
+	if !errors.Is(err, usecaseErrors.ErrQuotaExceeded) {
+		if s.logger != nil {
+			s.logger.Warn("⚠️ Failed to check AI quota, processing anyway",
+				zap.String("job_id", job.ID.String()),
+				zap.Error(err),
+			)
+		}
+		return true
+	}
+
+	errMsg := err.Error()
+	now := time.Now()
+	result := s.aiJobRepo.GetDB().WithContext(ctx).
+		Model(&entities.AIJob{}).
+		Where("id = ? AND status = ?", job.ID, entities.AIJobStatusPending).
+		Updates(map[string]interface{}{
+			"status":       entities.AIJobStatusCancelled,
+			"last_error":   errMsg,
+			"completed_at": now,
+			"updated_at":   now,
+		})
+	if result.Error != nil {
+		if s.logger != nil {
+			s.logger.Error("❌ Failed to cancel AI job over quota",
+				zap.String("job_id", job.ID.String()),
+				zap.Error(result.Error),
+			)
+		}
+		return false
+	}
+
+	if result.RowsAffected > 0 {
+		if s.logger != nil {
+			s.logger.Warn("⛔ AI job cancelled, AI minutes of the plan are used up",
+				zap.String("job_id", job.ID.String()),
+				zap.String("meeting_id", job.MeetingID.String()),
+			)
+		}
+		s.publishJobProgress(ctx, job.ID)
+	}
+	return false
+}
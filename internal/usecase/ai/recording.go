@@ -0,0 +1,48 @@
+package ai
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	usecaseErrors "github.com/johnquangdev/meeting-assistant/internal/usecase/errors"
+)
+
+// recordingURLTTL is how long the URL a recording is downloaded from stays valid.
+// It only has to outlive the start of the download.
+const recordingURLTTL = 15 * time.Minute
+
+// recordingDownloadURL mints a short-lived URL to download a recording from the private bucket
+func (s *aiService) recordingDownloadURL(ctx context.Context, recordingKey string) (string, error) {
+	key, err := s.recordingObjectKey(recordingKey)
+	if err != nil {
+		return "", err
+	}
+
+	downloadURL, err := s.storage.GetFileURL(ctx, key, recordingURLTTL)
+	if err != nil {
+		return "", fmt.Errorf("failed to get recording URL: %w", err)
+	}
+	return downloadURL, nil
+}
+
+// recordingObjectKey returns the object key of a recording in the recordings bucket.
+// Jobs store the key; jobs created before stored the URL of the file, which is reduced to its key
+// (migration 037 rewrote the URLs it could match against a recording, so this only serves the rest).
+// URLs of files stored elsewhere are rejected: only the recordings bucket is downloaded from.
+func (s *aiService) recordingObjectKey(recording string) (string, error) {
+	if s.storage == nil {
+		return "", fmt.Errorf("storage not configured")
+	}
+
+	recording = strings.TrimSpace(recording)
+	if !strings.Contains(recording, "://") {
+		return recording, nil
+	}
+	key, ok := s.storage.ObjectKey(recording)
+	if !ok {
+		return "", usecaseErrors.ErrRecordingNotInBucket
+	}
+	return key, nil
+}
@@ -20,13 +20,19 @@ import (
 	"github.com/johnquangdev/meeting-assistant/internal/adapter/repository"
 	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
 	domainrepo "github.com/johnquangdev/meeting-assistant/internal/domain/repositories"
+	"github.com/johnquangdev/meeting-assistant/internal/infrastructure/pubsub"
+	"github.com/johnquangdev/meeting-assistant/internal/infrastructure/storage"
+	usecaseErrors "github.com/johnquangdev/meeting-assistant/internal/usecase/errors"
+	"github.com/johnquangdev/meeting-assistant/internal/usecase/notification"
+	"github.com/johnquangdev/meeting-assistant/internal/usecase/quota"
 )
 
 // Service defines AI orchestration methods
 type Service interface {
 	StartProcessing(ctx context.Context, meetingID string, recordingURL string) error
 	HandleAssemblyAIWebhook(ctx context.Context, payload []byte, signature string) error
-	SubmitToAssemblyAI(ctx context.Context, jobID uuid.UUID, recordingURL string) error
+	SubmitToAssemblyAI(ctx context.Context, jobID uuid.UUID, recordingKey string) error
+	RetryJob(ctx context.Context, jobID uuid.UUID) (*entities.AIJob, error)
 	StartWorkerPool(ctx context.Context, workerCount int) error
 	StopWorkerPool() error
 }
@@ -37,6 +43,14 @@ type aiService struct {
 	summaryRepo         domainrepo.AIRepository
 	recordingRepo       *repository.RecordingRepository
 	roomRepo            domainrepo.RoomRepository
+	chatRepo            domainrepo.ChatRepository
+	pollRepo            domainrepo.PollRepository
+	agendaRepo          domainrepo.AgendaRepository
+	orgRepo             domainrepo.OrganizationRepository
+	quotas              quota.Service
+	notifier            notification.Service
+	events              pubsub.Broker
+	storage             *storage.MinIOClient // nil when storage is unavailable
 	asmClient           *pkgai.AssemblyAIClient
 	asmSDKClient        *aai.Client // Official SDK client
 	groqClient          *pkgai.GroqClient
@@ -57,6 +71,14 @@ func NewAIService(
 	summaryRepo domainrepo.AIRepository,
 	recordingRepo *repository.RecordingRepository,
 	roomRepo domainrepo.RoomRepository,
+	chatRepo domainrepo.ChatRepository,
+	pollRepo domainrepo.PollRepository,
+	agendaRepo domainrepo.AgendaRepository,
+	orgRepo domainrepo.OrganizationRepository,
+	quotas quota.Service,
+	notifier notification.Service,
+	events pubsub.Broker,
+	storage *storage.MinIOClient,
 	asm *pkgai.AssemblyAIClient,
 	groq *pkgai.GroqClient,
 	cfg *config.Config,
@@ -71,6 +93,14 @@ func NewAIService(
 		summaryRepo:         summaryRepo,
 		recordingRepo:       recordingRepo,
 		roomRepo:            roomRepo,
+		chatRepo:            chatRepo,
+		pollRepo:            pollRepo,
+		agendaRepo:          agendaRepo,
+		orgRepo:             orgRepo,
+		quotas:              quotas,
+		notifier:            notifier,
+		events:              events,
+		storage:             storage,
 		asmClient:           asm,
 		asmSDKClient:        asmSDKClient,
 		groqClient:          groq,
@@ -83,7 +113,8 @@ func NewAIService(
 	}
 }
 
-// StartProcessing starts AI processing for a recording (backward compatible)
+// StartProcessing starts AI processing for a recording (backward compatible).
+// recordingURL is an object key in the recordings bucket, or the URL of a file of that bucket.
 func (s *aiService) StartProcessing(ctx context.Context, meetingID string, recordingURL string) error {
 	if s.asmClient == nil {
 		return fmt.Errorf("assemblyai client not configured")
@@ -95,25 +126,49 @@ func (s *aiService) StartProcessing(ctx context.Context, meetingID string, recor
 		return fmt.Errorf("invalid meeting ID: %w", err)
 	}
 
+	// Respect the room's transcription setting
+	if s.roomRepo != nil {
+		room, err := s.roomRepo.FindByID(ctx, mid)
+		if err != nil {
+			return fmt.Errorf("failed to get room: %w", err)
+		}
+		if !room.GetSettings().EnableTranscription {
+			return usecaseErrors.ErrTranscriptionDisabled
+		}
+		if s.quotas != nil {
+			if err := s.quotas.CheckAIProcessing(ctx, room); err != nil {
+				return err
+			}
+		}
+	}
+
+	// The job keeps the object key: the bucket is private and the worker presigns it when submitting
+	recordingKey, err := s.recordingObjectKey(recordingURL)
+	if err != nil {
+		return err
+	}
+
 	// Create AI job first
-	aiJob := entities.NewAIJob(mid, entities.AIJobTypeTranscription, recordingURL)
+	aiJob := entities.NewAIJob(mid, entities.AIJobTypeTranscription, recordingKey)
 	if err := s.aiJobRepo.CreateAIJob(ctx, aiJob); err != nil {
 		return fmt.Errorf("failed to create AI job: %w", err)
 	}
 
-	return s.SubmitToAssemblyAI(ctx, aiJob.ID, recordingURL)
+	return s.SubmitToAssemblyAI(ctx, aiJob.ID, recordingKey)
 }
 
 // SubmitToAssemblyAI submits a recording to AssemblyAI for transcription
 // Uses official SDK with worker pool to limit concurrent uploads
 // Expects job to already exist in database (created by webhook or caller)
-func (s *aiService) SubmitToAssemblyAI(ctx context.Context, jobID uuid.UUID, recordingURL string) error {
+func (s *aiService) SubmitToAssemblyAI(ctx context.Context, jobID uuid.UUID, recordingKey string) error {
 	if s.asmSDKClient == nil {
 		return fmt.Errorf("assemblyai SDK client not configured")
 	}
 
-	if recordingURL == "" {
-		return fmt.Errorf("recording URL is required")
+	// Trim recording key to handle old jobs with \n character
+	recordingKey = strings.TrimSpace(recordingKey)
+	if recordingKey == "" {
+		return fmt.Errorf("recording key is required")
 	}
 
 	// Get existing job from database
@@ -129,7 +184,7 @@ func (s *aiService) SubmitToAssemblyAI(ctx context.Context, jobID uuid.UUID, rec
 		s.logger.Info("🔄 Processing existing AI job",
 			zap.String("job_id", aiJob.ID.String()),
 			zap.String("meeting_id", aiJob.MeetingID.String()),
-			zap.String("recording_url", recordingURL),
+			zap.String("recording_key", recordingKey),
 			zap.Int("retry_count", aiJob.RetryCount),
 		)
 	}
@@ -147,17 +202,21 @@ func (s *aiService) SubmitToAssemblyAI(ctx context.Context, jobID uuid.UUID, rec
 	// Submit to AssemblyAI with retry logic
 	var transcriptID string
 	submitFn := func() error {
-		// Trim recording URL to handle old jobs with \n character
-		cleanURL := strings.TrimSpace(recordingURL)
+		// The bucket is private: every attempt downloads through a fresh short-lived URL
+		downloadURL, err := s.recordingDownloadURL(ctx, recordingKey)
+		if err != nil {
+			// Presigning is local: retrying cannot fix a key outside the bucket or missing storage
+			return backoff.Permanent(err)
+		}
 
 		if s.logger != nil {
 			s.logger.Info("📥 Downloading file from MinIO",
-				zap.String("recording_url", cleanURL),
+				zap.String("recording_key", recordingKey),
 			)
 		}
 
 		// Download file from MinIO
-		resp, err := http.Get(cleanURL)
+		resp, err := http.Get(downloadURL)
 		if err != nil {
 			return fmt.Errorf("failed to download file: %w", err)
 		}
@@ -192,16 +251,17 @@ func (s *aiService) SubmitToAssemblyAI(ctx context.Context, jobID uuid.UUID, rec
 			webhookURL = "https://submaniacally-nonfeeding-adela.ngrok-free.dev/v1/webhooks/assemblyai"
 		}
 
-		// Transcribe with Vietnamese language
+		// Transcribe in the spoken language configured by the organization (Vietnamese by default)
+		language := s.aiSettings(ctx, aiJob.MeetingID).TranscriptionLanguage
 		params := &aai.TranscriptOptionalParams{
-			LanguageCode:  aai.TranscriptLanguageCode("vi"), // Type cast to TranscriptLanguageCode
+			LanguageCode:  aai.TranscriptLanguageCode(language), // Type cast to TranscriptLanguageCode
 			SpeakerLabels: aai.Bool(true),
 			WebhookURL:    &webhookURL, // Tell AssemblyAI where to send webhook when completed
 		}
 
 		if s.logger != nil {
 			s.logger.Info("🎙️ Starting transcription",
-				zap.String("language", "vi"),
+				zap.String("language", language),
 				zap.String("webhook_url", webhookURL),
 			)
 		}
@@ -234,6 +294,7 @@ func (s *aiService) SubmitToAssemblyAI(ctx context.Context, jobID uuid.UUID, rec
 			}
 			return fmt.Errorf("failed to update external_job_id: %w", err)
 		}
+		s.publishJobProgress(ctx, aiJob.ID)
 
 		if s.logger != nil {
 			s.logger.Info("✅ Transcription job submitted",
@@ -253,6 +314,7 @@ func (s *aiService) SubmitToAssemblyAI(ctx context.Context, jobID uuid.UUID, rec
 
 	if err := backoff.Retry(submitFn, backoff.WithContext(bo, ctx)); err != nil {
 		s.aiJobRepo.MarkJobAsFailed(ctx, aiJob.ID, fmt.Sprintf("failed to submit to AssemblyAI: %v", err))
+		s.publishJobProgress(ctx, aiJob.ID)
 		if s.logger != nil {
 			s.logger.Error("❌ Failed to submit to AssemblyAI after retries",
 				zap.String("job_id", aiJob.ID.String()),
@@ -360,6 +422,7 @@ func (s *aiService) HandleAssemblyAIWebhook(ctx context.Context, payload []byte,
 				s.logger.Error("failed to update job status", zap.Error(err))
 			}
 		}
+		s.publishJobProgress(ctx, aiJob.ID)
 
 	case "completed":
 		// Transcription completed, fetch full transcript and store
@@ -378,6 +441,7 @@ func (s *aiService) HandleAssemblyAIWebhook(ctx context.Context, payload []byte,
 				s.logger.Error("failed to mark job as failed", zap.Error(err))
 			}
 		}
+		s.publishJobProgress(ctx, aiJob.ID)
 		if s.logger != nil {
 			s.logger.Error("AssemblyAI reported error", zap.String("error", errorMsg))
 		}
@@ -388,6 +452,19 @@ func (s *aiService) HandleAssemblyAIWebhook(ctx context.Context, payload []byte,
 
 // handleCompletedTranscript fetches full transcript from AssemblyAI API and processes it
 func (s *aiService) handleCompletedTranscript(ctx context.Context, aiJob *entities.AIJob, transcriptID string) error {
+	// A transcript delivered again (webhook retry, or the timeout worker polling it) is stored once
+	switch aiJob.Status {
+	case entities.AIJobStatusTranscriptReady, entities.AIJobStatusSummarizing, entities.AIJobStatusCompleted, entities.AIJobStatusCancelled:
+		if s.logger != nil {
+			s.logger.Info("⏭️ Transcript already handled, skipping",
+				zap.String("transcript_id", transcriptID),
+				zap.String("job_id", aiJob.ID.String()),
+				zap.String("status", string(aiJob.Status)),
+			)
+		}
+		return nil
+	}
+
 	if s.logger != nil {
 		s.logger.Info("📥 Fetching full transcript from AssemblyAI",
 			zap.String("transcript_id", transcriptID),
@@ -408,62 +485,46 @@ func (s *aiService) handleCompletedTranscript(ctx context.Context, aiJob *entiti
 		)
 	}
 
-	// Create transcript entity
-	transcriptEntity := entities.NewTranscript(aiJob.MeetingID)
-	transcriptEntity.ModelUsed = "assemblyai"
+	// Segments after the first continue the transcript of the recording, shifted by the audio before them
+	var transcriptEntity *entities.Transcript
+	var offset float64
+	appending := aiJob.Metadata.SegmentsTranscribed > 0 && aiJob.TranscriptID != nil
+	if appending {
+		transcriptEntity, err = s.transcriptRepo.GetTranscriptByID(ctx, *aiJob.TranscriptID)
+		if err != nil {
+			return fmt.Errorf("failed to get transcript: %w", err)
+		}
+		if transcriptEntity == nil {
+			return fmt.Errorf("transcript not found: %s", *aiJob.TranscriptID)
+		}
+		offset = float64(aiJob.Metadata.DurationSeconds)
+	} else {
+		transcriptEntity = s.newRecordingTranscript(ctx, aiJob)
+	}
 
 	// Extract text
-	if transcript.Text != nil {
-		transcriptEntity.Text = *transcript.Text
+	if transcript.Text != nil && *transcript.Text != "" {
+		if transcriptEntity.Text != "" {
+			transcriptEntity.Text += "\n"
+		}
+		transcriptEntity.Text += *transcript.Text
 	}
 
 	// Extract language (not a pointer)
-	if transcript.LanguageCode != "" {
+	if transcript.LanguageCode != "" && transcriptEntity.Language == "" {
 		transcriptEntity.Language = string(transcript.LanguageCode)
 	}
 
 	// Extract confidence
-	if transcript.Confidence != nil {
+	if transcript.Confidence != nil && !appending {
 		transcriptEntity.ConfidenceScore = *transcript.Confidence
 	}
 
 	// Extract audio duration
+	audioSeconds := 0
 	if transcript.AudioDuration != nil {
-		transcriptEntity.ProcessingTime = int(*transcript.AudioDuration)
-		aiJob.Metadata.DurationSeconds = int(*transcript.AudioDuration)
-	}
-
-	// Query recording_id from recordings table (get most recent recording for this room)
-	recordings, err := s.recordingRepo.FindByRoomID(ctx, aiJob.MeetingID)
-	if err == nil && len(recordings) > 0 {
-		// Use the most recent recording (already sorted DESC by started_at)
-		transcriptEntity.RecordingID = recordings[0].ID.String()
-		if s.logger != nil {
-			s.logger.Info("✅ Found recording_id for transcript",
-				zap.String("recording_id", recordings[0].ID.String()),
-			)
-		}
-	} else if s.logger != nil {
-		s.logger.Warn("⚠️ Could not find recording_id",
-			zap.String("meeting_id", aiJob.MeetingID.String()),
-			zap.Error(err),
-		)
-	}
-
-	// Query room_id (livekit_room_name) from rooms table
-	room, err := s.roomRepo.FindByID(ctx, aiJob.MeetingID)
-	if err == nil && room != nil {
-		transcriptEntity.RoomID = room.LivekitRoomName
-		if s.logger != nil {
-			s.logger.Info("✅ Found room_id for transcript",
-				zap.String("room_id", room.LivekitRoomName),
-			)
-		}
-	} else if s.logger != nil {
-		s.logger.Warn("⚠️ Could not find room_id",
-			zap.String("meeting_id", aiJob.MeetingID.String()),
-			zap.Error(err),
-		)
+		audioSeconds = int(*transcript.AudioDuration)
+		transcriptEntity.ProcessingTime += audioSeconds
 	}
 
 	// Extract words with timestamps from AssemblyAI response
@@ -475,10 +536,10 @@ func (s *aiService) handleCompletedTranscript(ctx context.Context, aiJob *entiti
 				word.Word = *w.Text
 			}
 			if w.Start != nil {
-				word.Start = float64(*w.Start) / 1000.0 // ms to seconds
+				word.Start = offset + float64(*w.Start)/1000.0 // ms to seconds
 			}
 			if w.End != nil {
-				word.End = float64(*w.End) / 1000.0
+				word.End = offset + float64(*w.End)/1000.0
 			}
 			if w.Confidence != nil {
 				word.Confidence = *w.Confidence
@@ -488,7 +549,7 @@ func (s *aiService) handleCompletedTranscript(ctx context.Context, aiJob *entiti
 			}
 			words = append(words, word)
 		}
-		transcriptEntity.Words = words
+		transcriptEntity.Words = append(transcriptEntity.Words, words...)
 		if s.logger != nil {
 			s.logger.Info("✅ Extracted words from transcript",
 				zap.Int("word_count", len(words)),
@@ -497,9 +558,14 @@ func (s *aiService) handleCompletedTranscript(ctx context.Context, aiJob *entiti
 	}
 
 	// Store transcript in database
-	if err := s.transcriptRepo.CreateTranscript(ctx, transcriptEntity); err != nil {
+	if appending {
+		err = s.transcriptRepo.UpdateTranscript(ctx, transcriptEntity)
+	} else {
+		err = s.transcriptRepo.CreateTranscript(ctx, transcriptEntity)
+	}
+	if err != nil {
 		if s.logger != nil {
-			s.logger.Error("❌ Failed to create transcript", zap.Error(err))
+			s.logger.Error("❌ Failed to store transcript", zap.Error(err))
 		}
 		return fmt.Errorf("failed to store transcript: %w", err)
 	}
@@ -508,6 +574,7 @@ func (s *aiService) handleCompletedTranscript(ctx context.Context, aiJob *entiti
 		s.logger.Info("✅ Transcript stored in database",
 			zap.String("transcript_id", transcriptEntity.ID.String()),
 			zap.String("meeting_id", aiJob.MeetingID.String()),
+			zap.Int("segment", aiJob.Metadata.SegmentsTranscribed+1),
 			zap.Int("text_length", len(transcriptEntity.Text)),
 		)
 	}
@@ -526,10 +593,10 @@ func (s *aiService) handleCompletedTranscript(ctx context.Context, aiJob *entiti
 				utterance.Speaker = *utt.Speaker
 			}
 			if utt.Start != nil {
-				utterance.StartTime = float64(*utt.Start) / 1000.0 // ms to seconds
+				utterance.StartTime = offset + float64(*utt.Start)/1000.0 // ms to seconds
 			}
 			if utt.End != nil {
-				utterance.EndTime = float64(*utt.End) / 1000.0
+				utterance.EndTime = offset + float64(*utt.End)/1000.0
 			}
 			if utt.Confidence != nil {
 				utterance.Confidence = *utt.Confidence
@@ -550,23 +617,79 @@ func (s *aiService) handleCompletedTranscript(ctx context.Context, aiJob *entiti
 		}
 	}
 
-	// Mark AI job as completed and set status to transcript_ready for summary generation
-	if err := s.aiJobRepo.UpdateAIJobStatus(ctx, aiJob.ID, entities.AIJobStatusTranscriptReady); err != nil {
+	// The job moves on to the next segment, or to transcript_ready for summary generation once all are in
+	aiJob.SegmentTranscribed(transcriptEntity.ID, audioSeconds)
+	if err := s.aiJobRepo.UpdateAIJob(ctx, aiJob); err != nil {
 		if s.logger != nil {
-			s.logger.Error("⚠️ Failed to mark job as transcript_ready", zap.Error(err))
+			s.logger.Error("⚠️ Failed to update job after transcript", zap.Error(err))
 		}
-	} else {
-		if s.logger != nil {
+		return fmt.Errorf("failed to update AI job: %w", err)
+	}
+	s.publishJobProgress(ctx, aiJob.ID)
+
+	if s.logger != nil {
+		if aiJob.Status == entities.AIJobStatusTranscriptReady {
 			s.logger.Info("✅ Job marked as transcript_ready, will be picked up by worker pool",
 				zap.String("job_id", aiJob.ID.String()),
 				zap.String("transcript_id", transcriptEntity.ID.String()),
 			)
+		} else {
+			s.logger.Info("⏭️ Segment transcribed, next segment queued",
+				zap.String("job_id", aiJob.ID.String()),
+				zap.Int("segments_transcribed", aiJob.Metadata.SegmentsTranscribed),
+				zap.Int("segments", len(aiJob.Metadata.RecordingKeys)),
+			)
 		}
 	}
 
 	return nil
 }
 
+// newRecordingTranscript creates the transcript of a job, linked to its recording and room
+func (s *aiService) newRecordingTranscript(ctx context.Context, aiJob *entities.AIJob) *entities.Transcript {
+	transcriptEntity := entities.NewTranscript(aiJob.MeetingID)
+	transcriptEntity.ModelUsed = "assemblyai"
+
+	if aiJob.RecordingID != nil {
+		transcriptEntity.RecordingID = aiJob.RecordingID.String()
+	} else {
+		// Jobs created before they tracked their recording: use the most recent recording for this room
+		recordings, err := s.recordingRepo.FindByRoomID(ctx, aiJob.MeetingID)
+		if err == nil && len(recordings) > 0 {
+			// Already sorted DESC by started_at
+			transcriptEntity.RecordingID = recordings[0].ID.String()
+			if s.logger != nil {
+				s.logger.Info("✅ Found recording_id for transcript",
+					zap.String("recording_id", recordings[0].ID.String()),
+				)
+			}
+		} else if s.logger != nil {
+			s.logger.Warn("⚠️ Could not find recording_id",
+				zap.String("meeting_id", aiJob.MeetingID.String()),
+				zap.Error(err),
+			)
+		}
+	}
+
+	// Query room_id (livekit_room_name) from rooms table
+	room, err := s.roomRepo.FindByID(ctx, aiJob.MeetingID)
+	if err == nil && room != nil {
+		transcriptEntity.RoomID = room.LivekitRoomName
+		if s.logger != nil {
+			s.logger.Info("✅ Found room_id for transcript",
+				zap.String("room_id", room.LivekitRoomName),
+			)
+		}
+	} else if s.logger != nil {
+		s.logger.Warn("⚠️ Could not find room_id",
+			zap.String("meeting_id", aiJob.MeetingID.String()),
+			zap.Error(err),
+		)
+	}
+
+	return transcriptEntity
+}
+
 // generateGroqSummary generates summary using Groq LLM (legacy, kept for backward compatibility)
 func (s *aiService) generateGroqSummary(ctx context.Context, transcript *entities.Transcript) error {
 	if s.groqClient == nil {
@@ -753,6 +876,7 @@ func (s *aiService) summaryWorker(parentCtx context.Context, workerID int) {
 					zap.String("meeting_id", job.MeetingID.String()),
 				)
 			}
+			s.publishJobProgress(parentCtx, job.ID)
 
 			// Create job context with timeout
 			jobCtx, cancel := jobcontext.JobBegin(parentCtx, job.ID, string(job.JobType), workerID)
@@ -773,6 +897,7 @@ func (s *aiService) summaryWorker(parentCtx context.Context, workerID int) {
 					)
 				}
 				s.aiJobRepo.MarkJobAsFailed(parentCtx, job.ID, err.Error())
+				s.publishJobProgress(parentCtx, job.ID)
 			} else {
 				// Job succeeded
 				if s.logger != nil {
@@ -781,17 +906,72 @@ func (s *aiService) summaryWorker(parentCtx context.Context, workerID int) {
 					)
 				}
 				s.aiJobRepo.UpdateAIJobStatus(parentCtx, job.ID, entities.AIJobStatusCompleted)
+				s.publishJobProgress(parentCtx, job.ID)
+				s.notifySummaryReady(parentCtx, job.MeetingID)
 			}
 		}
 	}
 }
 
+// notifySummaryReady emails the meeting attendees in the background; failures never fail the job
+func (s *aiService) notifySummaryReady(ctx context.Context, roomID uuid.UUID) {
+	if s.notifier == nil {
+		return
+	}
+
+	go func() {
+		if err := s.notifier.SendSummaryReady(context.WithoutCancel(ctx), roomID); err != nil && s.logger != nil {
+			s.logger.Warn("⚠️ Failed to send summary ready emails",
+				zap.String("room_id", roomID.String()),
+				zap.Error(err),
+			)
+		}
+	}()
+}
+
+// publishJobProgress reports the current status of an AI job on the meeting's event stream
+func (s *aiService) publishJobProgress(ctx context.Context, jobID uuid.UUID) {
+	if s.events == nil {
+		return
+	}
+
+	job, err := s.aiJobRepo.GetAIJobByID(ctx, jobID)
+	if err != nil || job == nil {
+		if s.logger != nil {
+			s.logger.Warn("⚠️ Failed to load job for progress event",
+				zap.String("job_id", jobID.String()),
+				zap.Error(err),
+			)
+		}
+		return
+	}
+
+	data := map[string]interface{}{
+		"job_id":      job.ID,
+		"job_type":    job.JobType,
+		"status":      job.Status,
+		"retry_count": job.RetryCount,
+		"max_retries": job.MaxRetries,
+	}
+	if job.LastError != nil && job.Status == entities.AIJobStatusFailed {
+		data["error"] = *job.LastError
+	}
+
+	s.events.Publish(ctx, entities.NewRoomEvent(job.MeetingID, entities.RoomEventSummaryProgress, data))
+}
+
 // generateMeetingSummary generates structured meeting summary using Groq
 func (s *aiService) generateMeetingSummary(ctx context.Context, job *entities.AIJob) error {
 	startTime := time.Now()
 
-	// Get transcript
-	transcript, err := s.transcriptRepo.GetTranscriptByMeetingID(ctx, job.MeetingID)
+	// Get the transcript of the job; older jobs did not record it and use the meeting's
+	var transcript *entities.Transcript
+	var err error
+	if job.TranscriptID != nil {
+		transcript, err = s.transcriptRepo.GetTranscriptByID(ctx, *job.TranscriptID)
+	} else {
+		transcript, err = s.transcriptRepo.GetTranscriptByMeetingID(ctx, job.MeetingID)
+	}
 	if err != nil {
 		return fmt.Errorf("failed to get transcript: %w", err)
 	}
@@ -806,28 +986,42 @@ func (s *aiService) generateMeetingSummary(ctx context.Context, job *entities.AI
 		return fmt.Errorf("failed to get transcript utterances: %w", err)
 	}
 
+	// Chat messages typed during the meeting (links, decisions) are merged into the timeline
+	chatLines, err := s.chatTranscriptLines(ctx, job.MeetingID)
+	if err != nil {
+		if s.logger != nil {
+			s.logger.Warn("⚠️ Failed to load chat messages, summarizing without chat",
+				zap.String("meeting_id", job.MeetingID.String()),
+				zap.Error(err),
+			)
+		}
+		chatLines = nil
+	}
+
 	// Format utterances into structured text for Groq
 	var formattedTranscript string
 	if len(utterances) > 0 {
 		// Use speaker-segmented format for better analysis
-		var sb strings.Builder
+		lines := make([]transcriptLine, 0, len(utterances)+len(chatLines))
 		for _, utt := range utterances {
 			// Format: [MM:SS Speaker A]: text
-			minutes := int(utt.StartTime) / 60
-			seconds := int(utt.StartTime) % 60
-			sb.WriteString(fmt.Sprintf("[%02d:%02d %s]: %s\n", minutes, seconds, utt.Speaker, utt.Text))
+			lines = append(lines, transcriptLine{offset: utt.StartTime, speaker: utt.Speaker, text: utt.Text})
 		}
-		formattedTranscript = sb.String()
+		formattedTranscript = formatTranscriptLines(mergeTranscriptLines(lines, chatLines))
 
 		if s.logger != nil {
 			s.logger.Info("✅ Formatted transcript with speaker segments",
 				zap.Int("utterance_count", len(utterances)),
+				zap.Int("chat_message_count", len(chatLines)),
 				zap.Int("formatted_length", len(formattedTranscript)),
 			)
 		}
 	} else {
 		// Fallback to plain text if no utterances available
 		formattedTranscript = transcript.Text
+		if len(chatLines) > 0 {
+			formattedTranscript += "\n\n" + formatTranscriptLines(chatLines)
+		}
 
 		if s.logger != nil {
 			s.logger.Warn("⚠️ No utterances found, using plain text",
@@ -868,6 +1062,10 @@ func (s *aiService) generateMeetingSummary(ctx context.Context, job *entities.AI
 			language = "en" // default fallback
 		}
 	}
+	// The organization may ask for summaries in a fixed language
+	if summaryLanguage := s.aiSettings(ctx, job.MeetingID).SummaryLanguage; summaryLanguage != "" {
+		language = summaryLanguage
+	}
 
 	// Generate structured analysis with Groq
 	if s.logger != nil {
@@ -879,7 +1077,20 @@ func (s *aiService) generateMeetingSummary(ctx context.Context, job *entities.AI
 		)
 	}
 
-	jsonResponse, err := s.groqClient.GenerateStructuredAnalysis(ctx, formattedTranscript, language)
+	// The agenda lets the analysis map the discussion to agenda items and flag the ones never covered
+	agenda, err := s.meetingAgenda(ctx, job.MeetingID)
+	if err != nil {
+		if s.logger != nil {
+			s.logger.Warn("⚠️ Failed to load agenda, summarizing without agenda", zap.Error(err))
+		}
+		agenda = nil
+	}
+	analysisAgenda, err := s.analysisAgenda(ctx, job.MeetingID, agenda)
+	if err != nil {
+		return fmt.Errorf("failed to prepare agenda: %w", err)
+	}
+
+	jsonResponse, err := s.groqClient.GenerateStructuredAnalysis(ctx, formattedTranscript, language, analysisAgenda)
 	if err != nil {
 		return fmt.Errorf("failed to generate structured analysis: %w", err)
 	}
@@ -901,6 +1112,12 @@ func (s *aiService) generateMeetingSummary(ctx context.Context, job *entities.AI
 	if err := s.parser.ValidateAnalysisResult(analysisResult); err != nil {
 		return fmt.Errorf("invalid analysis result: %w", err)
 	}
+	normalizeAgendaCoverage(agenda, analysisResult)
+
+	// Poll outcomes are decisions the participants actually voted on
+	if err := s.appendPollDecisions(ctx, job.MeetingID, analysisResult); err != nil && s.logger != nil {
+		s.logger.Warn("⚠️ Failed to add poll results to decisions", zap.Error(err))
+	}
 
 	// Create MeetingSummary entity
 	summary := entities.NewMeetingSummary(job.MeetingID, transcript.ID)
@@ -928,6 +1145,11 @@ func (s *aiService) generateMeetingSummary(ctx context.Context, job *entities.AI
 	if sentimentBreakdown, err := json.Marshal(analysisResult.SpeakerSentiment); err == nil {
 		summary.SentimentBreakdown = sentimentBreakdown
 	}
+	if len(analysisResult.AgendaCoverage) > 0 {
+		if agendaCoverage, err := json.Marshal(analysisResult.AgendaCoverage); err == nil {
+			summary.AgendaCoverage = agendaCoverage
+		}
+	}
 
 	// Save meeting summary
 	if err := s.summaryRepo.SaveMeetingSummary(summary); err != nil {
@@ -942,7 +1164,7 @@ func (s *aiService) generateMeetingSummary(ctx context.Context, job *entities.AI
 	}
 
 	// Extract and save action items
-	actionItems, err := s.parser.ExtractActionItems(ctx, job.MeetingID, summary.ID, analysisResult)
+	actionItems, err := s.parser.ExtractActionItems(ctx, job.MeetingID, summary.ID, analysisResult, agenda)
 	if err != nil {
 		if s.logger != nil {
 			s.logger.Warn("⚠️ Failed to extract action items", zap.Error(err))
@@ -1063,6 +1285,11 @@ func (s *aiService) pendingJobWorker(parentCtx context.Context) {
 
 			// Process each job
 			for _, job := range jobs {
+				// Meetings whose plan has no AI minutes left are not submitted
+				if !s.jobWithinQuota(parentCtx, &job) {
+					continue
+				}
+
 				// Atomically claim the job by marking as submitted (prevent other workers from picking it up)
 				// Use WHERE clause with current status to ensure only one worker claims it
 				result := s.aiJobRepo.GetDB().WithContext(parentCtx).
@@ -1104,7 +1331,7 @@ func (s *aiService) pendingJobWorker(parentCtx context.Context) {
 				}
 
 				// Submit to AssemblyAI using existing job
-				if err := s.SubmitToAssemblyAI(parentCtx, job.ID, job.RecordingURL); err != nil {
+				if err := s.SubmitToAssemblyAI(parentCtx, job.ID, job.RecordingKey()); err != nil {
 					if s.logger != nil {
 						s.logger.Error("❌ Failed to submit job",
 							zap.String("job_id", job.ID.String()),
@@ -1221,6 +1448,7 @@ func (s *aiService) webhookTimeoutWorker(parentCtx context.Context) {
 						)
 					}
 					s.aiJobRepo.MarkJobAsFailed(parentCtx, job.ID, "no external transcript ID")
+					s.publishJobProgress(parentCtx, job.ID)
 					continue
 				}
 
@@ -1267,6 +1495,7 @@ func (s *aiService) webhookTimeoutWorker(parentCtx context.Context) {
 							)
 						}
 						s.aiJobRepo.MarkJobAsFailed(parentCtx, job.ID, fmt.Sprintf("failed to process transcript: %v", err))
+						s.publishJobProgress(parentCtx, job.ID)
 					}
 
 				case aai.TranscriptStatusError:
@@ -1283,6 +1512,7 @@ func (s *aiService) webhookTimeoutWorker(parentCtx context.Context) {
 						)
 					}
 					s.aiJobRepo.MarkJobAsFailed(parentCtx, job.ID, errorMsg)
+					s.publishJobProgress(parentCtx, job.ID)
 
 				case aai.TranscriptStatusQueued, aai.TranscriptStatusProcessing:
 					// Still processing, update timestamp to reset timeout
@@ -1457,6 +1687,7 @@ func (s *aiService) storeTranscriptFromWebhook(ctx context.Context, aiJob *entit
 		}
 		return fmt.Errorf("failed to update job status: %w", err)
 	}
+	s.publishJobProgress(ctx, aiJob.ID)
 
 	return nil
 }
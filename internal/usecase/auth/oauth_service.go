@@ -158,6 +158,11 @@ func (s *OAuthService) HandleGoogleCallback(ctx context.Context, req *GoogleCall
 		}
 	}
 
+	// Deactivated accounts cannot log in again
+	if !user.IsActive {
+		return nil, entities.ErrUserDeactivated
+	}
+
 	// Create session
 	accessToken, err := s.jwtManager.GenerateAccessToken(user.ID, user.Email, string(user.Role))
 	if err != nil {
@@ -216,6 +221,9 @@ func (s *OAuthService) RefreshAccessToken(ctx context.Context, refreshToken stri
 	if err != nil {
 		return nil, fmt.Errorf("failed to find user: %w", err)
 	}
+	if !user.IsActive {
+		return nil, entities.ErrUserDeactivated
+	}
 
 	// Generate new access token
 	newAccessToken, err := s.jwtManager.GenerateAccessToken(user.ID, user.Email, string(user.Role))
@@ -268,6 +276,9 @@ func (s *OAuthService) RefreshAccessTokenBySessionID(ctx context.Context, sessio
 	if err != nil {
 		return nil, fmt.Errorf("failed to find user: %w", err)
 	}
+	if !user.IsActive {
+		return nil, entities.ErrUserDeactivated
+	}
 
 	// Generate new access token
 	newAccessToken, err := s.jwtManager.GenerateAccessToken(user.ID, user.Email, string(user.Role))
@@ -304,7 +315,7 @@ func (s *OAuthService) ValidateSession(ctx context.Context, token string) (*enti
 	}
 
 	if !user.IsActive {
-		return nil, entities.ErrUnauthorized
+		return nil, entities.ErrUserDeactivated
 	}
 
 	return user, nil
@@ -363,6 +374,10 @@ func (s *OAuthService) ValidateSessionByID(ctx context.Context, sessionID uuid.U
 		return nil, err
 	}
 
+	if !user.IsActive {
+		return nil, entities.ErrUserDeactivated
+	}
+
 	return user, nil
 }
 
@@ -0,0 +1,708 @@
+package breakout
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math/rand/v2"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+	"github.com/johnquangdev/meeting-assistant/internal/domain/repositories"
+	lkpkg "github.com/johnquangdev/meeting-assistant/internal/infrastructure/external/livekit"
+	usecaseErrors "github.com/johnquangdev/meeting-assistant/internal/usecase/errors"
+	"github.com/johnquangdev/meeting-assistant/internal/usecase/room"
+)
+
+const (
+	// Topic is the LiveKit data topic used for breakout notices
+	Topic = "breakout"
+
+	// MaxBreakouts caps how many breakout rooms a meeting may open at once
+	MaxBreakouts = 20
+
+	// MaxTimer caps the countdown the host may broadcast
+	MaxTimer = 4 * time.Hour
+)
+
+// NoticeType is the type of a breakout notice sent into LiveKit rooms
+type NoticeType string
+
+const (
+	NoticeOpened   NoticeType = "breakouts_opened"
+	NoticeAssigned NoticeType = "breakouts_assigned"
+	NoticeTimer    NoticeType = "breakout_timer"
+	NoticeClosing  NoticeType = "breakouts_closing"
+	NoticeClosed   NoticeType = "breakouts_closed"
+)
+
+// Notice is sent on Topic to the main room and the breakout rooms.
+// Clients poll their destination when they receive one.
+type Notice struct {
+	Type             NoticeType         `json:"type"`
+	ParentRoomID     uuid.UUID          `json:"parent_room_id"`
+	EndsAt           *time.Time         `json:"ends_at,omitempty"`
+	SecondsRemaining int                `json:"seconds_remaining,omitempty"`
+	Assignments      []NoticeAssignment `json:"assignments,omitempty"`
+}
+
+// NoticeAssignment tells a participant (by LiveKit identity) where to go; nil means the main room
+type NoticeAssignment struct {
+	Identity       string     `json:"identity"`
+	BreakoutRoomID *uuid.UUID `json:"breakout_room_id"`
+}
+
+// Breakout is a breakout room with the participants of the main room assigned to it
+type Breakout struct {
+	Room         *entities.Room
+	Participants []*entities.Participant
+}
+
+// Overview is the state of the breakout rooms of a meeting
+type Overview struct {
+	Parent     *entities.Room
+	Breakouts  []*Breakout
+	Unassigned []*entities.Participant // in the main room and not assigned to any breakout room
+}
+
+// CreateBreakoutsInput represents input for opening breakout rooms.
+// Names, when given, set both the number and the names of the rooms.
+type CreateBreakoutsInput struct {
+	ParentRoomID uuid.UUID
+	HostID       uuid.UUID
+	Count        int
+	Names        []string
+}
+
+// Assignment moves a participant of the main room to a breakout room (nil = back to the main room)
+type Assignment struct {
+	ParticipantID  uuid.UUID
+	BreakoutRoomID *uuid.UUID
+}
+
+// AssignInput represents input for assigning participants manually
+type AssignInput struct {
+	ParentRoomID uuid.UUID
+	HostID       uuid.UUID
+	Assignments  []Assignment
+}
+
+// Destination is the room a participant should currently be in
+type Destination struct {
+	Room         *entities.Room        // breakout room, or the main room
+	Participant  *entities.Participant // participant record in Room
+	LivekitToken string
+	InBreakout   bool
+	EndsAt       *time.Time // breakout timer, if running
+}
+
+// BreakoutService manages breakout rooms: child rooms of a meeting with their own LiveKit rooms,
+// recordings and summaries
+type BreakoutService struct {
+	roomRepo        repositories.RoomRepository
+	participantRepo repositories.ParticipantRepository
+	roomService     room.Service
+	livekitClient   lkpkg.Client
+}
+
+// NewBreakoutService creates a new breakout service
+func NewBreakoutService(
+	roomRepo repositories.RoomRepository,
+	participantRepo repositories.ParticipantRepository,
+	roomService room.Service,
+	livekitClient lkpkg.Client,
+) *BreakoutService {
+	return &BreakoutService{
+		roomRepo:        roomRepo,
+		participantRepo: participantRepo,
+		roomService:     roomService,
+		livekitClient:   livekitClient,
+	}
+}
+
+// CreateBreakouts opens breakout rooms for an active meeting (host only)
+func (s *BreakoutService) CreateBreakouts(ctx context.Context, input CreateBreakoutsInput) (*Overview, error) {
+	parent, err := s.getHostedParent(ctx, input.ParentRoomID, input.HostID)
+	if err != nil {
+		return nil, err
+	}
+	if !parent.IsActive() {
+		return nil, usecaseErrors.ErrRoomNotActive
+	}
+
+	open, err := s.openBreakouts(ctx, parent.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(open) > 0 {
+		return nil, usecaseErrors.ErrBreakoutsAlreadyOpen
+	}
+
+	count := input.Count
+	if len(input.Names) > 0 {
+		count = len(input.Names)
+	}
+	if count < 1 || count > MaxBreakouts {
+		return nil, usecaseErrors.ErrInvalidBreakoutCount
+	}
+
+	// Breakouts inherit the meeting settings (recording, transcription...), but only assigned people get in
+	settings := parent.GetSettings()
+	settings.RequireApproval = false
+	settings.EnableWaitingRoom = false
+	settings.AllowGuests = false
+
+	created := make([]*entities.Room, 0, count)
+	for i := 0; i < count; i++ {
+		name := fmt.Sprintf("%s - Breakout %d", parent.Name, i+1)
+		if i < len(input.Names) && strings.TrimSpace(input.Names[i]) != "" {
+			name = strings.TrimSpace(input.Names[i])
+		}
+
+		output, err := s.roomService.CreateRoom(ctx, room.CreateRoomInput{
+			Name:            name,
+			HostID:          parent.HostID,
+			Type:            entities.RoomTypePrivate,
+			MaxParticipants: parent.MaxParticipants,
+			Settings:        settings.ToMap(),
+			ParentRoomID:    &parent.ID,
+			OrganizationID:  parent.OrganizationID,
+		})
+		if err != nil {
+			s.abort(ctx, parent, created)
+			return nil, fmt.Errorf("failed to create breakout room: %w", err)
+		}
+
+		started, err := s.roomService.StartRoom(ctx, output.Room.ID, parent.HostID)
+		if err != nil {
+			created = append(created, output.Room)
+			s.abort(ctx, parent, created)
+			return nil, fmt.Errorf("failed to start breakout room: %w", err)
+		}
+		created = append(created, started)
+	}
+
+	s.broadcast(ctx, Notice{Type: NoticeOpened, ParentRoomID: parent.ID}, parent)
+
+	log.Printf("[Breakout] ✅ Breakout rooms opened: parent=%s, count=%d", parent.ID, len(created))
+
+	return s.overview(ctx, parent)
+}
+
+// ListBreakouts retrieves the open breakout rooms and their assigned participants (host only)
+func (s *BreakoutService) ListBreakouts(ctx context.Context, parentRoomID, hostID uuid.UUID) (*Overview, error) {
+	parent, err := s.getHostedParent(ctx, parentRoomID, hostID)
+	if err != nil {
+		return nil, err
+	}
+	return s.overview(ctx, parent)
+}
+
+// AssignParticipants moves participants to breakout rooms or back to the main room (host only)
+func (s *BreakoutService) AssignParticipants(ctx context.Context, input AssignInput) (*Overview, error) {
+	parent, err := s.getHostedParent(ctx, input.ParentRoomID, input.HostID)
+	if err != nil {
+		return nil, err
+	}
+
+	breakouts, err := s.openBreakoutsByID(ctx, parent.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var moved []NoticeAssignment
+	for _, a := range input.Assignments {
+		participant, err := s.participantRepo.FindByID(ctx, a.ParticipantID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, usecaseErrors.ErrParticipantNotFound
+			}
+			return nil, fmt.Errorf("failed to get participant: %w", err)
+		}
+		if participant.RoomID != parent.ID {
+			return nil, usecaseErrors.ErrParticipantNotFound
+		}
+		if !isAssignable(participant) {
+			return nil, usecaseErrors.ErrParticipantNotAssigned
+		}
+
+		var target *entities.Room
+		if a.BreakoutRoomID != nil {
+			var ok bool
+			if target, ok = breakouts[*a.BreakoutRoomID]; !ok {
+				return nil, usecaseErrors.ErrBreakoutNotFound
+			}
+		}
+
+		changed, err := s.assign(ctx, participant, target, breakouts)
+		if err != nil {
+			return nil, err
+		}
+		if changed {
+			moved = append(moved, NoticeAssignment{Identity: participant.Identity(), BreakoutRoomID: participant.BreakoutRoomID})
+		}
+	}
+
+	s.notifyAssigned(ctx, parent, breakouts, moved)
+
+	return s.overview(ctx, parent)
+}
+
+// AssignRandomly spreads the participants of the main room evenly across the breakout rooms (host only).
+// Hosts and co-hosts stay in the main room, and participants already assigned keep their room.
+func (s *BreakoutService) AssignRandomly(ctx context.Context, parentRoomID, hostID uuid.UUID) (*Overview, error) {
+	parent, err := s.getHostedParent(ctx, parentRoomID, hostID)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := s.overview(ctx, parent)
+	if err != nil {
+		return nil, err
+	}
+	if len(current.Breakouts) == 0 {
+		return nil, usecaseErrors.ErrNoOpenBreakouts
+	}
+
+	breakouts := make(map[uuid.UUID]*entities.Room, len(current.Breakouts))
+	sizes := make([]int, len(current.Breakouts))
+	for i, b := range current.Breakouts {
+		breakouts[b.Room.ID] = b.Room
+		sizes[i] = len(b.Participants)
+	}
+
+	var pending []*entities.Participant
+	for _, p := range current.Unassigned {
+		if !p.IsHost() {
+			pending = append(pending, p)
+		}
+	}
+	rand.Shuffle(len(pending), func(i, j int) { pending[i], pending[j] = pending[j], pending[i] })
+
+	var moved []NoticeAssignment
+	for _, p := range pending {
+		// Fill the smallest room first so sizes never differ by more than one
+		smallest := 0
+		for i := range sizes {
+			if sizes[i] < sizes[smallest] {
+				smallest = i
+			}
+		}
+		sizes[smallest]++
+
+		if _, err := s.assign(ctx, p, current.Breakouts[smallest].Room, breakouts); err != nil {
+			return nil, err
+		}
+		moved = append(moved, NoticeAssignment{Identity: p.Identity(), BreakoutRoomID: p.BreakoutRoomID})
+	}
+
+	s.notifyAssigned(ctx, parent, breakouts, moved)
+
+	log.Printf("[Breakout] 🎲 Participants assigned randomly: parent=%s, assigned=%d, rooms=%d",
+		parent.ID, len(moved), len(current.Breakouts))
+
+	return s.overview(ctx, parent)
+}
+
+// StartTimer broadcasts a countdown to the main room and every breakout room (host only)
+func (s *BreakoutService) StartTimer(ctx context.Context, parentRoomID, hostID uuid.UUID, duration time.Duration) (time.Time, error) {
+	if duration <= 0 || duration > MaxTimer {
+		return time.Time{}, usecaseErrors.ErrInvalidBreakoutTimer
+	}
+
+	parent, err := s.getHostedParent(ctx, parentRoomID, hostID)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	open, err := s.openBreakouts(ctx, parent.ID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if len(open) == 0 {
+		return time.Time{}, usecaseErrors.ErrNoOpenBreakouts
+	}
+
+	endsAt := time.Now().Add(duration)
+	if err := s.roomRepo.SetBreakoutTimer(ctx, parent.ID, &endsAt); err != nil {
+		return time.Time{}, fmt.Errorf("failed to set breakout timer: %w", err)
+	}
+
+	notice := Notice{
+		Type:             NoticeTimer,
+		ParentRoomID:     parent.ID,
+		EndsAt:           &endsAt,
+		SecondsRemaining: int(duration.Seconds()),
+	}
+	s.broadcast(ctx, notice, append([]*entities.Room{parent}, open...)...)
+
+	log.Printf("[Breakout] ⏱️  Breakout timer started: parent=%s, ends_at=%s", parent.ID, endsAt.Format(time.RFC3339))
+
+	return endsAt, nil
+}
+
+// CloseBreakouts ends every breakout room and brings everyone back to the main room (host only).
+// Ending the breakout LiveKit rooms finalizes their recordings, whose summaries roll up into the meeting.
+func (s *BreakoutService) CloseBreakouts(ctx context.Context, parentRoomID, hostID uuid.UUID) error {
+	parent, err := s.getHostedParent(ctx, parentRoomID, hostID)
+	if err != nil {
+		return err
+	}
+
+	open, err := s.openBreakouts(ctx, parent.ID)
+	if err != nil {
+		return err
+	}
+	if len(open) == 0 {
+		return usecaseErrors.ErrNoOpenBreakouts
+	}
+
+	// Warn the breakout rooms first so clients reconnect to the main room instead of showing "room ended"
+	s.broadcast(ctx, Notice{Type: NoticeClosing, ParentRoomID: parent.ID}, open...)
+
+	if err := s.roomService.EndBreakouts(ctx, parent); err != nil {
+		return err
+	}
+
+	s.broadcast(ctx, Notice{Type: NoticeClosed, ParentRoomID: parent.ID}, parent)
+
+	log.Printf("[Breakout] 🔙 Breakout rooms closed: parent=%s, count=%d", parent.ID, len(open))
+	return nil
+}
+
+// GetUserDestination returns the room a user should be in and a LiveKit token for it (for polling)
+func (s *BreakoutService) GetUserDestination(ctx context.Context, parentRoomID, userID uuid.UUID) (*Destination, error) {
+	parent, err := s.getParent(ctx, parentRoomID)
+	if err != nil {
+		return nil, err
+	}
+
+	participant, err := s.participantRepo.FindByRoomAndUser(ctx, parent.ID, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, usecaseErrors.ErrNotParticipant
+		}
+		return nil, fmt.Errorf("failed to get participant: %w", err)
+	}
+
+	return s.destination(ctx, parent, participant)
+}
+
+// GetGuestDestination returns the room a guest should be in and a LiveKit token for it (for polling)
+func (s *BreakoutService) GetGuestDestination(ctx context.Context, parentRoomID, participantID uuid.UUID) (*Destination, error) {
+	parent, err := s.getParent(ctx, parentRoomID)
+	if err != nil {
+		return nil, err
+	}
+
+	participant, err := s.participantRepo.FindByID(ctx, participantID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, usecaseErrors.ErrParticipantNotFound
+		}
+		return nil, fmt.Errorf("failed to get participant: %w", err)
+	}
+	if participant.RoomID != parent.ID || !participant.IsGuest() {
+		return nil, usecaseErrors.ErrParticipantNotFound
+	}
+
+	return s.destination(ctx, parent, participant)
+}
+
+// destination enters the participant into its breakout room, or back into the main room once unassigned
+func (s *BreakoutService) destination(ctx context.Context, parent *entities.Room, participant *entities.Participant) (*Destination, error) {
+	if !isAssignable(participant) {
+		return nil, usecaseErrors.ErrInvalidParticipantStatus
+	}
+
+	if participant.BreakoutRoomID != nil {
+		b, err := s.roomRepo.FindByID(ctx, *participant.BreakoutRoomID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("failed to get breakout room: %w", err)
+		}
+		if b != nil && b.IsActive() {
+			member, err := s.breakoutParticipant(ctx, b, participant, true)
+			if err != nil {
+				return nil, err
+			}
+			token, err := s.enter(ctx, b, member)
+			if err != nil {
+				return nil, err
+			}
+			return &Destination{
+				Room:         b,
+				Participant:  member,
+				LivekitToken: token,
+				InBreakout:   true,
+				EndsAt:       parent.BreakoutEndsAt,
+			}, nil
+		}
+	}
+
+	if parent.IsEnded() {
+		return nil, usecaseErrors.ErrRoomEnded
+	}
+
+	token, err := s.enter(ctx, parent, participant)
+	if err != nil {
+		return nil, err
+	}
+	return &Destination{
+		Room:         parent,
+		Participant:  participant,
+		LivekitToken: token,
+		EndsAt:       parent.BreakoutEndsAt,
+	}, nil
+}
+
+// enter marks the participant as joined (moving between rooms makes LiveKit report it as left)
+// and issues a LiveKit token for the room
+func (s *BreakoutService) enter(ctx context.Context, r *entities.Room, participant *entities.Participant) (string, error) {
+	if !participant.IsActive() {
+		// Assigned participants always get their seat back, whatever the room holds
+		if _, err := s.participantRepo.TakeSeat(ctx, participant, true); err != nil {
+			return "", fmt.Errorf("failed to update participant: %w", err)
+		}
+	}
+
+	return s.roomService.GenerateParticipantToken(ctx, r, participant)
+}
+
+// assign sets the breakout room of a participant of the main room and reports whether it changed.
+// A participant taken out of a breakout room it is connected to is disconnected from it.
+func (s *BreakoutService) assign(ctx context.Context, participant *entities.Participant, target *entities.Room, breakouts map[uuid.UUID]*entities.Room) (bool, error) {
+	previous := participant.BreakoutRoomID
+	if (previous == nil && target == nil) || (previous != nil && target != nil && *previous == target.ID) {
+		return false, nil
+	}
+
+	if target != nil {
+		if _, err := s.breakoutParticipant(ctx, target, participant, true); err != nil {
+			return false, err
+		}
+		participant.BreakoutRoomID = &target.ID
+	} else {
+		participant.BreakoutRoomID = nil
+	}
+
+	if err := s.participantRepo.Update(ctx, participant); err != nil {
+		return false, fmt.Errorf("failed to update participant: %w", err)
+	}
+
+	if previous != nil {
+		if prev, ok := breakouts[*previous]; ok {
+			s.disconnect(ctx, prev, participant)
+		}
+	}
+
+	return true, nil
+}
+
+// disconnect removes a participant from the LiveKit room of a breakout it no longer belongs to
+func (s *BreakoutService) disconnect(ctx context.Context, b *entities.Room, participant *entities.Participant) {
+	member, err := s.breakoutParticipant(ctx, b, participant, false)
+	if err != nil || member == nil || !member.IsActive() {
+		return
+	}
+	if err := s.livekitClient.RemoveParticipant(ctx, b.LivekitRoomName, member.Identity()); err != nil {
+		log.Printf("[Breakout] ⚠️  Failed to disconnect %s from breakout room %s: %v", member.Identity(), b.ID, err)
+	}
+}
+
+// breakoutParticipant returns the participant record of a breakout room for a participant of the main room,
+// creating it when create is set
+func (s *BreakoutService) breakoutParticipant(ctx context.Context, b *entities.Room, participant *entities.Participant, create bool) (*entities.Participant, error) {
+	var (
+		member *entities.Participant
+		err    error
+	)
+	if participant.UserID != nil {
+		member, err = s.participantRepo.FindByRoomAndUser(ctx, b.ID, *participant.UserID)
+	} else {
+		member, err = s.participantRepo.FindBreakoutGuest(ctx, b.ID, participant.ID)
+	}
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("failed to get breakout participant: %w", err)
+	}
+	if member != nil || !create {
+		return member, nil
+	}
+
+	meta, err := json.Marshal(map[string]string{"parent_participant_id": participant.ID.String()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode participant metadata: %w", err)
+	}
+
+	// Hosts of the meeting other than the breakout owner help out as co-hosts
+	role := participant.Role
+	if role == entities.ParticipantRoleHost {
+		role = entities.ParticipantRoleCoHost
+	}
+
+	now := time.Now()
+	member = &entities.Participant{
+		RoomID:         b.ID,
+		UserID:         participant.UserID,
+		GuestName:      participant.GuestName,
+		Role:           role,
+		Status:         entities.ParticipantStatusInvited,
+		InvitedBy:      &b.HostID,
+		InvitedAt:      &now,
+		CanShareScreen: participant.CanShareScreen,
+		CanRecord:      participant.CanRecord,
+		CanMuteOthers:  participant.CanMuteOthers,
+		Metadata:       datatypes.JSON(meta),
+	}
+	if err := s.participantRepo.Create(ctx, member); err != nil {
+		return nil, fmt.Errorf("failed to create breakout participant: %w", err)
+	}
+
+	return member, nil
+}
+
+// notifyAssigned tells the main room and the breakout rooms who moved where
+func (s *BreakoutService) notifyAssigned(ctx context.Context, parent *entities.Room, breakouts map[uuid.UUID]*entities.Room, moved []NoticeAssignment) {
+	if len(moved) == 0 {
+		return
+	}
+
+	rooms := []*entities.Room{parent}
+	for _, b := range breakouts {
+		rooms = append(rooms, b)
+	}
+	s.broadcast(ctx, Notice{Type: NoticeAssigned, ParentRoomID: parent.ID, Assignments: moved}, rooms...)
+}
+
+// broadcast sends a notice to the LiveKit rooms; delivery failures are logged only
+func (s *BreakoutService) broadcast(ctx context.Context, notice Notice, rooms ...*entities.Room) {
+	data, err := json.Marshal(notice)
+	if err != nil {
+		log.Printf("[Breakout] ❌ Failed to encode %s notice: %v", notice.Type, err)
+		return
+	}
+
+	for _, r := range rooms {
+		if err := s.livekitClient.SendData(ctx, r.LivekitRoomName, data, Topic); err != nil {
+			log.Printf("[Breakout] ⚠️  Failed to send %s notice to room %s: %v", notice.Type, r.ID, err)
+		}
+	}
+}
+
+// abort ends the breakout rooms created before a failure
+func (s *BreakoutService) abort(ctx context.Context, parent *entities.Room, created []*entities.Room) {
+	for _, b := range created {
+		if err := s.roomService.EndRoom(ctx, b.ID, parent.HostID); err != nil {
+			log.Printf("[Breakout] ⚠️  Failed to clean up breakout room %s: %v", b.ID, err)
+		}
+	}
+}
+
+// overview groups the participants of the main room by breakout room
+func (s *BreakoutService) overview(ctx context.Context, parent *entities.Room) (*Overview, error) {
+	open, err := s.openBreakouts(ctx, parent.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	participants, err := s.participantRepo.FindByRoomID(ctx, parent.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get participants: %w", err)
+	}
+
+	result := &Overview{Parent: parent, Breakouts: make([]*Breakout, len(open))}
+	byID := make(map[uuid.UUID]*Breakout, len(open))
+	for i, b := range open {
+		result.Breakouts[i] = &Breakout{Room: b}
+		byID[b.ID] = result.Breakouts[i]
+	}
+
+	for _, p := range participants {
+		if p.BreakoutRoomID != nil {
+			if b, ok := byID[*p.BreakoutRoomID]; ok {
+				b.Participants = append(b.Participants, p)
+				continue
+			}
+		}
+		if p.IsActive() {
+			result.Unassigned = append(result.Unassigned, p)
+		}
+	}
+
+	return result, nil
+}
+
+// openBreakouts retrieves the breakout rooms of a meeting that have not ended
+func (s *BreakoutService) openBreakouts(ctx context.Context, parentRoomID uuid.UUID) ([]*entities.Room, error) {
+	breakouts, err := s.roomRepo.FindBreakouts(ctx, parentRoomID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get breakout rooms: %w", err)
+	}
+
+	open := make([]*entities.Room, 0, len(breakouts))
+	for _, b := range breakouts {
+		if b.IsActive() {
+			open = append(open, b)
+		}
+	}
+	return open, nil
+}
+
+// openBreakoutsByID is openBreakouts indexed by room ID; it fails when no breakout room is open
+func (s *BreakoutService) openBreakoutsByID(ctx context.Context, parentRoomID uuid.UUID) (map[uuid.UUID]*entities.Room, error) {
+	open, err := s.openBreakouts(ctx, parentRoomID)
+	if err != nil {
+		return nil, err
+	}
+	if len(open) == 0 {
+		return nil, usecaseErrors.ErrNoOpenBreakouts
+	}
+
+	byID := make(map[uuid.UUID]*entities.Room, len(open))
+	for _, b := range open {
+		byID[b.ID] = b
+	}
+	return byID, nil
+}
+
+// getHostedParent retrieves a meeting that may have breakout rooms and checks that the user may manage them
+func (s *BreakoutService) getHostedParent(ctx context.Context, parentRoomID, hostID uuid.UUID) (*entities.Room, error) {
+	parent, err := s.getParent(ctx, parentRoomID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.roomService.Authorize(ctx, parent, hostID, entities.RoomActionManageBreakouts); err != nil {
+		return nil, err
+	}
+	return parent, nil
+}
+
+// getParent retrieves a meeting that may have breakout rooms
+func (s *BreakoutService) getParent(ctx context.Context, roomID uuid.UUID) (*entities.Room, error) {
+	parent, err := s.roomRepo.FindByID(ctx, roomID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, usecaseErrors.ErrRoomNotFound
+		}
+		return nil, fmt.Errorf("failed to get room: %w", err)
+	}
+	if parent.IsBreakout() {
+		return nil, usecaseErrors.ErrNestedBreakout
+	}
+	return parent, nil
+}
+
+// isAssignable checks if a participant of the main room was let into the meeting
+// (it may have left the main room to go to a breakout room)
+func isAssignable(p *entities.Participant) bool {
+	if p.IsRemoved {
+		return false
+	}
+	return p.Status == entities.ParticipantStatusJoined || p.Status == entities.ParticipantStatusLeft
+}
@@ -0,0 +1,38 @@
+package breakout
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Service defines the interface for breakout rooms of a meeting
+type Service interface {
+	// CreateBreakouts opens breakout rooms for an active meeting (host only)
+	CreateBreakouts(ctx context.Context, input CreateBreakoutsInput) (*Overview, error)
+
+	// ListBreakouts retrieves the open breakout rooms and their assigned participants (host only)
+	ListBreakouts(ctx context.Context, parentRoomID, hostID uuid.UUID) (*Overview, error)
+
+	// AssignParticipants moves participants to breakout rooms or back to the main room (host only)
+	AssignParticipants(ctx context.Context, input AssignInput) (*Overview, error)
+
+	// AssignRandomly spreads the participants of the main room evenly across the breakout rooms (host only)
+	AssignRandomly(ctx context.Context, parentRoomID, hostID uuid.UUID) (*Overview, error)
+
+	// StartTimer broadcasts a countdown to the main room and every breakout room (host only)
+	StartTimer(ctx context.Context, parentRoomID, hostID uuid.UUID, duration time.Duration) (time.Time, error)
+
+	// CloseBreakouts ends every breakout room and brings everyone back to the main room (host only)
+	CloseBreakouts(ctx context.Context, parentRoomID, hostID uuid.UUID) error
+
+	// GetUserDestination returns the room a user should be in and a LiveKit token for it (for polling)
+	GetUserDestination(ctx context.Context, parentRoomID, userID uuid.UUID) (*Destination, error)
+
+	// GetGuestDestination returns the room a guest should be in and a LiveKit token for it (for polling)
+	GetGuestDestination(ctx context.Context, parentRoomID, participantID uuid.UUID) (*Destination, error)
+}
+
+// Ensure BreakoutService implements Service interface
+var _ Service = (*BreakoutService)(nil)
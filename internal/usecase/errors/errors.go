@@ -1,6 +1,9 @@
 package errors
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 // Common errors
 var (
@@ -30,6 +33,15 @@ var (
 	ErrRoomAlreadyStarted     = errors.New("room already started")
 	ErrInvalidRoomType        = errors.New("invalid room type")
 	ErrInvalidMaxParticipants = errors.New("max participants must be between 2 and 100")
+	ErrInvalidRoomSettings    = errors.New("invalid room settings")
+	ErrRecordingDisabled      = errors.New("recording is disabled for this room")
+	ErrTranscriptionDisabled  = errors.New("transcription is disabled for this room")
+	ErrRoomLocked             = errors.New("room is locked")
+	ErrInvalidLockMode        = errors.New("invalid lock mode")
+	ErrPasscodeRequired       = errors.New("room requires a passcode")
+	ErrIncorrectPasscode      = errors.New("incorrect passcode")
+	ErrInvalidPasscode        = errors.New("passcode must be between 4 and 32 characters")
+	ErrTooManyPasscodeTries   = errors.New("too many incorrect passcode attempts, try again later")
 )
 
 // Participant errors
@@ -47,14 +59,123 @@ var (
 	ErrInvalidParticipantStatus = errors.New("invalid participant status for this operation")
 	ErrWaitingForHostApproval   = errors.New("waiting for host approval")
 	ErrInvitationNotFound       = errors.New("invitation not found")
+	ErrCannotModerate           = errors.New("user is not allowed to moderate this participant")
+	ErrInvalidTrackSource       = errors.New("invalid track source")
+	ErrReservedMetadataKey      = errors.New("participant metadata key is reserved")
+	ErrCannotChangeHostRole     = errors.New("cannot change the role of the host")
+	ErrGuestCannotBeCoHost      = errors.New("guests cannot be co-hosts")
+	ErrHandNotRaised            = errors.New("participant does not have a raised hand")
+)
+
+// Invitation errors
+var (
+	ErrInvitationExpired    = errors.New("invitation has expired")
+	ErrInvitationRevoked    = errors.New("invitation has been revoked")
+	ErrInvitationResponded  = errors.New("invitation has already been responded to")
+	ErrInvitationNotForUser = errors.New("invitation was issued to another user")
+	ErrInvalidInvitationTTL = errors.New("invalid invitation expiry")
+)
+
+// Guest errors
+var (
+	ErrGuestsNotAllowed  = errors.New("guests are not allowed in this room")
+	ErrGuestRoomRequired = errors.New("room slug or invitation token is required")
+	ErrGuestBlocked      = errors.New("guest has been removed from this room")
+)
+
+// Breakout errors
+var (
+	ErrBreakoutNotFound       = errors.New("breakout room not found")
+	ErrBreakoutsAlreadyOpen   = errors.New("breakout rooms are already open")
+	ErrNoOpenBreakouts        = errors.New("no open breakout rooms")
+	ErrNestedBreakout         = errors.New("breakout rooms cannot have breakout rooms")
+	ErrInvalidBreakoutCount   = errors.New("invalid number of breakout rooms")
+	ErrInvalidBreakoutTimer   = errors.New("invalid breakout timer duration")
+	ErrRoomNotActive          = errors.New("room is not active")
+	ErrParticipantNotAssigned = errors.New("participant cannot be assigned to a breakout room")
+)
+
+// Chat errors
+var (
+	ErrChatDisabled        = errors.New("chat is disabled for this room")
+	ErrChatMessageNotFound = errors.New("chat message not found")
+	ErrChatMessageEmpty    = errors.New("chat message cannot be empty")
+	ErrChatMessageTooLong  = errors.New("chat message is too long")
+	ErrChatMessageDeleted  = errors.New("chat message has been deleted")
+	ErrNotMessageAuthor    = errors.New("only the author can edit this message")
+)
+
+// Poll errors
+var (
+	ErrPollNotFound       = errors.New("poll not found")
+	ErrPollClosed         = errors.New("poll is closed")
+	ErrInvalidPollType    = errors.New("invalid poll type")
+	ErrInvalidPollOptions = errors.New("choice polls need between 2 and 10 distinct options")
+	ErrInvalidPollClose   = errors.New("poll closing time must be in the future")
+	ErrInvalidVote        = errors.New("vote does not match the poll")
+)
+
+// Agenda errors
+var (
+	ErrAgendaItemNotFound = errors.New("agenda item not found")
+	ErrInvalidAgenda      = errors.New("agenda needs at most 50 items, each with a title")
+)
+
+// Organization errors
+var (
+	ErrOrganizationNotFound    = errors.New("organization not found")
+	ErrNotOrganizationMember   = errors.New("not a member of this organization")
+	ErrNotOrganizationAdmin    = errors.New("only organization owners and admins can do this")
+	ErrNotOrganizationOwner    = errors.New("only organization owners can do this")
+	ErrInvalidOrganizationRole = errors.New("invalid organization role")
+	ErrInvalidOrgSettings      = errors.New("invalid organization settings")
+	ErrLastOrganizationOwner   = errors.New("an organization needs at least one owner")
+	ErrOrgMemberNotFound       = errors.New("organization member not found")
+	ErrOrgInviteNotFound       = errors.New("organization invite not found")
+	ErrOrgInviteNotPending     = errors.New("organization invite is no longer pending")
+	ErrOrgInviteWrongRecipient = errors.New("organization invite was sent to another email address")
+)
+
+// Series errors
+var (
+	ErrSeriesNotFound          = errors.New("meeting series not found")
+	ErrSeriesEnded             = errors.New("meeting series has ended")
+	ErrInvalidRecurrenceRule   = errors.New("invalid recurrence rule")
+	ErrInvalidTimezone         = errors.New("invalid time zone")
+	ErrOccurrenceNotFound      = errors.New("occurrence not found in series")
+	ErrOccurrenceCancelled     = errors.New("occurrence has been cancelled")
+	ErrOccurrenceAlreadyLocked = errors.New("occurrence has already started")
 )
 
 // Recording errors
 var (
-	ErrRecordingNotFound   = errors.New("recording not found")
-	ErrRecordingInProgress = errors.New("recording already in progress")
-	ErrRecordingNotStarted = errors.New("recording not started")
-	ErrRecordingFailed     = errors.New("recording failed")
+	ErrRecordingNotFound    = errors.New("recording not found")
+	ErrRecordingInProgress  = errors.New("recording already in progress")
+	ErrRecordingNotStarted  = errors.New("recording not started")
+	ErrRecordingFailed      = errors.New("recording failed")
+	ErrRecordingPaused      = errors.New("recording is paused")
+	ErrRecordingNotPaused   = errors.New("recording is not paused")
+	ErrRecordingNotInBucket = errors.New("recording is not stored in the recordings bucket")
+
+	ErrRecordingConsentRequired = errors.New("everyone in the meeting must consent to be recorded")
+	ErrConsentPolicyOutdated    = errors.New("consent must be given to the current recording policy")
+)
+
+// AI job errors
+var (
+	ErrAIJobNotFound     = errors.New("AI job not found")
+	ErrAIJobNotRetryable = errors.New("only failed or cancelled AI jobs can be retried")
+)
+
+// Quota errors
+var (
+	ErrQuotaExceeded              = errors.New("plan quota exceeded")
+	ErrPlanNotFound               = errors.New("plan not found")
+	ErrParticipantLimitExceeded   = fmt.Errorf("%w: max participants is above the limit of the plan", ErrQuotaExceeded)
+	ErrConcurrentRoomLimitReached = fmt.Errorf("%w: too many meetings in progress for the plan", ErrQuotaExceeded)
+	ErrMeetingMinutesExhausted    = fmt.Errorf("%w: monthly meeting minutes of the plan are used up", ErrQuotaExceeded)
+	ErrRecordingStorageFull       = fmt.Errorf("%w: recording storage of the plan is full", ErrQuotaExceeded)
+	ErrAIMinutesExhausted         = fmt.Errorf("%w: monthly AI processing minutes of the plan are used up", ErrQuotaExceeded)
 )
 
 // LiveKit errors
@@ -69,4 +190,6 @@ var (
 	ErrUserNotFound     = errors.New("user not found")
 	ErrUserNotActive    = errors.New("user is not active")
 	ErrEmailAlreadyUsed = errors.New("email already in use")
+	ErrInvalidUserRole  = errors.New("invalid user role")
+	ErrCannotModifySelf = errors.New("admins cannot change their own role or deactivate themselves")
 )
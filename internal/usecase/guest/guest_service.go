@@ -0,0 +1,352 @@
+package guest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+	"github.com/johnquangdev/meeting-assistant/internal/domain/repositories"
+	usecaseErrors "github.com/johnquangdev/meeting-assistant/internal/usecase/errors"
+	"github.com/johnquangdev/meeting-assistant/internal/usecase/room"
+	"github.com/johnquangdev/meeting-assistant/pkg/config"
+	"github.com/johnquangdev/meeting-assistant/pkg/jwt"
+)
+
+// earlyJoinWindow is how long before the scheduled start guests may join (same as registered users)
+const earlyJoinWindow = 15 * time.Minute
+
+// GuestService lets people without an account join rooms with a display name
+type GuestService struct {
+	roomRepo        repositories.RoomRepository
+	participantRepo repositories.ParticipantRepository
+	invitationRepo  repositories.InvitationRepository
+	roomService     room.Service
+	jwtManager      *jwt.Manager
+	tokenTTL        time.Duration
+}
+
+// NewGuestService creates a new guest service
+func NewGuestService(
+	roomRepo repositories.RoomRepository,
+	participantRepo repositories.ParticipantRepository,
+	invitationRepo repositories.InvitationRepository,
+	roomService room.Service,
+	jwtManager *jwt.Manager,
+	cfg config.GuestConfig,
+) *GuestService {
+	ttl := cfg.TokenTTL
+	if ttl <= 0 {
+		ttl = 4 * time.Hour
+	}
+
+	return &GuestService{
+		roomRepo:        roomRepo,
+		participantRepo: participantRepo,
+		invitationRepo:  invitationRepo,
+		roomService:     roomService,
+		jwtManager:      jwtManager,
+		tokenTTL:        ttl,
+	}
+}
+
+// JoinInput represents input for a guest joining a room.
+// Exactly one of RoomSlug and InviteToken identifies the room; InviteToken wins when both are set.
+type JoinInput struct {
+	DisplayName string
+	RoomSlug    string
+	InviteToken string
+	Passcode    string // Required when joining a room with a passcode by slug
+	ClientIP    string // Rate limits wrong passcodes of guests
+}
+
+// JoinOutput represents the result of a guest join.
+// LivekitToken is empty while the guest waits for the host to admit them.
+type JoinOutput struct {
+	Room           *entities.Room
+	Participant    *entities.Participant
+	GuestToken     string
+	GuestExpiresAt time.Time
+	LivekitToken   string
+}
+
+// StatusOutput represents the current status of a guest (for polling)
+type StatusOutput struct {
+	Room         *entities.Room
+	Participant  *entities.Participant
+	LivekitToken string
+}
+
+// Join lets a guest join a room by slug or invitation token and issues a room-scoped guest credential
+func (s *GuestService) Join(ctx context.Context, input JoinInput) (*JoinOutput, error) {
+	name := strings.TrimSpace(input.DisplayName)
+
+	var (
+		r          *entities.Room
+		invitation *entities.RoomInvitation
+		err        error
+	)
+	switch {
+	case input.InviteToken != "":
+		invitation, err = s.getUsableInvitation(ctx, input.InviteToken)
+		if err != nil {
+			return nil, err
+		}
+		r = invitation.Room
+		if r == nil {
+			if r, err = s.getRoom(ctx, invitation.RoomID); err != nil {
+				return nil, err
+			}
+		}
+
+	case input.RoomSlug != "":
+		r, err = s.roomRepo.FindBySlug(ctx, strings.ToLower(strings.TrimSpace(input.RoomSlug)))
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, usecaseErrors.ErrRoomNotFound
+			}
+			return nil, fmt.Errorf("failed to get room: %w", err)
+		}
+		// Uninvited guests need the host to opt in
+		if !r.GetSettings().AllowGuests {
+			return nil, usecaseErrors.ErrGuestsNotAllowed
+		}
+
+	default:
+		return nil, usecaseErrors.ErrGuestRoomRequired
+	}
+
+	if err := s.checkJoinable(r); err != nil {
+		return nil, err
+	}
+
+	if err := s.roomService.CheckJoinQuota(ctx, r, false); err != nil {
+		return nil, err
+	}
+
+	// The invitation stands in for the passcode
+	if invitation == nil {
+		if err := s.roomService.VerifyPasscode(ctx, r, input.Passcode, nil, input.ClientIP); err != nil {
+			return nil, err
+		}
+	}
+
+	participant, err := s.upsertParticipant(ctx, r, invitation, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if invitation != nil && invitation.Status == entities.InvitationStatusPending {
+		invitation.AcceptAsGuest()
+		if err := s.invitationRepo.Update(ctx, invitation); err != nil {
+			return nil, fmt.Errorf("failed to accept invitation: %w", err)
+		}
+	}
+
+	expiresAt := time.Now().Add(s.tokenTTL)
+	guestToken, err := s.jwtManager.GenerateGuestToken(participant.ID, r.ID, name, s.tokenTTL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate guest token: %w", err)
+	}
+
+	// Let the moderators know a guest is waiting in the lobby
+	if participant.Status == entities.ParticipantStatusWaiting {
+		s.roomService.PublishEvent(ctx, entities.NewParticipantEvent(entities.RoomEventParticipantWaiting, participant, nil))
+	}
+
+	output := &JoinOutput{
+		Room:           r,
+		Participant:    participant,
+		GuestToken:     guestToken,
+		GuestExpiresAt: expiresAt,
+	}
+
+	if participant.Status == entities.ParticipantStatusJoined {
+		if output.LivekitToken, err = s.roomService.GenerateParticipantToken(ctx, r, participant); err != nil {
+			return nil, err
+		}
+	}
+
+	log.Printf("[Guest] Guest joined room: name=%q, room=%s, participant=%s, status=%s",
+		name, r.ID, participant.ID, participant.Status)
+
+	return output, nil
+}
+
+// GetStatus gets the guest's participant status and a LiveKit token once admitted (for polling)
+func (s *GuestService) GetStatus(ctx context.Context, roomID, participantID uuid.UUID) (*StatusOutput, error) {
+	participant, err := s.getGuestParticipant(ctx, roomID, participantID)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := s.getRoom(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &StatusOutput{Room: r, Participant: participant}
+	if participant.Status == entities.ParticipantStatusJoined && !r.IsEnded() {
+		if output.LivekitToken, err = s.roomService.GenerateParticipantToken(ctx, r, participant); err != nil {
+			return nil, err
+		}
+	}
+
+	return output, nil
+}
+
+// Leave marks the guest as left
+func (s *GuestService) Leave(ctx context.Context, roomID, participantID uuid.UUID) error {
+	return s.roomService.LeaveRoomAsGuest(ctx, roomID, participantID)
+}
+
+// upsertParticipant creates the guest participant, or reuses the one an invitation already created
+func (s *GuestService) upsertParticipant(ctx context.Context, r *entities.Room, invitation *entities.RoomInvitation, name string) (*entities.Participant, error) {
+	// Guests invited by the host follow the room settings (and lock); everyone else waits to be admitted
+	mustWait := invitation == nil || r.GetSettings().RequiresAdmission() || r.IsLocked()
+
+	if invitation != nil {
+		existing, err := s.participantRepo.FindGuestByInvitation(ctx, r.ID, invitation.ID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("failed to get participant: %w", err)
+		}
+		if existing != nil {
+			return s.rejoin(ctx, r, existing, name, mustWait)
+		}
+	}
+
+	participant := &entities.Participant{
+		RoomID:    r.ID,
+		GuestName: &name,
+		Role:      entities.ParticipantRoleGuest,
+		Status:    entities.ParticipantStatusWaiting,
+		// Guests never share their screen, whatever the room allows
+		CanShareScreen: false,
+	}
+	if invitation != nil {
+		meta, err := json.Marshal(map[string]string{"invitation_id": invitation.ID.String()})
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode participant metadata: %w", err)
+		}
+		participant.Metadata = datatypes.JSON(meta)
+		participant.InvitedBy = &invitation.InviterID
+		participant.InvitedAt = &invitation.CreatedAt
+	}
+	// Take a seat (or get in line for one when the room is full) unless the guest must be admitted
+	if !mustWait {
+		if err := s.roomService.SeatParticipant(ctx, r, participant); err != nil {
+			return nil, err
+		}
+		return participant, nil
+	}
+
+	if err := s.participantRepo.Create(ctx, participant); err != nil {
+		return nil, fmt.Errorf("failed to create participant: %w", err)
+	}
+
+	return participant, nil
+}
+
+// rejoin lets an invited guest come back (e.g. after closing the tab) with the same participant record
+func (s *GuestService) rejoin(ctx context.Context, r *entities.Room, participant *entities.Participant, name string, mustWait bool) (*entities.Participant, error) {
+	switch participant.Status {
+	case entities.ParticipantStatusDenied, entities.ParticipantStatusRemoved:
+		return nil, usecaseErrors.ErrGuestBlocked
+	case entities.ParticipantStatusJoined, entities.ParticipantStatusWaiting, entities.ParticipantStatusWaitlisted:
+		// Still in the room, in the lobby or in line for a seat: only refresh the credential
+		participant.GuestName = &name
+		if err := s.participantRepo.Update(ctx, participant); err != nil {
+			return nil, fmt.Errorf("failed to update participant: %w", err)
+		}
+		return participant, nil
+	}
+
+	participant.GuestName = &name
+	participant.LeftAt = nil
+	if !mustWait {
+		if err := s.roomService.SeatParticipant(ctx, r, participant); err != nil {
+			return nil, err
+		}
+		return participant, nil
+	}
+
+	participant.Status = entities.ParticipantStatusWaiting
+	if err := s.participantRepo.Update(ctx, participant); err != nil {
+		return nil, fmt.Errorf("failed to update participant: %w", err)
+	}
+
+	return participant, nil
+}
+
+// checkJoinable checks that the room is open to new participants
+func (s *GuestService) checkJoinable(r *entities.Room) error {
+	if r.IsEnded() || r.Status == entities.RoomStatusCancelled {
+		return usecaseErrors.ErrRoomEnded
+	}
+	if r.IsLocked() && *r.LockMode == entities.RoomLockModeReject {
+		return usecaseErrors.ErrRoomLocked
+	}
+	if r.ScheduledStartTime != nil && time.Now().Before(r.ScheduledStartTime.Add(-earlyJoinWindow)) {
+		return usecaseErrors.ErrTooEarly
+	}
+	return nil
+}
+
+// getUsableInvitation retrieves an invitation that may still be used to join
+func (s *GuestService) getUsableInvitation(ctx context.Context, token string) (*entities.RoomInvitation, error) {
+	invitation, err := s.invitationRepo.FindByToken(ctx, token)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, usecaseErrors.ErrInvitationNotFound
+		}
+		return nil, fmt.Errorf("failed to get invitation: %w", err)
+	}
+
+	switch invitation.EffectiveStatus() {
+	case entities.InvitationStatusPending, entities.InvitationStatusAccepted:
+		if invitation.IsExpired() {
+			return nil, usecaseErrors.ErrInvitationExpired
+		}
+		return invitation, nil
+	case entities.InvitationStatusExpired:
+		return nil, usecaseErrors.ErrInvitationExpired
+	case entities.InvitationStatusRevoked:
+		return nil, usecaseErrors.ErrInvitationRevoked
+	default:
+		return nil, usecaseErrors.ErrInvitationResponded
+	}
+}
+
+// getGuestParticipant retrieves a guest participant of a room
+func (s *GuestService) getGuestParticipant(ctx context.Context, roomID, participantID uuid.UUID) (*entities.Participant, error) {
+	participant, err := s.participantRepo.FindByID(ctx, participantID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, usecaseErrors.ErrParticipantNotFound
+		}
+		return nil, fmt.Errorf("failed to get participant: %w", err)
+	}
+	if participant.RoomID != roomID || !participant.IsGuest() {
+		return nil, usecaseErrors.ErrParticipantNotFound
+	}
+	return participant, nil
+}
+
+func (s *GuestService) getRoom(ctx context.Context, roomID uuid.UUID) (*entities.Room, error) {
+	r, err := s.roomRepo.FindByID(ctx, roomID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, usecaseErrors.ErrRoomNotFound
+		}
+		return nil, fmt.Errorf("failed to get room: %w", err)
+	}
+	return r, nil
+}
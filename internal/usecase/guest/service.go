@@ -0,0 +1,22 @@
+package guest
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Service defines the interface for guests joining rooms without an account
+type Service interface {
+	// Join lets a guest join a room by slug or invitation token and issues a room-scoped guest credential
+	Join(ctx context.Context, input JoinInput) (*JoinOutput, error)
+
+	// GetStatus gets the guest's participant status and a LiveKit token once admitted (for polling)
+	GetStatus(ctx context.Context, roomID, participantID uuid.UUID) (*StatusOutput, error)
+
+	// Leave marks the guest as left
+	Leave(ctx context.Context, roomID, participantID uuid.UUID) error
+}
+
+// Ensure GuestService implements Service interface
+var _ Service = (*GuestService)(nil)
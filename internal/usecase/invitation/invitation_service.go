@@ -0,0 +1,534 @@
+package invitation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+	"github.com/johnquangdev/meeting-assistant/internal/domain/repositories"
+	usecaseErrors "github.com/johnquangdev/meeting-assistant/internal/usecase/errors"
+	"github.com/johnquangdev/meeting-assistant/internal/usecase/notification"
+	"github.com/johnquangdev/meeting-assistant/internal/usecase/room"
+	"github.com/johnquangdev/meeting-assistant/pkg/config"
+)
+
+// InvitationService handles tokenized room invitations backed by the room_invitations table
+type InvitationService struct {
+	invitationRepo  repositories.InvitationRepository
+	roomRepo        repositories.RoomRepository
+	participantRepo repositories.ParticipantRepository
+	userRepo        repositories.UserRepository
+	roomService     room.Service
+	notifier        notification.Service
+	cfg             config.InvitationConfig
+	frontendURL     string
+
+	workerStopChan  chan struct{}
+	workerWg        sync.WaitGroup
+	isWorkerRunning bool
+	workerMutex     sync.Mutex
+}
+
+// NewInvitationService creates a new invitation service
+func NewInvitationService(
+	invitationRepo repositories.InvitationRepository,
+	roomRepo repositories.RoomRepository,
+	participantRepo repositories.ParticipantRepository,
+	userRepo repositories.UserRepository,
+	roomService room.Service,
+	notifier notification.Service,
+	appConfig *config.Config,
+) *InvitationService {
+	cfg := appConfig.Invitation
+	if cfg.DefaultTTL <= 0 {
+		cfg.DefaultTTL = 7 * 24 * time.Hour
+	}
+	if cfg.MaxTTL < cfg.DefaultTTL {
+		cfg.MaxTTL = cfg.DefaultTTL
+	}
+	if cfg.ExpiryInterval <= 0 {
+		cfg.ExpiryInterval = 15 * time.Minute
+	}
+
+	return &InvitationService{
+		invitationRepo:  invitationRepo,
+		roomRepo:        roomRepo,
+		participantRepo: participantRepo,
+		userRepo:        userRepo,
+		roomService:     roomService,
+		notifier:        notifier,
+		cfg:             cfg,
+		frontendURL:     strings.TrimRight(appConfig.Server.FrontendURL, "/"),
+	}
+}
+
+// CreateInvitationInput represents input for inviting a user to a room
+type CreateInvitationInput struct {
+	RoomID    uuid.UUID
+	InviterID uuid.UUID
+	Email     string
+	Message   *string
+	ExpiresIn time.Duration // Zero uses the configured default
+}
+
+// AcceptInvitationOutput represents the result of accepting an invitation.
+// LivekitToken is empty when the participant cannot enter yet (waiting room, too early).
+type AcceptInvitationOutput struct {
+	Invitation   *entities.RoomInvitation
+	Room         *entities.Room
+	Participant  *entities.Participant
+	LivekitToken string
+}
+
+// CreateInvitation invites a user to a room by email (host only)
+func (s *InvitationService) CreateInvitation(ctx context.Context, input CreateInvitationInput) (*entities.RoomInvitation, error) {
+	email := strings.ToLower(strings.TrimSpace(input.Email))
+
+	ttl := input.ExpiresIn
+	if ttl == 0 {
+		ttl = s.cfg.DefaultTTL
+	}
+	if ttl < 0 || ttl > s.cfg.MaxTTL {
+		return nil, usecaseErrors.ErrInvalidInvitationTTL
+	}
+
+	r, err := s.getRoom(ctx, input.RoomID)
+	if err != nil {
+		return nil, err
+	}
+	if r.IsEnded() || r.Status == entities.RoomStatusCancelled {
+		return nil, usecaseErrors.ErrRoomEnded
+	}
+
+	if err := s.requireHost(ctx, input.RoomID, input.InviterID); err != nil {
+		return nil, err
+	}
+
+	// Link the invitation to an existing account when there is one
+	var inviteeID *uuid.UUID
+	invitee, err := s.userRepo.FindByEmail(ctx, email)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("failed to look up invitee: %w", err)
+	}
+	if invitee != nil {
+		inviteeID = &invitee.ID
+
+		participant, err := s.participantRepo.FindByRoomAndUser(ctx, input.RoomID, invitee.ID)
+		if err == nil && participant != nil && participant.IsActive() {
+			return nil, usecaseErrors.ErrAlreadyInvited
+		}
+	}
+
+	// A pending invitation is returned as is (idempotent for network lag)
+	lookupID := uuid.Nil
+	if inviteeID != nil {
+		lookupID = *inviteeID
+	}
+	existing, err := s.invitationRepo.FindPendingByRoomAndInvitee(ctx, input.RoomID, lookupID, email)
+	if err == nil && existing != nil {
+		log.Printf("[Invitation] User already invited (pending): email=%s, room=%s", email, r.Name)
+		return existing, nil
+	}
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("failed to check existing invitations: %w", err)
+	}
+
+	token, err := entities.NewInvitationToken()
+	if err != nil {
+		return nil, err
+	}
+
+	invitation := &entities.RoomInvitation{
+		RoomID:       input.RoomID,
+		InviterID:    input.InviterID,
+		InviteeID:    inviteeID,
+		InviteeEmail: &email,
+		Token:        token,
+		Status:       entities.InvitationStatusPending,
+		Message:      input.Message,
+		ExpiresAt:    time.Now().Add(ttl),
+	}
+
+	if err := s.invitationRepo.Create(ctx, invitation); err != nil {
+		return nil, fmt.Errorf("failed to create invitation: %w", err)
+	}
+	invitation.Room = r
+
+	log.Printf("[Invitation] User invited to room: email=%s, room=%s, inviter=%s, expires_at=%s",
+		email, r.Name, input.InviterID, invitation.ExpiresAt.Format(time.RFC3339))
+
+	s.sendInvitationEmail(ctx, invitation)
+
+	return invitation, nil
+}
+
+// sendInvitationEmail emails the invitation link in the background so SMTP latency never blocks the request
+func (s *InvitationService) sendInvitationEmail(ctx context.Context, invitation *entities.RoomInvitation) {
+	if s.notifier == nil {
+		return
+	}
+
+	inviteURL := s.InvitationURL(invitation)
+	go func() {
+		if err := s.notifier.SendInvitation(context.WithoutCancel(ctx), invitation, inviteURL); err != nil {
+			log.Printf("[Invitation] ⚠️  Failed to email invitation %s: %v", invitation.ID, err)
+		}
+	}()
+}
+
+// GetInvitationByToken retrieves an invitation by its token (with room and inviter)
+func (s *InvitationService) GetInvitationByToken(ctx context.Context, token string) (*entities.RoomInvitation, error) {
+	invitation, err := s.invitationRepo.FindByToken(ctx, token)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, usecaseErrors.ErrInvitationNotFound
+		}
+		return nil, fmt.Errorf("failed to get invitation: %w", err)
+	}
+	return invitation, nil
+}
+
+// AcceptInvitation accepts an invitation by token and joins the room when possible
+func (s *InvitationService) AcceptInvitation(ctx context.Context, token string, userID uuid.UUID, email string) (*AcceptInvitationOutput, error) {
+	invitation, err := s.GetInvitationByToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	return s.accept(ctx, invitation, userID, email)
+}
+
+// DeclineInvitation declines an invitation by token
+func (s *InvitationService) DeclineInvitation(ctx context.Context, token string, userID uuid.UUID, email string) error {
+	invitation, err := s.GetInvitationByToken(ctx, token)
+	if err != nil {
+		return err
+	}
+	return s.decline(ctx, invitation, userID, email)
+}
+
+// AcceptRoomInvitation accepts the pending invitation of the current user to a room
+func (s *InvitationService) AcceptRoomInvitation(ctx context.Context, roomID, userID uuid.UUID, email string) (*AcceptInvitationOutput, error) {
+	invitation, err := s.findPendingForUser(ctx, roomID, userID, email)
+	if err != nil {
+		return nil, err
+	}
+	return s.accept(ctx, invitation, userID, email)
+}
+
+// DeclineRoomInvitation declines the pending invitation of the current user to a room
+func (s *InvitationService) DeclineRoomInvitation(ctx context.Context, roomID, userID uuid.UUID, email string) error {
+	invitation, err := s.findPendingForUser(ctx, roomID, userID, email)
+	if err != nil {
+		return err
+	}
+	return s.decline(ctx, invitation, userID, email)
+}
+
+// RevokeInvitation revokes a pending invitation (host only)
+func (s *InvitationService) RevokeInvitation(ctx context.Context, roomID, invitationID, hostID uuid.UUID) error {
+	if err := s.requireHost(ctx, roomID, hostID); err != nil {
+		return err
+	}
+
+	invitation, err := s.invitationRepo.FindByID(ctx, invitationID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return usecaseErrors.ErrInvitationNotFound
+		}
+		return fmt.Errorf("failed to get invitation: %w", err)
+	}
+	if invitation.RoomID != roomID {
+		return usecaseErrors.ErrInvitationNotFound
+	}
+
+	if invitation.Status == entities.InvitationStatusRevoked {
+		return nil
+	}
+	if invitation.Status != entities.InvitationStatusPending {
+		return usecaseErrors.ErrInvitationResponded
+	}
+
+	invitation.Revoke()
+	if err := s.invitationRepo.Update(ctx, invitation); err != nil {
+		return fmt.Errorf("failed to revoke invitation: %w", err)
+	}
+
+	log.Printf("[Invitation] Invitation revoked: id=%s, room=%s, host=%s", invitation.ID, roomID, hostID)
+	return nil
+}
+
+// ListRoomInvitations retrieves all invitations of a room (host only)
+func (s *InvitationService) ListRoomInvitations(ctx context.Context, roomID, hostID uuid.UUID) ([]*entities.RoomInvitation, error) {
+	if err := s.requireHost(ctx, roomID, hostID); err != nil {
+		return nil, err
+	}
+
+	invitations, err := s.invitationRepo.FindByRoomID(ctx, roomID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get invitations: %w", err)
+	}
+	return invitations, nil
+}
+
+// ListMyInvitations retrieves pending invitations addressed to the current user
+func (s *InvitationService) ListMyInvitations(ctx context.Context, userID uuid.UUID, email string) ([]*entities.RoomInvitation, error) {
+	invitations, err := s.invitationRepo.FindPendingByInvitee(ctx, userID, email)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get invitations: %w", err)
+	}
+	return invitations, nil
+}
+
+// InvitationURL builds the shareable link of an invitation
+func (s *InvitationService) InvitationURL(invitation *entities.RoomInvitation) string {
+	return fmt.Sprintf("%s/invitations/%s", s.frontendURL, invitation.Token)
+}
+
+// ExpireStale marks pending invitations past their expiry as expired
+func (s *InvitationService) ExpireStale(ctx context.Context) (int64, error) {
+	expired, err := s.invitationRepo.ExpirePending(ctx, time.Now())
+	if err != nil {
+		return 0, fmt.Errorf("failed to expire invitations: %w", err)
+	}
+	return expired, nil
+}
+
+// StartWorker starts the background job that expires stale invitations
+func (s *InvitationService) StartWorker(ctx context.Context) error {
+	s.workerMutex.Lock()
+	defer s.workerMutex.Unlock()
+
+	if s.isWorkerRunning {
+		return fmt.Errorf("invitation worker already running")
+	}
+
+	s.isWorkerRunning = true
+	s.workerStopChan = make(chan struct{})
+
+	s.workerWg.Add(1)
+	go s.expiryWorker(ctx)
+
+	return nil
+}
+
+// StopWorker gracefully stops the background job
+func (s *InvitationService) StopWorker() error {
+	s.workerMutex.Lock()
+	defer s.workerMutex.Unlock()
+
+	if !s.isWorkerRunning {
+		return fmt.Errorf("invitation worker not running")
+	}
+
+	close(s.workerStopChan)
+	s.workerWg.Wait()
+	s.isWorkerRunning = false
+
+	return nil
+}
+
+// expiryWorker periodically expires stale pending invitations
+func (s *InvitationService) expiryWorker(ctx context.Context) {
+	defer s.workerWg.Done()
+
+	ticker := time.NewTicker(s.cfg.ExpiryInterval)
+	defer ticker.Stop()
+
+	log.Printf("[Invitation] 👷 Expiry worker started (interval=%s)", s.cfg.ExpiryInterval)
+
+	expire := func() {
+		expired, err := s.ExpireStale(ctx)
+		if err != nil {
+			log.Printf("[Invitation] ❌ %v", err)
+			return
+		}
+		if expired > 0 {
+			log.Printf("[Invitation] ⌛ Expired %d stale invitations", expired)
+		}
+	}
+
+	expire()
+
+	for {
+		select {
+		case <-s.workerStopChan:
+			log.Println("[Invitation] 👷 Expiry worker stopping")
+			return
+		case <-ctx.Done():
+			log.Println("[Invitation] 👷 Expiry worker context cancelled")
+			return
+		case <-ticker.C:
+			expire()
+		}
+	}
+}
+
+// accept validates the invitation, records the acceptance and joins the room.
+// Accepting an already accepted invitation again re-runs the join for the same user.
+func (s *InvitationService) accept(ctx context.Context, invitation *entities.RoomInvitation, userID uuid.UUID, email string) (*AcceptInvitationOutput, error) {
+	alreadyAccepted := invitation.Status == entities.InvitationStatusAccepted &&
+		invitation.InviteeID != nil && *invitation.InviteeID == userID
+	if !alreadyAccepted {
+		if err := s.checkRespondable(invitation, userID, email); err != nil {
+			return nil, err
+		}
+	}
+
+	r, err := s.getRoom(ctx, invitation.RoomID)
+	if err != nil {
+		return nil, err
+	}
+	if r.IsEnded() || r.Status == entities.RoomStatusCancelled {
+		return nil, usecaseErrors.ErrRoomEnded
+	}
+
+	if err := s.ensureInvitedParticipant(ctx, r, invitation, userID); err != nil {
+		return nil, err
+	}
+
+	if !alreadyAccepted {
+		invitation.Accept(userID)
+		if err := s.invitationRepo.Update(ctx, invitation); err != nil {
+			return nil, fmt.Errorf("failed to update invitation: %w", err)
+		}
+		log.Printf("[Invitation] User accepted invitation: id=%s, room=%s, user=%s", invitation.ID, r.Name, userID)
+	}
+
+	output := &AcceptInvitationOutput{Invitation: invitation, Room: r}
+
+	joinedRoom, participant, err := s.roomService.JoinRoom(ctx, room.JoinRoomInput{RoomID: r.ID, UserID: userID})
+	switch {
+	case errors.Is(err, usecaseErrors.ErrTooEarly), errors.Is(err, usecaseErrors.ErrAlreadyInRoom),
+		errors.Is(err, usecaseErrors.ErrRoomLocked):
+		// Accepted; the user joins later through the regular join flow
+		output.Participant, _ = s.participantRepo.FindByRoomAndUser(ctx, r.ID, userID)
+		return output, nil
+	case err != nil:
+		return nil, err
+	}
+
+	output.Room = joinedRoom
+	output.Participant = participant
+
+	if participant.Status == entities.ParticipantStatusJoined {
+		token, err := s.roomService.GenerateParticipantToken(ctx, joinedRoom, participant)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate token: %w", err)
+		}
+		output.LivekitToken = token
+	}
+
+	return output, nil
+}
+
+// decline validates and declines an invitation
+func (s *InvitationService) decline(ctx context.Context, invitation *entities.RoomInvitation, userID uuid.UUID, email string) error {
+	if err := s.checkRespondable(invitation, userID, email); err != nil {
+		return err
+	}
+
+	invitation.Decline(userID)
+	if err := s.invitationRepo.Update(ctx, invitation); err != nil {
+		return fmt.Errorf("failed to update invitation: %w", err)
+	}
+
+	log.Printf("[Invitation] User declined invitation: id=%s, room_id=%s, user=%s", invitation.ID, invitation.RoomID, userID)
+	return nil
+}
+
+// checkRespondable checks that a pending invitation may be answered by the user
+func (s *InvitationService) checkRespondable(invitation *entities.RoomInvitation, userID uuid.UUID, email string) error {
+	switch invitation.EffectiveStatus() {
+	case entities.InvitationStatusPending:
+	case entities.InvitationStatusExpired:
+		return usecaseErrors.ErrInvitationExpired
+	case entities.InvitationStatusRevoked:
+		return usecaseErrors.ErrInvitationRevoked
+	default:
+		return usecaseErrors.ErrInvitationResponded
+	}
+
+	if !invitation.IsFor(userID, email) {
+		return usecaseErrors.ErrInvitationNotForUser
+	}
+	return nil
+}
+
+// ensureInvitedParticipant creates (or resets) the participant record that lets the
+// invitee pass the private/scheduled room join checks
+func (s *InvitationService) ensureInvitedParticipant(ctx context.Context, r *entities.Room, invitation *entities.RoomInvitation, userID uuid.UUID) error {
+	participant, err := s.participantRepo.FindByRoomAndUser(ctx, r.ID, userID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return fmt.Errorf("failed to get participant: %w", err)
+	}
+
+	if participant != nil {
+		switch participant.Status {
+		case entities.ParticipantStatusLeft, entities.ParticipantStatusDeclined:
+			participant.Status = entities.ParticipantStatusInvited
+			participant.InvitedBy = &invitation.InviterID
+			participant.InvitedAt = &invitation.CreatedAt
+			if err := s.participantRepo.Update(ctx, participant); err != nil {
+				return fmt.Errorf("failed to update participant: %w", err)
+			}
+		case entities.ParticipantStatusDenied, entities.ParticipantStatusRemoved:
+			return usecaseErrors.ErrAccessDenied
+		}
+		return nil
+	}
+
+	participant = &entities.Participant{
+		RoomID:         r.ID,
+		UserID:         &userID,
+		Role:           entities.ParticipantRoleParticipant,
+		Status:         entities.ParticipantStatusInvited,
+		InvitedEmail:   invitation.InviteeEmail,
+		InvitedBy:      &invitation.InviterID,
+		InvitedAt:      &invitation.CreatedAt,
+		CanShareScreen: r.GetSettings().EnableScreenShare,
+	}
+	if err := s.participantRepo.Create(ctx, participant); err != nil {
+		return fmt.Errorf("failed to create participant: %w", err)
+	}
+	return nil
+}
+
+// findPendingForUser retrieves the pending invitation of a user to a room
+func (s *InvitationService) findPendingForUser(ctx context.Context, roomID, userID uuid.UUID, email string) (*entities.RoomInvitation, error) {
+	invitation, err := s.invitationRepo.FindPendingByRoomAndInvitee(ctx, roomID, userID, email)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, usecaseErrors.ErrInvitationNotFound
+		}
+		return nil, fmt.Errorf("failed to find invitation: %w", err)
+	}
+	return invitation, nil
+}
+
+// requireHost checks that the user may manage the invitations of the room (host or co-host)
+func (s *InvitationService) requireHost(ctx context.Context, roomID, userID uuid.UUID) error {
+	r, err := s.getRoom(ctx, roomID)
+	if err != nil {
+		return err
+	}
+	return s.roomService.Authorize(ctx, r, userID, entities.RoomActionInvite)
+}
+
+func (s *InvitationService) getRoom(ctx context.Context, roomID uuid.UUID) (*entities.Room, error) {
+	r, err := s.roomRepo.FindByID(ctx, roomID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, usecaseErrors.ErrRoomNotFound
+		}
+		return nil, fmt.Errorf("failed to get room: %w", err)
+	}
+	return r, nil
+}
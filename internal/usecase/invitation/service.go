@@ -0,0 +1,53 @@
+package invitation
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+)
+
+// Service defines the interface for room invitation use case
+type Service interface {
+	// CreateInvitation invites a user to a room by email (host only)
+	CreateInvitation(ctx context.Context, input CreateInvitationInput) (*entities.RoomInvitation, error)
+
+	// GetInvitationByToken retrieves an invitation by its token (with room and inviter)
+	GetInvitationByToken(ctx context.Context, token string) (*entities.RoomInvitation, error)
+
+	// AcceptInvitation accepts an invitation by token and joins the room when possible
+	AcceptInvitation(ctx context.Context, token string, userID uuid.UUID, email string) (*AcceptInvitationOutput, error)
+
+	// DeclineInvitation declines an invitation by token
+	DeclineInvitation(ctx context.Context, token string, userID uuid.UUID, email string) error
+
+	// AcceptRoomInvitation accepts the pending invitation of the current user to a room
+	AcceptRoomInvitation(ctx context.Context, roomID, userID uuid.UUID, email string) (*AcceptInvitationOutput, error)
+
+	// DeclineRoomInvitation declines the pending invitation of the current user to a room
+	DeclineRoomInvitation(ctx context.Context, roomID, userID uuid.UUID, email string) error
+
+	// RevokeInvitation revokes a pending invitation (host only)
+	RevokeInvitation(ctx context.Context, roomID, invitationID, hostID uuid.UUID) error
+
+	// ListRoomInvitations retrieves all invitations of a room (host only)
+	ListRoomInvitations(ctx context.Context, roomID, hostID uuid.UUID) ([]*entities.RoomInvitation, error)
+
+	// ListMyInvitations retrieves pending invitations addressed to the current user
+	ListMyInvitations(ctx context.Context, userID uuid.UUID, email string) ([]*entities.RoomInvitation, error)
+
+	// InvitationURL builds the shareable link of an invitation
+	InvitationURL(invitation *entities.RoomInvitation) string
+
+	// ExpireStale marks pending invitations past their expiry as expired
+	ExpireStale(ctx context.Context) (int64, error)
+
+	// StartWorker starts the background job that expires stale invitations
+	StartWorker(ctx context.Context) error
+
+	// StopWorker gracefully stops the background job
+	StopWorker() error
+}
+
+// Ensure InvitationService implements Service interface
+var _ Service = (*InvitationService)(nil)
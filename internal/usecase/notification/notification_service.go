@@ -0,0 +1,428 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+	"github.com/johnquangdev/meeting-assistant/internal/domain/repositories"
+	"github.com/johnquangdev/meeting-assistant/internal/infrastructure/external/mail"
+)
+
+// maxExcerptLength caps the executive summary quoted in the "summary ready" email
+const maxExcerptLength = 400
+
+// NotificationService renders and delivers notification emails in the recipient's language
+type NotificationService struct {
+	sender          mail.Sender
+	renderer        *mail.Renderer
+	userRepo        repositories.UserRepository
+	roomRepo        repositories.RoomRepository
+	participantRepo repositories.ParticipantRepository
+	invitationRepo  repositories.InvitationRepository
+	summaryRepo     repositories.AIRepository
+	frontendURL     string
+}
+
+// NewNotificationService creates a new notification service
+func NewNotificationService(
+	sender mail.Sender,
+	renderer *mail.Renderer,
+	userRepo repositories.UserRepository,
+	roomRepo repositories.RoomRepository,
+	participantRepo repositories.ParticipantRepository,
+	invitationRepo repositories.InvitationRepository,
+	summaryRepo repositories.AIRepository,
+	frontendURL string,
+) *NotificationService {
+	return &NotificationService{
+		sender:          sender,
+		renderer:        renderer,
+		userRepo:        userRepo,
+		roomRepo:        roomRepo,
+		participantRepo: participantRepo,
+		invitationRepo:  invitationRepo,
+		summaryRepo:     summaryRepo,
+		frontendURL:     strings.TrimRight(frontendURL, "/"),
+	}
+}
+
+// recipient is an email address with the locale used to render the message
+type recipient struct {
+	Email    string
+	Name     string
+	Language string
+	Timezone string
+}
+
+func recipientFromUser(u *entities.User) recipient {
+	return recipient{Email: u.Email, Name: u.Name, Language: u.Language, Timezone: u.Timezone}
+}
+
+// invitationData is the data of the invitation template
+type invitationData struct {
+	RecipientName string
+	InviterName   string
+	RoomName      string
+	Message       string
+	StartsAt      string
+	ExpiresAt     string
+	InviteURL     string
+}
+
+// reminderData is the data of the meeting reminder template
+type reminderData struct {
+	RecipientName string
+	RoomName      string
+	StartsAt      string
+	MinutesUntil  int
+	JoinURL       string
+}
+
+// summaryReadyData is the data of the summary ready template
+type summaryReadyData struct {
+	RecipientName string
+	RoomName      string
+	Excerpt       string
+	SummaryURL    string
+}
+
+// organizationInviteData is the data of the organization invite template
+type organizationInviteData struct {
+	RecipientName    string
+	InviterName      string
+	OrganizationName string
+	Role             string
+	ExpiresAt        string
+	InviteURL        string
+}
+
+// SendInvitation emails the invitation link to the invitee
+func (s *NotificationService) SendInvitation(ctx context.Context, invitation *entities.RoomInvitation, inviteURL string) error {
+	if invitation.InviteeEmail == nil || *invitation.InviteeEmail == "" {
+		return nil
+	}
+
+	r := invitation.Room
+	if r == nil {
+		var err error
+		if r, err = s.roomRepo.FindByID(ctx, invitation.RoomID); err != nil {
+			return fmt.Errorf("failed to get room: %w", err)
+		}
+	}
+
+	inviter := invitation.Inviter
+	if inviter == nil {
+		var err error
+		if inviter, err = s.userRepo.FindByID(ctx, invitation.InviterID); err != nil {
+			return fmt.Errorf("failed to get inviter: %w", err)
+		}
+	}
+
+	// Invitees without an account get the inviter's language and time zone
+	to := recipient{
+		Email:    *invitation.InviteeEmail,
+		Language: inviter.Language,
+		Timezone: inviter.Timezone,
+	}
+	if invitee, err := s.findInvitee(ctx, invitation); err != nil {
+		return err
+	} else if invitee != nil {
+		to = recipientFromUser(invitee)
+	}
+
+	data := invitationData{
+		RecipientName: to.Name,
+		InviterName:   inviter.Name,
+		RoomName:      r.Name,
+		ExpiresAt:     formatTime(invitation.ExpiresAt, to),
+		InviteURL:     inviteURL,
+	}
+	if invitation.Message != nil {
+		data.Message = *invitation.Message
+	}
+	if r.ScheduledStartTime != nil {
+		data.StartsAt = formatTime(*r.ScheduledStartTime, to)
+	}
+
+	return s.send(ctx, mail.TemplateInvitation, to, data)
+}
+
+// SendOrganizationInvite emails an organization invite link to the invitee
+func (s *NotificationService) SendOrganizationInvite(ctx context.Context, invite *entities.OrganizationInvite, inviteURL string) error {
+	if invite.Organization == nil {
+		return fmt.Errorf("organization of invite %s not loaded", invite.ID)
+	}
+
+	inviter := invite.Inviter
+	if inviter == nil {
+		var err error
+		if inviter, err = s.userRepo.FindByID(ctx, invite.InvitedBy); err != nil {
+			return fmt.Errorf("failed to get inviter: %w", err)
+		}
+	}
+
+	// Invitees without an account get the inviter's language and time zone
+	to := recipient{
+		Email:    invite.Email,
+		Language: inviter.Language,
+		Timezone: inviter.Timezone,
+	}
+	invitee, err := s.userRepo.FindByEmail(ctx, invite.Email)
+	if err != nil && !errors.Is(err, entities.ErrUserNotFound) {
+		return fmt.Errorf("failed to look up invitee: %w", err)
+	}
+	if invitee != nil {
+		to = recipientFromUser(invitee)
+	}
+
+	return s.send(ctx, mail.TemplateOrganizationInvite, to, organizationInviteData{
+		RecipientName:    to.Name,
+		InviterName:      inviter.Name,
+		OrganizationName: invite.Organization.Name,
+		Role:             string(invite.Role),
+		ExpiresAt:        formatTime(invite.ExpiresAt, to),
+		InviteURL:        inviteURL,
+	})
+}
+
+// SendMeetingReminder emails the host and invitees of a scheduled room before it starts
+func (s *NotificationService) SendMeetingReminder(ctx context.Context, r *entities.Room) error {
+	if r.ScheduledStartTime == nil {
+		return nil
+	}
+
+	recipients, err := s.reminderRecipients(ctx, r)
+	if err != nil {
+		return err
+	}
+
+	minutes := int(time.Until(*r.ScheduledStartTime).Round(time.Minute).Minutes())
+	if minutes < 0 {
+		minutes = 0
+	}
+
+	var errs []error
+	for _, to := range recipients {
+		data := reminderData{
+			RecipientName: to.Name,
+			RoomName:      r.Name,
+			StartsAt:      formatTime(*r.ScheduledStartTime, to),
+			MinutesUntil:  minutes,
+			JoinURL:       fmt.Sprintf("%s/rooms/%s", s.frontendURL, r.ID),
+		}
+		if err := s.send(ctx, mail.TemplateMeetingReminder, to, data); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	log.Printf("[Notification] ⏰ Meeting reminder sent: room=%s, recipients=%d, failed=%d", r.ID, len(recipients), len(errs))
+	return errors.Join(errs...)
+}
+
+// SendSummaryReady emails the host and attendees once the meeting summary is available
+func (s *NotificationService) SendSummaryReady(ctx context.Context, roomID uuid.UUID) error {
+	r, err := s.roomRepo.FindByID(ctx, roomID)
+	if err != nil {
+		return fmt.Errorf("failed to get room: %w", err)
+	}
+
+	excerpt := ""
+	summary, err := s.summaryRepo.GetMeetingSummaryByRoom(ctx, roomID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return fmt.Errorf("failed to get meeting summary: %w", err)
+	}
+	if summary != nil {
+		excerpt = truncate(summary.ExecutiveSummary, maxExcerptLength)
+	}
+
+	recipients, err := s.attendeeRecipients(ctx, r)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, to := range recipients {
+		data := summaryReadyData{
+			RecipientName: to.Name,
+			RoomName:      r.Name,
+			Excerpt:       excerpt,
+			SummaryURL:    fmt.Sprintf("%s/rooms/%s/summary", s.frontendURL, r.ID),
+		}
+		if err := s.send(ctx, mail.TemplateSummaryReady, to, data); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	log.Printf("[Notification] 📝 Summary ready sent: room=%s, recipients=%d, failed=%d", r.ID, len(recipients), len(errs))
+	return errors.Join(errs...)
+}
+
+// reminderRecipients returns the host, participants still expected and pending or accepted invitees
+func (s *NotificationService) reminderRecipients(ctx context.Context, r *entities.Room) ([]recipient, error) {
+	set := newRecipientSet()
+
+	if err := s.addHost(ctx, set, r); err != nil {
+		return nil, err
+	}
+
+	participants, err := s.participantRepo.FindByRoomID(ctx, r.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get participants: %w", err)
+	}
+	for _, p := range participants {
+		switch p.Status {
+		case entities.ParticipantStatusRemoved, entities.ParticipantStatusDeclined, entities.ParticipantStatusDenied:
+			continue
+		}
+		if p.User != nil {
+			set.add(recipientFromUser(p.User))
+		}
+	}
+
+	invitations, err := s.invitationRepo.FindByRoomID(ctx, r.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get invitations: %w", err)
+	}
+	for _, inv := range invitations {
+		status := inv.EffectiveStatus()
+		if status != entities.InvitationStatusPending && status != entities.InvitationStatusAccepted {
+			continue
+		}
+		invitee, err := s.findInvitee(ctx, inv)
+		if err != nil {
+			return nil, err
+		}
+		if invitee != nil {
+			set.add(recipientFromUser(invitee))
+		} else if inv.InviteeEmail != nil {
+			set.add(recipient{Email: *inv.InviteeEmail})
+		}
+	}
+
+	return set.list, nil
+}
+
+// attendeeRecipients returns the host and every participant who actually joined the meeting
+func (s *NotificationService) attendeeRecipients(ctx context.Context, r *entities.Room) ([]recipient, error) {
+	set := newRecipientSet()
+
+	if err := s.addHost(ctx, set, r); err != nil {
+		return nil, err
+	}
+
+	participants, err := s.participantRepo.FindByRoomID(ctx, r.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get participants: %w", err)
+	}
+	for _, p := range participants {
+		if p.JoinedAt != nil && p.User != nil {
+			set.add(recipientFromUser(p.User))
+		}
+	}
+
+	return set.list, nil
+}
+
+func (s *NotificationService) addHost(ctx context.Context, set *recipientSet, r *entities.Room) error {
+	host := r.Host
+	if host == nil {
+		var err error
+		if host, err = s.userRepo.FindByID(ctx, r.HostID); err != nil {
+			return fmt.Errorf("failed to get host: %w", err)
+		}
+	}
+	set.add(recipientFromUser(host))
+	return nil
+}
+
+// findInvitee returns the account an invitation is addressed to, or nil if the invitee has none
+func (s *NotificationService) findInvitee(ctx context.Context, invitation *entities.RoomInvitation) (*entities.User, error) {
+	var (
+		user *entities.User
+		err  error
+	)
+	switch {
+	case invitation.InviteeID != nil:
+		user, err = s.userRepo.FindByID(ctx, *invitation.InviteeID)
+	case invitation.InviteeEmail != nil:
+		user, err = s.userRepo.FindByEmail(ctx, *invitation.InviteeEmail)
+	default:
+		return nil, nil
+	}
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up invitee: %w", err)
+	}
+	return user, nil
+}
+
+// send renders a template for one recipient and delivers it
+func (s *NotificationService) send(ctx context.Context, name mail.Template, to recipient, data interface{}) error {
+	msg, err := s.renderer.Render(name, to.Language, data)
+	if err != nil {
+		return err
+	}
+	msg.To = []string{to.Email}
+
+	if err := s.sender.Send(ctx, msg); err != nil {
+		return fmt.Errorf("failed to send %s email to %s: %w", name, to.Email, err)
+	}
+	return nil
+}
+
+// recipientSet keeps recipients unique by email, preserving insertion order
+type recipientSet struct {
+	seen map[string]struct{}
+	list []recipient
+}
+
+func newRecipientSet() *recipientSet {
+	return &recipientSet{seen: make(map[string]struct{})}
+}
+
+func (rs *recipientSet) add(r recipient) {
+	key := strings.ToLower(strings.TrimSpace(r.Email))
+	if key == "" {
+		return
+	}
+	if _, ok := rs.seen[key]; ok {
+		return
+	}
+	rs.seen[key] = struct{}{}
+	rs.list = append(rs.list, r)
+}
+
+// formatTime formats t in the recipient's time zone using a layout familiar in their language
+func formatTime(t time.Time, to recipient) string {
+	loc, err := time.LoadLocation(to.Timezone)
+	if err != nil || to.Timezone == "" {
+		loc = time.UTC
+	}
+
+	layout := "Mon, 02 Jan 2006 15:04 MST"
+	if mail.NormalizeLanguage(to.Language) == "vi" {
+		layout = "15:04 02/01/2006 (MST)"
+	}
+	return t.In(loc).Format(layout)
+}
+
+func truncate(s string, max int) string {
+	s = strings.TrimSpace(s)
+	if utf8.RuneCountInString(s) <= max {
+		return s
+	}
+	runes := []rune(s)
+	return strings.TrimSpace(string(runes[:max])) + "…"
+}
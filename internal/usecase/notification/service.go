@@ -0,0 +1,26 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+)
+
+// Service defines the interface for email notifications
+type Service interface {
+	// SendInvitation emails the invitation link to the invitee
+	SendInvitation(ctx context.Context, invitation *entities.RoomInvitation, inviteURL string) error
+
+	// SendMeetingReminder emails the host and invitees of a scheduled room before it starts
+	SendMeetingReminder(ctx context.Context, room *entities.Room) error
+
+	// SendSummaryReady emails the host and attendees once the meeting summary is available
+	SendSummaryReady(ctx context.Context, roomID uuid.UUID) error
+
+	// SendOrganizationInvite emails an organization invite link to the invitee
+	SendOrganizationInvite(ctx context.Context, invite *entities.OrganizationInvite, inviteURL string) error
+}
+
+// Ensure NotificationService implements Service interface
+var _ Service = (*NotificationService)(nil)
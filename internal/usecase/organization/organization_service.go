@@ -0,0 +1,497 @@
+package organization
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+	"github.com/johnquangdev/meeting-assistant/internal/domain/repositories"
+	usecaseErrors "github.com/johnquangdev/meeting-assistant/internal/usecase/errors"
+	"github.com/johnquangdev/meeting-assistant/internal/usecase/notification"
+	"github.com/johnquangdev/meeting-assistant/pkg/config"
+)
+
+// OrganizationService handles organizations, their members and invites
+type OrganizationService struct {
+	orgRepo     repositories.OrganizationRepository
+	notifier    notification.Service
+	inviteTTL   time.Duration
+	frontendURL string
+}
+
+// NewOrganizationService creates a new organization service
+func NewOrganizationService(
+	orgRepo repositories.OrganizationRepository,
+	notifier notification.Service,
+	appConfig *config.Config,
+) *OrganizationService {
+	inviteTTL := appConfig.Invitation.DefaultTTL
+	if inviteTTL <= 0 {
+		inviteTTL = 7 * 24 * time.Hour
+	}
+
+	return &OrganizationService{
+		orgRepo:     orgRepo,
+		notifier:    notifier,
+		inviteTTL:   inviteTTL,
+		frontendURL: strings.TrimRight(appConfig.Server.FrontendURL, "/"),
+	}
+}
+
+// CreateOrganizationInput represents input for creating an organization
+type CreateOrganizationInput struct {
+	Name      string
+	CreatedBy uuid.UUID
+	Settings  map[string]interface{} // Partial settings on top of the defaults
+}
+
+// UpdateOrganizationInput represents a change to an organization
+type UpdateOrganizationInput struct {
+	OrganizationID uuid.UUID
+	UserID         uuid.UUID
+	Name           *string
+	Settings       map[string]interface{} // Partial settings update (room_defaults is replaced as a whole)
+}
+
+// CreateInviteInput represents input for inviting someone to an organization
+type CreateInviteInput struct {
+	OrganizationID uuid.UUID
+	InviterID      uuid.UUID
+	Email          string
+	Role           entities.OrgRole // Admin or member
+}
+
+// CreateOrganization creates an organization owned by its creator
+func (s *OrganizationService) CreateOrganization(ctx context.Context, input CreateOrganizationInput) (*entities.Organization, error) {
+	settings, err := entities.DefaultOrganizationSettings().Merge(input.Settings)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", usecaseErrors.ErrInvalidOrgSettings, err)
+	}
+
+	slug, err := s.uniqueSlug(ctx, input.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	org := &entities.Organization{
+		Name:      strings.TrimSpace(input.Name),
+		Slug:      slug,
+		CreatedBy: input.CreatedBy,
+	}
+	if err := org.SetSettings(settings); err != nil {
+		return nil, err
+	}
+
+	owner := &entities.OrganizationMember{
+		UserID:   input.CreatedBy,
+		Role:     entities.OrgRoleOwner,
+		JoinedAt: time.Now(),
+	}
+	if err := s.orgRepo.Create(ctx, org, owner); err != nil {
+		return nil, fmt.Errorf("failed to create organization: %w", err)
+	}
+
+	log.Printf("[Organization] 🏢 Organization created: id=%s, slug=%s, owner=%s", org.ID, org.Slug, input.CreatedBy)
+
+	return org, nil
+}
+
+// GetOrganization retrieves an organization and the membership of the current user (members only)
+func (s *OrganizationService) GetOrganization(ctx context.Context, orgID, userID uuid.UUID) (*entities.Organization, *entities.OrganizationMember, error) {
+	member, err := s.requireMember(ctx, orgID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return member.Organization, member, nil
+}
+
+// ListMyOrganizations retrieves the memberships (with organizations) of the current user
+func (s *OrganizationService) ListMyOrganizations(ctx context.Context, userID uuid.UUID) ([]*entities.OrganizationMember, error) {
+	members, err := s.orgRepo.ListMemberships(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organizations: %w", err)
+	}
+	return members, nil
+}
+
+// UpdateOrganization renames an organization and/or applies a partial settings update (owners and admins)
+func (s *OrganizationService) UpdateOrganization(ctx context.Context, input UpdateOrganizationInput) (*entities.Organization, error) {
+	member, err := s.requireAdmin(ctx, input.OrganizationID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	org := member.Organization
+
+	if input.Name != nil {
+		org.Name = strings.TrimSpace(*input.Name)
+	}
+	if input.Settings != nil {
+		settings, err := org.GetSettings().Merge(input.Settings)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", usecaseErrors.ErrInvalidOrgSettings, err)
+		}
+		if err := org.SetSettings(settings); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.orgRepo.Update(ctx, org); err != nil {
+		return nil, fmt.Errorf("failed to update organization: %w", err)
+	}
+
+	log.Printf("[Organization] ⚙️ Organization updated: id=%s, by=%s", org.ID, input.UserID)
+
+	return org, nil
+}
+
+// DeleteOrganization deletes an organization with all its rooms (owners only)
+func (s *OrganizationService) DeleteOrganization(ctx context.Context, orgID, userID uuid.UUID) error {
+	member, err := s.requireMember(ctx, orgID, userID)
+	if err != nil {
+		return err
+	}
+	if member.Role != entities.OrgRoleOwner {
+		return usecaseErrors.ErrNotOrganizationOwner
+	}
+
+	if err := s.orgRepo.Delete(ctx, orgID); err != nil {
+		return fmt.Errorf("failed to delete organization: %w", err)
+	}
+
+	log.Printf("[Organization] 🗑️ Organization deleted: id=%s, by=%s", orgID, userID)
+
+	return nil
+}
+
+// ListMembers retrieves the members of an organization (members only)
+func (s *OrganizationService) ListMembers(ctx context.Context, orgID, userID uuid.UUID) ([]*entities.OrganizationMember, error) {
+	if _, err := s.requireMember(ctx, orgID, userID); err != nil {
+		return nil, err
+	}
+
+	members, err := s.orgRepo.ListMembers(ctx, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list members: %w", err)
+	}
+	return members, nil
+}
+
+// UpdateMemberRole changes the role of a member (owners and admins; owners only for the owner role)
+func (s *OrganizationService) UpdateMemberRole(ctx context.Context, orgID, userID, memberUserID uuid.UUID, role entities.OrgRole) (*entities.OrganizationMember, error) {
+	if !role.IsValid() {
+		return nil, usecaseErrors.ErrInvalidOrganizationRole
+	}
+
+	actor, err := s.requireAdmin(ctx, orgID, userID)
+	if err != nil {
+		return nil, err
+	}
+	member, err := s.targetMember(ctx, orgID, memberUserID)
+	if err != nil {
+		return nil, err
+	}
+	if member.Role == role {
+		return member, nil
+	}
+
+	// Only owners make or unmake owners
+	if (role == entities.OrgRoleOwner || member.Role == entities.OrgRoleOwner) && actor.Role != entities.OrgRoleOwner {
+		return nil, usecaseErrors.ErrNotOrganizationOwner
+	}
+	if member.Role == entities.OrgRoleOwner {
+		if err := s.keepOwner(ctx, orgID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.orgRepo.UpdateMemberRole(ctx, member.ID, role); err != nil {
+		return nil, fmt.Errorf("failed to update member role: %w", err)
+	}
+	member.Role = role
+
+	log.Printf("[Organization] 👤 Member role changed: org=%s, user=%s, role=%s, by=%s", orgID, memberUserID, role, userID)
+
+	return member, nil
+}
+
+// RemoveMember removes a member (owners and admins), or lets the current user leave
+func (s *OrganizationService) RemoveMember(ctx context.Context, orgID, userID, memberUserID uuid.UUID) error {
+	var actor *entities.OrganizationMember
+	if memberUserID != userID {
+		var err error
+		if actor, err = s.requireAdmin(ctx, orgID, userID); err != nil {
+			return err
+		}
+	}
+
+	member, err := s.targetMember(ctx, orgID, memberUserID)
+	if err != nil {
+		if actor == nil && errors.Is(err, usecaseErrors.ErrOrgMemberNotFound) {
+			return usecaseErrors.ErrOrganizationNotFound
+		}
+		return err
+	}
+	if actor != nil && member.Role == entities.OrgRoleOwner && actor.Role != entities.OrgRoleOwner {
+		return usecaseErrors.ErrNotOrganizationOwner
+	}
+	if member.Role == entities.OrgRoleOwner {
+		if err := s.keepOwner(ctx, orgID); err != nil {
+			return err
+		}
+	}
+
+	if err := s.orgRepo.RemoveMember(ctx, member.ID); err != nil {
+		return fmt.Errorf("failed to remove member: %w", err)
+	}
+
+	log.Printf("[Organization] 👋 Member removed: org=%s, user=%s, by=%s", orgID, memberUserID, userID)
+
+	return nil
+}
+
+// CreateInvite invites someone to an organization by email (owners and admins)
+func (s *OrganizationService) CreateInvite(ctx context.Context, input CreateInviteInput) (*entities.OrganizationInvite, error) {
+	email := strings.ToLower(strings.TrimSpace(input.Email))
+	role := input.Role
+	if role == "" {
+		role = entities.OrgRoleMember
+	}
+	if role != entities.OrgRoleAdmin && role != entities.OrgRoleMember {
+		return nil, usecaseErrors.ErrInvalidOrganizationRole
+	}
+
+	actor, err := s.requireAdmin(ctx, input.OrganizationID, input.InviterID)
+	if err != nil {
+		return nil, err
+	}
+
+	// A pending invite is returned as is (idempotent for network lag)
+	existing, err := s.orgRepo.FindPendingInvite(ctx, input.OrganizationID, email)
+	if err == nil && existing != nil {
+		existing.Organization = actor.Organization
+		return existing, nil
+	}
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("failed to check existing invites: %w", err)
+	}
+
+	token, err := entities.NewInvitationToken()
+	if err != nil {
+		return nil, err
+	}
+
+	invite := &entities.OrganizationInvite{
+		OrganizationID: input.OrganizationID,
+		Email:          email,
+		Role:           role,
+		Token:          token,
+		Status:         entities.InvitationStatusPending,
+		InvitedBy:      input.InviterID,
+		ExpiresAt:      time.Now().Add(s.inviteTTL),
+	}
+	if err := s.orgRepo.CreateInvite(ctx, invite); err != nil {
+		return nil, fmt.Errorf("failed to create invite: %w", err)
+	}
+	invite.Organization = actor.Organization
+
+	log.Printf("[Organization] ✉️ Invite created: org=%s, email=%s, role=%s, inviter=%s", input.OrganizationID, email, role, input.InviterID)
+
+	s.sendInviteEmail(ctx, invite)
+
+	return invite, nil
+}
+
+// sendInviteEmail emails the invite link in the background so SMTP latency never blocks the request
+func (s *OrganizationService) sendInviteEmail(ctx context.Context, invite *entities.OrganizationInvite) {
+	if s.notifier == nil {
+		return
+	}
+
+	inviteURL := s.InviteURL(invite)
+	go func() {
+		if err := s.notifier.SendOrganizationInvite(context.WithoutCancel(ctx), invite, inviteURL); err != nil {
+			log.Printf("[Organization] ⚠️  Failed to email invite %s: %v", invite.ID, err)
+		}
+	}()
+}
+
+// ListInvites retrieves the invites of an organization (owners and admins)
+func (s *OrganizationService) ListInvites(ctx context.Context, orgID, userID uuid.UUID) ([]*entities.OrganizationInvite, error) {
+	if _, err := s.requireAdmin(ctx, orgID, userID); err != nil {
+		return nil, err
+	}
+
+	invites, err := s.orgRepo.ListInvites(ctx, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list invites: %w", err)
+	}
+	return invites, nil
+}
+
+// RevokeInvite revokes a pending invite (owners and admins)
+func (s *OrganizationService) RevokeInvite(ctx context.Context, orgID, inviteID, userID uuid.UUID) error {
+	if _, err := s.requireAdmin(ctx, orgID, userID); err != nil {
+		return err
+	}
+
+	invite, err := s.orgRepo.FindInviteByID(ctx, orgID, inviteID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return usecaseErrors.ErrOrgInviteNotFound
+		}
+		return fmt.Errorf("failed to get invite: %w", err)
+	}
+	if !invite.IsPending() {
+		return usecaseErrors.ErrOrgInviteNotPending
+	}
+
+	invite.Revoke()
+	if err := s.orgRepo.UpdateInvite(ctx, invite); err != nil {
+		return fmt.Errorf("failed to revoke invite: %w", err)
+	}
+
+	log.Printf("[Organization] 🚫 Invite revoked: org=%s, invite=%s, by=%s", orgID, inviteID, userID)
+
+	return nil
+}
+
+// GetInviteByToken retrieves an invite by its token (with organization and inviter)
+func (s *OrganizationService) GetInviteByToken(ctx context.Context, token string) (*entities.OrganizationInvite, error) {
+	invite, err := s.orgRepo.FindInviteByToken(ctx, token)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, usecaseErrors.ErrOrgInviteNotFound
+		}
+		return nil, fmt.Errorf("failed to get invite: %w", err)
+	}
+	return invite, nil
+}
+
+// AcceptInvite makes the current user a member of the organization of an invite sent to their email
+func (s *OrganizationService) AcceptInvite(ctx context.Context, token string, userID uuid.UUID, email string) (*entities.OrganizationMember, error) {
+	invite, err := s.GetInviteByToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if !invite.IsPending() {
+		return nil, usecaseErrors.ErrOrgInviteNotPending
+	}
+	if !invite.IsFor(email) {
+		return nil, usecaseErrors.ErrOrgInviteWrongRecipient
+	}
+
+	if existing, err := s.getMember(ctx, invite.OrganizationID, userID); err == nil {
+		// Accepting twice keeps the membership (and role) the user already has
+		invite.Accept()
+		if err := s.orgRepo.UpdateInvite(ctx, invite); err != nil {
+			return nil, fmt.Errorf("failed to accept invite: %w", err)
+		}
+		return existing, nil
+	} else if !errors.Is(err, usecaseErrors.ErrNotOrganizationMember) {
+		return nil, err
+	}
+
+	member := &entities.OrganizationMember{
+		OrganizationID: invite.OrganizationID,
+		UserID:         userID,
+		Role:           invite.Role,
+		JoinedAt:       time.Now(),
+	}
+	if err := s.orgRepo.AddMember(ctx, member); err != nil {
+		return nil, fmt.Errorf("failed to add member: %w", err)
+	}
+	member.Organization = invite.Organization
+
+	invite.Accept()
+	if err := s.orgRepo.UpdateInvite(ctx, invite); err != nil {
+		return nil, fmt.Errorf("failed to accept invite: %w", err)
+	}
+
+	log.Printf("[Organization] ✅ Invite accepted: org=%s, user=%s, role=%s", invite.OrganizationID, userID, invite.Role)
+
+	return member, nil
+}
+
+// InviteURL builds the shareable link of an invite
+func (s *OrganizationService) InviteURL(invite *entities.OrganizationInvite) string {
+	return fmt.Sprintf("%s/organizations/invites/%s", s.frontendURL, invite.Token)
+}
+
+// getMember retrieves the membership (with organization) of a user
+func (s *OrganizationService) getMember(ctx context.Context, orgID, userID uuid.UUID) (*entities.OrganizationMember, error) {
+	member, err := s.orgRepo.FindMember(ctx, orgID, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, usecaseErrors.ErrNotOrganizationMember
+		}
+		return nil, fmt.Errorf("failed to get organization member: %w", err)
+	}
+	return member, nil
+}
+
+// targetMember retrieves the member an owner or admin acts on
+func (s *OrganizationService) targetMember(ctx context.Context, orgID, userID uuid.UUID) (*entities.OrganizationMember, error) {
+	member, err := s.getMember(ctx, orgID, userID)
+	if errors.Is(err, usecaseErrors.ErrNotOrganizationMember) {
+		return nil, usecaseErrors.ErrOrgMemberNotFound
+	}
+	return member, err
+}
+
+// requireMember checks that a user belongs to an organization. Outsiders cannot tell
+// an organization they do not belong to from one that does not exist.
+func (s *OrganizationService) requireMember(ctx context.Context, orgID, userID uuid.UUID) (*entities.OrganizationMember, error) {
+	member, err := s.getMember(ctx, orgID, userID)
+	if errors.Is(err, usecaseErrors.ErrNotOrganizationMember) {
+		return nil, usecaseErrors.ErrOrganizationNotFound
+	}
+	return member, err
+}
+
+// requireAdmin checks that a user is an owner or admin of an organization
+func (s *OrganizationService) requireAdmin(ctx context.Context, orgID, userID uuid.UUID) (*entities.OrganizationMember, error) {
+	member, err := s.requireMember(ctx, orgID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !member.Role.CanManage() {
+		return nil, usecaseErrors.ErrNotOrganizationAdmin
+	}
+	return member, nil
+}
+
+// keepOwner refuses to demote or remove the last owner of an organization
+func (s *OrganizationService) keepOwner(ctx context.Context, orgID uuid.UUID) error {
+	owners, err := s.orgRepo.CountMembersWithRole(ctx, orgID, entities.OrgRoleOwner)
+	if err != nil {
+		return fmt.Errorf("failed to count owners: %w", err)
+	}
+	if owners <= 1 {
+		return usecaseErrors.ErrLastOrganizationOwner
+	}
+	return nil
+}
+
+// uniqueSlug derives a slug from the organization name that no other organization uses
+func (s *OrganizationService) uniqueSlug(ctx context.Context, name string) (string, error) {
+	base := entities.NewOrganizationSlug(name)
+	slug := base
+	for attempt := 0; attempt < 5; attempt++ {
+		_, err := s.orgRepo.FindBySlug(ctx, slug)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return slug, nil
+		}
+		if err != nil {
+			return "", fmt.Errorf("failed to check organization slug: %w", err)
+		}
+		slug = fmt.Sprintf("%s-%s", base, uuid.NewString()[:6])
+	}
+	return "", fmt.Errorf("failed to find a free organization slug for %q", name)
+}
@@ -0,0 +1,56 @@
+package organization
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+)
+
+// Service defines the interface for organization use case
+type Service interface {
+	// CreateOrganization creates an organization owned by its creator
+	CreateOrganization(ctx context.Context, input CreateOrganizationInput) (*entities.Organization, error)
+
+	// GetOrganization retrieves an organization and the membership of the current user (members only)
+	GetOrganization(ctx context.Context, orgID, userID uuid.UUID) (*entities.Organization, *entities.OrganizationMember, error)
+
+	// ListMyOrganizations retrieves the memberships (with organizations) of the current user
+	ListMyOrganizations(ctx context.Context, userID uuid.UUID) ([]*entities.OrganizationMember, error)
+
+	// UpdateOrganization renames an organization and/or applies a partial settings update (owners and admins)
+	UpdateOrganization(ctx context.Context, input UpdateOrganizationInput) (*entities.Organization, error)
+
+	// DeleteOrganization deletes an organization with all its rooms (owners only)
+	DeleteOrganization(ctx context.Context, orgID, userID uuid.UUID) error
+
+	// ListMembers retrieves the members of an organization (members only)
+	ListMembers(ctx context.Context, orgID, userID uuid.UUID) ([]*entities.OrganizationMember, error)
+
+	// UpdateMemberRole changes the role of a member (owners and admins; owners only for the owner role)
+	UpdateMemberRole(ctx context.Context, orgID, userID, memberUserID uuid.UUID, role entities.OrgRole) (*entities.OrganizationMember, error)
+
+	// RemoveMember removes a member (owners and admins), or lets the current user leave
+	RemoveMember(ctx context.Context, orgID, userID, memberUserID uuid.UUID) error
+
+	// CreateInvite invites someone to an organization by email (owners and admins)
+	CreateInvite(ctx context.Context, input CreateInviteInput) (*entities.OrganizationInvite, error)
+
+	// ListInvites retrieves the invites of an organization (owners and admins)
+	ListInvites(ctx context.Context, orgID, userID uuid.UUID) ([]*entities.OrganizationInvite, error)
+
+	// RevokeInvite revokes a pending invite (owners and admins)
+	RevokeInvite(ctx context.Context, orgID, inviteID, userID uuid.UUID) error
+
+	// GetInviteByToken retrieves an invite by its token (with organization and inviter)
+	GetInviteByToken(ctx context.Context, token string) (*entities.OrganizationInvite, error)
+
+	// AcceptInvite makes the current user a member of the organization of an invite sent to their email
+	AcceptInvite(ctx context.Context, token string, userID uuid.UUID, email string) (*entities.OrganizationMember, error)
+
+	// InviteURL builds the shareable link of an invite
+	InviteURL(invite *entities.OrganizationInvite) string
+}
+
+// Ensure OrganizationService implements Service interface
+var _ Service = (*OrganizationService)(nil)
@@ -0,0 +1,237 @@
+package quota
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+	"github.com/johnquangdev/meeting-assistant/internal/domain/repositories"
+	usecaseErrors "github.com/johnquangdev/meeting-assistant/internal/usecase/errors"
+)
+
+// QuotaService meters usage and enforces the limits of plans
+type QuotaService struct {
+	planRepo repositories.PlanRepository
+	orgRepo  repositories.OrganizationRepository
+}
+
+// NewQuotaService creates a new quota service
+func NewQuotaService(planRepo repositories.PlanRepository, orgRepo repositories.OrganizationRepository) *QuotaService {
+	return &QuotaService{
+		planRepo: planRepo,
+		orgRepo:  orgRepo,
+	}
+}
+
+// Report is the plan of a user or organization with what it used
+type Report struct {
+	Plan  *entities.Plan // nil when no plan applies (no limits)
+	Usage *entities.Usage
+}
+
+// CheckRoomCreation checks that a room may be created with a participant cap (and automatic recording)
+func (s *QuotaService) CheckRoomCreation(ctx context.Context, hostID uuid.UUID, orgID *uuid.UUID, maxParticipants int, autoRecord bool) error {
+	scope := repositories.UsageScope{OrganizationID: orgID, HostID: hostID}
+	plan, err := s.plan(ctx, scope)
+	if err != nil || plan == nil {
+		return err
+	}
+
+	if maxParticipants > plan.MaxParticipants {
+		return fmt.Errorf("%w (%d)", usecaseErrors.ErrParticipantLimitExceeded, plan.MaxParticipants)
+	}
+
+	usage, err := s.usage(ctx, scope)
+	if err != nil {
+		return err
+	}
+	if plan.MeetingMinutesExhausted(usage) {
+		return s.reject(scope, plan, usecaseErrors.ErrMeetingMinutesExhausted)
+	}
+	if autoRecord && plan.RecordingStorageFull(usage) {
+		return s.reject(scope, plan, usecaseErrors.ErrRecordingStorageFull)
+	}
+	return nil
+}
+
+// CheckRoomJoin checks that someone may join a room; starting tells that the join starts the meeting
+func (s *QuotaService) CheckRoomJoin(ctx context.Context, room *entities.Room, starting bool) error {
+	scope := roomScope(room)
+	plan, err := s.plan(ctx, scope)
+	if err != nil || plan == nil {
+		return err
+	}
+
+	usage, err := s.usage(ctx, scope)
+	if err != nil {
+		return err
+	}
+	if plan.MeetingMinutesExhausted(usage) {
+		return s.reject(scope, plan, usecaseErrors.ErrMeetingMinutesExhausted)
+	}
+	// Breakout rooms run alongside their meeting and do not take another slot
+	if starting && !room.IsBreakout() && plan.ConcurrentRoomsReached(usage) {
+		return s.reject(scope, plan, usecaseErrors.ErrConcurrentRoomLimitReached)
+	}
+	return nil
+}
+
+// CheckRecording checks that a room may record, given the recording storage already used
+func (s *QuotaService) CheckRecording(ctx context.Context, room *entities.Room) error {
+	scope := roomScope(room)
+	plan, err := s.plan(ctx, scope)
+	if err != nil || plan == nil {
+		return err
+	}
+
+	usage, err := s.usage(ctx, scope)
+	if err != nil {
+		return err
+	}
+	if plan.RecordingStorageFull(usage) {
+		return s.reject(scope, plan, usecaseErrors.ErrRecordingStorageFull)
+	}
+	return nil
+}
+
+// CheckAIProcessing checks that the recording of a meeting may be transcribed and summarized
+func (s *QuotaService) CheckAIProcessing(ctx context.Context, room *entities.Room) error {
+	scope := roomScope(room)
+	plan, err := s.plan(ctx, scope)
+	if err != nil || plan == nil {
+		return err
+	}
+
+	usage, err := s.usage(ctx, scope)
+	if err != nil {
+		return err
+	}
+	if plan.AIMinutesExhausted(usage) {
+		return s.reject(scope, plan, usecaseErrors.ErrAIMinutesExhausted)
+	}
+	return nil
+}
+
+// GetUsage reports the plan and usage of an organization (members only), or of the personal rooms of the user
+func (s *QuotaService) GetUsage(ctx context.Context, userID uuid.UUID, orgID *uuid.UUID) (*Report, error) {
+	if orgID != nil {
+		if _, err := s.orgRepo.FindMember(ctx, *orgID, userID); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, usecaseErrors.ErrNotOrganizationMember
+			}
+			return nil, fmt.Errorf("failed to get organization member: %w", err)
+		}
+	}
+
+	scope := repositories.UsageScope{OrganizationID: orgID, HostID: userID}
+	plan, err := s.plan(ctx, scope)
+	if err != nil {
+		return nil, err
+	}
+	usage, err := s.usage(ctx, scope)
+	if err != nil {
+		return nil, err
+	}
+	return &Report{Plan: plan, Usage: usage}, nil
+}
+
+// ListPlans retrieves all plans
+func (s *QuotaService) ListPlans(ctx context.Context) ([]*entities.Plan, error) {
+	plans, err := s.planRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list plans: %w", err)
+	}
+	return plans, nil
+}
+
+// AssignUserPlan assigns a plan to a user by code (empty = default plan)
+func (s *QuotaService) AssignUserPlan(ctx context.Context, userID uuid.UUID, code string) error {
+	planID, err := s.planID(ctx, code)
+	if err != nil {
+		return err
+	}
+	if err := s.planRepo.SetUserPlan(ctx, userID, planID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return usecaseErrors.ErrUserNotFound
+		}
+		return fmt.Errorf("failed to assign plan: %w", err)
+	}
+	return nil
+}
+
+// AssignOrganizationPlan assigns a plan to an organization by code (empty = default plan)
+func (s *QuotaService) AssignOrganizationPlan(ctx context.Context, orgID uuid.UUID, code string) error {
+	planID, err := s.planID(ctx, code)
+	if err != nil {
+		return err
+	}
+	if err := s.planRepo.SetOrganizationPlan(ctx, orgID, planID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return usecaseErrors.ErrOrganizationNotFound
+		}
+		return fmt.Errorf("failed to assign plan: %w", err)
+	}
+	return nil
+}
+
+// planID resolves a plan code (empty = default plan, nil)
+func (s *QuotaService) planID(ctx context.Context, code string) (*uuid.UUID, error) {
+	if code == "" {
+		return nil, nil
+	}
+	plan, err := s.planRepo.FindByCode(ctx, code)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, usecaseErrors.ErrPlanNotFound
+		}
+		return nil, fmt.Errorf("failed to get plan: %w", err)
+	}
+	return &plan.ID, nil
+}
+
+// plan retrieves the plan applying to a scope (nil = no limits)
+func (s *QuotaService) plan(ctx context.Context, scope repositories.UsageScope) (*entities.Plan, error) {
+	var (
+		plan *entities.Plan
+		err  error
+	)
+	if scope.OrganizationID != nil {
+		plan, err = s.planRepo.FindOrganizationPlan(ctx, *scope.OrganizationID)
+	} else {
+		plan, err = s.planRepo.FindUserPlan(ctx, scope.HostID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get plan: %w", err)
+	}
+	return plan, nil
+}
+
+// usage meters a scope for the current month
+func (s *QuotaService) usage(ctx context.Context, scope repositories.UsageScope) (*entities.Usage, error) {
+	usage, err := s.planRepo.GetUsage(ctx, scope, entities.UsagePeriodStart(time.Now()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to meter usage: %w", err)
+	}
+	return usage, nil
+}
+
+// reject logs a quota rejection and returns its error
+func (s *QuotaService) reject(scope repositories.UsageScope, plan *entities.Plan, err error) error {
+	if scope.OrganizationID != nil {
+		log.Printf("[Quota] ⛔ Organization %s (plan=%s): %v", *scope.OrganizationID, plan.Code, err)
+	} else {
+		log.Printf("[Quota] ⛔ User %s (plan=%s): %v", scope.HostID, plan.Code, err)
+	}
+	return err
+}
+
+// roomScope selects the rooms a room's usage counts against
+func roomScope(room *entities.Room) repositories.UsageScope {
+	return repositories.UsageScope{OrganizationID: room.OrganizationID, HostID: room.HostID}
+}
@@ -0,0 +1,39 @@
+package quota
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+)
+
+// Service defines the interface for plans and usage quotas.
+// Organization rooms count against the plan of the organization, personal rooms against the plan of their host.
+type Service interface {
+	// CheckRoomCreation checks that a room may be created with a participant cap (and automatic recording)
+	CheckRoomCreation(ctx context.Context, hostID uuid.UUID, orgID *uuid.UUID, maxParticipants int, autoRecord bool) error
+
+	// CheckRoomJoin checks that someone may join a room; starting tells that the join starts the meeting
+	CheckRoomJoin(ctx context.Context, room *entities.Room, starting bool) error
+
+	// CheckRecording checks that a room may record, given the recording storage already used
+	CheckRecording(ctx context.Context, room *entities.Room) error
+
+	// CheckAIProcessing checks that the recording of a meeting may be transcribed and summarized
+	CheckAIProcessing(ctx context.Context, room *entities.Room) error
+
+	// GetUsage reports the plan and usage of an organization (members only), or of the personal rooms of the user
+	GetUsage(ctx context.Context, userID uuid.UUID, orgID *uuid.UUID) (*Report, error)
+
+	// ListPlans retrieves all plans
+	ListPlans(ctx context.Context) ([]*entities.Plan, error)
+
+	// AssignUserPlan assigns a plan to a user by code (empty = default plan)
+	AssignUserPlan(ctx context.Context, userID uuid.UUID, code string) error
+
+	// AssignOrganizationPlan assigns a plan to an organization by code (empty = default plan)
+	AssignOrganizationPlan(ctx context.Context, orgID uuid.UUID, code string) error
+}
+
+// Ensure QuotaService implements Service interface
+var _ Service = (*QuotaService)(nil)
@@ -0,0 +1,331 @@
+package reconciler
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+	"github.com/johnquangdev/meeting-assistant/internal/domain/repositories"
+	lkpkg "github.com/johnquangdev/meeting-assistant/internal/infrastructure/external/livekit"
+	"github.com/johnquangdev/meeting-assistant/internal/usecase/room"
+	"github.com/johnquangdev/meeting-assistant/pkg/config"
+)
+
+// Correction is a kind of fix applied when the database disagrees with LiveKit
+type Correction string
+
+const (
+	CorrectionParticipantLeft       Correction = "participant_left"       // Joined in the database, gone from LiveKit
+	CorrectionParticipantJoined     Correction = "participant_joined"     // Connected to LiveKit, not joined in the database
+	CorrectionParticipantKicked     Correction = "participant_kicked"     // Removed, blocked or never admitted in the database, still connected to LiveKit
+	CorrectionParticipantWaitlisted Correction = "participant_waitlisted" // Connected to LiveKit while the room is full
+	CorrectionRoomEnded             Correction = "room_ended"             // Active in the database, gone from LiveKit
+)
+
+// Result sums up a reconciliation pass
+type Result struct {
+	Rooms       int                // Active rooms checked
+	Corrections map[Correction]int // Fixes applied, by kind
+}
+
+// Reconciler repairs the state of active rooms when LiveKit webhooks (participant_joined,
+// participant_left, room_finished) are lost: it compares every active room with what LiveKit
+// reports and brings participant statuses, LeftAt/Duration and room status back in line.
+type Reconciler struct {
+	roomRepo        repositories.RoomRepository
+	participantRepo repositories.ParticipantRepository
+	roomService     room.Service
+	livekitClient   lkpkg.Client
+	cfg             config.ReconcilerConfig
+
+	workerStopChan  chan struct{}
+	workerWg        sync.WaitGroup
+	isWorkerRunning bool
+	workerMutex     sync.Mutex
+}
+
+// NewReconciler creates a new LiveKit reconciler
+func NewReconciler(
+	roomRepo repositories.RoomRepository,
+	participantRepo repositories.ParticipantRepository,
+	roomService room.Service,
+	livekitClient lkpkg.Client,
+	cfg config.ReconcilerConfig,
+) *Reconciler {
+	if cfg.Interval <= 0 {
+		cfg.Interval = time.Minute
+	}
+
+	return &Reconciler{
+		roomRepo:        roomRepo,
+		participantRepo: participantRepo,
+		roomService:     roomService,
+		livekitClient:   livekitClient,
+		cfg:             cfg,
+	}
+}
+
+// StartWorker starts the reconciliation loop
+func (r *Reconciler) StartWorker(ctx context.Context) error {
+	r.workerMutex.Lock()
+	defer r.workerMutex.Unlock()
+
+	if r.isWorkerRunning {
+		return fmt.Errorf("reconciler already running")
+	}
+
+	r.workerStopChan = make(chan struct{})
+	r.isWorkerRunning = true
+
+	r.workerWg.Add(1)
+	go r.worker(ctx)
+
+	log.Printf("[Reconciler] ✅ Started (interval=%s, grace=%s)", r.cfg.Interval, r.cfg.Grace)
+	return nil
+}
+
+// StopWorker gracefully stops the reconciliation loop
+func (r *Reconciler) StopWorker() error {
+	r.workerMutex.Lock()
+	defer r.workerMutex.Unlock()
+
+	if !r.isWorkerRunning {
+		return fmt.Errorf("reconciler not running")
+	}
+
+	close(r.workerStopChan)
+	r.workerWg.Wait()
+	r.isWorkerRunning = false
+
+	log.Printf("[Reconciler] 🛑 Stopped")
+	return nil
+}
+
+func (r *Reconciler) worker(ctx context.Context) {
+	defer r.workerWg.Done()
+
+	ticker := time.NewTicker(r.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-r.workerStopChan:
+			return
+		case <-ticker.C:
+			if _, err := r.Reconcile(ctx); err != nil {
+				log.Printf("[Reconciler] ❌ %v", err)
+			}
+		}
+	}
+}
+
+// Reconcile runs a single pass over the active rooms
+func (r *Reconciler) Reconcile(ctx context.Context) (*Result, error) {
+	result := &Result{Corrections: make(map[Correction]int)}
+
+	active, err := r.roomRepo.FindActiveRooms(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active rooms: %w", err)
+	}
+	if len(active) == 0 {
+		return result, nil
+	}
+
+	names := make([]string, len(active))
+	for i, rm := range active {
+		names[i] = rm.LivekitRoomName
+	}
+
+	// Without an answer from LiveKit nothing can be told apart from a lost webhook
+	livekitRooms, err := r.livekitClient.ListRooms(ctx, names)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list livekit rooms: %w", err)
+	}
+	open := make(map[string]bool, len(livekitRooms))
+	for _, lr := range livekitRooms {
+		open[lr.Name] = true
+	}
+
+	now := time.Now()
+	for _, rm := range active {
+		result.Rooms++
+
+		participants, err := r.participantRepo.FindByRoomID(ctx, rm.ID)
+		if err != nil {
+			log.Printf("[Reconciler] ❌ Failed to get participants of room %s: %v", rm.ID, err)
+			continue
+		}
+
+		if !open[rm.LivekitRoomName] {
+			if r.shouldEnd(rm, participants, now) {
+				r.endRoom(ctx, rm, result)
+			}
+			continue
+		}
+
+		connected, err := r.livekitClient.ListParticipants(ctx, rm.LivekitRoomName)
+		if err != nil {
+			log.Printf("[Reconciler] ❌ Failed to list livekit participants of room %s: %v", rm.ID, err)
+			continue
+		}
+		r.reconcileParticipants(ctx, rm, participants, connected, now, result)
+	}
+
+	if total := result.total(); total > 0 {
+		log.Printf("[Reconciler] 🔧 Pass done: rooms=%d, corrections=%d %v", result.Rooms, total, result.Corrections)
+	}
+
+	return result, nil
+}
+
+// shouldEnd reports whether an active room missing from LiveKit is over rather than about to be opened:
+// LiveKit only creates a room when someone connects, so rooms get the grace period after starting
+// and after the last join through the API
+func (r *Reconciler) shouldEnd(rm *entities.Room, participants []*entities.Participant, now time.Time) bool {
+	// Breakout rooms stay open until the host closes them
+	if rm.IsBreakout() {
+		return false
+	}
+	if rm.StartedAt != nil && now.Sub(*rm.StartedAt) < r.cfg.Grace {
+		return false
+	}
+	for _, p := range participants {
+		if p.JoinedAt != nil && now.Sub(*p.JoinedAt) < r.cfg.Grace {
+			return false
+		}
+	}
+	return true
+}
+
+// reconcileParticipants brings the participants of a room in line with the ones connected to LiveKit
+func (r *Reconciler) reconcileParticipants(ctx context.Context, rm *entities.Room, participants []*entities.Participant, connected []*lkpkg.ParticipantInfo, now time.Time, result *Result) {
+	byIdentity := make(map[string]*entities.Participant, len(participants))
+	for _, p := range participants {
+		if p.UserID != nil || p.IsGuest() {
+			byIdentity[p.Identity()] = p
+		}
+	}
+
+	online := make(map[string]bool, len(connected))
+	for _, info := range connected {
+		online[info.Identity] = true
+
+		// Recorders and other server-side participants have no participant record
+		p, ok := byIdentity[info.Identity]
+		if !ok {
+			continue
+		}
+
+		switch {
+		case p.Status == entities.ParticipantStatusRemoved || p.Status == entities.ParticipantStatusDenied || p.IsRemoved:
+			r.kick(ctx, rm, p, result)
+		case p.IsActive():
+			// Already in line with LiveKit
+		case p.WasAdmitted():
+			r.markJoined(ctx, rm, p, info, result)
+		default:
+			// Connected while still in the lobby (or never let in): only the host admits participants
+			r.kick(ctx, rm, p, result)
+		}
+	}
+
+	for _, p := range participants {
+		if !p.IsActive() || online[p.Identity()] {
+			continue
+		}
+		// Participants get some time to connect after joining through the API
+		if p.JoinedAt != nil && now.Sub(*p.JoinedAt) < r.cfg.Grace {
+			continue
+		}
+		r.markLeft(ctx, rm, p, result)
+	}
+}
+
+// markLeft marks a participant that is no longer connected as left, the way participant_left does
+func (r *Reconciler) markLeft(ctx context.Context, rm *entities.Room, p *entities.Participant, result *Result) {
+	var err error
+	if p.UserID != nil {
+		err = r.roomService.LeaveRoom(ctx, rm.ID, *p.UserID)
+	} else {
+		err = r.roomService.LeaveRoomAsGuest(ctx, rm.ID, p.ID)
+	}
+	if err != nil {
+		log.Printf("[Reconciler] ❌ Failed to mark participant %s of room %s as left: %v", p.ID, rm.ID, err)
+		return
+	}
+	r.record(result, CorrectionParticipantLeft, rm, p)
+}
+
+// markJoined seats a previously admitted participant connected to LiveKit the way joining does: the seat
+// is taken under the room's seat lock and counts against max_participants; when the room is full the
+// participant is waitlisted and disconnected
+func (r *Reconciler) markJoined(ctx context.Context, rm *entities.Room, p *entities.Participant, info *lkpkg.ParticipantInfo, result *Result) {
+	if err := r.roomService.SeatParticipant(ctx, rm, p); err != nil {
+		log.Printf("[Reconciler] ❌ Failed to mark participant %s of room %s as joined: %v", p.ID, rm.ID, err)
+		return
+	}
+
+	if p.Status == entities.ParticipantStatusWaitlisted {
+		if err := r.livekitClient.RemoveParticipant(ctx, rm.LivekitRoomName, p.Identity()); err != nil {
+			log.Printf("[Reconciler] ❌ Failed to disconnect waitlisted participant %s of room %s: %v", p.ID, rm.ID, err)
+			return
+		}
+		r.record(result, CorrectionParticipantWaitlisted, rm, p)
+		return
+	}
+
+	// Joined since it connected rather than since the pass noticed it
+	if !info.JoinedAt.IsZero() {
+		joinedAt := info.JoinedAt
+		p.JoinedAt = &joinedAt
+		if err := r.participantRepo.Update(ctx, p); err != nil {
+			log.Printf("[Reconciler] ⚠️  Failed to update join time of participant %s of room %s: %v", p.ID, rm.ID, err)
+		}
+	}
+	r.record(result, CorrectionParticipantJoined, rm, p)
+}
+
+// kick disconnects a participant that was removed from the room, or never admitted, but is connected
+func (r *Reconciler) kick(ctx context.Context, rm *entities.Room, p *entities.Participant, result *Result) {
+	if err := r.livekitClient.RemoveParticipant(ctx, rm.LivekitRoomName, p.Identity()); err != nil {
+		log.Printf("[Reconciler] ❌ Failed to disconnect participant %s (%s) of room %s: %v", p.ID, p.Status, rm.ID, err)
+		return
+	}
+	r.record(result, CorrectionParticipantKicked, rm, p)
+}
+
+// endRoom ends a room LiveKit already closed, the way room_finished does
+func (r *Reconciler) endRoom(ctx context.Context, rm *entities.Room, result *Result) {
+	if err := r.roomService.EndRoom(ctx, rm.ID, rm.HostID); err != nil {
+		log.Printf("[Reconciler] ❌ Failed to end room %s: %v", rm.ID, err)
+		return
+	}
+	r.record(result, CorrectionRoomEnded, rm, nil)
+}
+
+// record counts a correction and logs it as an event
+func (r *Reconciler) record(result *Result, correction Correction, rm *entities.Room, p *entities.Participant) {
+	result.Corrections[correction]++
+
+	participantID := uuid.Nil
+	if p != nil {
+		participantID = p.ID
+	}
+	log.Printf("[Reconciler] 🔧 correction=%s room=%s livekit_room=%s participant=%s",
+		correction, rm.ID, rm.LivekitRoomName, participantID)
+}
+
+func (res *Result) total() int {
+	total := 0
+	for _, n := range res.Corrections {
+		total += n
+	}
+	return total
+}
@@ -0,0 +1,302 @@
+package reconciler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+	"github.com/johnquangdev/meeting-assistant/internal/domain/repositories"
+	lkpkg "github.com/johnquangdev/meeting-assistant/internal/infrastructure/external/livekit"
+	"github.com/johnquangdev/meeting-assistant/internal/usecase/room"
+	"github.com/johnquangdev/meeting-assistant/pkg/config"
+)
+
+const grace = 2 * time.Minute
+
+// fakeRoomRepo serves the active rooms of a pass
+type fakeRoomRepo struct {
+	repositories.RoomRepository
+	rooms []*entities.Room
+}
+
+func (f *fakeRoomRepo) FindActiveRooms(ctx context.Context) ([]*entities.Room, error) {
+	return f.rooms, nil
+}
+
+// fakeParticipantRepo keeps the participants of the rooms in memory
+type fakeParticipantRepo struct {
+	repositories.ParticipantRepository
+	participants []*entities.Participant
+}
+
+func (f *fakeParticipantRepo) FindByRoomID(ctx context.Context, roomID uuid.UUID) ([]*entities.Participant, error) {
+	var participants []*entities.Participant
+	for _, p := range f.participants {
+		if p.RoomID == roomID {
+			participants = append(participants, p)
+		}
+	}
+	return participants, nil
+}
+
+func (f *fakeParticipantRepo) Update(ctx context.Context, participant *entities.Participant) error {
+	return nil
+}
+
+// fakeRoomService records what the reconciler asked for, seating participants up to capacity
+type fakeRoomService struct {
+	room.Service
+	participants *fakeParticipantRepo
+	capacity     int
+	left         []uuid.UUID // Participants marked as left
+	ended        []uuid.UUID // Rooms ended
+}
+
+func (f *fakeRoomService) LeaveRoom(ctx context.Context, roomID, userID uuid.UUID) error {
+	for _, p := range f.participants.participants {
+		if p.RoomID == roomID && p.UserID != nil && *p.UserID == userID {
+			p.Leave()
+			f.left = append(f.left, p.ID)
+		}
+	}
+	return nil
+}
+
+func (f *fakeRoomService) LeaveRoomAsGuest(ctx context.Context, roomID, participantID uuid.UUID) error {
+	for _, p := range f.participants.participants {
+		if p.ID == participantID {
+			p.Leave()
+			f.left = append(f.left, p.ID)
+		}
+	}
+	return nil
+}
+
+func (f *fakeRoomService) EndRoom(ctx context.Context, roomID, userID uuid.UUID) error {
+	f.ended = append(f.ended, roomID)
+	return nil
+}
+
+func (f *fakeRoomService) SeatParticipant(ctx context.Context, rm *entities.Room, participant *entities.Participant) error {
+	joined := 0
+	for _, p := range f.participants.participants {
+		if p.RoomID == rm.ID && p.IsActive() {
+			joined++
+		}
+	}
+	if f.capacity > 0 && joined >= f.capacity {
+		participant.Waitlist()
+		return nil
+	}
+	participant.Join()
+	return nil
+}
+
+func TestReconcile(t *testing.T) {
+	now := time.Now()
+	longAgo := now.Add(-time.Hour)
+	connectedAt := now.Add(-10 * time.Minute)
+
+	userParticipant := func(rm *entities.Room, status entities.ParticipantStatus, joinedAt *time.Time) *entities.Participant {
+		userID := uuid.New()
+		return &entities.Participant{ID: uuid.New(), RoomID: rm.ID, UserID: &userID, Status: status, JoinedAt: joinedAt}
+	}
+	connect := func(lk *lkpkg.MockClient, rm *entities.Room, p *entities.Participant) {
+		lk.ConnectParticipant(rm.LivekitRoomName, &lkpkg.ParticipantInfo{Identity: p.Identity(), JoinedAt: connectedAt})
+	}
+	openRoom := func(lk *lkpkg.MockClient, rm *entities.Room) {
+		lk.CreateRoom(context.Background(), rm.LivekitRoomName, nil)
+	}
+
+	tests := []struct {
+		name        string
+		startedAt   time.Time
+		capacity    int
+		setup       func(lk *lkpkg.MockClient, rm *entities.Room) []*entities.Participant
+		corrections map[Correction]int
+		check       func(t *testing.T, lk *lkpkg.MockClient, rm *entities.Room, participants []*entities.Participant, svc *fakeRoomService)
+	}{
+		{
+			name:      "participant left",
+			startedAt: longAgo,
+			setup: func(lk *lkpkg.MockClient, rm *entities.Room) []*entities.Participant {
+				openRoom(lk, rm)
+				return []*entities.Participant{userParticipant(rm, entities.ParticipantStatusJoined, &longAgo)}
+			},
+			corrections: map[Correction]int{CorrectionParticipantLeft: 1},
+			check: func(t *testing.T, lk *lkpkg.MockClient, rm *entities.Room, participants []*entities.Participant, svc *fakeRoomService) {
+				if len(svc.left) != 1 || svc.left[0] != participants[0].ID {
+					t.Fatalf("expected participant %s marked as left, got %v", participants[0].ID, svc.left)
+				}
+			},
+		},
+		{
+			name:      "participant still connecting within grace",
+			startedAt: longAgo,
+			setup: func(lk *lkpkg.MockClient, rm *entities.Room) []*entities.Participant {
+				openRoom(lk, rm)
+				joinedAt := now.Add(-grace / 2)
+				return []*entities.Participant{userParticipant(rm, entities.ParticipantStatusJoined, &joinedAt)}
+			},
+			corrections: map[Correction]int{},
+		},
+		{
+			name:      "participant joined",
+			startedAt: longAgo,
+			setup: func(lk *lkpkg.MockClient, rm *entities.Room) []*entities.Participant {
+				p := userParticipant(rm, entities.ParticipantStatusLeft, &longAgo)
+				connect(lk, rm, p)
+				return []*entities.Participant{p}
+			},
+			corrections: map[Correction]int{CorrectionParticipantJoined: 1},
+			check: func(t *testing.T, lk *lkpkg.MockClient, rm *entities.Room, participants []*entities.Participant, svc *fakeRoomService) {
+				p := participants[0]
+				if !p.IsActive() || p.JoinedAt == nil || !p.JoinedAt.Equal(connectedAt) {
+					t.Fatalf("status = %s, joined at %v, want joined at %v", p.Status, p.JoinedAt, connectedAt)
+				}
+			},
+		},
+		{
+			name:      "participant joined a full room",
+			startedAt: longAgo,
+			capacity:  1,
+			setup: func(lk *lkpkg.MockClient, rm *entities.Room) []*entities.Participant {
+				seated := userParticipant(rm, entities.ParticipantStatusJoined, &longAgo)
+				late := userParticipant(rm, entities.ParticipantStatusLeft, &longAgo)
+				connect(lk, rm, seated)
+				connect(lk, rm, late)
+				return []*entities.Participant{seated, late}
+			},
+			corrections: map[Correction]int{CorrectionParticipantWaitlisted: 1},
+			check: func(t *testing.T, lk *lkpkg.MockClient, rm *entities.Room, participants []*entities.Participant, svc *fakeRoomService) {
+				late := participants[1]
+				if late.Status != entities.ParticipantStatusWaitlisted {
+					t.Fatalf("status = %s, want waitlisted", late.Status)
+				}
+				connected, _ := lk.ListParticipants(context.Background(), rm.LivekitRoomName)
+				if len(connected) != 1 || connected[0].Identity != participants[0].Identity() {
+					t.Fatalf("expected only the seated participant connected, got %d", len(connected))
+				}
+			},
+		},
+		{
+			name:      "participant kicked",
+			startedAt: longAgo,
+			setup: func(lk *lkpkg.MockClient, rm *entities.Room) []*entities.Participant {
+				p := userParticipant(rm, entities.ParticipantStatusRemoved, &longAgo)
+				connect(lk, rm, p)
+				return []*entities.Participant{p}
+			},
+			corrections: map[Correction]int{CorrectionParticipantKicked: 1},
+			check: func(t *testing.T, lk *lkpkg.MockClient, rm *entities.Room, participants []*entities.Participant, svc *fakeRoomService) {
+				if p, _ := lk.ListParticipants(context.Background(), rm.LivekitRoomName); len(p) != 0 {
+					t.Fatalf("expected the removed participant disconnected, %d still connected", len(p))
+				}
+			},
+		},
+		{
+			name:      "participant connected from the lobby",
+			startedAt: longAgo,
+			setup: func(lk *lkpkg.MockClient, rm *entities.Room) []*entities.Participant {
+				waiting := userParticipant(rm, entities.ParticipantStatusWaiting, nil)
+				invited := userParticipant(rm, entities.ParticipantStatusInvited, nil)
+				connect(lk, rm, waiting)
+				connect(lk, rm, invited)
+				return []*entities.Participant{waiting, invited}
+			},
+			corrections: map[Correction]int{CorrectionParticipantKicked: 2},
+			check: func(t *testing.T, lk *lkpkg.MockClient, rm *entities.Room, participants []*entities.Participant, svc *fakeRoomService) {
+				if participants[0].Status != entities.ParticipantStatusWaiting || participants[1].Status != entities.ParticipantStatusInvited {
+					t.Fatalf("statuses = %s, %s, want waiting and invited untouched", participants[0].Status, participants[1].Status)
+				}
+				if p, _ := lk.ListParticipants(context.Background(), rm.LivekitRoomName); len(p) != 0 {
+					t.Fatalf("expected participants that were never admitted disconnected, %d still connected", len(p))
+				}
+			},
+		},
+		{
+			name:      "room ended",
+			startedAt: longAgo,
+			setup: func(lk *lkpkg.MockClient, rm *entities.Room) []*entities.Participant {
+				return []*entities.Participant{userParticipant(rm, entities.ParticipantStatusJoined, &longAgo)}
+			},
+			corrections: map[Correction]int{CorrectionRoomEnded: 1},
+			check: func(t *testing.T, lk *lkpkg.MockClient, rm *entities.Room, participants []*entities.Participant, svc *fakeRoomService) {
+				if len(svc.ended) != 1 || svc.ended[0] != rm.ID {
+					t.Fatalf("expected room %s ended, got %v", rm.ID, svc.ended)
+				}
+			},
+		},
+		{
+			name:      "room just started within grace",
+			startedAt: now.Add(-grace / 2),
+			setup: func(lk *lkpkg.MockClient, rm *entities.Room) []*entities.Participant {
+				return nil
+			},
+			corrections: map[Correction]int{},
+		},
+		{
+			name:      "room with a join within grace",
+			startedAt: longAgo,
+			setup: func(lk *lkpkg.MockClient, rm *entities.Room) []*entities.Participant {
+				joinedAt := now.Add(-grace / 2)
+				return []*entities.Participant{userParticipant(rm, entities.ParticipantStatusJoined, &joinedAt)}
+			},
+			corrections: map[Correction]int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			startedAt := tt.startedAt
+			rm := &entities.Room{ID: uuid.New(), HostID: uuid.New(), LivekitRoomName: "room-" + uuid.NewString(), Status: entities.RoomStatusActive, StartedAt: &startedAt}
+
+			lk := lkpkg.NewMockClient("ws://localhost:7880", "key", "secret")
+			participants := tt.setup(lk, rm)
+			participantRepo := &fakeParticipantRepo{participants: participants}
+			svc := &fakeRoomService{participants: participantRepo, capacity: tt.capacity}
+
+			r := NewReconciler(&fakeRoomRepo{rooms: []*entities.Room{rm}}, participantRepo, svc, lk, config.ReconcilerConfig{Grace: grace})
+			result, err := r.Reconcile(context.Background())
+			if err != nil {
+				t.Fatalf("reconcile failed: %v", err)
+			}
+
+			if result.Rooms != 1 {
+				t.Fatalf("rooms checked = %d, want 1", result.Rooms)
+			}
+			if len(result.Corrections) != len(tt.corrections) {
+				t.Fatalf("corrections = %v, want %v", result.Corrections, tt.corrections)
+			}
+			for kind, n := range tt.corrections {
+				if result.Corrections[kind] != n {
+					t.Fatalf("corrections = %v, want %v", result.Corrections, tt.corrections)
+				}
+			}
+			if tt.check != nil {
+				tt.check(t, lk, rm, participants, svc)
+			}
+		})
+	}
+}
+
+func TestReconcile_BreakoutRoomsStayOpen(t *testing.T) {
+	startedAt := time.Now().Add(-time.Hour)
+	parentID := uuid.New()
+	rm := &entities.Room{ID: uuid.New(), LivekitRoomName: "breakout", Status: entities.RoomStatusActive, StartedAt: &startedAt, ParentRoomID: &parentID}
+
+	participantRepo := &fakeParticipantRepo{}
+	svc := &fakeRoomService{participants: participantRepo}
+	lk := lkpkg.NewMockClient("ws://localhost:7880", "key", "secret")
+
+	r := NewReconciler(&fakeRoomRepo{rooms: []*entities.Room{rm}}, participantRepo, svc, lk, config.ReconcilerConfig{Grace: grace})
+	if _, err := r.Reconcile(context.Background()); err != nil {
+		t.Fatalf("reconcile failed: %v", err)
+	}
+	if len(svc.ended) != 0 {
+		t.Fatalf("expected breakout room left open, ended %v", svc.ended)
+	}
+}
@@ -0,0 +1,182 @@
+package recording
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/johnquangdev/meeting-assistant/internal/adapter/repository"
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+	"github.com/johnquangdev/meeting-assistant/internal/infrastructure/storage"
+	usecaseErrors "github.com/johnquangdev/meeting-assistant/internal/usecase/errors"
+	"github.com/johnquangdev/meeting-assistant/internal/usecase/room"
+)
+
+// RecordingService gives access to the recordings of meetings
+type RecordingService struct {
+	recordingRepo *repository.RecordingRepository
+	roomService   room.Service
+	storage       *storage.MinIOClient // nil when storage is unavailable
+	playbackTTL   time.Duration
+}
+
+// NewRecordingService creates a new recording service
+func NewRecordingService(
+	recordingRepo *repository.RecordingRepository,
+	roomService room.Service,
+	storage *storage.MinIOClient,
+	playbackTTL time.Duration,
+) *RecordingService {
+	return &RecordingService{
+		recordingRepo: recordingRepo,
+		roomService:   roomService,
+		storage:       storage,
+		playbackTTL:   playbackTTL,
+	}
+}
+
+// Playback is a recording with the URLs its files play from, in order
+type Playback struct {
+	Recording *entities.Recording
+	URLs      []string
+	ExpiresAt time.Time
+}
+
+// ListRoomRecordings retrieves the recordings of a room, newest first (deleted recordings excluded)
+func (s *RecordingService) ListRoomRecordings(ctx context.Context, roomID, userID uuid.UUID) ([]*entities.Recording, error) {
+	r, err := s.roomService.GetRoom(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizeView(ctx, r, userID); err != nil {
+		return nil, err
+	}
+
+	recordings, err := s.recordingRepo.FindByRoomID(ctx, roomID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list recordings: %w", err)
+	}
+
+	visible := make([]*entities.Recording, 0, len(recordings))
+	for _, recording := range recordings {
+		if !recording.IsDeleted() {
+			visible = append(visible, recording)
+		}
+	}
+	return visible, nil
+}
+
+// GetRecording retrieves a recording with short-lived URLs to play its files.
+// Files are only playable once the recording completed.
+func (s *RecordingService) GetRecording(ctx context.Context, recordingID, userID uuid.UUID) (*Playback, error) {
+	recording, r, err := s.getRecording(ctx, recordingID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizeView(ctx, r, userID); err != nil {
+		// Recordings of meetings the user has nothing to do with are not revealed
+		if errors.Is(err, usecaseErrors.ErrNotParticipant) {
+			return nil, usecaseErrors.ErrRecordingNotFound
+		}
+		return nil, err
+	}
+
+	playback := &Playback{Recording: recording, URLs: []string{}}
+	if !recording.IsCompleted() || s.storage == nil {
+		return playback, nil
+	}
+
+	playback.ExpiresAt = time.Now().Add(s.playbackTTL)
+	for _, objectName := range recording.ObjectNames() {
+		url, err := s.storage.GetFileURL(ctx, objectName, s.playbackTTL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get playback URL: %w", err)
+		}
+		playback.URLs = append(playback.URLs, url)
+	}
+	return playback, nil
+}
+
+// DeleteRecording deletes the files of a recording and marks it deleted (host, or owners and admins of its organization).
+// Recordings still running or paused have to be stopped first.
+func (s *RecordingService) DeleteRecording(ctx context.Context, recordingID, userID uuid.UUID) error {
+	recording, r, err := s.getRecording(ctx, recordingID)
+	if err != nil {
+		return err
+	}
+	if err := s.roomService.Authorize(ctx, r, userID, entities.RoomActionDeleteRecording); err != nil {
+		return err
+	}
+	if recording.IsActive() {
+		return usecaseErrors.ErrRecordingInProgress
+	}
+
+	if s.storage == nil {
+		return fmt.Errorf("failed to delete recording files: storage unavailable")
+	}
+	// Files go first: a failure leaves the recording as it was, so deleting can be retried
+	for _, objectName := range recording.ObjectNames() {
+		if err := s.storage.DeleteFile(ctx, objectName); err != nil {
+			return fmt.Errorf("failed to delete recording file %s: %w", objectName, err)
+		}
+	}
+
+	if err := s.recordingRepo.UpdateStatus(ctx, recording.ID, entities.RecordingStatusDeleted); err != nil {
+		return fmt.Errorf("failed to delete recording: %w", err)
+	}
+
+	log.Printf("[Recording] 🗑️ Recording deleted: recording=%s, room=%s, by=%s", recording.ID, r.ID, userID)
+	return nil
+}
+
+// getRecording retrieves a recording that was not deleted, with its room
+func (s *RecordingService) getRecording(ctx context.Context, recordingID uuid.UUID) (*entities.Recording, *entities.Room, error) {
+	recording, err := s.recordingRepo.FindByID(ctx, recordingID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get recording: %w", err)
+	}
+	if recording == nil || recording.IsDeleted() {
+		return nil, nil, usecaseErrors.ErrRecordingNotFound
+	}
+
+	r, err := s.roomService.GetRoom(ctx, recording.RoomID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return recording, r, nil
+}
+
+// authorizeView checks that a user may see the recordings of a room:
+// the host, members of the organization owning the room, and participants who joined the meeting
+func (s *RecordingService) authorizeView(ctx context.Context, r *entities.Room, userID uuid.UUID) error {
+	if r.HostID == userID {
+		return nil
+	}
+
+	if r.OrganizationID != nil {
+		err := s.roomService.AuthorizeOrganization(ctx, *r.OrganizationID, userID)
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, usecaseErrors.ErrNotOrganizationMember) {
+			return err
+		}
+	}
+
+	participant, err := s.roomService.GetParticipantByRoomAndUser(ctx, r.ID, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return usecaseErrors.ErrNotParticipant
+		}
+		return fmt.Errorf("failed to get participant: %w", err)
+	}
+	if participant == nil || participant.IsRemoved || participant.JoinedAt == nil {
+		return usecaseErrors.ErrNotParticipant
+	}
+	return nil
+}
@@ -0,0 +1,24 @@
+package recording
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+)
+
+// Service defines the interface for viewing, playing and deleting recordings.
+// Recordings are visible to the host, to those who attended the meeting and, for organization rooms, to the members.
+type Service interface {
+	// ListRoomRecordings retrieves the recordings of a room, newest first (deleted recordings excluded)
+	ListRoomRecordings(ctx context.Context, roomID, userID uuid.UUID) ([]*entities.Recording, error)
+
+	// GetRecording retrieves a recording with short-lived URLs to play its files
+	GetRecording(ctx context.Context, recordingID, userID uuid.UUID) (*Playback, error)
+
+	// DeleteRecording deletes the files of a recording and marks it deleted (host, or owners and admins of its organization)
+	DeleteRecording(ctx context.Context, recordingID, userID uuid.UUID) error
+}
+
+// Ensure RecordingService implements Service interface
+var _ Service = (*RecordingService)(nil)
@@ -0,0 +1,258 @@
+package room
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+	usecaseErrors "github.com/johnquangdev/meeting-assistant/internal/usecase/errors"
+)
+
+const (
+	// passcodeMaxFailures is how many wrong passcodes a user (or guest IP) may enter per window
+	passcodeMaxFailures = 5
+	// passcodeFailureWindow is how long wrong passcodes count against the limit
+	passcodeFailureWindow = 15 * time.Minute
+
+	passcodeMinLength = 4
+	passcodeMaxLength = 32
+)
+
+// LockRoom closes a running room to new joins (host or co-host only).
+// Locking an already locked room changes its lock mode.
+func (s *RoomService) LockRoom(ctx context.Context, roomID, userID uuid.UUID, mode entities.RoomLockMode) (*entities.Room, error) {
+	if mode != entities.RoomLockModeWaitingRoom && mode != entities.RoomLockModeReject {
+		return nil, usecaseErrors.ErrInvalidLockMode
+	}
+
+	room, err := s.getLockableRoom(ctx, roomID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	room.Lock(mode, userID)
+	if err := s.roomRepo.UpdateLock(ctx, room); err != nil {
+		return nil, fmt.Errorf("failed to lock room: %w", err)
+	}
+
+	s.recordAudit(ctx, roomID, &userID, "", entities.RoomAuditLocked, map[string]interface{}{"mode": mode})
+	s.events.Publish(ctx, entities.NewRoomEvent(roomID, entities.RoomEventRoomLocked, map[string]interface{}{
+		"mode":      mode,
+		"locked_by": userID,
+	}))
+
+	log.Printf("[Room] 🔒 Room locked: room=%s, mode=%s, by=%s", roomID, mode, userID)
+
+	return room, nil
+}
+
+// UnlockRoom opens a locked room to new joins again (host or co-host only)
+func (s *RoomService) UnlockRoom(ctx context.Context, roomID, userID uuid.UUID) (*entities.Room, error) {
+	room, err := s.getLockableRoom(ctx, roomID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !room.IsLocked() {
+		return room, nil
+	}
+
+	room.Unlock()
+	if err := s.roomRepo.UpdateLock(ctx, room); err != nil {
+		return nil, fmt.Errorf("failed to unlock room: %w", err)
+	}
+
+	s.recordAudit(ctx, roomID, &userID, "", entities.RoomAuditUnlocked, nil)
+	s.events.Publish(ctx, entities.NewRoomEvent(roomID, entities.RoomEventRoomUnlocked, map[string]interface{}{
+		"unlocked_by": userID,
+	}))
+
+	log.Printf("[Room] 🔓 Room unlocked: room=%s, by=%s", roomID, userID)
+
+	return room, nil
+}
+
+// SetPasscode sets, changes or (with an empty passcode) removes the join passcode of a room (host only).
+// Only a bcrypt hash of the passcode is stored.
+func (s *RoomService) SetPasscode(ctx context.Context, roomID, userID uuid.UUID, passcode string) (*entities.Room, error) {
+	if passcode != "" && (len(passcode) < passcodeMinLength || len(passcode) > passcodeMaxLength) {
+		return nil, usecaseErrors.ErrInvalidPasscode
+	}
+
+	room, err := s.GetRoom(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Authorize(ctx, room, userID, entities.RoomActionUpdateSettings); err != nil {
+		return nil, err
+	}
+	if room.IsEnded() {
+		return nil, usecaseErrors.ErrRoomEnded
+	}
+
+	action := entities.RoomAuditPasscodeCleared
+	room.PasscodeHash = nil
+	if passcode != "" {
+		hash, err := hashPasscode(passcode)
+		if err != nil {
+			return nil, err
+		}
+		room.PasscodeHash = &hash
+		action = entities.RoomAuditPasscodeSet
+	}
+
+	if err := s.roomRepo.UpdatePasscode(ctx, roomID, room.PasscodeHash); err != nil {
+		return nil, fmt.Errorf("failed to update passcode: %w", err)
+	}
+
+	s.recordAudit(ctx, roomID, &userID, "", action, nil)
+
+	log.Printf("[Room] 🔑 Passcode updated: room=%s, action=%s, by=%s", roomID, action, userID)
+
+	return room, nil
+}
+
+// VerifyPasscode checks a join passcode against the room's hash.
+// Wrong passcodes are audited and rate limited per user, or per client IP when userID is nil (guests).
+// Every attempt is recorded as a failure before the check so concurrent guesses cannot pass the limit,
+// and withdrawn when the passcode is right; an attempt that cannot be recorded is rejected.
+func (s *RoomService) VerifyPasscode(ctx context.Context, room *entities.Room, passcode string, userID *uuid.UUID, clientIP string) error {
+	if !room.HasPasscode() {
+		return nil
+	}
+	if passcode == "" {
+		return usecaseErrors.ErrPasscodeRequired
+	}
+
+	attempt := newAuditLog(room.ID, userID, clientIP, entities.RoomAuditPasscodeFailed, nil)
+	recorded, err := s.roomRepo.RecordPasscodeFailure(ctx, attempt, time.Now().Add(-passcodeFailureWindow), passcodeMaxFailures)
+	if err != nil {
+		return fmt.Errorf("failed to record passcode attempt: %w", err)
+	}
+	if !recorded {
+		return usecaseErrors.ErrTooManyPasscodeTries
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(*room.PasscodeHash), []byte(passcode)); err != nil {
+		return usecaseErrors.ErrIncorrectPasscode
+	}
+
+	if err := s.roomRepo.DeleteAuditLog(ctx, attempt.ID); err != nil {
+		// The right passcode then counts as a failure, which only tightens the limit
+		log.Printf("[Room] ⚠️ Failed to withdraw passcode attempt: room=%s, attempt=%s, err=%v", room.ID, attempt.ID, err)
+	}
+	return nil
+}
+
+// ListAuditLogs retrieves the access control audit trail of a room, newest first (host or co-host only)
+func (s *RoomService) ListAuditLogs(ctx context.Context, roomID, userID uuid.UUID, limit, offset int) ([]*entities.RoomAuditLog, int64, error) {
+	room, err := s.GetRoomForUser(ctx, roomID, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := s.Authorize(ctx, room, userID, entities.RoomActionLock); err != nil {
+		return nil, 0, err
+	}
+
+	logs, total, err := s.roomRepo.ListAuditLogs(ctx, roomID, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list audit logs: %w", err)
+	}
+	return logs, total, nil
+}
+
+// checkRoomAccess applies the passcode and the lock of a room to a registered user joining it.
+// It reports whether the user must wait in the lobby because the room is locked.
+// The host, co-hosts and invited participants skip the passcode; only the host and co-hosts skip the lock.
+func (s *RoomService) checkRoomAccess(ctx context.Context, room *entities.Room, userID uuid.UUID, passcode, clientIP string) (bool, error) {
+	if room.HostID == userID {
+		return false, nil
+	}
+
+	participant, err := s.participantRepo.FindByRoomAndUser(ctx, room.ID, userID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, fmt.Errorf("failed to get participant: %w", err)
+	}
+	if participant != nil && participant.Role == entities.ParticipantRoleCoHost {
+		return false, nil
+	}
+
+	invited := participant != nil && (participant.InvitedBy != nil || participant.Status == entities.ParticipantStatusInvited)
+	if !invited {
+		if err := s.VerifyPasscode(ctx, room, passcode, &userID, clientIP); err != nil {
+			return false, err
+		}
+	}
+
+	return checkLock(room)
+}
+
+// checkLock reports whether new joins of a room must wait in the lobby, or fails when they are rejected
+func checkLock(room *entities.Room) (bool, error) {
+	if !room.IsLocked() {
+		return false, nil
+	}
+	if *room.LockMode == entities.RoomLockModeReject {
+		return false, usecaseErrors.ErrRoomLocked
+	}
+	return true, nil
+}
+
+// getLockableRoom retrieves a running room the user may lock or unlock
+func (s *RoomService) getLockableRoom(ctx context.Context, roomID, userID uuid.UUID) (*entities.Room, error) {
+	room, err := s.GetRoom(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Authorize(ctx, room, userID, entities.RoomActionLock); err != nil {
+		return nil, err
+	}
+	if room.IsEnded() {
+		return nil, usecaseErrors.ErrRoomEnded
+	}
+	return room, nil
+}
+
+// recordAudit stores an audit log entry. Failures are logged, not returned: auditing never blocks the action.
+func (s *RoomService) recordAudit(ctx context.Context, roomID uuid.UUID, actorID *uuid.UUID, actorIP string, action entities.RoomAuditAction, details map[string]interface{}) {
+	entry := newAuditLog(roomID, actorID, actorIP, action, details)
+	if err := s.roomRepo.CreateAuditLog(ctx, entry); err != nil {
+		log.Printf("[Room] ⚠️ Failed to record audit log: room=%s, action=%s, err=%v", roomID, action, err)
+	}
+}
+
+// newAuditLog builds an audit log entry of a room
+func newAuditLog(roomID uuid.UUID, actorID *uuid.UUID, actorIP string, action entities.RoomAuditAction, details map[string]interface{}) *entities.RoomAuditLog {
+	entry := &entities.RoomAuditLog{
+		RoomID:  roomID,
+		ActorID: actorID,
+		ActorIP: actorIP,
+		Action:  action,
+	}
+	if len(details) > 0 {
+		raw, err := json.Marshal(details)
+		if err != nil {
+			log.Printf("[Room] ⚠️ Failed to encode audit details: room=%s, action=%s, err=%v", roomID, action, err)
+		} else {
+			entry.Details = datatypes.JSON(raw)
+		}
+	}
+	return entry
+}
+
+// hashPasscode hashes a room passcode for storage
+func hashPasscode(passcode string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(passcode), bcrypt.DefaultCost)
+	if err != nil {
+		return "", fmt.Errorf("failed to hash passcode: %w", err)
+	}
+	return string(hash), nil
+}
@@ -0,0 +1,225 @@
+package room
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+	usecaseErrors "github.com/johnquangdev/meeting-assistant/internal/usecase/errors"
+)
+
+// AgendaItemInput represents an item of an agenda being set.
+// ID keeps an existing item (and its timing); items without ID are added.
+type AgendaItemInput struct {
+	ID              *uuid.UUID
+	Title           string
+	Description     *string
+	OwnerID         *uuid.UUID // User presenting the item
+	DurationMinutes int        // Time box (0 = none)
+}
+
+// GetAgenda retrieves the agenda of a room in order (anyone who may see the room)
+func (s *RoomService) GetAgenda(ctx context.Context, roomID, userID uuid.UUID) (*entities.Room, []*entities.AgendaItem, error) {
+	room, err := s.GetRoomForUser(ctx, roomID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	items, err := s.agendaRepo.FindByRoomID(ctx, roomID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get agenda: %w", err)
+	}
+	return room, items, nil
+}
+
+// SetAgenda replaces the agenda of a room with items in the given order (host or co-host).
+// The agenda can be edited until the meeting ends.
+func (s *RoomService) SetAgenda(ctx context.Context, roomID, userID uuid.UUID, inputs []AgendaItemInput) (*entities.Room, []*entities.AgendaItem, error) {
+	room, err := s.GetRoom(ctx, roomID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if room.IsEnded() || room.Status == entities.RoomStatusCancelled {
+		return nil, nil, usecaseErrors.ErrRoomEnded
+	}
+	if err := s.Authorize(ctx, room, userID, entities.RoomActionManageAgenda); err != nil {
+		return nil, nil, err
+	}
+	if len(inputs) > entities.MaxAgendaItems {
+		return nil, nil, usecaseErrors.ErrInvalidAgenda
+	}
+
+	current, err := s.agendaRepo.FindByRoomID(ctx, roomID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get agenda: %w", err)
+	}
+	existing := make(map[uuid.UUID]*entities.AgendaItem, len(current))
+	for _, item := range current {
+		existing[item.ID] = item
+	}
+
+	items := make([]*entities.AgendaItem, 0, len(inputs))
+	kept := make(map[uuid.UUID]bool, len(inputs))
+	for i, input := range inputs {
+		title := strings.TrimSpace(input.Title)
+		if title == "" || input.DurationMinutes < 0 {
+			return nil, nil, usecaseErrors.ErrInvalidAgenda
+		}
+
+		item := &entities.AgendaItem{RoomID: roomID}
+		if input.ID != nil {
+			previous, ok := existing[*input.ID]
+			if !ok || kept[*input.ID] {
+				return nil, nil, usecaseErrors.ErrAgendaItemNotFound
+			}
+			kept[*input.ID] = true
+			item = previous
+		}
+		item.Position = i
+		item.Title = title
+		item.Description = input.Description
+		item.OwnerID = input.OwnerID
+		item.Owner = nil
+		item.DurationMinutes = input.DurationMinutes
+		items = append(items, item)
+	}
+
+	if err := s.agendaRepo.Replace(ctx, roomID, items); err != nil {
+		return nil, nil, fmt.Errorf("failed to save agenda: %w", err)
+	}
+	if room.CurrentAgendaItemID != nil && !kept[*room.CurrentAgendaItemID] {
+		// Cleared in the database along with the deleted item
+		room.CurrentAgendaItemID = nil
+	}
+
+	// Reload for the owners
+	items, err = s.agendaRepo.FindByRoomID(ctx, roomID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get agenda: %w", err)
+	}
+
+	log.Printf("[Room] 🗒️ Agenda updated: room=%s, items=%d", roomID, len(items))
+	s.events.Publish(ctx, entities.NewRoomEvent(roomID, entities.RoomEventAgendaUpdated, map[string]interface{}{
+		"items":                  agendaEventItems(items),
+		"current_agenda_item_id": room.CurrentAgendaItemID,
+	}))
+
+	return room, items, nil
+}
+
+// SetCurrentAgendaItem moves an ongoing meeting to an agenda item, ending the previous one
+// (host or co-host). A nil itemID leaves the agenda without current item.
+func (s *RoomService) SetCurrentAgendaItem(ctx context.Context, roomID, userID uuid.UUID, itemID *uuid.UUID) (*entities.Room, []*entities.AgendaItem, error) {
+	room, err := s.GetRoom(ctx, roomID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !room.IsActive() {
+		return nil, nil, usecaseErrors.ErrRoomNotActive
+	}
+	if err := s.Authorize(ctx, room, userID, entities.RoomActionManageAgenda); err != nil {
+		return nil, nil, err
+	}
+
+	items, err := s.agendaRepo.FindByRoomID(ctx, roomID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get agenda: %w", err)
+	}
+
+	var next *entities.AgendaItem
+	if itemID != nil {
+		for _, item := range items {
+			if item.ID == *itemID {
+				next = item
+			}
+		}
+		if next == nil {
+			return nil, nil, usecaseErrors.ErrAgendaItemNotFound
+		}
+		if room.CurrentAgendaItemID != nil && *room.CurrentAgendaItemID == next.ID {
+			return room, items, nil
+		}
+	}
+
+	now := time.Now()
+	if err := s.endCurrentAgendaItem(ctx, room, items, now); err != nil {
+		return nil, nil, err
+	}
+	if next != nil {
+		next.Start(now)
+		if err := s.agendaRepo.Update(ctx, next); err != nil {
+			return nil, nil, fmt.Errorf("failed to start agenda item: %w", err)
+		}
+		room.CurrentAgendaItemID = &next.ID
+	}
+	if err := s.roomRepo.Update(ctx, room); err != nil {
+		return nil, nil, fmt.Errorf("failed to update room: %w", err)
+	}
+
+	data := map[string]interface{}{"agenda_item_id": nil}
+	if next != nil {
+		log.Printf("[Room] 🗒️ Agenda item started: room=%s, item=%d %q", roomID, next.Number(), next.Title)
+		data = agendaEventItem(next)
+		data["agenda_item_id"] = next.ID
+	}
+	s.events.Publish(ctx, entities.NewRoomEvent(roomID, entities.RoomEventAgendaItemStarted, data))
+
+	return room, items, nil
+}
+
+// endCurrentAgendaItem records that the meeting moved on from its current agenda item.
+// The room itself is not saved.
+func (s *RoomService) endCurrentAgendaItem(ctx context.Context, room *entities.Room, items []*entities.AgendaItem, now time.Time) error {
+	if room.CurrentAgendaItemID == nil {
+		return nil
+	}
+	for _, item := range items {
+		if item.ID != *room.CurrentAgendaItemID {
+			continue
+		}
+		item.End(now)
+		if err := s.agendaRepo.Update(ctx, item); err != nil {
+			return fmt.Errorf("failed to end agenda item: %w", err)
+		}
+	}
+	room.CurrentAgendaItemID = nil
+	return nil
+}
+
+// finishAgenda ends the current agenda item of a meeting that is ending. The room itself is not saved.
+func (s *RoomService) finishAgenda(ctx context.Context, room *entities.Room) error {
+	if room.CurrentAgendaItemID == nil {
+		return nil
+	}
+	items, err := s.agendaRepo.FindByRoomID(ctx, room.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get agenda: %w", err)
+	}
+	return s.endCurrentAgendaItem(ctx, room, items, time.Now())
+}
+
+// agendaEventItems describes the agenda in room events
+func agendaEventItems(items []*entities.AgendaItem) []map[string]interface{} {
+	data := make([]map[string]interface{}, len(items))
+	for i, item := range items {
+		data[i] = agendaEventItem(item)
+	}
+	return data
+}
+
+// agendaEventItem describes an agenda item in room events
+func agendaEventItem(item *entities.AgendaItem) map[string]interface{} {
+	return map[string]interface{}{
+		"id":               item.ID,
+		"number":           item.Number(),
+		"title":            item.Title,
+		"owner":            item.OwnerName(),
+		"duration_minutes": item.DurationMinutes,
+		"started_at":       item.StartedAt,
+	}
+}
@@ -0,0 +1,144 @@
+package room
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+	usecaseErrors "github.com/johnquangdev/meeting-assistant/internal/usecase/errors"
+)
+
+// RecordSessionStart records a participant connecting to the LiveKit room (participant_joined webhook)
+func (s *RoomService) RecordSessionStart(ctx context.Context, roomID uuid.UUID, identity, sessionSID string, joinedAt time.Time) error {
+	participant, err := s.findByIdentity(ctx, roomID, identity)
+	if err != nil {
+		return err
+	}
+
+	session := &entities.AttendanceSession{
+		RoomID:        roomID,
+		ParticipantID: participant.ID,
+		Identity:      identity,
+		SessionSID:    sessionSID,
+		JoinedAt:      joinedAt,
+	}
+	if err := s.participantRepo.StartSession(ctx, session); err != nil {
+		return fmt.Errorf("failed to start attendance session: %w", err)
+	}
+	return nil
+}
+
+// RecordSessionEnd records a participant disconnecting from the LiveKit room (participant_left webhook).
+// When the session start was missed, the whole session is recorded from the LiveKit join time.
+func (s *RoomService) RecordSessionEnd(ctx context.Context, roomID uuid.UUID, identity, sessionSID string, joinedAt, leftAt time.Time) error {
+	ended, err := s.participantRepo.EndSession(ctx, roomID, sessionSID, leftAt)
+	if err != nil {
+		return fmt.Errorf("failed to end attendance session: %w", err)
+	}
+	if ended {
+		return nil
+	}
+
+	participant, err := s.findByIdentity(ctx, roomID, identity)
+	if err != nil {
+		return err
+	}
+
+	duration := int(leftAt.Sub(joinedAt).Seconds())
+	session := &entities.AttendanceSession{
+		RoomID:        roomID,
+		ParticipantID: participant.ID,
+		Identity:      identity,
+		SessionSID:    sessionSID,
+		JoinedAt:      joinedAt,
+		LeftAt:        &leftAt,
+		Duration:      &duration,
+	}
+	if err := s.participantRepo.StartSession(ctx, session); err != nil {
+		return fmt.Errorf("failed to record attendance session: %w", err)
+	}
+
+	log.Printf("[Room] ⚠️  Attendance session recorded without its start: room=%s, identity=%s, sid=%s", roomID, identity, sessionSID)
+	return nil
+}
+
+// GetAttendance computes who attended a meeting and for how long (host or co-host).
+// Invited participants who never connected are listed as absent.
+func (s *RoomService) GetAttendance(ctx context.Context, roomID, userID uuid.UUID) (*entities.Room, []*entities.Attendance, error) {
+	room, err := s.GetRoomForUser(ctx, roomID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := s.Authorize(ctx, room, userID, entities.RoomActionViewAttendance); err != nil {
+		return nil, nil, err
+	}
+
+	participants, err := s.participantRepo.FindByRoomID(ctx, roomID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get participants: %w", err)
+	}
+	sessions, err := s.participantRepo.FindSessions(ctx, roomID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get attendance sessions: %w", err)
+	}
+
+	byParticipant := make(map[uuid.UUID][]*entities.AttendanceSession)
+	for _, session := range sessions {
+		byParticipant[session.ParticipantID] = append(byParticipant[session.ParticipantID], session)
+	}
+
+	now := time.Now()
+	attendances := make([]*entities.Attendance, 0, len(participants))
+	for _, p := range participants {
+		own := byParticipant[p.ID]
+		if len(own) == 0 && p.Status != entities.ParticipantStatusInvited {
+			continue
+		}
+		attendances = append(attendances, entities.SummarizeAttendance(room, p, own, now))
+	}
+
+	// Earliest arrivals first, absentees last
+	sort.SliceStable(attendances, func(i, j int) bool {
+		a, b := attendances[i].FirstJoinedAt, attendances[j].FirstJoinedAt
+		if a == nil || b == nil {
+			return a != nil
+		}
+		return a.Before(*b)
+	})
+
+	return room, attendances, nil
+}
+
+// findByIdentity retrieves the participant of a room with the given LiveKit identity
+func (s *RoomService) findByIdentity(ctx context.Context, roomID uuid.UUID, identity string) (*entities.Participant, error) {
+	var participant *entities.Participant
+	var err error
+	if guestID, ok := entities.ParseGuestIdentity(identity); ok {
+		participant, err = s.participantRepo.FindByID(ctx, guestID)
+		if err == nil && participant.RoomID != roomID {
+			return nil, usecaseErrors.ErrNotParticipant
+		}
+	} else {
+		userID, parseErr := uuid.Parse(identity)
+		if parseErr != nil {
+			return nil, usecaseErrors.ErrNotParticipant
+		}
+		participant, err = s.participantRepo.FindByRoomAndUser(ctx, roomID, userID)
+	}
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, usecaseErrors.ErrNotParticipant
+		}
+		return nil, fmt.Errorf("failed to get participant: %w", err)
+	}
+	return participant, nil
+}
@@ -0,0 +1,220 @@
+package room
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+	usecaseErrors "github.com/johnquangdev/meeting-assistant/internal/usecase/errors"
+)
+
+// PostChatMessage posts a message in the chat of an ongoing meeting.
+// replyToID optionally names a message of the same room the new one answers.
+func (s *RoomService) PostChatMessage(ctx context.Context, roomID, participantID uuid.UUID, content string, replyToID *uuid.UUID) (*entities.ChatMessage, error) {
+	participant, err := s.getActiveParticipant(ctx, roomID, participantID)
+	if err != nil {
+		return nil, err
+	}
+	if participant.Room != nil && !participant.Room.GetSettings().EnableChat {
+		return nil, usecaseErrors.ErrChatDisabled
+	}
+
+	content, err = normalizeChatContent(content)
+	if err != nil {
+		return nil, err
+	}
+
+	if replyToID != nil {
+		if _, err := s.getChatMessage(ctx, roomID, *replyToID); err != nil {
+			return nil, err
+		}
+	}
+
+	message := &entities.ChatMessage{
+		RoomID:        roomID,
+		ParticipantID: participant.ID,
+		Participant:   participant,
+		Content:       content,
+		ReplyToID:     replyToID,
+		CreatedAt:     time.Now(),
+	}
+	if err := s.chatRepo.Create(ctx, message); err != nil {
+		return nil, fmt.Errorf("failed to post chat message: %w", err)
+	}
+
+	s.publishChatEvent(ctx, entities.RoomEventChatMessage, message)
+
+	return message, nil
+}
+
+// ListChatMessages retrieves a page of a room's chat in chronological order, with messages posted
+// before a time (the latest ones when before is nil). hasMore reports whether older messages remain.
+// Participants that were in the meeting may read the chat, also after it ended.
+func (s *RoomService) ListChatMessages(ctx context.Context, roomID, participantID uuid.UUID, before *time.Time, limit int) ([]*entities.ChatMessage, bool, error) {
+	if _, err := s.getMeetingMember(ctx, roomID, participantID); err != nil {
+		return nil, false, err
+	}
+
+	messages, err := s.chatRepo.List(ctx, roomID, before, limit+1)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get chat messages: %w", err)
+	}
+
+	hasMore := len(messages) > limit
+	if hasMore {
+		messages = messages[:limit]
+	}
+	slices.Reverse(messages)
+
+	return messages, hasMore, nil
+}
+
+// EditChatMessage replaces the content of a message (author only, while the meeting is running)
+func (s *RoomService) EditChatMessage(ctx context.Context, roomID, participantID, messageID uuid.UUID, content string) (*entities.ChatMessage, error) {
+	if _, err := s.getActiveParticipant(ctx, roomID, participantID); err != nil {
+		return nil, err
+	}
+
+	message, err := s.getChatMessage(ctx, roomID, messageID)
+	if err != nil {
+		return nil, err
+	}
+	if message.ParticipantID != participantID {
+		return nil, usecaseErrors.ErrNotMessageAuthor
+	}
+	if message.IsDeleted() {
+		return nil, usecaseErrors.ErrChatMessageDeleted
+	}
+
+	content, err = normalizeChatContent(content)
+	if err != nil {
+		return nil, err
+	}
+
+	message.Edit(content)
+	if err := s.chatRepo.Update(ctx, message); err != nil {
+		return nil, fmt.Errorf("failed to edit chat message: %w", err)
+	}
+
+	s.publishChatEvent(ctx, entities.RoomEventChatMessageEdited, message)
+
+	return message, nil
+}
+
+// DeleteChatMessage deletes a message. Authors may delete their own messages;
+// moderators (host, co-hosts) may delete anyone's. Deleted messages stay listed as placeholders.
+func (s *RoomService) DeleteChatMessage(ctx context.Context, roomID, participantID, messageID uuid.UUID) (*entities.ChatMessage, error) {
+	participant, err := s.getMeetingMember(ctx, roomID, participantID)
+	if err != nil {
+		return nil, err
+	}
+
+	message, err := s.getChatMessage(ctx, roomID, messageID)
+	if err != nil {
+		return nil, err
+	}
+	if message.IsDeleted() {
+		return message, nil
+	}
+
+	if message.ParticipantID != participantID {
+		if participant.UserID == nil {
+			return nil, usecaseErrors.ErrCannotModerate
+		}
+		room, err := s.GetRoom(ctx, roomID)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.Authorize(ctx, room, *participant.UserID, entities.RoomActionModerateChat); err != nil {
+			if errors.Is(err, usecaseErrors.ErrNotHost) {
+				return nil, usecaseErrors.ErrCannotModerate
+			}
+			return nil, err
+		}
+	}
+
+	message.Delete(participantID)
+	if err := s.chatRepo.Update(ctx, message); err != nil {
+		return nil, fmt.Errorf("failed to delete chat message: %w", err)
+	}
+
+	if message.IsModerated() {
+		log.Printf("[Room] 🧹 Chat message removed by moderator: room=%s, message=%s, moderator=%s", roomID, messageID, participantID)
+	}
+	s.publishChatEvent(ctx, entities.RoomEventChatMessageDeleted, message)
+
+	return message, nil
+}
+
+// getMeetingMember retrieves a participant who is or was in the meeting (allowed to read its chat and polls)
+func (s *RoomService) getMeetingMember(ctx context.Context, roomID, participantID uuid.UUID) (*entities.Participant, error) {
+	participant, err := s.participantRepo.FindByID(ctx, participantID)
+	if err != nil || participant.RoomID != roomID {
+		return nil, usecaseErrors.ErrParticipantNotFound
+	}
+	if participant.IsRemoved || (participant.Status != entities.ParticipantStatusJoined && participant.Status != entities.ParticipantStatusLeft) {
+		return nil, usecaseErrors.ErrInvalidParticipantStatus
+	}
+	return participant, nil
+}
+
+// getChatMessage retrieves a message of a room's chat
+func (s *RoomService) getChatMessage(ctx context.Context, roomID, messageID uuid.UUID) (*entities.ChatMessage, error) {
+	message, err := s.chatRepo.FindByID(ctx, messageID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, usecaseErrors.ErrChatMessageNotFound
+		}
+		return nil, fmt.Errorf("failed to get chat message: %w", err)
+	}
+	if message.RoomID != roomID {
+		return nil, usecaseErrors.ErrChatMessageNotFound
+	}
+	return message, nil
+}
+
+// publishChatEvent tells the room about a new, edited or deleted chat message
+func (s *RoomService) publishChatEvent(ctx context.Context, eventType entities.RoomEventType, message *entities.ChatMessage) {
+	data := map[string]interface{}{
+		"message_id":     message.ID,
+		"participant_id": message.ParticipantID,
+		"display_name":   message.SenderName(),
+		"created_at":     message.CreatedAt,
+	}
+	switch {
+	case message.IsDeleted():
+		data["deleted_by"] = *message.DeletedBy
+		data["moderated"] = message.IsModerated()
+	default:
+		data["content"] = message.Content
+		if message.ReplyToID != nil {
+			data["reply_to_id"] = *message.ReplyToID
+		}
+		if message.EditedAt != nil {
+			data["edited_at"] = *message.EditedAt
+		}
+	}
+
+	s.events.Publish(ctx, entities.NewRoomEvent(message.RoomID, eventType, data).About(message.ParticipantID))
+}
+
+// normalizeChatContent trims a chat message and checks its length
+func normalizeChatContent(content string) (string, error) {
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return "", usecaseErrors.ErrChatMessageEmpty
+	}
+	if utf8.RuneCountInString(content) > entities.MaxChatMessageLength {
+		return "", usecaseErrors.ErrChatMessageTooLong
+	}
+	return content, nil
+}
@@ -0,0 +1,246 @@
+package room
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+	usecaseErrors "github.com/johnquangdev/meeting-assistant/internal/usecase/errors"
+)
+
+// RecordingConsentStatus tells a participant whether it must consent to be recorded
+type RecordingConsentStatus struct {
+	Required      bool                       // The room records only participants that consented
+	PolicyVersion string                     // Policy version to consent to
+	Consent       *entities.RecordingConsent // Consent to the current policy; nil when not given
+}
+
+// Pending checks if the participant still has to consent
+func (s *RecordingConsentStatus) Pending() bool {
+	return s.Required && s.Consent == nil
+}
+
+// GiveRecordingConsentInput represents input for consenting to be recorded
+type GiveRecordingConsentInput struct {
+	RoomID        uuid.UUID
+	ParticipantID uuid.UUID
+	PolicyVersion string // Policy version the participant was shown
+	ClientIP      string // For the audit trail
+	UserAgent     string // For the audit trail
+}
+
+// GetRecordingConsent retrieves whether a participant must (still) consent to be recorded
+func (s *RoomService) GetRecordingConsent(ctx context.Context, roomID, participantID uuid.UUID) (*RecordingConsentStatus, error) {
+	participant, err := s.participantRepo.FindByID(ctx, participantID)
+	if err != nil || participant.RoomID != roomID {
+		return nil, usecaseErrors.ErrParticipantNotFound
+	}
+
+	room := participant.Room
+	if room == nil {
+		if room, err = s.GetRoom(ctx, roomID); err != nil {
+			return nil, err
+		}
+	}
+	return s.recordingConsentStatus(ctx, room, participant.ID)
+}
+
+// GiveRecordingConsent records that a participant consents to be recorded under the current policy.
+// A recording paused for consent resumes once everyone in the meeting consented.
+func (s *RoomService) GiveRecordingConsent(ctx context.Context, input GiveRecordingConsentInput) (*RecordingConsentStatus, error) {
+	participant, err := s.getActiveParticipant(ctx, input.RoomID, input.ParticipantID)
+	if err != nil {
+		return nil, err
+	}
+	if input.PolicyVersion != s.consentPolicy {
+		return nil, usecaseErrors.ErrConsentPolicyOutdated
+	}
+
+	consent := &entities.RecordingConsent{
+		RoomID:        input.RoomID,
+		ParticipantID: participant.ID,
+		UserID:        participant.UserID,
+		DisplayName:   participant.DisplayName(),
+		PolicyVersion: input.PolicyVersion,
+		ConsentedAt:   time.Now(),
+	}
+	if input.ClientIP != "" {
+		consent.IPAddress = &input.ClientIP
+	}
+	if input.UserAgent != "" {
+		consent.UserAgent = &input.UserAgent
+	}
+	if err := s.recordingRepo.CreateConsent(ctx, consent); err != nil {
+		return nil, fmt.Errorf("failed to save recording consent: %w", err)
+	}
+
+	log.Printf("[Room] ✅ Recording consent given: room=%s, participant=%s, policy=%s", input.RoomID, participant.ID, input.PolicyVersion)
+
+	room := participant.Room
+	if room == nil {
+		if room, err = s.GetRoom(ctx, input.RoomID); err != nil {
+			return nil, err
+		}
+	}
+	s.enforceRecordingConsent(ctx, room)
+
+	return s.recordingConsentStatus(ctx, room, participant.ID)
+}
+
+// ListRecordingConsents retrieves every recording consent given in a room, for audits (host and co-hosts)
+func (s *RoomService) ListRecordingConsents(ctx context.Context, roomID, userID uuid.UUID) (*entities.Room, []*entities.RecordingConsent, error) {
+	room, err := s.GetRoomForUser(ctx, roomID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := s.Authorize(ctx, room, userID, entities.RoomActionViewConsents); err != nil {
+		return nil, nil, err
+	}
+
+	consents, err := s.recordingRepo.FindConsentsByRoomID(ctx, roomID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get recording consents: %w", err)
+	}
+	return room, consents, nil
+}
+
+// recordingConsentStatus retrieves the consent of a participant to the current policy
+func (s *RoomService) recordingConsentStatus(ctx context.Context, room *entities.Room, participantID uuid.UUID) (*RecordingConsentStatus, error) {
+	settings := room.GetSettings()
+	status := &RecordingConsentStatus{
+		Required:      settings.EnableRecording && settings.RequireRecordingConsent,
+		PolicyVersion: s.consentPolicy,
+	}
+
+	consent, err := s.recordingRepo.FindConsent(ctx, participantID, s.consentPolicy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recording consent: %w", err)
+	}
+	status.Consent = consent
+	return status, nil
+}
+
+// pendingConsents retrieves the participants in the meeting that did not consent to the current policy,
+// along with the number of participants in the meeting
+func (s *RoomService) pendingConsents(ctx context.Context, roomID uuid.UUID) ([]*entities.Participant, int, error) {
+	participants, err := s.participantRepo.FindActiveByRoomID(ctx, roomID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get participants: %w", err)
+	}
+
+	consented, err := s.recordingRepo.FindConsentedParticipantIDs(ctx, roomID, s.consentPolicy)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get recording consents: %w", err)
+	}
+	given := make(map[uuid.UUID]bool, len(consented))
+	for _, id := range consented {
+		given[id] = true
+	}
+
+	var pending []*entities.Participant
+	for _, participant := range participants {
+		if !given[participant.ID] {
+			pending = append(pending, participant)
+		}
+	}
+	return pending, len(participants), nil
+}
+
+// checkRecordingConsent refuses to record a room while someone in the meeting has not consented
+func (s *RoomService) checkRecordingConsent(ctx context.Context, room *entities.Room) error {
+	if !room.GetSettings().RequireRecordingConsent {
+		return nil
+	}
+
+	pending, _, err := s.pendingConsents(ctx, room.ID)
+	if err != nil {
+		return err
+	}
+	if len(pending) > 0 {
+		s.publishConsentRequired(ctx, room.ID, pending)
+		return usecaseErrors.ErrRecordingConsentRequired
+	}
+	return nil
+}
+
+// enforceRecordingConsent pauses the recording of a room while someone in the meeting has not consented,
+// and resumes it (or starts it, in rooms recording automatically) once everyone did.
+// It runs whenever people join or leave; failures are logged so they never fail the join or leave.
+func (s *RoomService) enforceRecordingConsent(ctx context.Context, room *entities.Room) {
+	settings := room.GetSettings()
+	if !settings.EnableRecording || room.IsEnded() {
+		return
+	}
+
+	recording, err := s.recordingRepo.FindActiveByRoomID(ctx, room.ID)
+	if err != nil {
+		log.Printf("[Room] ⚠️ Failed to get recording: room=%s, err=%v", room.ID, err)
+		return
+	}
+	pausedForConsent := recording != nil && recording.IsPaused() && recording.PauseReason() == entities.RecordingPauseReasonConsent
+
+	var pending []*entities.Participant
+	var present int
+	if settings.RequireRecordingConsent {
+		if pending, present, err = s.pendingConsents(ctx, room.ID); err != nil {
+			log.Printf("[Room] ⚠️ Failed to check recording consent: room=%s, err=%v", room.ID, err)
+			return
+		}
+	} else if !pausedForConsent {
+		return
+	}
+
+	switch {
+	case len(pending) > 0:
+		// A recording whose egress is still starting is paused on the next join or leave
+		if recording == nil || recording.IsPaused() || recording.LivekitEgressID == nil {
+			return
+		}
+		if err := s.pauseRecording(ctx, recording, nil); err != nil {
+			log.Printf("[Room] ⚠️ Failed to pause recording for consent: room=%s, recording=%s, err=%v", room.ID, recording.ID, err)
+			return
+		}
+		s.publishConsentRequired(ctx, room.ID, pending)
+		log.Printf("[Room] ⏸️ Recording paused until everyone consented: room=%s, recording=%s, pending=%d", room.ID, recording.ID, len(pending))
+
+	case pausedForConsent:
+		if err := s.resumeRecording(ctx, room, recording, nil); err != nil {
+			log.Printf("[Room] ⚠️ Failed to resume recording after consent: room=%s, recording=%s, err=%v", room.ID, recording.ID, err)
+			return
+		}
+		log.Printf("[Room] ▶️ Recording resumed, everyone consented: room=%s, recording=%s", room.ID, recording.ID)
+
+	case recording == nil && present > 0 && settings.ShouldAutoRecord():
+		// Rooms recording automatically start once everyone consented, but never restart a recording someone stopped
+		recordings, err := s.recordingRepo.FindByRoomID(ctx, room.ID)
+		if err != nil {
+			log.Printf("[Room] ⚠️ Failed to get recordings: room=%s, err=%v", room.ID, err)
+			return
+		}
+		if len(recordings) > 0 {
+			return
+		}
+		recording, err := s.startRecording(ctx, room, nil)
+		if err != nil {
+			log.Printf("[Room] ⚠️ Failed to start recording after consent: room=%s, err=%v", room.ID, err)
+			return
+		}
+		log.Printf("[Room] ⏺️ Recording started automatically, everyone consented: room=%s, recording=%s", room.ID, recording.ID)
+	}
+}
+
+// publishConsentRequired asks the participants that did not consent yet to consent to be recorded
+func (s *RoomService) publishConsentRequired(ctx context.Context, roomID uuid.UUID, pending []*entities.Participant) {
+	participantIDs := make([]uuid.UUID, len(pending))
+	for i, participant := range pending {
+		participantIDs[i] = participant.ID
+	}
+	s.events.Publish(ctx, entities.NewRoomEvent(roomID, entities.RoomEventRecordingConsent, map[string]interface{}{
+		"policy_version":  s.consentPolicy,
+		"participant_ids": participantIDs,
+	}))
+}
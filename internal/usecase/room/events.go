@@ -0,0 +1,47 @@
+package room
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+)
+
+// PublishEvent delivers an event to the room's event stream on every API instance
+func (s *RoomService) PublishEvent(ctx context.Context, event *entities.RoomEvent) {
+	s.events.Publish(ctx, event)
+}
+
+// SubscribeEvents returns the event stream of a room and a function that ends the subscription
+func (s *RoomService) SubscribeEvents(roomID uuid.UUID) (<-chan *entities.RoomEvent, func()) {
+	return s.events.Subscribe(roomID)
+}
+
+// publishParticipantEvent publishes an event about a participant
+func (s *RoomService) publishParticipantEvent(ctx context.Context, eventType entities.RoomEventType, participant *entities.Participant, data map[string]interface{}) {
+	s.events.Publish(ctx, entities.NewParticipantEvent(eventType, participant, data))
+}
+
+// publishRoomEnded tells everyone in the room that the meeting is over
+func (s *RoomService) publishRoomEnded(ctx context.Context, roomID uuid.UUID, reason string) {
+	s.events.Publish(ctx, entities.NewRoomEvent(roomID, entities.RoomEventRoomEnded, map[string]interface{}{
+		"reason": reason,
+	}))
+}
+
+// publishHostTransferred tells everyone in the room who the new host is
+func (s *RoomService) publishHostTransferred(ctx context.Context, roomID uuid.UUID, previousHostID *uuid.UUID, newHost *entities.Participant) {
+	data := map[string]interface{}{
+		"participant_id": newHost.ID,
+		"display_name":   newHost.DisplayName(),
+	}
+	if newHost.UserID != nil {
+		data["new_host_id"] = *newHost.UserID
+	}
+	if previousHostID != nil {
+		data["previous_host_id"] = *previousHostID
+	}
+
+	s.events.Publish(ctx, entities.NewRoomEvent(roomID, entities.RoomEventHostTransferred, data).About(newHost.ID))
+}
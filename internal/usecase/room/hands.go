@@ -0,0 +1,226 @@
+package room
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+	usecaseErrors "github.com/johnquangdev/meeting-assistant/internal/usecase/errors"
+)
+
+// RaiseHand puts a participant at the end of the room's speaking queue (no-op if the hand is already up)
+func (s *RoomService) RaiseHand(ctx context.Context, roomID, participantID uuid.UUID) (*entities.Participant, error) {
+	participant, err := s.getActiveParticipant(ctx, roomID, participantID)
+	if err != nil {
+		return nil, err
+	}
+	if participant.IsHandRaised {
+		return participant, nil
+	}
+
+	if err := s.raiseHand(ctx, participant); err != nil {
+		return nil, err
+	}
+
+	log.Printf("[Room] ✋ Hand raised: room=%s, participant=%s, position=%d", roomID, participantID, *participant.HandQueuePosition)
+
+	return participant, nil
+}
+
+// LowerHand takes a participant's own hand down (no-op if the hand is not raised)
+func (s *RoomService) LowerHand(ctx context.Context, roomID, participantID uuid.UUID) (*entities.Participant, error) {
+	participant, err := s.participantRepo.FindByID(ctx, participantID)
+	if err != nil || participant.RoomID != roomID {
+		return nil, usecaseErrors.ErrParticipantNotFound
+	}
+	if !participant.IsHandRaised {
+		return participant, nil
+	}
+
+	if err := s.lowerHands(ctx, roomID, []uuid.UUID{participantID}, nil); err != nil {
+		return nil, err
+	}
+	participant.LowerHand()
+
+	return participant, nil
+}
+
+// LowerParticipantHand lowers the hand of another participant, e.g. once they have been called on (moderators only)
+func (s *RoomService) LowerParticipantHand(ctx context.Context, roomID, moderatorID, participantID uuid.UUID) (*entities.Participant, error) {
+	if _, err := s.authorizeHands(ctx, roomID, moderatorID); err != nil {
+		return nil, err
+	}
+
+	participant, err := s.participantRepo.FindByID(ctx, participantID)
+	if err != nil || participant.RoomID != roomID {
+		return nil, usecaseErrors.ErrParticipantNotFound
+	}
+	if !participant.IsHandRaised {
+		return nil, usecaseErrors.ErrHandNotRaised
+	}
+
+	if err := s.lowerHands(ctx, roomID, []uuid.UUID{participantID}, &moderatorID); err != nil {
+		return nil, err
+	}
+	participant.LowerHand()
+
+	return participant, nil
+}
+
+// LowerAllHands clears the speaking queue of a room (moderators only) and returns how many hands were lowered
+func (s *RoomService) LowerAllHands(ctx context.Context, roomID, moderatorID uuid.UUID) (int, error) {
+	if _, err := s.authorizeHands(ctx, roomID, moderatorID); err != nil {
+		return 0, err
+	}
+
+	lowered, err := s.participantRepo.LowerHands(ctx, roomID, nil, &moderatorID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to lower hands: %w", err)
+	}
+	if len(lowered) > 0 {
+		s.publishHandQueue(ctx, roomID, nil)
+	}
+
+	log.Printf("[Room] ✋ All hands lowered: room=%s, count=%d, by=%s", roomID, len(lowered), moderatorID)
+
+	return len(lowered), nil
+}
+
+// ReorderHands changes the speaking order of the raised hands (moderators only).
+// Listed participants are called first, in the given order; the others keep their order after them.
+func (s *RoomService) ReorderHands(ctx context.Context, roomID, moderatorID uuid.UUID, order []uuid.UUID) ([]*entities.Participant, error) {
+	if _, err := s.authorizeHands(ctx, roomID, moderatorID); err != nil {
+		return nil, err
+	}
+
+	queue, err := s.participantRepo.FindRaisedHands(ctx, roomID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get raised hands: %w", err)
+	}
+	raised := make(map[uuid.UUID]bool, len(queue))
+	for _, p := range queue {
+		raised[p.ID] = true
+	}
+	for _, id := range order {
+		if !raised[id] {
+			return nil, usecaseErrors.ErrHandNotRaised
+		}
+	}
+
+	if err := s.participantRepo.ReorderHands(ctx, roomID, order); err != nil {
+		return nil, fmt.Errorf("failed to reorder hands: %w", err)
+	}
+
+	queue, err = s.participantRepo.FindRaisedHands(ctx, roomID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get raised hands: %w", err)
+	}
+	s.publishHandQueue(ctx, roomID, queue)
+
+	return queue, nil
+}
+
+// GetHandQueue retrieves the raised hands of a room in speaking order (host or participants of the room)
+func (s *RoomService) GetHandQueue(ctx context.Context, roomID, userID uuid.UUID) ([]*entities.Participant, error) {
+	room, err := s.GetRoomForUser(ctx, roomID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if room.HostID != userID {
+		participant, err := s.participantRepo.FindByRoomAndUser(ctx, roomID, userID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, usecaseErrors.ErrNotParticipant
+			}
+			return nil, fmt.Errorf("failed to get participant: %w", err)
+		}
+		if participant.IsRemoved {
+			return nil, usecaseErrors.ErrNotParticipant
+		}
+	}
+
+	queue, err := s.participantRepo.FindRaisedHands(ctx, roomID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get raised hands: %w", err)
+	}
+	return queue, nil
+}
+
+// getActiveParticipant retrieves a participant of a room that is currently in the meeting
+func (s *RoomService) getActiveParticipant(ctx context.Context, roomID, participantID uuid.UUID) (*entities.Participant, error) {
+	participant, err := s.participantRepo.FindByID(ctx, participantID)
+	if err != nil || participant.RoomID != roomID {
+		return nil, usecaseErrors.ErrParticipantNotFound
+	}
+	if participant.Room != nil && participant.Room.IsEnded() {
+		return nil, usecaseErrors.ErrRoomEnded
+	}
+	if !participant.IsActive() {
+		return nil, usecaseErrors.ErrInvalidParticipantStatus
+	}
+	return participant, nil
+}
+
+// authorizeHands checks that a user may manage the speaking queue of an ongoing meeting
+func (s *RoomService) authorizeHands(ctx context.Context, roomID, userID uuid.UUID) (*entities.Room, error) {
+	room, err := s.GetRoom(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+	if room.IsEnded() {
+		return nil, usecaseErrors.ErrRoomEnded
+	}
+	if err := s.Authorize(ctx, room, userID, entities.RoomActionManageHands); err != nil {
+		return nil, err
+	}
+	return room, nil
+}
+
+// raiseHand queues a participant and tells the room
+func (s *RoomService) raiseHand(ctx context.Context, participant *entities.Participant) error {
+	if err := s.participantRepo.RaiseHand(ctx, participant); err != nil {
+		return fmt.Errorf("failed to raise hand: %w", err)
+	}
+
+	s.publishParticipantEvent(ctx, entities.RoomEventHandRaised, participant, map[string]interface{}{
+		"position":  *participant.HandQueuePosition,
+		"raised_at": participant.HandRaisedAt,
+	})
+	return nil
+}
+
+// lowerHands takes participants out of the speaking queue and tells the room.
+// loweredBy is the moderator lowering the hands (nil when participants lower their own or leave).
+func (s *RoomService) lowerHands(ctx context.Context, roomID uuid.UUID, participantIDs []uuid.UUID, loweredBy *uuid.UUID) error {
+	lowered, err := s.participantRepo.LowerHands(ctx, roomID, participantIDs, loweredBy)
+	if err != nil {
+		return fmt.Errorf("failed to lower hand: %w", err)
+	}
+
+	for _, id := range lowered {
+		data := map[string]interface{}{"participant_id": id}
+		if loweredBy != nil {
+			data["lowered_by"] = *loweredBy
+		}
+		s.events.Publish(ctx, entities.NewRoomEvent(roomID, entities.RoomEventHandLowered, data).About(id))
+	}
+	return nil
+}
+
+// publishHandQueue sends the whole speaking order to the room (after a reorder or lowering all hands)
+func (s *RoomService) publishHandQueue(ctx context.Context, roomID uuid.UUID, queue []*entities.Participant) {
+	order := make([]uuid.UUID, 0, len(queue))
+	for _, p := range queue {
+		order = append(order, p.ID)
+	}
+
+	s.events.Publish(ctx, entities.NewRoomEvent(roomID, entities.RoomEventHandQueueUpdated, map[string]interface{}{
+		"queue": order,
+	}))
+}
@@ -0,0 +1,411 @@
+package room
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+	lkpkg "github.com/johnquangdev/meeting-assistant/internal/infrastructure/external/livekit"
+	usecaseErrors "github.com/johnquangdev/meeting-assistant/internal/usecase/errors"
+)
+
+// reservedMetadataKeys are participant metadata keys managed by the server.
+// They cannot be changed by moderators and are not pushed to LiveKit.
+var reservedMetadataKeys = map[string]bool{
+	"invitation_id":         true,
+	"parent_participant_id": true,
+}
+
+// MuteParticipantInput represents input for muting or unmuting a participant's tracks
+type MuteParticipantInput struct {
+	RoomID        uuid.UUID
+	ModeratorID   uuid.UUID
+	ParticipantID uuid.UUID
+	Muted         bool
+	Sources       []string // Track sources to (un)mute, defaults to the microphone
+}
+
+// UpdateParticipantInput represents a partial moderation update of a participant.
+// Nil fields are left unchanged; Metadata keys are merged and a nil value deletes the key.
+type UpdateParticipantInput struct {
+	RoomID         uuid.UUID
+	ModeratorID    uuid.UUID
+	ParticipantID  uuid.UUID
+	IsMuted        *bool
+	IsHandRaised   *bool
+	CanShareScreen *bool
+	CanPublish     *bool
+	CanRecord      *bool // host only
+	CanMuteOthers  *bool // host only
+	Metadata       map[string]interface{}
+}
+
+// MuteParticipant mutes or unmutes the published tracks of a participant (host or CanMuteOthers only)
+func (s *RoomService) MuteParticipant(ctx context.Context, input MuteParticipantInput) (*entities.Participant, error) {
+	sources := input.Sources
+	if len(sources) == 0 {
+		sources = []string{lkpkg.SourceMicrophone}
+	}
+	for _, source := range sources {
+		if !isTrackSource(source) {
+			return nil, usecaseErrors.ErrInvalidTrackSource
+		}
+	}
+
+	room, participant, err := s.getModerationTarget(ctx, input.RoomID, input.ModeratorID, input.ParticipantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.muteTracks(ctx, room, participant, input.Muted, sources); err != nil {
+		return nil, err
+	}
+
+	// Only the microphone is mirrored in the DB
+	if slices.Contains(sources, lkpkg.SourceMicrophone) {
+		participant.IsMuted = input.Muted
+		if err := s.participantRepo.Update(ctx, participant); err != nil {
+			return nil, fmt.Errorf("failed to update participant: %w", err)
+		}
+	}
+
+	log.Printf("[Room] 🔇 Participant tracks muted=%t: room=%s, participant=%s, sources=%v, by=%s",
+		input.Muted, room.ID, participant.ID, sources, input.ModeratorID)
+
+	return participant, nil
+}
+
+// UpdateParticipant applies moderation changes to a participant (host or CanMuteOthers only).
+// Permission and metadata changes are pushed to LiveKit so they take effect immediately.
+func (s *RoomService) UpdateParticipant(ctx context.Context, input UpdateParticipantInput) (*entities.Participant, error) {
+	room, participant, err := s.getModerationTarget(ctx, input.RoomID, input.ModeratorID, input.ParticipantID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Granting privileges is part of managing co-hosts
+	if input.CanRecord != nil || input.CanMuteOthers != nil {
+		if err := s.Authorize(ctx, room, input.ModeratorID, entities.RoomActionManageCoHosts); err != nil {
+			return nil, err
+		}
+		if participant.IsGuest() {
+			return nil, usecaseErrors.ErrGuestCannotBeCoHost
+		}
+		if input.CanRecord != nil {
+			participant.CanRecord = *input.CanRecord
+		}
+		if input.CanMuteOthers != nil {
+			participant.CanMuteOthers = *input.CanMuteOthers
+		}
+	}
+
+	if input.IsMuted != nil {
+		if err := s.muteTracks(ctx, room, participant, *input.IsMuted, []string{lkpkg.SourceMicrophone}); err != nil {
+			return nil, err
+		}
+		participant.IsMuted = *input.IsMuted
+	}
+	if input.IsHandRaised != nil && *input.IsHandRaised != participant.IsHandRaised {
+		// Keep the speaking queue in sync with the flag
+		if *input.IsHandRaised {
+			if err := s.raiseHand(ctx, participant); err != nil {
+				return nil, err
+			}
+		} else {
+			if err := s.lowerHands(ctx, room.ID, []uuid.UUID{participant.ID}, &input.ModeratorID); err != nil {
+				return nil, err
+			}
+			participant.LowerHand()
+		}
+	}
+
+	var options lkpkg.UpdateParticipantOptions
+
+	permissionsChanged := false
+	if input.CanShareScreen != nil && *input.CanShareScreen != participant.CanShareScreen {
+		participant.CanShareScreen = *input.CanShareScreen
+		permissionsChanged = true
+	}
+	if input.CanPublish != nil && *input.CanPublish != participant.CanPublish {
+		participant.CanPublish = *input.CanPublish
+		permissionsChanged = true
+	}
+	if permissionsChanged {
+		options.Permission = s.participantPermission(room, participant)
+	}
+
+	if len(input.Metadata) > 0 {
+		metadata, public, err := mergeMetadata(participant.Metadata, input.Metadata)
+		if err != nil {
+			return nil, err
+		}
+		participant.Metadata = metadata
+		options.Metadata = public
+	}
+
+	if options.Permission != nil || options.Metadata != "" {
+		if _, err := s.livekitClient.UpdateParticipant(ctx, room.LivekitRoomName, participant.Identity(), &options); err != nil {
+			return nil, fmt.Errorf("failed to update LiveKit participant: %w", err)
+		}
+	}
+
+	if err := s.participantRepo.Update(ctx, participant); err != nil {
+		return nil, fmt.Errorf("failed to update participant: %w", err)
+	}
+
+	log.Printf("[Room] 🛡️ Participant updated: room=%s, participant=%s, by=%s", room.ID, participant.ID, input.ModeratorID)
+
+	return participant, nil
+}
+
+// Authorize checks that a user may perform a privileged action in a room (see entities.RoomAction).
+// The room host may do anything; co-hosts and participants follow the permission matrix, and
+// owners and admins of the room's organization may also perform the actions granted to them.
+func (s *RoomService) Authorize(ctx context.Context, room *entities.Room, userID uuid.UUID, action entities.RoomAction) error {
+	if room.HostID == userID {
+		return nil
+	}
+	if action.GrantedToOrgManagers() && room.OrganizationID != nil {
+		member, err := s.organizationMember(ctx, *room.OrganizationID, userID)
+		if err == nil && member.Role.CanManage() {
+			return nil
+		}
+		if err != nil && !errors.Is(err, usecaseErrors.ErrNotOrganizationMember) {
+			return err
+		}
+	}
+
+	participant, err := s.participantRepo.FindByRoomAndUser(ctx, room.ID, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return usecaseErrors.ErrNotHost
+		}
+		return fmt.Errorf("failed to get participant: %w", err)
+	}
+	if !participant.Can(action) {
+		return usecaseErrors.ErrNotHost
+	}
+
+	return nil
+}
+
+// SetCoHost promotes a participant to co-host, or demotes a co-host back to participant (host only)
+func (s *RoomService) SetCoHost(ctx context.Context, roomID, hostID, participantID uuid.UUID, coHost bool) (*entities.Participant, error) {
+	room, err := s.roomRepo.FindByID(ctx, roomID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, usecaseErrors.ErrRoomNotFound
+		}
+		return nil, fmt.Errorf("failed to get room: %w", err)
+	}
+	if room.IsEnded() {
+		return nil, usecaseErrors.ErrRoomEnded
+	}
+
+	if err := s.Authorize(ctx, room, hostID, entities.RoomActionManageCoHosts); err != nil {
+		return nil, err
+	}
+
+	participant, err := s.participantRepo.FindByID(ctx, participantID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, usecaseErrors.ErrParticipantNotFound
+		}
+		return nil, fmt.Errorf("failed to get participant: %w", err)
+	}
+	if participant.RoomID != roomID {
+		return nil, usecaseErrors.ErrParticipantNotFound
+	}
+	if participant.UserID != nil && *participant.UserID == room.HostID {
+		return nil, usecaseErrors.ErrCannotChangeHostRole
+	}
+	if participant.IsGuest() {
+		return nil, usecaseErrors.ErrGuestCannotBeCoHost
+	}
+	if participant.IsRemoved {
+		return nil, usecaseErrors.ErrInvalidParticipantStatus
+	}
+
+	role := entities.ParticipantRoleParticipant
+	if coHost {
+		role = entities.ParticipantRoleCoHost
+	}
+	if err := s.participantRepo.UpdateRole(ctx, participant.ID, role); err != nil {
+		return nil, fmt.Errorf("failed to update participant role: %w", err)
+	}
+	if coHost {
+		participant.PromoteToCoHost()
+	} else {
+		participant.DemoteToParticipant()
+	}
+
+	// Let connected clients show the new role; the participant's next token carries the admin grant
+	if participant.IsActive() {
+		if _, err := s.livekitClient.UpdateParticipant(ctx, room.LivekitRoomName, participant.Identity(), &lkpkg.UpdateParticipantOptions{
+			Attributes: map[string]string{"role": string(role)},
+		}); err != nil {
+			log.Printf("[Room] ⚠️ Failed to update LiveKit role of participant %s: %v", participant.ID, err)
+		}
+	}
+
+	log.Printf("[Room] 👥 Participant role changed: room=%s, participant=%s, role=%s, by=%s", room.ID, participant.ID, role, hostID)
+
+	return participant, nil
+}
+
+// checkCanActOn checks that someone other than the room host does not act on the host or a co-host
+func checkCanActOn(room *entities.Room, userID uuid.UUID, participant *entities.Participant) error {
+	if room.HostID == userID {
+		return nil
+	}
+	if participant.IsHost() || (participant.UserID != nil && *participant.UserID == room.HostID) {
+		return usecaseErrors.ErrCannotModerate
+	}
+	return nil
+}
+
+// getModerationTarget checks that the moderator may moderate the participant and returns both room and participant.
+// The room host may moderate anyone; others need CanMuteOthers and cannot moderate the host or co-hosts.
+func (s *RoomService) getModerationTarget(ctx context.Context, roomID, moderatorID, participantID uuid.UUID) (*entities.Room, *entities.Participant, error) {
+	room, err := s.roomRepo.FindByID(ctx, roomID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, usecaseErrors.ErrRoomNotFound
+		}
+		return nil, nil, fmt.Errorf("failed to get room: %w", err)
+	}
+	if room.IsEnded() {
+		return nil, nil, usecaseErrors.ErrRoomEnded
+	}
+
+	if err := s.Authorize(ctx, room, moderatorID, entities.RoomActionMute); err != nil {
+		if errors.Is(err, usecaseErrors.ErrNotHost) {
+			return nil, nil, usecaseErrors.ErrCannotModerate
+		}
+		return nil, nil, err
+	}
+
+	participant, err := s.participantRepo.FindByID(ctx, participantID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, usecaseErrors.ErrParticipantNotFound
+		}
+		return nil, nil, fmt.Errorf("failed to get participant: %w", err)
+	}
+	if participant.RoomID != roomID {
+		return nil, nil, usecaseErrors.ErrParticipantNotFound
+	}
+	if err := checkCanActOn(room, moderatorID, participant); err != nil {
+		return nil, nil, err
+	}
+	if !participant.IsActive() {
+		return nil, nil, usecaseErrors.ErrInvalidParticipantStatus
+	}
+
+	return room, participant, nil
+}
+
+// muteTracks mutes or unmutes the participant's published tracks of the given sources in LiveKit
+func (s *RoomService) muteTracks(ctx context.Context, room *entities.Room, participant *entities.Participant, muted bool, sources []string) error {
+	info, err := s.livekitClient.GetParticipant(ctx, room.LivekitRoomName, participant.Identity())
+	if err != nil {
+		return fmt.Errorf("failed to get LiveKit participant: %w", err)
+	}
+
+	for _, track := range info.Tracks {
+		if track.Muted == muted || !slices.Contains(sources, track.Source) {
+			continue
+		}
+		if _, err := s.livekitClient.MutePublishedTrack(ctx, room.LivekitRoomName, participant.Identity(), track.SID, muted); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// participantPermission builds the live LiveKit permissions of a participant (same rules as its token)
+func (s *RoomService) participantPermission(room *entities.Room, participant *entities.Participant) *lkpkg.ParticipantPermission {
+	options := s.tokenOptions(room.GetSettings(), participant.IsHost())
+	applyParticipantPermissions(options, participant)
+
+	return &lkpkg.ParticipantPermission{
+		CanPublish:        options.CanPublish,
+		CanSubscribe:      options.CanSubscribe,
+		CanPublishData:    options.CanPublishData,
+		CanPublishSources: options.CanPublishSources,
+	}
+}
+
+// applyParticipantPermissions narrows room-wide token permissions to what the participant is allowed,
+// so moderation survives a reconnect
+func applyParticipantPermissions(options *lkpkg.TokenOptions, participant *entities.Participant) {
+	// Guests never share their screen, whatever the room allows
+	if participant.IsGuest() || !participant.CanShareScreen {
+		options.CanPublishSources = []string{lkpkg.SourceCamera, lkpkg.SourceMicrophone}
+	}
+	if !participant.CanPublish {
+		options.CanPublish = false
+	}
+	if participant.IsMuted && options.Attributes != nil {
+		options.Attributes["mute_on_join"] = "true"
+	}
+}
+
+// mergeMetadata merges changes into the participant metadata.
+// It returns the stored metadata and the JSON shown to LiveKit clients (without reserved keys).
+func mergeMetadata(current datatypes.JSON, changes map[string]interface{}) (datatypes.JSON, string, error) {
+	metadata := map[string]interface{}{}
+	if len(current) > 0 {
+		if err := json.Unmarshal(current, &metadata); err != nil {
+			return nil, "", fmt.Errorf("failed to decode participant metadata: %w", err)
+		}
+	}
+
+	for key, value := range changes {
+		if reservedMetadataKeys[key] {
+			return nil, "", usecaseErrors.ErrReservedMetadataKey
+		}
+		if value == nil {
+			delete(metadata, key)
+			continue
+		}
+		metadata[key] = value
+	}
+
+	public := make(map[string]interface{}, len(metadata))
+	for key, value := range metadata {
+		if !reservedMetadataKeys[key] {
+			public[key] = value
+		}
+	}
+
+	stored, err := json.Marshal(metadata)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to encode participant metadata: %w", err)
+	}
+	shown, err := json.Marshal(public)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to encode participant metadata: %w", err)
+	}
+
+	return datatypes.JSON(stored), string(shown), nil
+}
+
+// isTrackSource checks if a source is one LiveKit tracks can be published from
+func isTrackSource(source string) bool {
+	switch source {
+	case lkpkg.SourceCamera, lkpkg.SourceMicrophone, lkpkg.SourceScreenShare, lkpkg.SourceScreenShareAudio:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,70 @@
+package room
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+	usecaseErrors "github.com/johnquangdev/meeting-assistant/internal/usecase/errors"
+)
+
+// AuthorizeOrganization checks that a user is a member of an organization,
+// before listing its rooms or creating a room in it
+func (s *RoomService) AuthorizeOrganization(ctx context.Context, orgID, userID uuid.UUID) error {
+	_, err := s.organizationMember(ctx, orgID, userID)
+	return err
+}
+
+// CanAccessRoom checks that a user may see a room. Personal rooms are not restricted here;
+// organization rooms are visible to the organization's members and to the people invited to them.
+func (s *RoomService) CanAccessRoom(ctx context.Context, room *entities.Room, userID uuid.UUID) error {
+	if room.OrganizationID == nil || room.HostID == userID {
+		return nil
+	}
+
+	_, err := s.organizationMember(ctx, *room.OrganizationID, userID)
+	if err == nil || !errors.Is(err, usecaseErrors.ErrNotOrganizationMember) {
+		return err
+	}
+
+	// Outsiders invited to the meeting (or already in it) keep access, unless removed
+	participant, findErr := s.participantRepo.FindByRoomAndUser(ctx, room.ID, userID)
+	if findErr == nil && participant != nil && !participant.IsRemoved {
+		return nil
+	}
+	return err
+}
+
+// GetRoomForUser retrieves a room the user may see. Rooms of another tenant are reported as not found.
+func (s *RoomService) GetRoomForUser(ctx context.Context, roomID, userID uuid.UUID) (*entities.Room, error) {
+	room, err := s.GetRoom(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.CanAccessRoom(ctx, room, userID); err != nil {
+		if errors.Is(err, usecaseErrors.ErrNotOrganizationMember) {
+			return nil, usecaseErrors.ErrRoomNotFound
+		}
+		return nil, err
+	}
+	return room, nil
+}
+
+// organizationMember retrieves the membership (with organization) of a user
+func (s *RoomService) organizationMember(ctx context.Context, orgID, userID uuid.UUID) (*entities.OrganizationMember, error) {
+	if s.orgRepo == nil {
+		return nil, usecaseErrors.ErrNotOrganizationMember
+	}
+	member, err := s.orgRepo.FindMember(ctx, orgID, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, usecaseErrors.ErrNotOrganizationMember
+		}
+		return nil, fmt.Errorf("failed to get organization member: %w", err)
+	}
+	return member, nil
+}
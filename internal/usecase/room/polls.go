@@ -0,0 +1,412 @@
+package room
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+	usecaseErrors "github.com/johnquangdev/meeting-assistant/internal/usecase/errors"
+)
+
+// maxPollOptions is the most options a choice poll may offer
+const maxPollOptions = 10
+
+// CreatePollInput represents input for creating a poll
+type CreatePollInput struct {
+	RoomID            uuid.UUID
+	UserID            uuid.UUID // Moderator creating the poll
+	Question          string
+	Type              entities.PollType
+	Options           []string // Choices of single- and multiple-choice polls
+	Anonymous         bool
+	Duration          time.Duration // Closes the poll automatically after this long (0 = no deadline)
+	CloseWhenAllVoted bool
+}
+
+// VoteInput represents the vote of a participant: options for choice polls, text for free-text polls.
+// Voting again replaces the previous vote while the poll is open.
+type VoteInput struct {
+	RoomID        uuid.UUID
+	PollID        uuid.UUID
+	ParticipantID uuid.UUID
+	OptionIDs     []uuid.UUID
+	Text          string
+}
+
+// PollView is a poll with its live (or final) results and the votes of the viewer
+type PollView struct {
+	Poll    *entities.Poll
+	Results *entities.PollResults
+	MyVotes []*entities.PollVote
+}
+
+// CreatePoll opens a poll in an ongoing meeting (host or co-host)
+func (s *RoomService) CreatePoll(ctx context.Context, input CreatePollInput) (*PollView, error) {
+	room, err := s.GetRoom(ctx, input.RoomID)
+	if err != nil {
+		return nil, err
+	}
+	if room.IsEnded() {
+		return nil, usecaseErrors.ErrRoomEnded
+	}
+	if err := s.Authorize(ctx, room, input.UserID, entities.RoomActionManagePolls); err != nil {
+		return nil, err
+	}
+
+	creator, err := s.participantRepo.FindByRoomAndUser(ctx, room.ID, input.UserID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, usecaseErrors.ErrNotParticipant
+		}
+		return nil, fmt.Errorf("failed to get participant: %w", err)
+	}
+
+	poll, err := newPoll(room.ID, creator, input)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.pollRepo.Create(ctx, poll); err != nil {
+		return nil, fmt.Errorf("failed to create poll: %w", err)
+	}
+
+	log.Printf("[Room] 📊 Poll opened: room=%s, poll=%s, type=%s", room.ID, poll.ID, poll.Type)
+
+	options := make([]map[string]interface{}, len(poll.Options))
+	for i, o := range poll.Options {
+		options[i] = map[string]interface{}{"id": o.ID, "text": o.Text}
+	}
+	s.events.Publish(ctx, entities.NewRoomEvent(room.ID, entities.RoomEventPollOpened, map[string]interface{}{
+		"poll_id":              poll.ID,
+		"question":             poll.Question,
+		"type":                 poll.Type,
+		"anonymous":            poll.Anonymous,
+		"options":              options,
+		"closes_at":            poll.ClosesAt,
+		"close_when_all_voted": poll.CloseWhenAllVoted,
+		"created_by":           creator.DisplayName(),
+	}))
+
+	return &PollView{Poll: poll, Results: entities.TallyPoll(poll, nil)}, nil
+}
+
+// ListPolls retrieves the polls of a meeting with their results, oldest first
+func (s *RoomService) ListPolls(ctx context.Context, roomID, participantID uuid.UUID) ([]*PollView, error) {
+	if _, err := s.getMeetingMember(ctx, roomID, participantID); err != nil {
+		return nil, err
+	}
+
+	polls, err := s.pollRepo.FindByRoomID(ctx, roomID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get polls: %w", err)
+	}
+
+	views := make([]*PollView, 0, len(polls))
+	for _, poll := range polls {
+		view, err := s.pollView(ctx, poll, participantID)
+		if err != nil {
+			return nil, err
+		}
+		views = append(views, view)
+	}
+	return views, nil
+}
+
+// GetPoll retrieves a poll of a meeting with its results
+func (s *RoomService) GetPoll(ctx context.Context, roomID, pollID, participantID uuid.UUID) (*PollView, error) {
+	if _, err := s.getMeetingMember(ctx, roomID, participantID); err != nil {
+		return nil, err
+	}
+
+	poll, err := s.getPoll(ctx, roomID, pollID)
+	if err != nil {
+		return nil, err
+	}
+	return s.pollView(ctx, poll, participantID)
+}
+
+// Vote casts (or replaces) the vote of a participant in an open poll and shares the live results
+func (s *RoomService) Vote(ctx context.Context, input VoteInput) (*PollView, error) {
+	if _, err := s.getActiveParticipant(ctx, input.RoomID, input.ParticipantID); err != nil {
+		return nil, err
+	}
+
+	poll, err := s.getPoll(ctx, input.RoomID, input.PollID)
+	if err != nil {
+		return nil, err
+	}
+	if poll.IsDue(time.Now()) {
+		if _, err := s.closePoll(ctx, poll, entities.PollCloseDeadline, nil); err != nil {
+			return nil, err
+		}
+	}
+	if !poll.IsOpen() {
+		return nil, usecaseErrors.ErrPollClosed
+	}
+
+	votes, err := newVotes(poll, input)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.pollRepo.ReplaceVotes(ctx, poll.ID, input.ParticipantID, votes); err != nil {
+		return nil, fmt.Errorf("failed to vote: %w", err)
+	}
+
+	all, err := s.pollRepo.FindVotes(ctx, poll.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get votes: %w", err)
+	}
+	results := entities.TallyPoll(poll, all)
+
+	if poll.CloseWhenAllVoted {
+		everyone, err := s.everyoneVoted(ctx, poll.RoomID, all)
+		if err != nil {
+			return nil, err
+		}
+		if everyone {
+			if results, err = s.closePoll(ctx, poll, entities.PollCloseAllVoted, nil); err != nil {
+				return nil, err
+			}
+			return &PollView{Poll: poll, Results: results, MyVotes: votes}, nil
+		}
+	}
+
+	s.events.Publish(ctx, entities.NewRoomEvent(poll.RoomID, entities.RoomEventPollResults, map[string]interface{}{
+		"poll_id": poll.ID,
+		"results": results,
+	}))
+
+	return &PollView{Poll: poll, Results: results, MyVotes: votes}, nil
+}
+
+// ClosePoll stops a poll and stores its final results (host or co-host)
+func (s *RoomService) ClosePoll(ctx context.Context, roomID, pollID, userID uuid.UUID) (*PollView, error) {
+	room, err := s.GetRoom(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Authorize(ctx, room, userID, entities.RoomActionManagePolls); err != nil {
+		return nil, err
+	}
+
+	poll, err := s.getPoll(ctx, roomID, pollID)
+	if err != nil {
+		return nil, err
+	}
+	if !poll.IsOpen() {
+		return &PollView{Poll: poll, Results: poll.FinalResults()}, nil
+	}
+
+	var closedBy *uuid.UUID
+	if moderator, err := s.participantRepo.FindByRoomAndUser(ctx, roomID, userID); err == nil {
+		closedBy = &moderator.ID
+	}
+
+	results, err := s.closePoll(ctx, poll, entities.PollCloseManual, closedBy)
+	if err != nil {
+		return nil, err
+	}
+	return &PollView{Poll: poll, Results: results}, nil
+}
+
+// CloseDuePolls closes the open polls whose deadline passed (called by the scheduler)
+func (s *RoomService) CloseDuePolls(ctx context.Context, now time.Time) (int, error) {
+	polls, err := s.pollRepo.FindDue(ctx, now)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get due polls: %w", err)
+	}
+
+	closed := 0
+	for _, poll := range polls {
+		if _, err := s.closePoll(ctx, poll, entities.PollCloseDeadline, nil); err != nil {
+			log.Printf("[Room] ❌ Failed to close poll %s: %v", poll.ID, err)
+			continue
+		}
+		closed++
+	}
+	return closed, nil
+}
+
+// closeRoomPolls closes the polls still open when a meeting ends
+func (s *RoomService) closeRoomPolls(ctx context.Context, roomID uuid.UUID) error {
+	polls, err := s.pollRepo.FindByRoomID(ctx, roomID)
+	if err != nil {
+		return fmt.Errorf("failed to get polls: %w", err)
+	}
+	for _, poll := range polls {
+		if !poll.IsOpen() {
+			continue
+		}
+		if _, err := s.closePoll(ctx, poll, entities.PollCloseRoomEnded, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// closePoll tallies the final results of a poll, stores them and tells the room
+func (s *RoomService) closePoll(ctx context.Context, poll *entities.Poll, reason entities.PollCloseReason, closedBy *uuid.UUID) (*entities.PollResults, error) {
+	votes, err := s.pollRepo.FindVotes(ctx, poll.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get votes: %w", err)
+	}
+	results := entities.TallyPoll(poll, votes)
+
+	poll.Close(reason, closedBy, results)
+	closed, err := s.pollRepo.Close(ctx, poll)
+	if err != nil {
+		return nil, fmt.Errorf("failed to close poll: %w", err)
+	}
+	if !closed {
+		// Closed concurrently (e.g. by the scheduler): keep the results stored first
+		stored, err := s.pollRepo.FindByID(ctx, poll.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get poll: %w", err)
+		}
+		*poll = *stored
+		return poll.FinalResults(), nil
+	}
+
+	log.Printf("[Room] 📊 Poll closed: room=%s, poll=%s, reason=%s, voters=%d", poll.RoomID, poll.ID, reason, results.TotalVoters)
+	s.events.Publish(ctx, entities.NewRoomEvent(poll.RoomID, entities.RoomEventPollClosed, map[string]interface{}{
+		"poll_id": poll.ID,
+		"reason":  reason,
+		"results": results,
+	}))
+
+	return results, nil
+}
+
+// pollView tallies the live results of an open poll, or reads the final ones of a closed poll
+func (s *RoomService) pollView(ctx context.Context, poll *entities.Poll, participantID uuid.UUID) (*PollView, error) {
+	votes, err := s.pollRepo.FindVotes(ctx, poll.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get votes: %w", err)
+	}
+
+	view := &PollView{Poll: poll, Results: poll.FinalResults()}
+	if view.Results == nil {
+		view.Results = entities.TallyPoll(poll, votes)
+	}
+	for _, v := range votes {
+		if v.ParticipantID == participantID {
+			view.MyVotes = append(view.MyVotes, v)
+		}
+	}
+	return view, nil
+}
+
+// everyoneVoted reports whether every participant currently in the meeting voted
+func (s *RoomService) everyoneVoted(ctx context.Context, roomID uuid.UUID, votes []*entities.PollVote) (bool, error) {
+	active, err := s.participantRepo.FindActiveByRoomID(ctx, roomID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get active participants: %w", err)
+	}
+
+	voted := make(map[uuid.UUID]bool, len(votes))
+	for _, v := range votes {
+		voted[v.ParticipantID] = true
+	}
+	for _, p := range active {
+		if !voted[p.ID] {
+			return false, nil
+		}
+	}
+	return len(active) > 0, nil
+}
+
+// getPoll retrieves a poll of a room
+func (s *RoomService) getPoll(ctx context.Context, roomID, pollID uuid.UUID) (*entities.Poll, error) {
+	poll, err := s.pollRepo.FindByID(ctx, pollID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, usecaseErrors.ErrPollNotFound
+		}
+		return nil, fmt.Errorf("failed to get poll: %w", err)
+	}
+	if poll.RoomID != roomID {
+		return nil, usecaseErrors.ErrPollNotFound
+	}
+	return poll, nil
+}
+
+// newPoll validates the input of a new poll
+func newPoll(roomID uuid.UUID, creator *entities.Participant, input CreatePollInput) (*entities.Poll, error) {
+	poll := &entities.Poll{
+		RoomID:            roomID,
+		CreatedBy:         creator.ID,
+		Creator:           creator,
+		Question:          strings.TrimSpace(input.Question),
+		Type:              input.Type,
+		Anonymous:         input.Anonymous,
+		Status:            entities.PollStatusOpen,
+		CloseWhenAllVoted: input.CloseWhenAllVoted,
+	}
+
+	switch input.Type {
+	case entities.PollTypeSingleChoice, entities.PollTypeMultipleChoice:
+		seen := make(map[string]bool, len(input.Options))
+		for _, text := range input.Options {
+			text = strings.TrimSpace(text)
+			if text == "" || seen[strings.ToLower(text)] {
+				return nil, usecaseErrors.ErrInvalidPollOptions
+			}
+			seen[strings.ToLower(text)] = true
+			poll.Options = append(poll.Options, entities.PollOption{Position: len(poll.Options), Text: text})
+		}
+		if len(poll.Options) < 2 || len(poll.Options) > maxPollOptions {
+			return nil, usecaseErrors.ErrInvalidPollOptions
+		}
+	case entities.PollTypeFreeText:
+		// Answers are typed, there are no options
+	default:
+		return nil, usecaseErrors.ErrInvalidPollType
+	}
+
+	if input.Duration < 0 {
+		return nil, usecaseErrors.ErrInvalidPollClose
+	}
+	if input.Duration > 0 {
+		closesAt := time.Now().Add(input.Duration)
+		poll.ClosesAt = &closesAt
+	}
+
+	return poll, nil
+}
+
+// newVotes validates a vote against the poll and converts it to vote rows
+func newVotes(poll *entities.Poll, input VoteInput) ([]*entities.PollVote, error) {
+	if poll.Type == entities.PollTypeFreeText {
+		text := strings.TrimSpace(input.Text)
+		if text == "" || len(input.OptionIDs) > 0 || utf8.RuneCountInString(text) > entities.MaxPollAnswerLength {
+			return nil, usecaseErrors.ErrInvalidVote
+		}
+		return []*entities.PollVote{{PollID: poll.ID, ParticipantID: input.ParticipantID, Text: &text}}, nil
+	}
+
+	if len(input.OptionIDs) == 0 || input.Text != "" {
+		return nil, usecaseErrors.ErrInvalidVote
+	}
+	if poll.Type == entities.PollTypeSingleChoice && len(input.OptionIDs) != 1 {
+		return nil, usecaseErrors.ErrInvalidVote
+	}
+
+	votes := make([]*entities.PollVote, 0, len(input.OptionIDs))
+	seen := make(map[uuid.UUID]bool, len(input.OptionIDs))
+	for _, id := range input.OptionIDs {
+		if seen[id] || !poll.HasOption(id) {
+			return nil, usecaseErrors.ErrInvalidVote
+		}
+		seen[id] = true
+		optionID := id
+		votes = append(votes, &entities.PollVote{PollID: poll.ID, ParticipantID: input.ParticipantID, OptionID: &optionID})
+	}
+	return votes, nil
+}
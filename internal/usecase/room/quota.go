@@ -0,0 +1,16 @@
+package room
+
+import (
+	"context"
+
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+)
+
+// CheckJoinQuota checks that the plan of a room allows another join: meeting minutes are left and,
+// when the join starts the meeting, the organization (or host) has a free meeting slot
+func (s *RoomService) CheckJoinQuota(ctx context.Context, room *entities.Room, starting bool) error {
+	if s.quotas == nil {
+		return nil
+	}
+	return s.quotas.CheckRoomJoin(ctx, room, starting)
+}
@@ -0,0 +1,315 @@
+package room
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+	usecaseErrors "github.com/johnquangdev/meeting-assistant/internal/usecase/errors"
+)
+
+// StartRecording starts recording a running room (host, or participants allowed to record).
+// The recording is tracked from the start; the egress delivers its file when it ends.
+func (s *RoomService) StartRecording(ctx context.Context, roomID, userID uuid.UUID) (*entities.Recording, error) {
+	room, err := s.getRecordableRoom(ctx, roomID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !room.GetSettings().EnableRecording {
+		return nil, usecaseErrors.ErrRecordingDisabled
+	}
+
+	active, err := s.recordingRepo.FindActiveByRoomID(ctx, roomID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recording: %w", err)
+	}
+	if active != nil {
+		return nil, usecaseErrors.ErrRecordingInProgress
+	}
+	if err := s.checkRecordingConsent(ctx, room); err != nil {
+		return nil, err
+	}
+
+	recording, err := s.startRecording(ctx, room, &userID)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[Room] ⏺️ Recording started: room=%s, recording=%s, egress=%s, by=%s", roomID, recording.ID, *recording.LivekitEgressID, userID)
+	return recording, nil
+}
+
+// StopRecording ends the recording of a room (host, or participants allowed to record)
+func (s *RoomService) StopRecording(ctx context.Context, roomID, userID uuid.UUID) (*entities.Recording, error) {
+	room, recording, err := s.getActiveRecording(ctx, roomID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// A paused recording has no egress running
+	if !recording.IsPaused() {
+		if err := s.livekitClient.StopEgress(ctx, *recording.LivekitEgressID); err != nil {
+			return nil, fmt.Errorf("%w: %v", usecaseErrors.ErrRecordingFailed, err)
+		}
+	}
+
+	recording.Stop(time.Now())
+	if err := s.recordingRepo.Update(ctx, recording); err != nil {
+		return nil, fmt.Errorf("failed to update recording: %w", err)
+	}
+	s.queueTranscription(ctx, room, recording)
+
+	s.publishRecordingEvent(ctx, recording, entities.RoomEventRecordingStopped, &userID)
+	log.Printf("[Room] ⏹️ Recording stopped: room=%s, recording=%s, by=%s", roomID, recording.ID, userID)
+
+	return recording, nil
+}
+
+// PauseRecording pauses the recording of a room; nothing is recorded until it resumes
+func (s *RoomService) PauseRecording(ctx context.Context, roomID, userID uuid.UUID) (*entities.Recording, error) {
+	_, recording, err := s.getActiveRecording(ctx, roomID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if recording.IsPaused() {
+		return nil, usecaseErrors.ErrRecordingPaused
+	}
+
+	if err := s.pauseRecording(ctx, recording, &userID); err != nil {
+		return nil, err
+	}
+
+	log.Printf("[Room] ⏸️ Recording paused: room=%s, recording=%s, by=%s", roomID, recording.ID, userID)
+
+	return recording, nil
+}
+
+// ResumeRecording resumes a paused recording in a new egress segment
+func (s *RoomService) ResumeRecording(ctx context.Context, roomID, userID uuid.UUID) (*entities.Recording, error) {
+	room, recording, err := s.getActiveRecording(ctx, roomID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !recording.IsPaused() {
+		return nil, usecaseErrors.ErrRecordingNotPaused
+	}
+	if !room.GetSettings().EnableRecording {
+		return nil, usecaseErrors.ErrRecordingDisabled
+	}
+	if err := s.checkRecordingConsent(ctx, room); err != nil {
+		return nil, err
+	}
+
+	if err := s.resumeRecording(ctx, room, recording, &userID); err != nil {
+		return nil, err
+	}
+
+	log.Printf("[Room] ▶️ Recording resumed: room=%s, recording=%s, egress=%s, by=%s", roomID, recording.ID, *recording.LivekitEgressID, userID)
+	return recording, nil
+}
+
+// startRecording starts a new recording of a room; startedBy is nil when the room records automatically
+func (s *RoomService) startRecording(ctx context.Context, room *entities.Room, startedBy *uuid.UUID) (*entities.Recording, error) {
+	if s.quotas != nil {
+		if err := s.quotas.CheckRecording(ctx, room); err != nil {
+			return nil, err
+		}
+	}
+
+	// Created before the egress so the egress_started webhook finds the recording instead of creating another
+	now := time.Now()
+	recording := &entities.Recording{
+		RoomID:         room.ID,
+		OrganizationID: room.OrganizationID,
+		StartedBy:      startedBy,
+		Status:         entities.RecordingStatusRecording,
+		StartedAt:      now,
+	}
+	if err := s.recordingRepo.Create(ctx, recording); err != nil {
+		return nil, fmt.Errorf("failed to create recording: %w", err)
+	}
+
+	egressID, err := s.livekitClient.StartRoomCompositeEgress(ctx, s.recordingRequest(room.LivekitRoomName))
+	if err != nil {
+		recording.MarkAsFailed(err.Error())
+		if updateErr := s.recordingRepo.Update(ctx, recording); updateErr != nil {
+			log.Printf("[Room] ⚠️ Failed to mark recording as failed: recording=%s, err=%v", recording.ID, updateErr)
+		}
+		return nil, fmt.Errorf("%w: %v", usecaseErrors.ErrRecordingFailed, err)
+	}
+
+	recording.StartSegment(egressID, now)
+	if err := s.recordingRepo.Update(ctx, recording); err != nil {
+		return nil, fmt.Errorf("failed to update recording: %w", err)
+	}
+
+	s.publishRecordingEvent(ctx, recording, entities.RoomEventRecordingStarted, startedBy)
+	return recording, nil
+}
+
+// pauseRecording ends the egress of a running recording; pausedBy is nil when paused automatically
+func (s *RoomService) pauseRecording(ctx context.Context, recording *entities.Recording, pausedBy *uuid.UUID) error {
+	if err := s.livekitClient.StopEgress(ctx, *recording.LivekitEgressID); err != nil {
+		return fmt.Errorf("%w: %v", usecaseErrors.ErrRecordingFailed, err)
+	}
+
+	if pausedBy == nil {
+		recording.PauseForConsent(time.Now())
+	} else {
+		recording.Pause(time.Now())
+	}
+	if err := s.recordingRepo.Update(ctx, recording); err != nil {
+		return fmt.Errorf("failed to update recording: %w", err)
+	}
+
+	s.publishRecordingEvent(ctx, recording, entities.RoomEventRecordingPaused, pausedBy)
+	return nil
+}
+
+// resumeRecording continues a paused recording in a new egress segment; resumedBy is nil when resumed automatically
+func (s *RoomService) resumeRecording(ctx context.Context, room *entities.Room, recording *entities.Recording, resumedBy *uuid.UUID) error {
+	if s.quotas != nil {
+		if err := s.quotas.CheckRecording(ctx, room); err != nil {
+			return err
+		}
+	}
+
+	egressID, err := s.livekitClient.StartRoomCompositeEgress(ctx, s.recordingRequest(room.LivekitRoomName))
+	if err != nil {
+		return fmt.Errorf("%w: %v", usecaseErrors.ErrRecordingFailed, err)
+	}
+
+	recording.StartSegment(egressID, time.Now())
+	if err := s.recordingRepo.Update(ctx, recording); err != nil {
+		return fmt.Errorf("failed to update recording: %w", err)
+	}
+
+	s.publishRecordingEvent(ctx, recording, entities.RoomEventRecordingResumed, resumedBy)
+	return nil
+}
+
+// stopRoomRecording stops the recording of a room that is ending
+func (s *RoomService) stopRoomRecording(ctx context.Context, room *entities.Room) error {
+	recording, err := s.recordingRepo.FindActiveByRoomID(ctx, room.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get recording: %w", err)
+	}
+	if recording == nil {
+		return nil
+	}
+
+	if !recording.IsPaused() && recording.LivekitEgressID != nil {
+		if err := s.livekitClient.StopEgress(ctx, *recording.LivekitEgressID); err != nil {
+			// Deleting the LiveKit room ends the egress anyway
+			log.Printf("[Room] ⚠️ Failed to stop egress: room=%s, egress=%s, err=%v", room.ID, *recording.LivekitEgressID, err)
+		}
+	}
+
+	recording.Stop(time.Now())
+	if err := s.recordingRepo.Update(ctx, recording); err != nil {
+		return fmt.Errorf("failed to update recording: %w", err)
+	}
+	s.queueTranscription(ctx, room, recording)
+
+	s.publishRecordingEvent(ctx, recording, entities.RoomEventRecordingStopped, nil)
+	return nil
+}
+
+// QueueTranscription queues the transcription of a completed recording, once, covering all its segments
+func (s *RoomService) QueueTranscription(ctx context.Context, recording *entities.Recording) error {
+	if !recording.IsCompleted() {
+		return nil
+	}
+
+	room, err := s.GetRoom(ctx, recording.RoomID)
+	if err != nil {
+		return err
+	}
+	return s.createTranscriptionJob(ctx, room, recording)
+}
+
+// queueTranscription queues the transcription of a recording that completed when it stopped
+func (s *RoomService) queueTranscription(ctx context.Context, room *entities.Room, recording *entities.Recording) {
+	if !recording.IsCompleted() {
+		return
+	}
+	if err := s.createTranscriptionJob(ctx, room, recording); err != nil {
+		log.Printf("[Room] ⚠️ Failed to queue transcription: room=%s, recording=%s, err=%v", room.ID, recording.ID, err)
+	}
+}
+
+// createTranscriptionJob creates the AI job of a recording unless transcription is disabled or the job exists
+func (s *RoomService) createTranscriptionJob(ctx context.Context, room *entities.Room, recording *entities.Recording) error {
+	if !room.GetSettings().EnableTranscription {
+		log.Printf("[Room] ⏭️ Transcription disabled, skipping: room=%s, recording=%s", room.ID, recording.ID)
+		return nil
+	}
+
+	job := entities.NewRecordingAIJob(recording)
+	if job == nil {
+		return nil
+	}
+	created, err := s.aiJobRepo.CreateRecordingJob(ctx, job)
+	if err != nil {
+		return fmt.Errorf("failed to create AI job: %w", err)
+	}
+	if created {
+		log.Printf("[Room] 📝 Transcription queued: room=%s, recording=%s, job=%s, segments=%d", room.ID, recording.ID, job.ID, len(job.Metadata.RecordingKeys))
+	}
+	return nil
+}
+
+// getRecordableRoom retrieves a running room the user may record
+func (s *RoomService) getRecordableRoom(ctx context.Context, roomID, userID uuid.UUID) (*entities.Room, error) {
+	room, err := s.GetRoom(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Authorize(ctx, room, userID, entities.RoomActionRecord); err != nil {
+		return nil, err
+	}
+	if !room.IsActive() {
+		return nil, usecaseErrors.ErrRoomNotActive
+	}
+	return room, nil
+}
+
+// getActiveRecording retrieves the running or paused recording of a room the user may record
+func (s *RoomService) getActiveRecording(ctx context.Context, roomID, userID uuid.UUID) (*entities.Room, *entities.Recording, error) {
+	room, err := s.getRecordableRoom(ctx, roomID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	recording, err := s.recordingRepo.FindActiveByRoomID(ctx, roomID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get recording: %w", err)
+	}
+	// A recording whose egress is still starting cannot be controlled yet
+	if recording == nil || recording.LivekitEgressID == nil {
+		return nil, nil, usecaseErrors.ErrRecordingNotStarted
+	}
+	return room, recording, nil
+}
+
+// publishRecordingEvent tells everyone in the room about a change of the recording state
+func (s *RoomService) publishRecordingEvent(ctx context.Context, recording *entities.Recording, eventType entities.RoomEventType, userID *uuid.UUID) {
+	data := map[string]interface{}{
+		"recording_id": recording.ID,
+		"status":       recording.Status,
+	}
+	if recording.LivekitEgressID != nil {
+		data["egress_id"] = *recording.LivekitEgressID
+	}
+	if reason := recording.PauseReason(); reason != "" && recording.IsPaused() {
+		data["reason"] = reason
+	}
+	if userID != nil {
+		data["changed_by"] = *userID
+	}
+	s.events.Publish(ctx, entities.NewRoomEvent(recording.RoomID, eventType, data))
+}
@@ -2,20 +2,24 @@ package room
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/livekit/protocol/livekit"
-	lksdk "github.com/livekit/server-sdk-go/v2"
 	"gorm.io/gorm"
 
+	"github.com/johnquangdev/meeting-assistant/internal/adapter/repository"
 	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
 	"github.com/johnquangdev/meeting-assistant/internal/domain/repositories"
 	lkpkg "github.com/johnquangdev/meeting-assistant/internal/infrastructure/external/livekit"
+	"github.com/johnquangdev/meeting-assistant/internal/infrastructure/pubsub"
 	usecaseErrors "github.com/johnquangdev/meeting-assistant/internal/usecase/errors"
+	"github.com/johnquangdev/meeting-assistant/internal/usecase/quota"
 	"github.com/johnquangdev/meeting-assistant/pkg/config"
 )
 
@@ -23,31 +27,57 @@ import (
 type RoomService struct {
 	roomRepo        repositories.RoomRepository
 	participantRepo repositories.ParticipantRepository
+	chatRepo        repositories.ChatRepository
+	pollRepo        repositories.PollRepository
+	agendaRepo      repositories.AgendaRepository
+	orgRepo         repositories.OrganizationRepository
+	recordingRepo   *repository.RecordingRepository
+	aiJobRepo       *repository.AIJobRepository
+	quotas          quota.Service
 	livekitClient   lkpkg.Client
 	livekitURL      string
-	egressClient    *lksdk.EgressClient
 	storageConfig   *config.StorageConfig
 	apiKey          string
 	apiSecret       string
+	guestTokenTTL   time.Duration
+	consentPolicy   string // Version of the recording policy participants consent to
+	events          pubsub.Broker
 }
 
 // NewRoomService creates a new room service
 func NewRoomService(
 	roomRepo repositories.RoomRepository,
 	participantRepo repositories.ParticipantRepository,
+	chatRepo repositories.ChatRepository,
+	pollRepo repositories.PollRepository,
+	agendaRepo repositories.AgendaRepository,
+	orgRepo repositories.OrganizationRepository,
+	recordingRepo *repository.RecordingRepository,
+	aiJobRepo *repository.AIJobRepository,
+	quotas quota.Service,
 	livekitClient lkpkg.Client,
 	livekitURL string,
 	appConfig *config.Config,
+	events pubsub.Broker,
 ) *RoomService {
 	return &RoomService{
 		roomRepo:        roomRepo,
 		participantRepo: participantRepo,
+		chatRepo:        chatRepo,
+		pollRepo:        pollRepo,
+		agendaRepo:      agendaRepo,
+		orgRepo:         orgRepo,
+		recordingRepo:   recordingRepo,
+		aiJobRepo:       aiJobRepo,
+		quotas:          quotas,
 		livekitClient:   livekitClient,
 		livekitURL:      livekitURL,
-		egressClient:    lksdk.NewEgressClient(appConfig.LiveKit.URL, appConfig.LiveKit.APIKey, appConfig.LiveKit.APISecret),
 		storageConfig:   &appConfig.Storage,
 		apiKey:          appConfig.LiveKit.APIKey,
 		apiSecret:       appConfig.LiveKit.APISecret,
+		guestTokenTTL:   appConfig.Guest.TokenTTL,
+		consentPolicy:   appConfig.Recording.ConsentPolicyVersion,
+		events:          events,
 	}
 }
 
@@ -61,6 +91,18 @@ type CreateRoomInput struct {
 	Settings           map[string]interface{}
 	ScheduledStartTime *time.Time
 	ScheduledEndTime   *time.Time
+	Passcode           string // Optional join passcode, stored hashed
+
+	// Set when the room is an occurrence of a recurring series
+	SeriesID            *uuid.UUID
+	OccurrenceStartTime *time.Time
+
+	// Set when the room is a breakout room of another meeting
+	ParentRoomID *uuid.UUID
+
+	// Set when the room belongs to an organization (the host must be a member);
+	// the organization's room defaults apply before Settings
+	OrganizationID *uuid.UUID
 }
 
 // CreateRoomOutput represents the output of creating a room
@@ -74,62 +116,91 @@ type CreateRoomOutput struct {
 // CreateRoom creates a new room
 func (s *RoomService) CreateRoom(ctx context.Context, input CreateRoomInput) (*CreateRoomOutput, error) {
 	// Validate input
-	if input.MaxParticipants < 2 || input.MaxParticipants > 10 {
+	if input.MaxParticipants < 2 || input.MaxParticipants > 100 {
 		return nil, usecaseErrors.ErrInvalidMaxParticipants
 	}
 
-	// Generate LiveKit room name
+	// Apply requested settings on top of the defaults (of the organization, if any)
+	defaults := entities.DefaultRoomSettings()
+	if input.OrganizationID != nil {
+		member, err := s.organizationMember(ctx, *input.OrganizationID, input.HostID)
+		if err != nil {
+			return nil, err
+		}
+		defaults = member.Organization.RoomSettings()
+	}
+	settings, err := defaults.Merge(input.Settings)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", usecaseErrors.ErrInvalidRoomSettings, err)
+	}
+
+	// Stay within the plan of the organization (or of the host, for personal rooms)
+	if s.quotas != nil {
+		if err := s.quotas.CheckRoomCreation(ctx, input.HostID, input.OrganizationID, input.MaxParticipants, settings.ShouldAutoRecord()); err != nil {
+			return nil, err
+		}
+	}
+
+	var passcodeHash *string
+	if input.Passcode != "" {
+		if len(input.Passcode) < passcodeMinLength || len(input.Passcode) > passcodeMaxLength {
+			return nil, usecaseErrors.ErrInvalidPasscode
+		}
+		hash, err := hashPasscode(input.Passcode)
+		if err != nil {
+			return nil, err
+		}
+		passcodeHash = &hash
+	}
+
+	// Generate LiveKit room name and the shareable slug guests join with
 	livekitRoomName := fmt.Sprintf("room-%s", uuid.New().String())
+	slug, err := entities.NewRoomSlug()
+	if err != nil {
+		return nil, err
+	}
 
-	// Configure RoomCompositeEgress for auto-recording
-	// Use public MinIO endpoint for external services to access
-	publicURL := s.storageConfig.PublicURL
-	if publicURL == "" {
-		publicURL = fmt.Sprintf("https://%s", s.storageConfig.Endpoint)
+	// Configure RoomCompositeEgress only when the room records automatically.
+	// Rooms requiring consent start recording once everyone in the meeting consented.
+	var egressConfig *livekit.RoomEgress
+	if settings.ShouldAutoRecord() && !settings.RequireRecordingConsent {
+		egressConfig = s.buildRoomEgress(livekitRoomName)
 	}
 
-	egressConfig := &livekit.RoomEgress{
-		Room: &livekit.RoomCompositeEgressRequest{
-			RoomName:  livekitRoomName,
-			AudioOnly: true,
-			FileOutputs: []*livekit.EncodedFileOutput{
-				{
-					FileType: livekit.EncodedFileType_MP4,
-					Filepath: "recordings/{time}-{room_name}.mp4",
-					Output: &livekit.EncodedFileOutput_S3{
-						S3: &livekit.S3Upload{
-							AccessKey:      s.storageConfig.AccessKeyID,
-							Secret:         s.storageConfig.SecretAccessKey,
-							Region:         "us-east-1",
-							Endpoint:       publicURL,
-							Bucket:         s.storageConfig.BucketName,
-							ForcePathStyle: true,
-						},
-					},
-				},
-			},
-		},
+	metadata, err := json.Marshal(map[string]interface{}{
+		"name":             input.Name,
+		"enable_recording": settings.EnableRecording,
+		"auto_record":      settings.AutoRecord,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode room metadata: %w", err)
 	}
 
-	// Create room in LiveKit with egress auto-recording
+	// Create room in LiveKit (egress starts with the room when auto-recording)
 	roomInfo, err := s.livekitClient.CreateRoom(ctx, livekitRoomName, &lkpkg.CreateRoomOptions{
 		MaxParticipants:  int32(input.MaxParticipants),
 		EmptyTimeout:     300, // 5 minutes - auto-delete if no one joins
 		DepartureTimeout: 30,  // 30 seconds - auto-delete after last person leaves
-		Metadata:         fmt.Sprintf(`{"name":"%s","enable_recording":true}`, input.Name),
+		Metadata:         string(metadata),
 		Egress:           egressConfig,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create livekit room: %w", err)
 	}
 
-	log.Printf("[Room] ✅ Room created with egress auto-recording enabled: %s", livekitRoomName)
+	if egressConfig != nil {
+		log.Printf("[Room] ✅ Room created with egress auto-recording enabled: %s", livekitRoomName)
+	} else {
+		log.Printf("[Room] ✅ Room created: %s", livekitRoomName)
+	}
 
 	// Create room entity
 	room := &entities.Room{
 		Name:                input.Name,
 		Description:         input.Description,
+		Slug:                &slug,
 		HostID:              input.HostID,
+		OrganizationID:      input.OrganizationID,
 		Type:                input.Type,
 		Status:              entities.RoomStatusScheduled,
 		LivekitRoomName:     livekitRoomName,
@@ -138,12 +209,15 @@ func (s *RoomService) CreateRoom(ctx context.Context, input CreateRoomInput) (*C
 		CurrentParticipants: 0,
 		ScheduledStartTime:  input.ScheduledStartTime,
 		ScheduledEndTime:    input.ScheduledEndTime,
+		SeriesID:            input.SeriesID,
+		OccurrenceStartTime: input.OccurrenceStartTime,
+		ParentRoomID:        input.ParentRoomID,
+		PasscodeHash:        passcodeHash,
 	}
 
-	// Set default settings if not provided
-	if input.Settings != nil {
-		// TODO: Marshal settings to JSON
-		// room.Settings = input.Settings
+	if err := room.SetSettings(settings); err != nil {
+		_ = s.livekitClient.DeleteRoom(ctx, livekitRoomName)
+		return nil, err
 	}
 
 	// Create room in database
@@ -177,15 +251,8 @@ func (s *RoomService) CreateRoom(ctx context.Context, input CreateRoomInput) (*C
 	token, err := s.livekitClient.GenerateToken(
 		input.HostID.String(),
 		livekitRoomName,
-		"Host", // participant name
-		&lkpkg.TokenOptions{
-			ValidFor:       24 * time.Hour,
-			CanPublish:     true,
-			CanSubscribe:   true,
-			CanPublishData: true,
-			RoomJoin:       true,
-			RoomAdmin:      true, // Host has admin rights
-		},
+		"Host",                         // participant name
+		s.tokenOptions(settings, true), // Host has admin rights
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate livekit token: %w", err)
@@ -199,6 +266,43 @@ func (s *RoomService) CreateRoom(ctx context.Context, input CreateRoomInput) (*C
 	}, nil
 }
 
+// buildRoomEgress builds the egress config LiveKit starts with the room when it records automatically
+func (s *RoomService) buildRoomEgress(livekitRoomName string) *livekit.RoomEgress {
+	return &livekit.RoomEgress{
+		Room: s.recordingRequest(livekitRoomName),
+	}
+}
+
+// recordingRequest builds the audio-only egress recording a room to storage
+func (s *RoomService) recordingRequest(livekitRoomName string) *livekit.RoomCompositeEgressRequest {
+	// Use public MinIO endpoint for external services to access
+	publicURL := s.storageConfig.PublicURL
+	if publicURL == "" {
+		publicURL = fmt.Sprintf("https://%s", s.storageConfig.Endpoint)
+	}
+
+	return &livekit.RoomCompositeEgressRequest{
+		RoomName:  livekitRoomName,
+		AudioOnly: true,
+		FileOutputs: []*livekit.EncodedFileOutput{
+			{
+				FileType: livekit.EncodedFileType_MP4,
+				Filepath: "recordings/{time}-{room_name}.mp4",
+				Output: &livekit.EncodedFileOutput_S3{
+					S3: &livekit.S3Upload{
+						AccessKey:      s.storageConfig.AccessKeyID,
+						Secret:         s.storageConfig.SecretAccessKey,
+						Region:         "us-east-1",
+						Endpoint:       publicURL,
+						Bucket:         s.storageConfig.BucketName,
+						ForcePathStyle: true,
+					},
+				},
+			},
+		},
+	}
+}
+
 // GetRoom retrieves a room by ID
 func (s *RoomService) GetRoom(ctx context.Context, roomID uuid.UUID) (*entities.Room, error) {
 	room, err := s.roomRepo.FindByID(ctx, roomID)
@@ -235,25 +339,78 @@ func (s *RoomService) StartRoom(ctx context.Context, roomID, userID uuid.UUID) (
 		return nil, usecaseErrors.ErrNotHost
 	}
 
-	// Check if room can be started
-	if room.Status == entities.RoomStatusEnded {
+	// Check if room can be started (the scheduler cancels rooms nobody showed up to)
+	if room.IsEnded() || room.Status == entities.RoomStatusCancelled {
 		return nil, usecaseErrors.ErrRoomEnded
 	}
+	if room.IsActive() {
+		return room, nil
+	}
 
-	// Start the room
+	// Start the room, unless someone else (another scheduler instance) just did
 	room.Start()
+	started, err := s.roomRepo.MarkStarted(ctx, room.ID, *room.StartedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start room: %w", err)
+	}
+	if !started {
+		if room, err = s.GetRoom(ctx, roomID); err != nil {
+			return nil, err
+		}
+		if !room.IsActive() {
+			return nil, usecaseErrors.ErrRoomEnded
+		}
+	}
+
+	return room, nil
+}
+
+// UpdateRoomSettings applies a partial settings update to a room (host only).
+// Changes take effect for tokens issued and participants joining after the update.
+func (s *RoomService) UpdateRoomSettings(ctx context.Context, roomID, userID uuid.UUID, changes map[string]interface{}) (*entities.Room, error) {
+	room, err := s.roomRepo.FindByID(ctx, roomID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, usecaseErrors.ErrRoomNotFound
+		}
+		return nil, fmt.Errorf("failed to get room: %w", err)
+	}
+
+	if err := s.Authorize(ctx, room, userID, entities.RoomActionUpdateSettings); err != nil {
+		return nil, err
+	}
+
+	if room.IsEnded() {
+		return nil, usecaseErrors.ErrRoomEnded
+	}
+
+	settings, err := room.GetSettings().Merge(changes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", usecaseErrors.ErrInvalidRoomSettings, err)
+	}
+
+	if err := room.SetSettings(settings); err != nil {
+		return nil, err
+	}
 
 	if err := s.roomRepo.Update(ctx, room); err != nil {
-		return nil, fmt.Errorf("failed to start room: %w", err)
+		return nil, fmt.Errorf("failed to update room settings: %w", err)
 	}
 
+	log.Printf("[Room] Settings updated: room=%s, changes=%v", room.ID, changes)
+
+	// Turning consent on pauses the recording until everyone consented, turning it off resumes it
+	s.enforceRecordingConsent(ctx, room)
+
 	return room, nil
 }
 
 // JoinRoomInput represents input for joining a room
 type JoinRoomInput struct {
-	RoomID uuid.UUID
-	UserID uuid.UUID
+	RoomID   uuid.UUID
+	UserID   uuid.UUID
+	Passcode string // Required when the room has a passcode (unless invited)
+	ClientIP string // For auditing failed passcode attempts
 }
 
 // JoinRoom allows a user to join a room
@@ -267,8 +424,8 @@ func (s *RoomService) JoinRoom(ctx context.Context, input JoinRoomInput) (*entit
 		return nil, nil, fmt.Errorf("failed to get room: %w", err)
 	}
 
-	// Check if room has ended (IMPORTANT: Check this BEFORE authorization)
-	if room.IsEnded() {
+	// Check if room has ended or was cancelled (IMPORTANT: Check this BEFORE authorization)
+	if room.IsEnded() || room.Status == entities.RoomStatusCancelled {
 		return nil, nil, usecaseErrors.ErrRoomEnded
 	}
 
@@ -277,11 +434,20 @@ func (s *RoomService) JoinRoom(ctx context.Context, input JoinRoomInput) (*entit
 		return nil, nil, err
 	}
 
-	// Check if room is full
-	if room.IsFull() {
-		return nil, nil, usecaseErrors.ErrRoomFull
+	// Check passcode and lock (a room locked to the waiting room sends new joins to the lobby)
+	lockedToLobby, err := s.checkRoomAccess(ctx, room, input.UserID, input.Passcode, input.ClientIP)
+	if err != nil {
+		return nil, nil, err
 	}
 
+	// Stay within the plan (the host's join starts a scheduled room)
+	starting := room.HostID == input.UserID && room.Status == entities.RoomStatusScheduled
+	if err := s.CheckJoinQuota(ctx, room, starting); err != nil {
+		return nil, nil, err
+	}
+
+	settings := room.GetSettings()
+
 	// Check if user already in room
 	isInRoom, err := s.participantRepo.IsUserInRoom(ctx, input.RoomID, input.UserID)
 	if err != nil {
@@ -297,6 +463,9 @@ func (s *RoomService) JoinRoom(ctx context.Context, input JoinRoomInput) (*entit
 		return nil, nil, fmt.Errorf("failed to get participant: %w", err)
 	}
 
+	// Host (or anyone when the room is unlocked and needs no approval) takes a seat right away
+	admit := false
+
 	// If participant exists, check if they are blocked or removed
 	if participant != nil {
 		// Check if user is blocked (denied status) or has been removed
@@ -314,68 +483,101 @@ func (s *RoomService) JoinRoom(ctx context.Context, input JoinRoomInput) (*entit
 			return nil, nil, usecaseErrors.ErrAlreadyInRoom
 		}
 
+		// Already in line for a seat: keep the place
+		if participant.Status == entities.ParticipantStatusWaitlisted {
+			return room, participant, nil
+		}
+
 		// Allow rejoin only for: left, invited, or waiting status
-		if participant.Status == entities.ParticipantStatusLeft ||
-			participant.Status == entities.ParticipantStatusInvited ||
-			participant.Status == entities.ParticipantStatusWaiting {
-			// Update status based on role and room type
-			if room.HostID == input.UserID {
-				// Host can join immediately
-				participant.Status = entities.ParticipantStatusJoined
-			} else {
-				// Regular users go to waiting room (will be updated by authorization check)
-				participant.Status = entities.ParticipantStatusWaiting
-			}
+		if participant.Status != entities.ParticipantStatusLeft &&
+			participant.Status != entities.ParticipantStatusInvited &&
+			participant.Status != entities.ParticipantStatusWaiting {
+			// Invalid status for rejoining
+			return nil, nil, fmt.Errorf("cannot rejoin room with current status: %s", participant.Status)
+		}
+
+		// Update status based on role and room settings
+		admit = room.HostID == input.UserID || (!lockedToLobby && !s.requiresAdmission(room, participant))
+		if !admit {
+			// Regular users go to waiting room until the host admits them
+			participant.Status = entities.ParticipantStatusWaiting
 			if err := s.participantRepo.Update(ctx, participant); err != nil {
 				return nil, nil, fmt.Errorf("failed to update participant: %w", err)
 			}
-		} else {
-			// Invalid status for rejoining
-			return nil, nil, fmt.Errorf("cannot rejoin room with current status: %s", participant.Status)
 		}
 	} else {
 		// Create new participant
-		participantRole := entities.ParticipantRoleParticipant
-		participantStatus := entities.ParticipantStatusWaiting
-		if room.HostID == input.UserID {
-			participantRole = entities.ParticipantRoleHost
-			participantStatus = entities.ParticipantStatusJoined
-		}
 		participant = &entities.Participant{
 			RoomID:         input.RoomID,
 			UserID:         &input.UserID,
-			Role:           participantRole,
-			Status:         participantStatus,
-			CanShareScreen: true,
+			Role:           entities.ParticipantRoleParticipant,
+			Status:         entities.ParticipantStatusWaiting,
+			CanShareScreen: settings.EnableScreenShare,
 		}
-		if err := s.participantRepo.Create(ctx, participant); err != nil {
-			return nil, nil, fmt.Errorf("failed to create participant: %w", err)
+		if room.HostID == input.UserID {
+			participant.Role = entities.ParticipantRoleHost
+			admit = true
+		} else {
+			// Uninvited users of private/scheduled rooms can only get here as guests
+			if room.Type != entities.RoomTypePublic {
+				participant.Role = entities.ParticipantRoleGuest
+			}
+			admit = !lockedToLobby && !s.requiresAdmission(room, participant)
+		}
+		if !admit {
+			if err := s.participantRepo.Create(ctx, participant); err != nil {
+				return nil, nil, fmt.Errorf("failed to create participant: %w", err)
+			}
+		}
+	}
+
+	// Claim a seat (the capacity check and the join happen atomically), or get in line when the room is full
+	if admit {
+		if err := s.SeatParticipant(ctx, room, participant); err != nil {
+			return nil, nil, err
 		}
 	}
 
+	// Let the moderators know someone is waiting in the lobby
+	if participant.Status == entities.ParticipantStatusWaiting {
+		s.publishParticipantEvent(ctx, entities.RoomEventParticipantWaiting, participant, nil)
+	}
+
 	// Nếu là host, cho join luôn
 	if room.HostID == input.UserID {
-		// Increment participant count nếu vừa tạo mới
-		if participant.Status == entities.ParticipantStatusJoined {
-			if err := s.roomRepo.IncrementParticipantCount(ctx, input.RoomID); err != nil {
-				return nil, nil, fmt.Errorf("failed to increment participant count: %w", err)
-			}
-		}
-		// Start room nếu chưa bắt đầu
+		// Start room nếu chưa bắt đầu, unless the scheduler just started or cancelled it
 		if room.Status == entities.RoomStatusScheduled {
 			room.Start()
-			if err := s.roomRepo.Update(ctx, room); err != nil {
+			started, err := s.roomRepo.MarkStarted(ctx, room.ID, *room.StartedAt)
+			if err != nil {
 				return nil, nil, fmt.Errorf("failed to start room: %w", err)
 			}
+			if !started {
+				if room, err = s.GetRoom(ctx, room.ID); err != nil {
+					return nil, nil, err
+				}
+				if !room.IsActive() {
+					return nil, nil, usecaseErrors.ErrRoomEnded
+				}
+			}
 		}
 		return room, participant, nil
 	}
 
-	// Nếu không phải host, return participant với status waiting (không throw error)
+	// Nếu không phải host, return participant (waiting nếu phòng cần duyệt, không throw error)
 	// Handler sẽ check status và return 200 với message chờ duyệt
 	return room, participant, nil
 }
 
+// requiresAdmission checks if a (non-host) participant must wait for the host to admit them.
+// Guests always wait; everyone else waits only when the room requires approval.
+func (s *RoomService) requiresAdmission(room *entities.Room, participant *entities.Participant) bool {
+	if participant.Role == entities.ParticipantRoleGuest {
+		return true
+	}
+	return room.GetSettings().RequiresAdmission()
+}
+
 // checkJoinAuthorization checks if a user is authorized to join a room
 func (s *RoomService) checkJoinAuthorization(ctx context.Context, room *entities.Room, userID uuid.UUID) error {
 	// Host can always join their own room
@@ -385,15 +587,27 @@ func (s *RoomService) checkJoinAuthorization(ctx context.Context, room *entities
 
 	switch room.Type {
 	case entities.RoomTypePublic:
-		// Anyone can join public rooms
+		// Anyone can join public rooms; those of an organization are public to its members
+		if err := s.CanAccessRoom(ctx, room, userID); err != nil {
+			if errors.Is(err, usecaseErrors.ErrNotOrganizationMember) {
+				return usecaseErrors.ErrNotInvited
+			}
+			return err
+		}
 		return nil
 
 	case entities.RoomTypePrivate:
-		// Must be invited to join private rooms
+		// Must be invited to join private rooms (unless guests may ask to join)
 		participant, err := s.participantRepo.FindByRoomAndUser(ctx, room.ID, userID)
 		if err != nil || participant == nil {
+			if room.GetSettings().AllowGuests {
+				return nil
+			}
 			return usecaseErrors.ErrNotInvited
 		}
+		if participant.Role == entities.ParticipantRoleGuest && participant.Status == entities.ParticipantStatusWaiting {
+			return nil
+		}
 
 		// Check if invited (not yet joined or left)
 		if participant.Status != entities.ParticipantStatusInvited {
@@ -412,14 +626,15 @@ func (s *RoomService) checkJoinAuthorization(ctx context.Context, room *entities
 		return nil
 
 	case entities.RoomTypeScheduled:
-		// Must be invited to join scheduled rooms
+		// Must be invited to join scheduled rooms (unless guests may ask to join)
 		participant, err := s.participantRepo.FindByRoomAndUser(ctx, room.ID, userID)
 		if err != nil || participant == nil {
-			return usecaseErrors.ErrNotInvited
-		}
-
-		// Check if invited
-		if participant.Status != entities.ParticipantStatusInvited {
+			if !room.GetSettings().AllowGuests {
+				return usecaseErrors.ErrNotInvited
+			}
+		} else if participant.Role == entities.ParticipantRoleGuest && participant.Status == entities.ParticipantStatusWaiting {
+			// Guest already waiting for approval
+		} else if participant.Status != entities.ParticipantStatusInvited {
 			if participant.Status == entities.ParticipantStatusJoined {
 				return usecaseErrors.ErrAlreadyInRoom
 			}
@@ -453,34 +668,97 @@ func (s *RoomService) LeaveRoom(ctx context.Context, roomID, userID uuid.UUID) e
 		return fmt.Errorf("failed to get participant: %w", err)
 	}
 
+	return s.leave(ctx, roomID, participant)
+}
+
+// LeaveRoomAsGuest marks a guest participant as left
+func (s *RoomService) LeaveRoomAsGuest(ctx context.Context, roomID, participantID uuid.UUID) error {
+	participant, err := s.participantRepo.FindByID(ctx, participantID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return usecaseErrors.ErrNotParticipant
+		}
+		return fmt.Errorf("failed to get participant: %w", err)
+	}
+	if participant.RoomID != roomID || !participant.IsGuest() {
+		return usecaseErrors.ErrNotParticipant
+	}
+
+	return s.leave(ctx, roomID, participant)
+}
+
+// leave marks a participant as left, releases its seat and ends or hands over the room
+func (s *RoomService) leave(ctx context.Context, roomID uuid.UUID, participant *entities.Participant) error {
 	// Check if participant was actually joined (not just waiting)
 	wasJoined := participant.Status == entities.ParticipantStatusJoined
 
+	// People who leave lose their place in the speaking queue
+	if participant.IsHandRaised {
+		if err := s.lowerHands(ctx, roomID, []uuid.UUID{participant.ID}, nil); err != nil {
+			return err
+		}
+	}
+
 	// Mark as left
 	participant.Leave()
 	if err := s.participantRepo.Update(ctx, participant); err != nil {
 		return fmt.Errorf("failed to update participant: %w", err)
 	}
 
-	// Only decrement count if participant was actually joined (not waiting/denied/etc)
+	// Close the LiveKit sessions that participant_left did not close (yet)
+	if err := s.participantRepo.EndOpenSessions(ctx, roomID, &participant.ID, *participant.LeftAt); err != nil {
+		return fmt.Errorf("failed to end attendance sessions: %w", err)
+	}
+
+	// A freed seat goes to the first participant on the waitlist
 	if wasJoined {
-		if err := s.roomRepo.DecrementParticipantCount(ctx, roomID); err != nil {
-			return fmt.Errorf("failed to decrement participant count: %w", err)
+		if err := s.releaseSeats(ctx, roomID); err != nil {
+			return err
 		}
 	}
 
+	room, err := s.roomRepo.FindByID(ctx, roomID)
+	if err != nil {
+		return fmt.Errorf("failed to get room: %w", err)
+	}
+
+	// The recording may resume when the last participant who had not consented left
+	if wasJoined {
+		s.enforceRecordingConsent(ctx, room)
+	}
+
+	// Breakout rooms belong to the host of the parent meeting and stay open until they are closed
+	if room.IsBreakout() {
+		return nil
+	}
+
 	// Check if room should auto-end (no active participants)
 	activeCount, err := s.participantRepo.CountActiveByRoomID(ctx, roomID)
 	if err != nil {
 		return fmt.Errorf("failed to count active participants: %w", err)
 	}
 
+	hostLeft := wasJoined && participant.UserID != nil && *participant.UserID == room.HostID
+	if activeCount > 0 && !hostLeft {
+		return nil
+	}
+
+	// People (including the host) move to the breakout rooms and back:
+	// keep the meeting and its host while breakouts are open
+	open, err := s.hasOpenBreakouts(ctx, roomID)
+	if err != nil {
+		return err
+	}
+	if open {
+		return nil
+	}
+
 	if activeCount == 0 {
-		// Auto-end the room
-		if err := s.roomRepo.EndRoom(ctx, roomID); err != nil {
-			return fmt.Errorf("failed to end room: %w", err)
+		// Auto-end the room like any other meeting, unless someone else just ended it
+		if err := s.endRoom(ctx, room, "empty"); err != nil && !errors.Is(err, usecaseErrors.ErrRoomEnded) {
+			return err
 		}
-	} else if wasJoined && participant.IsHost() {
+	} else {
 		// If host left, promote another participant (only if host was actually joined)
 		if err := s.promoteNewHost(ctx, roomID); err != nil {
 			return fmt.Errorf("failed to promote new host: %w", err)
@@ -501,48 +779,222 @@ func (s *RoomService) EndRoom(ctx context.Context, roomID, userID uuid.UUID) err
 		return fmt.Errorf("failed to get room: %w", err)
 	}
 
-	// Check if user is host
-	if room.HostID != userID {
-		return usecaseErrors.ErrNotHost
+	// Check if user may end the meeting
+	if err := s.Authorize(ctx, room, userID, entities.RoomActionEnd); err != nil {
+		return err
+	}
+
+	return s.endRoom(ctx, room, "ended")
+}
+
+// ForceEndRoom ends any room on behalf of an administrator, whoever hosts it
+func (s *RoomService) ForceEndRoom(ctx context.Context, roomID, adminID uuid.UUID) error {
+	room, err := s.roomRepo.FindByID(ctx, roomID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return usecaseErrors.ErrRoomNotFound
+		}
+		return fmt.Errorf("failed to get room: %w", err)
+	}
+	if room.IsEnded() || room.Status == entities.RoomStatusCancelled {
+		return usecaseErrors.ErrRoomEnded
+	}
+
+	if err := s.endRoom(ctx, room, "ended_by_admin"); err != nil {
+		return err
+	}
+
+	s.recordAudit(ctx, room.ID, &adminID, "", entities.RoomAuditForceEnded, nil)
+	log.Printf("[Room] 🛑 Room force-ended by admin: room=%s, admin=%s", room.ID, adminID)
+
+	return nil
+}
+
+// CancelRoom calls off a meeting nobody showed up to. A room that has not started is cancelled;
+// a room that was opened is ended like any other meeting, so everyone is told and the recording
+// and attendance are closed.
+func (s *RoomService) CancelRoom(ctx context.Context, roomID uuid.UUID, reason string) error {
+	room, err := s.GetRoom(ctx, roomID)
+	if err != nil {
+		return err
+	}
+
+	if room.Status == entities.RoomStatusScheduled {
+		cancelled, err := s.roomRepo.CancelRoom(ctx, roomID)
+		if err != nil {
+			return fmt.Errorf("failed to cancel room: %w", err)
+		}
+		if cancelled {
+			if err := s.livekitClient.DeleteRoom(ctx, room.LivekitRoomName); err != nil {
+				log.Printf("[Room] ⚠️ Failed to delete livekit room %s: %v", room.LivekitRoomName, err)
+			}
+			s.publishRoomEnded(ctx, roomID, reason)
+			return nil
+		}
+
+		// Opened or closed in the meantime
+		if room, err = s.GetRoom(ctx, roomID); err != nil {
+			return err
+		}
+	}
+
+	if !room.IsActive() {
+		return usecaseErrors.ErrRoomEnded
+	}
+	return s.endRoom(ctx, room, reason)
+}
+
+// endRoom closes a room in LiveKit and in the database and lets everyone in it know why
+func (s *RoomService) endRoom(ctx context.Context, room *entities.Room, reason string) error {
+	// Claim the end first: the host, the scheduler (on every instance) and webhooks may end a room at the same time
+	ended, err := s.roomRepo.EndRoom(ctx, room.ID)
+	if err != nil {
+		return fmt.Errorf("failed to end room: %w", err)
+	}
+	if !ended {
+		return usecaseErrors.ErrRoomEnded
+	}
+	room.End()
+
+	s.closeRoom(ctx, room)
+	s.publishRoomEnded(ctx, room.ID, reason)
+
+	return nil
+}
+
+// CloseEndedRoom runs the cleanup of an ended room again, finishing what failed when it ended
+func (s *RoomService) CloseEndedRoom(ctx context.Context, roomID uuid.UUID) error {
+	room, err := s.GetRoom(ctx, roomID)
+	if err != nil {
+		return err
+	}
+	// Rooms still running are closed when they end
+	if !room.IsEnded() {
+		return nil
+	}
+
+	s.closeRoom(ctx, room)
+	return nil
+}
+
+// closeRoom releases what an ended room still holds. Every step can run again and a failed step
+// does not stop the others: it is logged, and the room_finished webhook runs the cleanup again.
+func (s *RoomService) closeRoom(ctx context.Context, room *entities.Room) {
+	roomID := room.ID
+
+	// Close the breakout rooms first so their recordings are finalized with the meeting
+	if err := s.EndBreakouts(ctx, room); err != nil {
+		log.Printf("[Room] ⚠️ Failed to end breakout rooms: room=%s, err=%v", roomID, err)
 	}
 
 	// Get all active participants to remove them from LiveKit
 	participants, err := s.participantRepo.FindActiveByRoomID(ctx, roomID)
 	if err != nil {
-		return fmt.Errorf("failed to get active participants: %w", err)
+		log.Printf("[Room] ⚠️ Failed to get active participants: room=%s, err=%v", roomID, err)
 	}
 
 	// Remove all participants from LiveKit room (kick them out)
 	for _, p := range participants {
-		if err := s.livekitClient.RemoveParticipant(ctx, room.LivekitRoomName, p.UserID.String()); err != nil {
+		if err := s.livekitClient.RemoveParticipant(ctx, room.LivekitRoomName, p.Identity()); err != nil {
 			// Log error but continue with other participants
-			fmt.Printf("⚠️  warning: failed to remove participant %s from livekit: %v\n", p.UserID.String(), err)
+			log.Printf("[Room] ⚠️ Failed to remove participant %s from livekit: %v", p.Identity(), err)
 		}
 	}
 
+	// Finish the recording before the LiveKit room (and with it the egress) goes away
+	if err := s.stopRoomRecording(ctx, room); err != nil {
+		log.Printf("[Room] ⚠️ Failed to stop recording: room=%s, err=%v", roomID, err)
+	}
+
 	// Delete room from LiveKit (closes room and ensures it's removed)
 	if err := s.livekitClient.DeleteRoom(ctx, room.LivekitRoomName); err != nil {
-		// Log error but don't fail - room status should still be updated in DB
-		fmt.Printf("⚠️  warning: failed to delete livekit room %s: %v\n", room.LivekitRoomName, err)
+		log.Printf("[Room] ⚠️ Failed to delete livekit room %s: %v", room.LivekitRoomName, err)
 	}
 
-	// End the room in database
-	room.End()
-	if err := s.roomRepo.Update(ctx, room); err != nil {
-		return fmt.Errorf("failed to end room: %w", err)
+	// Wrap up the agenda item being discussed
+	if err := s.finishAgenda(ctx, room); err != nil {
+		log.Printf("[Room] ⚠️ Failed to finish agenda: room=%s, err=%v", roomID, err)
+	} else if err := s.roomRepo.SetCurrentAgendaItem(ctx, roomID, nil); err != nil {
+		log.Printf("[Room] ⚠️ Failed to clear current agenda item: room=%s, err=%v", roomID, err)
 	}
 
-	// Mark all active participants as left
-	for _, p := range participants {
+	// Close the speaking queue
+	if _, err := s.participantRepo.LowerHands(ctx, roomID, nil, nil); err != nil {
+		log.Printf("[Room] ⚠️ Failed to lower hands: room=%s, err=%v", roomID, err)
+	}
+
+	// Mark all active participants as left, and nobody waits for a seat of a meeting that is over
+	waitlist, err := s.participantRepo.FindWaitlistedByRoomID(ctx, roomID)
+	if err != nil {
+		log.Printf("[Room] ⚠️ Failed to get waitlist: room=%s, err=%v", roomID, err)
+	}
+	for _, p := range append(participants, waitlist...) {
 		p.Leave()
 		if err := s.participantRepo.Update(ctx, p); err != nil {
-			return fmt.Errorf("failed to update participant: %w", err)
+			log.Printf("[Room] ⚠️ Failed to mark participant as left: room=%s, participant=%s, err=%v", roomID, p.ID, err)
+		}
+	}
+
+	if err := s.participantRepo.EndOpenSessions(ctx, roomID, nil, time.Now()); err != nil {
+		log.Printf("[Room] ⚠️ Failed to end attendance sessions: room=%s, err=%v", roomID, err)
+	}
+
+	// Polls left open are decided with the votes cast so far
+	if err := s.closeRoomPolls(ctx, roomID); err != nil {
+		log.Printf("[Room] ⚠️ Failed to close polls: room=%s, err=%v", roomID, err)
+	}
+
+	if err := s.roomRepo.SyncParticipantCount(ctx, roomID); err != nil {
+		log.Printf("[Room] ⚠️ Failed to sync participant count: room=%s, err=%v", roomID, err)
+	}
+}
+
+// EndBreakouts ends the open breakout rooms of a meeting and clears the assignments and timer
+func (s *RoomService) EndBreakouts(ctx context.Context, parent *entities.Room) error {
+	breakouts, err := s.roomRepo.FindBreakouts(ctx, parent.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get breakout rooms: %w", err)
+	}
+	if len(breakouts) == 0 {
+		return nil
+	}
+
+	for _, b := range breakouts {
+		if b.IsEnded() || b.Status == entities.RoomStatusCancelled {
+			continue
+		}
+		if err := s.EndRoom(ctx, b.ID, b.HostID); err != nil {
+			return fmt.Errorf("failed to end breakout room %s: %w", b.ID, err)
+		}
+	}
+
+	if err := s.participantRepo.ClearBreakoutAssignments(ctx, parent.ID); err != nil {
+		return fmt.Errorf("failed to clear breakout assignments: %w", err)
+	}
+	if parent.BreakoutEndsAt != nil {
+		if err := s.roomRepo.SetBreakoutTimer(ctx, parent.ID, nil); err != nil {
+			return fmt.Errorf("failed to clear breakout timer: %w", err)
 		}
+		parent.BreakoutEndsAt = nil
 	}
 
 	return nil
 }
 
+// hasOpenBreakouts checks if a room has breakout rooms that have not ended yet
+func (s *RoomService) hasOpenBreakouts(ctx context.Context, roomID uuid.UUID) (bool, error) {
+	breakouts, err := s.roomRepo.FindBreakouts(ctx, roomID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get breakout rooms: %w", err)
+	}
+	for _, b := range breakouts {
+		if !b.IsEnded() && b.Status != entities.RoomStatusCancelled {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 // GetParticipants retrieves all participants in a room
 func (s *RoomService) GetParticipants(ctx context.Context, roomID uuid.UUID) ([]*entities.Participant, error) {
 	participants, err := s.participantRepo.FindByRoomID(ctx, roomID)
@@ -555,9 +1007,9 @@ func (s *RoomService) GetParticipants(ctx context.Context, roomID uuid.UUID) ([]
 // GetWaitingParticipants retrieves all waiting participants in a room
 func (s *RoomService) GetWaitingParticipants(ctx context.Context, roomID, hostID uuid.UUID) ([]*entities.Participant, error) {
 	// Verify room exists
-	room, err := s.roomRepo.FindByID(ctx, roomID)
+	room, err := s.GetRoomForUser(ctx, roomID, hostID)
 	if err != nil {
-		return nil, usecaseErrors.ErrRoomNotFound
+		return nil, err
 	}
 
 	// Check if room has ended
@@ -565,9 +1017,9 @@ func (s *RoomService) GetWaitingParticipants(ctx context.Context, roomID, hostID
 		return nil, usecaseErrors.ErrRoomEnded
 	}
 
-	// Verify user is the host
-	if room.HostID != hostID {
-		return nil, usecaseErrors.ErrNotHost
+	// Verify user may admit participants (host or co-host)
+	if err := s.Authorize(ctx, room, hostID, entities.RoomActionAdmit); err != nil {
+		return nil, err
 	}
 
 	// Get waiting participants
@@ -579,7 +1031,7 @@ func (s *RoomService) GetWaitingParticipants(ctx context.Context, roomID, hostID
 	return participants, nil
 }
 
-// AdmitParticipant admits a waiting participant into the room and generates LiveKit access token
+// AdmitParticipant admits a waiting participant into the room (or its waitlist when the room is full)
 func (s *RoomService) AdmitParticipant(ctx context.Context, roomID, hostID, participantID uuid.UUID) (string, error) {
 	// Verify room exists
 	room, err := s.roomRepo.FindByID(ctx, roomID)
@@ -592,14 +1044,14 @@ func (s *RoomService) AdmitParticipant(ctx context.Context, roomID, hostID, part
 		return "", usecaseErrors.ErrRoomEnded
 	}
 
-	// Verify user is the host
-	if room.HostID != hostID {
-		return "", usecaseErrors.ErrNotHost
+	// Verify user may admit participants (host or co-host)
+	if err := s.Authorize(ctx, room, hostID, entities.RoomActionAdmit); err != nil {
+		return "", err
 	}
 
 	// Get participant
 	participant, err := s.participantRepo.FindByID(ctx, participantID)
-	if err != nil {
+	if err != nil || participant.RoomID != roomID {
 		return "", usecaseErrors.ErrParticipantNotFound
 	}
 
@@ -608,16 +1060,16 @@ func (s *RoomService) AdmitParticipant(ctx context.Context, roomID, hostID, part
 		return "", usecaseErrors.ErrInvalidParticipantStatus
 	}
 
-	// Mark as joined
-	if err := s.participantRepo.MarkAsJoined(ctx, participantID); err != nil {
-		return "", fmt.Errorf("failed to admit participant: %w", err)
+	// Take a seat, or get in line for one when the room is full
+	if err := s.SeatParticipant(ctx, room, participant); err != nil {
+		return "", err
 	}
-
-	// Increment participant count
-	if err := s.roomRepo.IncrementParticipantCount(ctx, roomID); err != nil {
-		return "", fmt.Errorf("failed to increment participant count: %w", err)
+	if participant.Status != entities.ParticipantStatusJoined {
+		return "", nil
 	}
 
+	s.publishParticipantEvent(ctx, entities.RoomEventParticipantAdmitted, participant, nil)
+
 	return "", nil
 }
 
@@ -636,14 +1088,14 @@ func (s *RoomService) DenyParticipant(ctx context.Context, roomID, hostID, parti
 		return usecaseErrors.ErrRoomEnded
 	}
 
-	// Verify user is the host
-	if room.HostID != hostID {
-		return usecaseErrors.ErrNotHost
+	// Verify user may admit participants (host or co-host)
+	if err := s.Authorize(ctx, room, hostID, entities.RoomActionAdmit); err != nil {
+		return err
 	}
 
 	// Get participant
 	participant, err := s.participantRepo.FindByID(ctx, participantID)
-	if err != nil {
+	if err != nil || participant.RoomID != roomID {
 		return usecaseErrors.ErrParticipantNotFound
 	}
 
@@ -658,6 +1110,10 @@ func (s *RoomService) DenyParticipant(ctx context.Context, roomID, hostID, parti
 		return fmt.Errorf("failed to deny participant: %w", err)
 	}
 
+	s.publishParticipantEvent(ctx, entities.RoomEventParticipantDenied, participant, map[string]interface{}{
+		"reason": reason,
+	})
+
 	return nil
 }
 
@@ -675,14 +1131,14 @@ func (s *RoomService) BlockParticipant(ctx context.Context, roomID, hostID, part
 		return usecaseErrors.ErrRoomEnded
 	}
 
-	// Verify user is the host
-	if room.HostID != hostID {
-		return usecaseErrors.ErrNotHost
+	// Verify user may block participants (host or co-host)
+	if err := s.Authorize(ctx, room, hostID, entities.RoomActionBlock); err != nil {
+		return err
 	}
 
 	// Get participant
 	participant, err := s.participantRepo.FindByID(ctx, participantID)
-	if err != nil {
+	if err != nil || participant.RoomID != roomID {
 		return usecaseErrors.ErrParticipantNotFound
 	}
 
@@ -690,6 +1146,9 @@ func (s *RoomService) BlockParticipant(ctx context.Context, roomID, hostID, part
 	if participant.UserID != nil && *participant.UserID == room.HostID {
 		return fmt.Errorf("cannot block the host")
 	}
+	if err := checkCanActOn(room, hostID, participant); err != nil {
+		return err
+	}
 
 	// Set status to denied (permanent block)
 	// Also mark as removed with reason
@@ -697,6 +1156,13 @@ func (s *RoomService) BlockParticipant(ctx context.Context, roomID, hostID, part
 	if reason != "" {
 		removalReason = fmt.Sprintf("Blocked: %s", reason)
 	}
+	if participant.IsHandRaised {
+		if err := s.lowerHands(ctx, roomID, []uuid.UUID{participant.ID}, &hostID); err != nil {
+			return err
+		}
+		participant.LowerHand()
+	}
+	wasJoined := participant.Status == entities.ParticipantStatusJoined
 	participant.Status = entities.ParticipantStatusDenied
 	participant.IsRemoved = true
 	participant.RemovedBy = &hostID
@@ -706,6 +1172,18 @@ func (s *RoomService) BlockParticipant(ctx context.Context, roomID, hostID, part
 		return fmt.Errorf("failed to block participant: %w", err)
 	}
 
+	// A freed seat goes to the first participant on the waitlist
+	if wasJoined {
+		if err := s.releaseSeats(ctx, roomID); err != nil {
+			return err
+		}
+	}
+
+	s.publishParticipantEvent(ctx, entities.RoomEventParticipantRemoved, participant, map[string]interface{}{
+		"reason":  removalReason,
+		"blocked": true,
+	})
+
 	return nil
 }
 
@@ -713,12 +1191,9 @@ func (s *RoomService) BlockParticipant(ctx context.Context, roomID, hostID, part
 // This is used for polling - user checks their status and gets token if admitted
 func (s *RoomService) GetMyParticipantStatus(ctx context.Context, roomID, userID uuid.UUID) (*entities.Room, *entities.Participant, string, error) {
 	// Get room
-	room, err := s.roomRepo.FindByID(ctx, roomID)
+	room, err := s.GetRoomForUser(ctx, roomID, userID)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, nil, "", usecaseErrors.ErrRoomNotFound
-		}
-		return nil, nil, "", fmt.Errorf("failed to get room: %w", err)
+		return nil, nil, "", err
 	}
 
 	// Get participant record
@@ -753,26 +1228,45 @@ func (s *RoomService) RemoveParticipant(ctx context.Context, roomID, hostID, par
 		return fmt.Errorf("failed to get room: %w", err)
 	}
 
-	// Check if user is host
-	if room.HostID != hostID {
-		return usecaseErrors.ErrNotHost
+	// Check if user may remove participants (host or co-host)
+	if err := s.Authorize(ctx, room, hostID, entities.RoomActionRemove); err != nil {
+		return err
+	}
+
+	participant, err := s.participantRepo.FindByID(ctx, participantID)
+	if err != nil || participant.RoomID != roomID {
+		return usecaseErrors.ErrParticipantNotFound
 	}
 
 	// Không cho host tự remove chính mình
-	if participantID == hostID {
+	if participant.UserID != nil && *participant.UserID == hostID {
 		return usecaseErrors.ErrCannotRemoveSelf
 	}
+	if err := checkCanActOn(room, hostID, participant); err != nil {
+		return err
+	}
+
+	if participant.IsHandRaised {
+		if err := s.lowerHands(ctx, roomID, []uuid.UUID{participant.ID}, &hostID); err != nil {
+			return err
+		}
+	}
 
 	// Remove participant
 	if err := s.participantRepo.Remove(ctx, participantID, hostID, reason); err != nil {
 		return fmt.Errorf("failed to remove participant: %w", err)
 	}
 
-	// Decrement participant count
-	if err := s.roomRepo.DecrementParticipantCount(ctx, roomID); err != nil {
-		return fmt.Errorf("failed to decrement participant count: %w", err)
+	// A freed seat goes to the first participant on the waitlist
+	if err := s.releaseSeats(ctx, roomID); err != nil {
+		return err
 	}
 
+	s.publishParticipantEvent(ctx, entities.RoomEventParticipantRemoved, participant, map[string]interface{}{
+		"reason":  reason,
+		"blocked": false,
+	})
+
 	return nil
 }
 
@@ -818,9 +1312,7 @@ func (s *RoomService) TransferHost(ctx context.Context, roomID, currentHostID, n
 	}
 
 	// Demote current host to participant
-	currentHost.Role = entities.ParticipantRoleParticipant
-	currentHost.CanRecord = false
-	currentHost.CanMuteOthers = false
+	currentHost.DemoteToParticipant()
 	if err := s.participantRepo.Update(ctx, currentHost); err != nil {
 		return fmt.Errorf("failed to demote current host: %w", err)
 	}
@@ -849,6 +1341,8 @@ func (s *RoomService) TransferHost(ctx context.Context, roomID, currentHostID, n
 	fmt.Printf("✅ [TRANSFER_HOST] Room %s - Host transferred from %s to %s\n",
 		roomID, currentHostID, newHostID)
 
+	s.publishHostTransferred(ctx, roomID, &currentHostID, newHost)
+
 	return nil
 }
 
@@ -859,12 +1353,24 @@ func (s *RoomService) promoteNewHost(ctx context.Context, roomID uuid.UUID) erro
 		return fmt.Errorf("failed to get active participants: %w", err)
 	}
 
-	if len(participants) == 0 {
+	// Promote a co-host first, otherwise the first registered participant; guests cannot own a room
+	var newHost *entities.Participant
+	for _, p := range participants {
+		if p.UserID == nil {
+			continue
+		}
+		if p.Role == entities.ParticipantRoleCoHost {
+			newHost = p
+			break
+		}
+		if newHost == nil {
+			newHost = p
+		}
+	}
+	if newHost == nil {
 		return nil // No participants to promote
 	}
 
-	// Promote first participant
-	newHost := participants[0]
 	newHost.PromoteToHost()
 	if err := s.participantRepo.Update(ctx, newHost); err != nil {
 		return fmt.Errorf("failed to promote participant: %w", err)
@@ -876,11 +1382,14 @@ func (s *RoomService) promoteNewHost(ctx context.Context, roomID uuid.UUID) erro
 		return fmt.Errorf("failed to get room: %w", err)
 	}
 
+	previousHostID := room.HostID
 	room.HostID = *newHost.UserID
 	if err := s.roomRepo.Update(ctx, room); err != nil {
 		return fmt.Errorf("failed to update room: %w", err)
 	}
 
+	s.publishHostTransferred(ctx, roomID, &previousHostID, newHost)
+
 	return nil
 }
 
@@ -894,19 +1403,23 @@ func (s *RoomService) GenerateParticipantToken(ctx context.Context, room *entiti
 		participantName = "Host"
 	}
 
+	options := s.tokenOptions(room.GetSettings(), isAdmin)
+	applyParticipantPermissions(options, participant)
+
+	// Guests get a short-lived token
+	if participant.IsGuest() {
+		participantName = participant.DisplayName()
+		if s.guestTokenTTL > 0 {
+			options.ValidFor = s.guestTokenTTL
+		}
+	}
+
 	// Generate token
 	token, err := s.livekitClient.GenerateToken(
-		participant.UserID.String(),
+		participant.Identity(),
 		room.LivekitRoomName,
 		participantName,
-		&lkpkg.TokenOptions{
-			ValidFor:       24 * time.Hour,
-			CanPublish:     true,
-			CanSubscribe:   true,
-			CanPublishData: true,
-			RoomJoin:       true,
-			RoomAdmin:      isAdmin,
-		},
+		options,
 	)
 	if err != nil {
 		return "", fmt.Errorf("failed to generate participant token: %w", err)
@@ -915,11 +1428,42 @@ func (s *RoomService) GenerateParticipantToken(ctx context.Context, room *entiti
 	return token, nil
 }
 
+// tokenOptions builds LiveKit token permissions from the room settings.
+// Hosts are never muted on join; chat and screen share apply to everyone.
+func (s *RoomService) tokenOptions(settings entities.RoomSettings, isAdmin bool) *lkpkg.TokenOptions {
+	options := &lkpkg.TokenOptions{
+		ValidFor:       24 * time.Hour,
+		CanPublish:     true,
+		CanSubscribe:   true,
+		CanPublishData: settings.EnableChat,
+		RoomJoin:       true,
+		RoomAdmin:      isAdmin,
+	}
+
+	if !settings.EnableScreenShare {
+		options.CanPublishSources = []string{lkpkg.SourceCamera, lkpkg.SourceMicrophone}
+	}
+
+	if !isAdmin {
+		options.Attributes = map[string]string{
+			"mute_on_join":          strconv.FormatBool(settings.MuteOnJoin),
+			"disable_video_on_join": strconv.FormatBool(settings.DisableVideoOnJoin),
+		}
+	}
+
+	return options
+}
+
 // GetLivekitURL returns the LiveKit server URL
 func (s *RoomService) GetLivekitURL() string {
 	return s.livekitURL
 }
 
+// GetRecordingConsentPolicy returns the recording policy version participants consent to
+func (s *RoomService) GetRecordingConsentPolicy() string {
+	return s.consentPolicy
+}
+
 // GetRoomByLivekitName retrieves a room by its LiveKit room name
 func (s *RoomService) GetRoomByLivekitName(ctx context.Context, livekitName string) (*entities.Room, error) {
 	room, err := s.roomRepo.FindByLivekitName(ctx, livekitName)
@@ -950,12 +1494,16 @@ func (s *RoomService) UpdateParticipantStatus(ctx context.Context, roomID, userI
 			if err := s.participantRepo.Update(ctx, participant); err != nil {
 				return fmt.Errorf("failed to update participant status: %w", err)
 			}
+			if err := s.roomRepo.SyncParticipantCount(ctx, roomID); err != nil {
+				return fmt.Errorf("failed to sync participant count: %w", err)
+			}
 		}
 	case "left":
 		participant.Leave()
 		if err := s.participantRepo.Update(ctx, participant); err != nil {
 			return fmt.Errorf("failed to update participant status: %w", err)
 		}
+		return s.releaseSeats(ctx, roomID)
 	}
 
 	return nil
@@ -965,179 +1513,3 @@ func (s *RoomService) UpdateParticipantStatus(ctx context.Context, roomID, userI
 func (s *RoomService) GetParticipantByRoomAndUser(ctx context.Context, roomID, userID uuid.UUID) (*entities.Participant, error) {
 	return s.participantRepo.FindByRoomAndUser(ctx, roomID, userID)
 }
-
-// InviteUserByEmail invites a user to join a room by email
-func (s *RoomService) InviteUserByEmail(ctx context.Context, roomID, inviterID uuid.UUID, email string) (*entities.Participant, error) {
-	// Verify room exists
-	room, err := s.roomRepo.FindByID(ctx, roomID)
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, usecaseErrors.ErrRoomNotFound
-		}
-		return nil, fmt.Errorf("failed to get room: %w", err)
-	}
-
-	// Verify inviter is host
-	inviter, err := s.participantRepo.FindByRoomAndUser(ctx, roomID, inviterID)
-	if err != nil {
-		return nil, usecaseErrors.ErrNotParticipant
-	}
-	if !inviter.IsHost() {
-		return nil, usecaseErrors.ErrNotHost
-	}
-
-	// Check if already invited/participating with this email
-	existing, err := s.participantRepo.FindByRoomAndEmail(ctx, roomID, email)
-	now := time.Now()
-
-	// If invitation already exists, handle based on status
-	if err == nil && existing != nil {
-		switch existing.Status {
-		case entities.ParticipantStatusInvited:
-			// Already pending - return existing invitation (idempotent for network lag)
-			log.Printf("[Invitation] User already invited (pending): email=%s, room=%s", email, room.Name)
-			return existing, nil
-
-		case entities.ParticipantStatusJoined:
-			// Already in room - no need to invite again
-			return nil, usecaseErrors.ErrAlreadyInvited
-
-		case entities.ParticipantStatusDeclined, entities.ParticipantStatusLeft:
-			// User declined or left - allow re-invitation by updating status
-			existing.Status = entities.ParticipantStatusInvited
-			existing.InvitedBy = &inviterID
-			existing.InvitedAt = &now
-			if err := s.participantRepo.Update(ctx, existing); err != nil {
-				return nil, fmt.Errorf("failed to update invitation: %w", err)
-			}
-			log.Printf("[Invitation] User re-invited to room: email=%s, room=%s, previous_status=%s",
-				email, room.Name, existing.Status)
-			return existing, nil
-		}
-	}
-
-	// Create new invitation participant record
-	participant := &entities.Participant{
-		RoomID:       roomID,
-		UserID:       nil, // Not assigned yet
-		Role:         entities.ParticipantRoleParticipant,
-		Status:       entities.ParticipantStatusInvited,
-		InvitedEmail: &email,
-		InvitedBy:    &inviterID,
-		InvitedAt:    &now,
-	}
-
-	if err := s.participantRepo.Create(ctx, participant); err != nil {
-		return nil, fmt.Errorf("failed to create invitation: %w", err)
-	}
-
-	log.Printf("[Invitation] User invited to room: email=%s, room=%s, inviter=%s", email, room.Name, inviterID)
-
-	return participant, nil
-}
-
-// GetInvitationsByEmail retrieves all invitations for a given email
-func (s *RoomService) GetInvitationsByEmail(ctx context.Context, email string) ([]*entities.Participant, error) {
-	participants, err := s.participantRepo.FindByInvitedEmail(ctx, email)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get invitations: %w", err)
-	}
-	return participants, nil
-}
-
-// AcceptInvitationByEmail accepts an invitation and joins the room
-func (s *RoomService) AcceptInvitationByEmail(ctx context.Context, roomID uuid.UUID, email string, userID uuid.UUID) (*entities.Room, *entities.Participant, string, error) {
-	// Find invitation
-	participant, err := s.participantRepo.FindByRoomAndEmail(ctx, roomID, email)
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, nil, "", usecaseErrors.ErrInvitationNotFound
-		}
-		return nil, nil, "", fmt.Errorf("failed to find invitation: %w", err)
-	}
-
-	// Verify room exists and is not ended
-	room, err := s.roomRepo.FindByID(ctx, roomID)
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, nil, "", usecaseErrors.ErrRoomNotFound
-		}
-		return nil, nil, "", fmt.Errorf("failed to get room: %w", err)
-	}
-
-	if room.Status == entities.RoomStatusEnded || room.Status == entities.RoomStatusCancelled {
-		return nil, nil, "", usecaseErrors.ErrRoomEnded
-	}
-
-	// Update participant record with user ID and join
-	participant.UserID = &userID
-	participant.Status = entities.ParticipantStatusJoined
-	now := time.Now()
-	participant.JoinedAt = &now
-
-	if err := s.participantRepo.Update(ctx, participant); err != nil {
-		return nil, nil, "", fmt.Errorf("failed to update participant: %w", err)
-	}
-
-	// Update room participant count
-	if room.Status == entities.RoomStatusActive {
-		room.CurrentParticipants++
-		if err := s.roomRepo.Update(ctx, room); err != nil {
-			return nil, nil, "", fmt.Errorf("failed to update room: %w", err)
-		}
-	}
-
-	// Generate LiveKit token
-	token, err := s.GenerateParticipantToken(ctx, room, participant)
-	if err != nil {
-		return nil, nil, "", fmt.Errorf("failed to generate token: %w", err)
-	}
-
-	log.Printf("[Invitation] User accepted invitation: email=%s, room=%s, user=%s", email, room.Name, userID)
-
-	return room, participant, token, nil
-}
-
-// DeclineInvitationByEmail declines an invitation
-func (s *RoomService) DeclineInvitationByEmail(ctx context.Context, roomID uuid.UUID, email string, userID uuid.UUID) error {
-	// Find invitation
-	participant, err := s.participantRepo.FindByRoomAndEmail(ctx, roomID, email)
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return usecaseErrors.ErrInvitationNotFound
-		}
-		return fmt.Errorf("failed to find invitation: %w", err)
-	}
-
-	// Update status to declined
-	participant.Status = entities.ParticipantStatusDeclined
-	participant.UserID = &userID // Link to user who declined
-
-	if err := s.participantRepo.Update(ctx, participant); err != nil {
-		return fmt.Errorf("failed to update participant: %w", err)
-	}
-
-	log.Printf("[Invitation] User declined invitation: email=%s, room_id=%s, user=%s", email, roomID, userID)
-
-	return nil
-}
-
-// GetRoomInvitations retrieves all invitations for a room (host only)
-func (s *RoomService) GetRoomInvitations(ctx context.Context, roomID, hostID uuid.UUID) ([]*entities.Participant, error) {
-	// Verify host permission
-	host, err := s.participantRepo.FindByRoomAndUser(ctx, roomID, hostID)
-	if err != nil {
-		return nil, usecaseErrors.ErrNotParticipant
-	}
-	if !host.IsHost() {
-		return nil, usecaseErrors.ErrNotHost
-	}
-
-	// Get all invitations for the room
-	invitations, err := s.participantRepo.FindInvitedByRoomID(ctx, roomID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get invitations: %w", err)
-	}
-
-	return invitations, nil
-}
@@ -2,6 +2,7 @@ package room
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
@@ -16,21 +17,42 @@ type Service interface {
 	// GetRoom retrieves a room by ID
 	GetRoom(ctx context.Context, roomID uuid.UUID) (*entities.Room, error)
 
+	// GetRoomForUser retrieves a room the user may see (rooms of another tenant are not found)
+	GetRoomForUser(ctx context.Context, roomID, userID uuid.UUID) (*entities.Room, error)
+
 	// ListRooms retrieves rooms with filters
 	ListRooms(ctx context.Context, filters repositories.RoomFilters) ([]*entities.Room, int64, error)
 
 	// StartRoom starts a scheduled room
 	StartRoom(ctx context.Context, roomID, userID uuid.UUID) (*entities.Room, error)
 
+	// UpdateRoomSettings applies a partial settings update to a room (host only)
+	UpdateRoomSettings(ctx context.Context, roomID, userID uuid.UUID, changes map[string]interface{}) (*entities.Room, error)
+
 	// JoinRoom allows a user to join a room
 	JoinRoom(ctx context.Context, input JoinRoomInput) (*entities.Room, *entities.Participant, error)
 
 	// LeaveRoom allows a user to leave a room
 	LeaveRoom(ctx context.Context, roomID, userID uuid.UUID) error
 
+	// LeaveRoomAsGuest marks a guest participant as left
+	LeaveRoomAsGuest(ctx context.Context, roomID, participantID uuid.UUID) error
+
 	// EndRoom ends a room (host only)
 	EndRoom(ctx context.Context, roomID, userID uuid.UUID) error
 
+	// CancelRoom calls off a meeting nobody showed up to (cancelled if it has not started, ended otherwise)
+	CancelRoom(ctx context.Context, roomID uuid.UUID, reason string) error
+
+	// ForceEndRoom ends any room on behalf of an administrator
+	ForceEndRoom(ctx context.Context, roomID, adminID uuid.UUID) error
+
+	// CloseEndedRoom runs the cleanup of an ended room again, finishing what failed when it ended
+	CloseEndedRoom(ctx context.Context, roomID uuid.UUID) error
+
+	// EndBreakouts ends the open breakout rooms of a meeting and clears the assignments and timer
+	EndBreakouts(ctx context.Context, parent *entities.Room) error
+
 	// GetParticipants retrieves all participants in a room
 	GetParticipants(ctx context.Context, roomID uuid.UUID) ([]*entities.Participant, error)
 
@@ -40,6 +62,24 @@ type Service interface {
 	// AdmitParticipant admits a waiting participant into the room and returns LiveKit access token
 	AdmitParticipant(ctx context.Context, roomID, hostID, participantID uuid.UUID) (string, error)
 
+	// SeatParticipant joins a participant allowed into a room, or waitlists it when the room is full
+	SeatParticipant(ctx context.Context, room *entities.Room, participant *entities.Participant) error
+
+	// GetWaitlist retrieves the participants waiting for a seat in a full room (host or co-host only)
+	GetWaitlist(ctx context.Context, roomID, userID uuid.UUID) ([]*entities.Participant, error)
+
+	// WaitlistPosition returns the place of a waitlisted participant in line (1 = next)
+	WaitlistPosition(ctx context.Context, participant *entities.Participant) (int, error)
+
+	// RecordSessionStart records a participant connecting to the LiveKit room
+	RecordSessionStart(ctx context.Context, roomID uuid.UUID, identity, sessionSID string, joinedAt time.Time) error
+
+	// RecordSessionEnd records a participant disconnecting from the LiveKit room
+	RecordSessionEnd(ctx context.Context, roomID uuid.UUID, identity, sessionSID string, joinedAt, leftAt time.Time) error
+
+	// GetAttendance computes who attended a meeting and for how long (host or co-host only)
+	GetAttendance(ctx context.Context, roomID, userID uuid.UUID) (*entities.Room, []*entities.Attendance, error)
+
 	// DenyParticipant denies a waiting participant from joining the room (soft rejection)
 	DenyParticipant(ctx context.Context, roomID, hostID, participantID uuid.UUID, reason string) error
 
@@ -52,6 +92,127 @@ type Service interface {
 	// RemoveParticipant removes a participant from a room (host only)
 	RemoveParticipant(ctx context.Context, roomID, hostID, participantID uuid.UUID, reason string) error
 
+	// MuteParticipant mutes or unmutes the published tracks of a participant (host or CanMuteOthers only)
+	MuteParticipant(ctx context.Context, input MuteParticipantInput) (*entities.Participant, error)
+
+	// UpdateParticipant applies moderation changes (mute, permissions, metadata) to a participant live
+	UpdateParticipant(ctx context.Context, input UpdateParticipantInput) (*entities.Participant, error)
+
+	// SetCoHost promotes a participant to co-host or demotes a co-host back to participant (host only)
+	SetCoHost(ctx context.Context, roomID, hostID, participantID uuid.UUID, coHost bool) (*entities.Participant, error)
+
+	// RaiseHand puts a participant at the end of the room's speaking queue
+	RaiseHand(ctx context.Context, roomID, participantID uuid.UUID) (*entities.Participant, error)
+
+	// LowerHand takes a participant's own hand down
+	LowerHand(ctx context.Context, roomID, participantID uuid.UUID) (*entities.Participant, error)
+
+	// LowerParticipantHand lowers the hand of another participant (host or co-host only)
+	LowerParticipantHand(ctx context.Context, roomID, moderatorID, participantID uuid.UUID) (*entities.Participant, error)
+
+	// LowerAllHands clears the speaking queue of a room (host or co-host only)
+	LowerAllHands(ctx context.Context, roomID, moderatorID uuid.UUID) (int, error)
+
+	// ReorderHands changes the speaking order of the raised hands (host or co-host only)
+	ReorderHands(ctx context.Context, roomID, moderatorID uuid.UUID, order []uuid.UUID) ([]*entities.Participant, error)
+
+	// GetHandQueue retrieves the raised hands of a room in speaking order
+	GetHandQueue(ctx context.Context, roomID, userID uuid.UUID) ([]*entities.Participant, error)
+
+	// GetTimeline builds the timeline of what happened during a meeting
+	GetTimeline(ctx context.Context, roomID uuid.UUID) ([]*entities.TimelineEntry, error)
+
+	// PostChatMessage posts a message in the chat of an ongoing meeting
+	PostChatMessage(ctx context.Context, roomID, participantID uuid.UUID, content string, replyToID *uuid.UUID) (*entities.ChatMessage, error)
+
+	// ListChatMessages retrieves a page of a room's chat in chronological order, and whether older messages remain
+	ListChatMessages(ctx context.Context, roomID, participantID uuid.UUID, before *time.Time, limit int) ([]*entities.ChatMessage, bool, error)
+
+	// EditChatMessage replaces the content of a chat message (author only)
+	EditChatMessage(ctx context.Context, roomID, participantID, messageID uuid.UUID, content string) (*entities.ChatMessage, error)
+
+	// DeleteChatMessage deletes a chat message (author, or host/co-host as moderation)
+	DeleteChatMessage(ctx context.Context, roomID, participantID, messageID uuid.UUID) (*entities.ChatMessage, error)
+
+	// CreatePoll opens a poll in an ongoing meeting (host or co-host)
+	CreatePoll(ctx context.Context, input CreatePollInput) (*PollView, error)
+
+	// ListPolls retrieves the polls of a meeting with their results
+	ListPolls(ctx context.Context, roomID, participantID uuid.UUID) ([]*PollView, error)
+
+	// GetPoll retrieves a poll of a meeting with its results
+	GetPoll(ctx context.Context, roomID, pollID, participantID uuid.UUID) (*PollView, error)
+
+	// Vote casts or replaces the vote of a participant in an open poll
+	Vote(ctx context.Context, input VoteInput) (*PollView, error)
+
+	// ClosePoll stops a poll and stores its final results (host or co-host)
+	ClosePoll(ctx context.Context, roomID, pollID, userID uuid.UUID) (*PollView, error)
+
+	// CloseDuePolls closes the open polls whose deadline passed, returning how many were closed
+	CloseDuePolls(ctx context.Context, now time.Time) (int, error)
+
+	// GetAgenda retrieves the agenda of a room in order (rooms of another tenant are not found)
+	GetAgenda(ctx context.Context, roomID, userID uuid.UUID) (*entities.Room, []*entities.AgendaItem, error)
+
+	// SetAgenda replaces the agenda of a room (host or co-host, until the meeting ends)
+	SetAgenda(ctx context.Context, roomID, userID uuid.UUID, items []AgendaItemInput) (*entities.Room, []*entities.AgendaItem, error)
+
+	// SetCurrentAgendaItem moves an ongoing meeting to an agenda item, or to none with a nil itemID (host or co-host)
+	SetCurrentAgendaItem(ctx context.Context, roomID, userID uuid.UUID, itemID *uuid.UUID) (*entities.Room, []*entities.AgendaItem, error)
+
+	// LockRoom closes a running room to new joins (host or co-host only)
+	LockRoom(ctx context.Context, roomID, userID uuid.UUID, mode entities.RoomLockMode) (*entities.Room, error)
+
+	// UnlockRoom opens a locked room to new joins again (host or co-host only)
+	UnlockRoom(ctx context.Context, roomID, userID uuid.UUID) (*entities.Room, error)
+
+	// SetPasscode sets, changes or (with an empty passcode) removes the join passcode of a room (host only)
+	SetPasscode(ctx context.Context, roomID, userID uuid.UUID, passcode string) (*entities.Room, error)
+
+	// VerifyPasscode checks a join passcode, rate limited per user (or per client IP for guests)
+	VerifyPasscode(ctx context.Context, room *entities.Room, passcode string, userID *uuid.UUID, clientIP string) error
+
+	// StartRecording starts recording a running room (host, or participants allowed to record)
+	StartRecording(ctx context.Context, roomID, userID uuid.UUID) (*entities.Recording, error)
+
+	// StopRecording ends the recording of a room (host, or participants allowed to record)
+	StopRecording(ctx context.Context, roomID, userID uuid.UUID) (*entities.Recording, error)
+
+	// PauseRecording pauses the recording of a room (host, or participants allowed to record)
+	PauseRecording(ctx context.Context, roomID, userID uuid.UUID) (*entities.Recording, error)
+
+	// ResumeRecording resumes a paused recording (host, or participants allowed to record)
+	ResumeRecording(ctx context.Context, roomID, userID uuid.UUID) (*entities.Recording, error)
+
+	// QueueTranscription queues the transcription of a completed recording, once, covering all its segments
+	QueueTranscription(ctx context.Context, recording *entities.Recording) error
+
+	// GetRecordingConsent retrieves whether a participant must (still) consent to be recorded
+	GetRecordingConsent(ctx context.Context, roomID, participantID uuid.UUID) (*RecordingConsentStatus, error)
+
+	// GiveRecordingConsent records that a participant consents to be recorded under the current policy
+	GiveRecordingConsent(ctx context.Context, input GiveRecordingConsentInput) (*RecordingConsentStatus, error)
+
+	// ListRecordingConsents retrieves every recording consent given in a room, for audits (host or co-host only)
+	ListRecordingConsents(ctx context.Context, roomID, userID uuid.UUID) (*entities.Room, []*entities.RecordingConsent, error)
+
+	// CheckJoinQuota checks that the plan of a room allows another join (starting: the join starts the meeting)
+	CheckJoinQuota(ctx context.Context, room *entities.Room, starting bool) error
+
+	// ListAuditLogs retrieves the access control audit trail of a room (host or co-host only)
+	ListAuditLogs(ctx context.Context, roomID, userID uuid.UUID, limit, offset int) ([]*entities.RoomAuditLog, int64, error)
+
+	// AuthorizeOrganization checks that a user is a member of an organization
+	AuthorizeOrganization(ctx context.Context, orgID, userID uuid.UUID) error
+
+	// CanAccessRoom checks that a user may see a room (organization members and invitees for organization rooms)
+	CanAccessRoom(ctx context.Context, room *entities.Room, userID uuid.UUID) error
+
+	// Authorize checks that a user may perform a privileged action in a room (host, or co-host per the permission matrix;
+	// organization owners and admins for the actions granted to them)
+	Authorize(ctx context.Context, room *entities.Room, userID uuid.UUID, action entities.RoomAction) error
+
 	// TransferHost transfers host role to another participant
 	TransferHost(ctx context.Context, roomID, currentHostID, newHostID uuid.UUID) error
 
@@ -61,6 +222,9 @@ type Service interface {
 	// GetLivekitURL returns the LiveKit server URL
 	GetLivekitURL() string
 
+	// GetRecordingConsentPolicy returns the recording policy version participants consent to
+	GetRecordingConsentPolicy() string
+
 	// GetRoomByLivekitName retrieves a room by LiveKit room name (for webhooks)
 	GetRoomByLivekitName(ctx context.Context, livekitName string) (*entities.Room, error)
 
@@ -70,21 +234,11 @@ type Service interface {
 	// GetParticipantByRoomAndUser retrieves a participant by room and user ID
 	GetParticipantByRoomAndUser(ctx context.Context, roomID, userID uuid.UUID) (*entities.Participant, error)
 
-	// Invitation methods
-	// InviteUserByEmail invites a user to join a room by email
-	InviteUserByEmail(ctx context.Context, roomID, inviterID uuid.UUID, email string) (*entities.Participant, error)
-
-	// GetInvitationsByEmail retrieves all invitations for a given email
-	GetInvitationsByEmail(ctx context.Context, email string) ([]*entities.Participant, error)
-
-	// AcceptInvitationByEmail accepts an invitation and joins the room
-	AcceptInvitationByEmail(ctx context.Context, roomID uuid.UUID, email string, userID uuid.UUID) (*entities.Room, *entities.Participant, string, error)
-
-	// DeclineInvitationByEmail declines an invitation
-	DeclineInvitationByEmail(ctx context.Context, roomID uuid.UUID, email string, userID uuid.UUID) error
+	// PublishEvent delivers an event to the room's event stream on every API instance
+	PublishEvent(ctx context.Context, event *entities.RoomEvent)
 
-	// GetRoomInvitations retrieves all invitations for a room (host only)
-	GetRoomInvitations(ctx context.Context, roomID, hostID uuid.UUID) ([]*entities.Participant, error)
+	// SubscribeEvents returns the event stream of a room and a function that ends the subscription
+	SubscribeEvents(roomID uuid.UUID) (<-chan *entities.RoomEvent, func())
 }
 
 // Ensure RoomService implements Service interface
@@ -0,0 +1,83 @@
+package room
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+)
+
+// GetTimeline builds the timeline of what happened during a meeting, in chronological order
+func (s *RoomService) GetTimeline(ctx context.Context, roomID uuid.UUID) ([]*entities.TimelineEntry, error) {
+	room, err := s.GetRoom(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	raises, err := s.participantRepo.FindHandRaises(ctx, roomID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get hand raises: %w", err)
+	}
+
+	timeline := make([]*entities.TimelineEntry, 0, len(raises)*2)
+	for _, raise := range raises {
+		participantID := raise.ParticipantID
+		name := ""
+		if raise.Participant != nil {
+			name = raise.Participant.DisplayName()
+		}
+
+		timeline = append(timeline, timelineEntry(room, entities.TimelineEntryHandRaised, raise.RaisedAt, &participantID, name, "Raised hand"))
+		if raise.LoweredAt != nil {
+			text := "Lowered hand"
+			if raise.LoweredBy != nil {
+				text = "Hand lowered by a moderator"
+			}
+			timeline = append(timeline, timelineEntry(room, entities.TimelineEntryHandLowered, *raise.LoweredAt, &participantID, name, text))
+		}
+	}
+
+	messages, err := s.chatRepo.FindVisibleByRoomID(ctx, roomID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chat messages: %w", err)
+	}
+	for _, message := range messages {
+		participantID := message.ParticipantID
+		timeline = append(timeline, timelineEntry(room, entities.TimelineEntryChatMessage, message.CreatedAt, &participantID, message.SenderName(), message.Content))
+	}
+
+	agenda, err := s.agendaRepo.FindByRoomID(ctx, roomID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get agenda: %w", err)
+	}
+	for _, item := range agenda {
+		if item.StartedAt != nil {
+			timeline = append(timeline, timelineEntry(room, entities.TimelineEntryAgendaItem, *item.StartedAt, nil, item.OwnerName(), fmt.Sprintf("Agenda item %d: %s", item.Number(), item.Title)))
+		}
+	}
+
+	sort.SliceStable(timeline, func(i, j int) bool {
+		return timeline[i].At.Before(timeline[j].At)
+	})
+
+	return timeline, nil
+}
+
+// timelineEntry creates a timeline entry positioned relative to the start of the meeting
+func timelineEntry(room *entities.Room, entryType entities.TimelineEntryType, at time.Time, participantID *uuid.UUID, displayName, text string) *entities.TimelineEntry {
+	entry := &entities.TimelineEntry{
+		Type:          entryType,
+		At:            at,
+		ParticipantID: participantID,
+		DisplayName:   displayName,
+		Text:          text,
+	}
+	if room.StartedAt != nil && at.After(*room.StartedAt) {
+		entry.OffsetSeconds = int(at.Sub(*room.StartedAt).Seconds())
+	}
+	return entry
+}
@@ -0,0 +1,109 @@
+package room
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/google/uuid"
+
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+)
+
+// SeatParticipant joins a participant that is allowed into a room, or puts it on the
+// waitlist when the room is full. New participants (without an ID) are created.
+// The host always gets a seat.
+func (s *RoomService) SeatParticipant(ctx context.Context, room *entities.Room, participant *entities.Participant) error {
+	isHost := participant.UserID != nil && *participant.UserID == room.HostID
+
+	seated, err := s.participantRepo.TakeSeat(ctx, participant, isHost)
+	if err != nil {
+		return fmt.Errorf("failed to seat participant: %w", err)
+	}
+	if seated {
+		// Recording pauses until the newcomer consented to be recorded
+		s.enforceRecordingConsent(ctx, room)
+		return nil
+	}
+
+	// Waitlisted participants keep their place in line
+	if participant.Status == entities.ParticipantStatusWaitlisted {
+		return nil
+	}
+
+	participant.Waitlist()
+	if participant.ID == uuid.Nil {
+		err = s.participantRepo.Create(ctx, participant)
+	} else {
+		err = s.participantRepo.Update(ctx, participant)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to waitlist participant: %w", err)
+	}
+
+	log.Printf("[Room] ⏳ Room full, participant waitlisted: room=%s, participant=%s", room.ID, participant.ID)
+	s.publishParticipantEvent(ctx, entities.RoomEventParticipantWaitlisted, participant, nil)
+
+	return nil
+}
+
+// releaseSeats recounts the participants of a room after someone left and
+// seats the waitlist, first come first seated, while seats are free
+func (s *RoomService) releaseSeats(ctx context.Context, roomID uuid.UUID) error {
+	if err := s.roomRepo.SyncParticipantCount(ctx, roomID); err != nil {
+		return fmt.Errorf("failed to sync participant count: %w", err)
+	}
+
+	for {
+		participant, err := s.participantRepo.SeatNextWaitlisted(ctx, roomID)
+		if err != nil {
+			return fmt.Errorf("failed to seat waitlisted participant: %w", err)
+		}
+		if participant == nil {
+			return nil
+		}
+
+		log.Printf("[Room] ✅ Waitlisted participant seated: room=%s, participant=%s", roomID, participant.ID)
+		s.publishParticipantEvent(ctx, entities.RoomEventParticipantAdmitted, participant, map[string]interface{}{
+			"from_waitlist": true,
+		})
+	}
+}
+
+// GetWaitlist retrieves the participants waiting for a seat in a full room, first in line first
+func (s *RoomService) GetWaitlist(ctx context.Context, roomID, userID uuid.UUID) ([]*entities.Participant, error) {
+	room, err := s.GetRoomForUser(ctx, roomID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify user may admit participants (host or co-host)
+	if err := s.Authorize(ctx, room, userID, entities.RoomActionAdmit); err != nil {
+		return nil, err
+	}
+
+	participants, err := s.participantRepo.FindWaitlistedByRoomID(ctx, roomID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get waitlist: %w", err)
+	}
+
+	return participants, nil
+}
+
+// WaitlistPosition returns the place of a waitlisted participant in line (1 = next), or 0 when it is not waitlisted
+func (s *RoomService) WaitlistPosition(ctx context.Context, participant *entities.Participant) (int, error) {
+	if participant.Status != entities.ParticipantStatusWaitlisted {
+		return 0, nil
+	}
+
+	waitlist, err := s.participantRepo.FindWaitlistedByRoomID(ctx, participant.RoomID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get waitlist: %w", err)
+	}
+	for i, p := range waitlist {
+		if p.ID == participant.ID {
+			return i + 1, nil
+		}
+	}
+	return 0, nil
+}
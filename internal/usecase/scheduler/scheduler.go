@@ -0,0 +1,391 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+	"github.com/johnquangdev/meeting-assistant/internal/domain/repositories"
+	lkpkg "github.com/johnquangdev/meeting-assistant/internal/infrastructure/external/livekit"
+	usecaseErrors "github.com/johnquangdev/meeting-assistant/internal/usecase/errors"
+	"github.com/johnquangdev/meeting-assistant/internal/usecase/notification"
+	"github.com/johnquangdev/meeting-assistant/internal/usecase/room"
+	"github.com/johnquangdev/meeting-assistant/pkg/config"
+)
+
+// SystemTopic is the LiveKit data topic used for server-sent room notices
+const SystemTopic = "system"
+
+// EndReason explains why the scheduler closed a room
+type EndReason string
+
+const (
+	EndReasonScheduledEnd EndReason = "scheduled_end"
+	EndReasonIdle         EndReason = "idle"
+	EndReasonNoShow       EndReason = "no_show"
+)
+
+// RoomEndingNotice is sent into the LiveKit room before a forced end
+type RoomEndingNotice struct {
+	Type             string    `json:"type"` // room_ending
+	Reason           EndReason `json:"reason"`
+	EndsAt           time.Time `json:"ends_at"`
+	SecondsRemaining int       `json:"seconds_remaining"`
+}
+
+// Scheduler drives the lifecycle of scheduled rooms: it emails reminders before the start,
+// opens rooms at their start time, cancels rooms nobody showed up to, and ends idle or
+// overdue rooms through RoomService.EndRoom.
+// Every API instance runs a scheduler: each transition is claimed in the database, so it happens once.
+type Scheduler struct {
+	roomRepo        repositories.RoomRepository
+	participantRepo repositories.ParticipantRepository
+	roomService     room.Service
+	livekitClient   lkpkg.Client
+	notifier        notification.Service
+	cfg             config.SchedulerConfig
+
+	workerStopChan  chan struct{}
+	workerWg        sync.WaitGroup
+	isWorkerRunning bool
+	workerMutex     sync.Mutex
+}
+
+// NewScheduler creates a new room scheduler
+func NewScheduler(
+	roomRepo repositories.RoomRepository,
+	participantRepo repositories.ParticipantRepository,
+	roomService room.Service,
+	livekitClient lkpkg.Client,
+	notifier notification.Service,
+	cfg config.SchedulerConfig,
+) *Scheduler {
+	if cfg.Interval <= 0 {
+		cfg.Interval = 30 * time.Second
+	}
+
+	return &Scheduler{
+		roomRepo:        roomRepo,
+		participantRepo: participantRepo,
+		roomService:     roomService,
+		livekitClient:   livekitClient,
+		notifier:        notifier,
+		cfg:             cfg,
+	}
+}
+
+// StartWorker starts the scheduler loop
+func (s *Scheduler) StartWorker(ctx context.Context) error {
+	s.workerMutex.Lock()
+	defer s.workerMutex.Unlock()
+
+	if s.isWorkerRunning {
+		return fmt.Errorf("scheduler already running")
+	}
+
+	s.workerStopChan = make(chan struct{})
+	s.isWorkerRunning = true
+
+	s.workerWg.Add(1)
+	go s.worker(ctx)
+
+	log.Printf("[Scheduler] ✅ Started (interval=%s, reminder_lead=%s, no_show_grace=%s, idle_timeout=%s, overrun_grace=%s, end_warning=%s)",
+		s.cfg.Interval, s.cfg.ReminderLead, s.cfg.NoShowGrace, s.cfg.IdleTimeout, s.cfg.OverrunGrace, s.cfg.EndWarning)
+	return nil
+}
+
+// StopWorker gracefully stops the scheduler loop
+func (s *Scheduler) StopWorker() error {
+	s.workerMutex.Lock()
+	defer s.workerMutex.Unlock()
+
+	if !s.isWorkerRunning {
+		return fmt.Errorf("scheduler not running")
+	}
+
+	close(s.workerStopChan)
+	s.workerWg.Wait()
+	s.isWorkerRunning = false
+
+	log.Printf("[Scheduler] 🛑 Stopped")
+	return nil
+}
+
+func (s *Scheduler) worker(ctx context.Context) {
+	defer s.workerWg.Done()
+
+	ticker := time.NewTicker(s.cfg.Interval)
+	defer ticker.Stop()
+
+	s.Tick(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.workerStopChan:
+			return
+		case <-ticker.C:
+			s.Tick(ctx)
+		}
+	}
+}
+
+// Tick runs a single scheduling pass over scheduled and active rooms and due polls
+func (s *Scheduler) Tick(ctx context.Context) {
+	now := time.Now()
+
+	scheduled, err := s.roomRepo.FindScheduledRooms(ctx)
+	if err != nil {
+		log.Printf("[Scheduler] ❌ Failed to get scheduled rooms: %v", err)
+	} else {
+		for _, r := range scheduled {
+			s.handleScheduledRoom(ctx, r, now)
+		}
+	}
+
+	if closed, err := s.roomService.CloseDuePolls(ctx, now); err != nil {
+		log.Printf("[Scheduler] ❌ Failed to close due polls: %v", err)
+	} else if closed > 0 {
+		log.Printf("[Scheduler] 📊 Closed %d poll(s) past their deadline", closed)
+	}
+
+	active, err := s.roomRepo.FindActiveRooms(ctx)
+	if err != nil {
+		log.Printf("[Scheduler] ❌ Failed to get active rooms: %v", err)
+		return
+	}
+	for _, r := range active {
+		s.handleActiveRoom(ctx, r, now)
+	}
+}
+
+// handleScheduledRoom reminds participants before the start, opens a room at its start time
+// or cancels it when nobody showed up
+func (s *Scheduler) handleScheduledRoom(ctx context.Context, r *entities.Room, now time.Time) {
+	// Ad-hoc rooms have no start time and are opened by the host joining
+	if r.ScheduledStartTime == nil {
+		return
+	}
+	if now.Before(*r.ScheduledStartTime) {
+		s.remind(ctx, r, now)
+		return
+	}
+
+	if s.isNoShow(ctx, r, now) {
+		s.cancelNoShow(ctx, r)
+		return
+	}
+
+	// StartRoom claims the start, so a room opened by another instance is not opened again
+	if _, err := s.roomService.StartRoom(ctx, r.ID, r.HostID); err != nil {
+		if !errors.Is(err, usecaseErrors.ErrRoomEnded) {
+			log.Printf("[Scheduler] ❌ Failed to start room %s: %v", r.ID, err)
+		}
+		return
+	}
+	log.Printf("[Scheduler] ▶️  Room opened at scheduled time: id=%s, name=%s", r.ID, r.Name)
+}
+
+// remind emails the meeting reminder once, when the room enters the reminder window
+func (s *Scheduler) remind(ctx context.Context, r *entities.Room, now time.Time) {
+	if s.notifier == nil || s.cfg.ReminderLead <= 0 || r.ReminderSentAt != nil {
+		return
+	}
+	if now.Before(r.ScheduledStartTime.Add(-s.cfg.ReminderLead)) {
+		return
+	}
+
+	// Mark first so a slow or failing mail server never causes duplicate reminders
+	marked, err := s.roomRepo.MarkReminderSent(ctx, r.ID, now)
+	if err != nil {
+		log.Printf("[Scheduler] ❌ Failed to mark reminder of room %s: %v", r.ID, err)
+		return
+	}
+	if !marked {
+		return
+	}
+
+	go func() {
+		if err := s.notifier.SendMeetingReminder(context.WithoutCancel(ctx), r); err != nil {
+			log.Printf("[Scheduler] ⚠️  Failed to send reminder of room %s: %v", r.ID, err)
+		}
+	}()
+}
+
+// handleActiveRoom ends rooms that are idle or past their scheduled end
+func (s *Scheduler) handleActiveRoom(ctx context.Context, r *entities.Room, now time.Time) {
+	if s.isNoShow(ctx, r, now) {
+		s.cancelNoShow(ctx, r)
+		return
+	}
+
+	// Overdue: warn first, then force end once the overrun grace has passed
+	if r.ScheduledEndTime != nil {
+		forcedEnd := r.ScheduledEndTime.Add(s.cfg.OverrunGrace)
+		if !now.Before(forcedEnd) {
+			s.endRoom(ctx, r, EndReasonScheduledEnd)
+			return
+		}
+		if !now.Before(forcedEnd.Add(-s.cfg.EndWarning)) {
+			s.warn(ctx, r, EndReasonScheduledEnd, forcedEnd, now)
+		}
+	}
+
+	if s.cfg.IdleTimeout <= 0 {
+		return
+	}
+
+	// Breakout rooms are closed by the host, and a meeting whose participants are in breakout rooms is not idle
+	if r.IsBreakout() {
+		return
+	}
+	if open, err := s.hasOpenBreakouts(ctx, r); err != nil {
+		log.Printf("[Scheduler] ❌ Failed to get breakout rooms of room %s: %v", r.ID, err)
+		return
+	} else if open {
+		return
+	}
+
+	idleSince, err := s.idleSince(ctx, r)
+	if err != nil {
+		log.Printf("[Scheduler] ❌ Failed to check activity of room %s: %v", r.ID, err)
+		return
+	}
+	if idleSince != nil && now.Sub(*idleSince) >= s.cfg.IdleTimeout {
+		s.endRoom(ctx, r, EndReasonIdle)
+	}
+}
+
+// isNoShow reports whether nobody joined a scheduled room within the grace period
+func (s *Scheduler) isNoShow(ctx context.Context, r *entities.Room, now time.Time) bool {
+	if s.cfg.NoShowGrace <= 0 || r.ScheduledStartTime == nil {
+		return false
+	}
+	if now.Before(r.ScheduledStartTime.Add(s.cfg.NoShowGrace)) {
+		return false
+	}
+
+	participants, err := s.participantRepo.FindByRoomID(ctx, r.ID)
+	if err != nil {
+		log.Printf("[Scheduler] ❌ Failed to get participants of room %s: %v", r.ID, err)
+		return false
+	}
+	for _, p := range participants {
+		if p.JoinedAt != nil || p.IsActive() {
+			return false
+		}
+	}
+	return true
+}
+
+// hasOpenBreakouts checks if a room has breakout rooms that have not ended yet
+func (s *Scheduler) hasOpenBreakouts(ctx context.Context, r *entities.Room) (bool, error) {
+	breakouts, err := s.roomRepo.FindBreakouts(ctx, r.ID)
+	if err != nil {
+		return false, err
+	}
+	for _, b := range breakouts {
+		if b.IsActive() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// idleSince returns when the room became empty, or nil if someone is still in it
+func (s *Scheduler) idleSince(ctx context.Context, r *entities.Room) (*time.Time, error) {
+	participants, err := s.participantRepo.FindByRoomID(ctx, r.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	since := r.StartedAt
+	joined := false
+	for _, p := range participants {
+		if p.IsActive() {
+			return nil, nil
+		}
+		if p.JoinedAt != nil || p.LeftAt != nil {
+			joined = true
+		}
+		if p.LeftAt != nil && (since == nil || p.LeftAt.After(*since)) {
+			since = p.LeftAt
+		}
+	}
+
+	// Scheduled rooms nobody joined yet are handled by the no-show check
+	if !joined && r.ScheduledStartTime != nil {
+		return nil, nil
+	}
+	return since, nil
+}
+
+// warn sends the end warning into the LiveKit room once per room
+func (s *Scheduler) warn(ctx context.Context, r *entities.Room, reason EndReason, endsAt, now time.Time) {
+	if r.EndWarningSentAt != nil {
+		return
+	}
+
+	notice := RoomEndingNotice{
+		Type:             "room_ending",
+		Reason:           reason,
+		EndsAt:           endsAt,
+		SecondsRemaining: int(endsAt.Sub(now).Seconds()),
+	}
+	data, err := json.Marshal(notice)
+	if err != nil {
+		log.Printf("[Scheduler] ❌ Failed to encode end warning for room %s: %v", r.ID, err)
+		return
+	}
+
+	// Mark first so the instances running the scheduler never warn twice; a warning that could
+	// not be sent is unmarked and sent on the next tick
+	marked, err := s.roomRepo.MarkEndWarningSent(ctx, r.ID, now)
+	if err != nil {
+		log.Printf("[Scheduler] ❌ Failed to mark end warning of room %s: %v", r.ID, err)
+		return
+	}
+	if !marked {
+		return
+	}
+
+	if err := s.livekitClient.SendData(ctx, r.LivekitRoomName, data, SystemTopic); err != nil {
+		log.Printf("[Scheduler] ⚠️  Failed to send end warning to room %s: %v", r.ID, err)
+		if err := s.roomRepo.ResetEndWarning(ctx, r.ID); err != nil {
+			log.Printf("[Scheduler] ❌ Failed to unmark end warning of room %s: %v", r.ID, err)
+		}
+		return
+	}
+
+	log.Printf("[Scheduler] ⏰ End warning sent: room=%s, ends_at=%s", r.ID, endsAt.Format(time.RFC3339))
+}
+
+// endRoom ends a room on behalf of its host
+func (s *Scheduler) endRoom(ctx context.Context, r *entities.Room, reason EndReason) {
+	if err := s.roomService.EndRoom(ctx, r.ID, r.HostID); err != nil {
+		// Ended by the host or another instance in the meantime
+		if !errors.Is(err, usecaseErrors.ErrRoomEnded) {
+			log.Printf("[Scheduler] ❌ Failed to end room %s (%s): %v", r.ID, reason, err)
+		}
+		return
+	}
+	log.Printf("[Scheduler] ⏹️  Room ended: id=%s, reason=%s", r.ID, reason)
+}
+
+// cancelNoShow calls off a room nobody joined, through the room service so the room is closed
+// in LiveKit and everyone waiting on its events is told
+func (s *Scheduler) cancelNoShow(ctx context.Context, r *entities.Room) {
+	if err := s.roomService.CancelRoom(ctx, r.ID, string(EndReasonNoShow)); err != nil {
+		if !errors.Is(err, usecaseErrors.ErrRoomEnded) {
+			log.Printf("[Scheduler] ❌ Failed to cancel room %s: %v", r.ID, err)
+		}
+		return
+	}
+	log.Printf("[Scheduler] 🚫 Room cancelled (no-show): id=%s, name=%s", r.ID, r.Name)
+}
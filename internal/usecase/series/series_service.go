@@ -0,0 +1,774 @@
+package series
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+	"github.com/johnquangdev/meeting-assistant/internal/domain/repositories"
+	usecaseErrors "github.com/johnquangdev/meeting-assistant/internal/usecase/errors"
+	"github.com/johnquangdev/meeting-assistant/internal/usecase/room"
+	"github.com/johnquangdev/meeting-assistant/pkg/rrule"
+)
+
+const (
+	// DefaultExpansionHorizon is how far ahead the worker materializes occurrences
+	DefaultExpansionHorizon = 7 * 24 * time.Hour
+
+	// maxExpansionWindow caps manual expansion so a single call cannot create unbounded rooms
+	maxExpansionWindow = 90 * 24 * time.Hour
+
+	// maxOccurrencesPerQuery caps how many occurrences a single listing returns
+	maxOccurrencesPerQuery = 500
+
+	expansionInterval = 1 * time.Hour
+)
+
+// OccurrenceStatus describes the state of a single occurrence
+type OccurrenceStatus string
+
+const (
+	OccurrenceStatusPending      OccurrenceStatus = "pending"      // not yet materialized into a room
+	OccurrenceStatusMaterialized OccurrenceStatus = "materialized" // room exists
+	OccurrenceStatusCancelled    OccurrenceStatus = "cancelled"
+)
+
+// Occurrence is a single instance of a series, merged with its exception and room
+type Occurrence struct {
+	OriginalStartTime time.Time
+	StartTime         time.Time
+	EndTime           time.Time
+	Status            OccurrenceStatus
+	Exception         *entities.SeriesException
+	Room              *entities.Room
+}
+
+// OccurrenceSummary pairs an occurrence room with its AI summary (nil if not generated yet)
+type OccurrenceSummary struct {
+	Room    *entities.Room
+	Summary *entities.MeetingSummary
+}
+
+// CreateSeriesInput represents input for creating a series
+type CreateSeriesInput struct {
+	Name            string
+	Description     *string
+	HostID          uuid.UUID
+	RoomType        entities.RoomType
+	MaxParticipants int
+	Settings        map[string]interface{}
+	RRule           string
+	Timezone        string
+	StartTime       time.Time
+	DurationMinutes int
+}
+
+// RescheduleOccurrenceInput represents input for moving an occurrence
+type RescheduleOccurrenceInput struct {
+	SeriesID        uuid.UUID
+	UserID          uuid.UUID
+	OccurrenceStart time.Time
+	NewStartTime    time.Time
+	NewEndTime      *time.Time
+	Reason          *string
+}
+
+// SeriesService handles recurring meeting series business logic
+type SeriesService struct {
+	seriesRepo      repositories.SeriesRepository
+	roomRepo        repositories.RoomRepository
+	participantRepo repositories.ParticipantRepository
+	summaryRepo     repositories.AIRepository
+	roomService     room.Service
+
+	workerStopChan  chan struct{}
+	workerWg        sync.WaitGroup
+	isWorkerRunning bool
+	workerMutex     sync.Mutex
+}
+
+// NewSeriesService creates a new series service
+func NewSeriesService(
+	seriesRepo repositories.SeriesRepository,
+	roomRepo repositories.RoomRepository,
+	participantRepo repositories.ParticipantRepository,
+	summaryRepo repositories.AIRepository,
+	roomService room.Service,
+) *SeriesService {
+	return &SeriesService{
+		seriesRepo:      seriesRepo,
+		roomRepo:        roomRepo,
+		participantRepo: participantRepo,
+		summaryRepo:     summaryRepo,
+		roomService:     roomService,
+	}
+}
+
+// CreateSeries creates a new recurring meeting series
+func (s *SeriesService) CreateSeries(ctx context.Context, input CreateSeriesInput) (*entities.MeetingSeries, error) {
+	if input.MaxParticipants < 2 || input.MaxParticipants > 100 {
+		return nil, usecaseErrors.ErrInvalidMaxParticipants
+	}
+	if input.DurationMinutes <= 0 {
+		input.DurationMinutes = 60
+	}
+	if input.Timezone == "" {
+		input.Timezone = "UTC"
+	}
+	if _, err := time.LoadLocation(input.Timezone); err != nil {
+		return nil, usecaseErrors.ErrInvalidTimezone
+	}
+
+	rule, err := rrule.Parse(input.RRule)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", usecaseErrors.ErrInvalidRecurrenceRule, err)
+	}
+
+	series := &entities.MeetingSeries{
+		HostID:          input.HostID,
+		Name:            input.Name,
+		Description:     input.Description,
+		RoomType:        input.RoomType,
+		MaxParticipants: input.MaxParticipants,
+		RRule:           rule.String(),
+		Timezone:        input.Timezone,
+		StartTime:       input.StartTime.UTC(),
+		DurationMinutes: input.DurationMinutes,
+		Status:          entities.SeriesStatusActive,
+	}
+
+	if input.Settings != nil {
+		settings, err := json.Marshal(input.Settings)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal settings: %w", err)
+		}
+		series.Settings = settings
+	}
+
+	if err := s.seriesRepo.Create(ctx, series); err != nil {
+		return nil, fmt.Errorf("failed to create series: %w", err)
+	}
+
+	log.Printf("[Series] Series created: id=%s, rule=%s, host=%s", series.ID, series.RRule, series.HostID)
+
+	return series, nil
+}
+
+// GetSeries retrieves a series the user may browse: its host, the participants of its occurrences
+// and the members of their organization. Other users do not find it.
+func (s *SeriesService) GetSeries(ctx context.Context, seriesID, userID uuid.UUID) (*entities.MeetingSeries, error) {
+	series, err := s.findSeries(ctx, seriesID)
+	if err != nil {
+		return nil, err
+	}
+	if series.HostID == userID {
+		return series, nil
+	}
+
+	rooms, err := s.seriesRepo.FindOccurrenceRooms(ctx, seriesID, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get occurrence rooms: %w", err)
+	}
+	for _, r := range rooms {
+		allowed, err := s.canReadOccurrence(ctx, r, userID)
+		if err != nil {
+			return nil, err
+		}
+		if allowed {
+			return series, nil
+		}
+	}
+	return nil, usecaseErrors.ErrSeriesNotFound
+}
+
+// findSeries retrieves a series by ID
+func (s *SeriesService) findSeries(ctx context.Context, seriesID uuid.UUID) (*entities.MeetingSeries, error) {
+	series, err := s.seriesRepo.FindByID(ctx, seriesID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, usecaseErrors.ErrSeriesNotFound
+		}
+		return nil, fmt.Errorf("failed to get series: %w", err)
+	}
+	return series, nil
+}
+
+// ListSeries retrieves the series hosted by a user
+func (s *SeriesService) ListSeries(ctx context.Context, hostID uuid.UUID, limit, offset int) ([]*entities.MeetingSeries, error) {
+	series, err := s.seriesRepo.FindByHostID(ctx, hostID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list series: %w", err)
+	}
+	return series, nil
+}
+
+// EndSeries stops a series and cancels its future occurrences that have not started
+func (s *SeriesService) EndSeries(ctx context.Context, seriesID, userID uuid.UUID) error {
+	series, err := s.getHostedSeries(ctx, seriesID, userID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	rooms, err := s.seriesRepo.FindOccurrenceRooms(ctx, seriesID, &now, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get occurrence rooms: %w", err)
+	}
+	for _, r := range rooms {
+		if r.Status != entities.RoomStatusScheduled {
+			continue
+		}
+		if err := s.roomRepo.UpdateStatus(ctx, r.ID, entities.RoomStatusCancelled); err != nil {
+			return fmt.Errorf("failed to cancel occurrence room: %w", err)
+		}
+	}
+
+	series.Status = entities.SeriesStatusEnded
+	if err := s.seriesRepo.Update(ctx, series); err != nil {
+		return fmt.Errorf("failed to end series: %w", err)
+	}
+
+	log.Printf("[Series] Series ended: id=%s, cancelled_rooms=%d", seriesID, len(rooms))
+
+	return nil
+}
+
+// ListOccurrences expands the series rule in [from, to) and merges exceptions and generated rooms
+func (s *SeriesService) ListOccurrences(ctx context.Context, seriesID, userID uuid.UUID, from, to time.Time) ([]*Occurrence, error) {
+	series, err := s.GetSeries(ctx, seriesID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.occurrences(ctx, series, from.UTC(), to.UTC())
+}
+
+// MaterializeOccurrence creates (or returns) the room for a single occurrence
+func (s *SeriesService) MaterializeOccurrence(ctx context.Context, seriesID, userID uuid.UUID, occurrenceStart time.Time) (*entities.Room, error) {
+	series, err := s.getHostedSeries(ctx, seriesID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !series.IsActive() {
+		return nil, usecaseErrors.ErrSeriesEnded
+	}
+
+	occ, err := s.lookupOccurrence(ctx, series, occurrenceStart)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.materialize(ctx, series, occ)
+}
+
+// ExpandSeries creates rooms for all upcoming occurrences up to the given time
+func (s *SeriesService) ExpandSeries(ctx context.Context, seriesID, userID uuid.UUID, until time.Time) ([]*entities.Room, error) {
+	series, err := s.getHostedSeries(ctx, seriesID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !series.IsActive() {
+		return nil, usecaseErrors.ErrSeriesEnded
+	}
+
+	now := time.Now().UTC()
+	if limit := now.Add(maxExpansionWindow); until.After(limit) {
+		until = limit
+	}
+
+	return s.expand(ctx, series, now, until.UTC())
+}
+
+// ExpandUpcoming creates rooms ahead of time for every active series
+func (s *SeriesService) ExpandUpcoming(ctx context.Context, horizon time.Duration) (int, error) {
+	seriesList, err := s.seriesRepo.FindActive(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get active series: %w", err)
+	}
+
+	now := time.Now().UTC()
+	created := 0
+	for _, series := range seriesList {
+		rooms, err := s.expand(ctx, series, now, now.Add(horizon))
+		if err != nil {
+			log.Printf("[Series] ⚠️ Failed to expand series %s: %v", series.ID, err)
+			continue
+		}
+		created += len(rooms)
+
+		// A rule with COUNT/UNTIL eventually runs out of occurrences
+		if finished, err := s.isFinished(series, now); err == nil && finished {
+			series.Status = entities.SeriesStatusEnded
+			if err := s.seriesRepo.Update(ctx, series); err != nil {
+				log.Printf("[Series] ⚠️ Failed to mark series %s as ended: %v", series.ID, err)
+			}
+		}
+	}
+
+	return created, nil
+}
+
+// CancelOccurrence cancels a single occurrence
+func (s *SeriesService) CancelOccurrence(ctx context.Context, seriesID, userID uuid.UUID, occurrenceStart time.Time, reason *string) error {
+	series, err := s.getHostedSeries(ctx, seriesID, userID)
+	if err != nil {
+		return err
+	}
+
+	occ, err := s.lookupOccurrence(ctx, series, occurrenceStart)
+	if err != nil {
+		return err
+	}
+	if occ.Room != nil && occ.Room.Status != entities.RoomStatusScheduled && occ.Room.Status != entities.RoomStatusCancelled {
+		return usecaseErrors.ErrOccurrenceAlreadyLocked
+	}
+
+	exception := &entities.SeriesException{
+		SeriesID:          seriesID,
+		OriginalStartTime: occ.OriginalStartTime,
+		Type:              entities.SeriesExceptionCancelled,
+		Reason:            reason,
+		CreatedBy:         userID,
+	}
+	if err := s.seriesRepo.SaveException(ctx, exception); err != nil {
+		return fmt.Errorf("failed to save exception: %w", err)
+	}
+
+	if occ.Room != nil && occ.Room.Status == entities.RoomStatusScheduled {
+		if err := s.roomRepo.UpdateStatus(ctx, occ.Room.ID, entities.RoomStatusCancelled); err != nil {
+			return fmt.Errorf("failed to cancel occurrence room: %w", err)
+		}
+	}
+
+	log.Printf("[Series] Occurrence cancelled: series=%s, occurrence=%s", seriesID, occ.OriginalStartTime.Format(time.RFC3339))
+
+	return nil
+}
+
+// RescheduleOccurrence moves a single occurrence to a new time
+func (s *SeriesService) RescheduleOccurrence(ctx context.Context, input RescheduleOccurrenceInput) (*Occurrence, error) {
+	series, err := s.getHostedSeries(ctx, input.SeriesID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	occ, err := s.lookupOccurrence(ctx, series, input.OccurrenceStart)
+	if err != nil {
+		return nil, err
+	}
+	if occ.Status == OccurrenceStatusCancelled {
+		return nil, usecaseErrors.ErrOccurrenceCancelled
+	}
+	if occ.Room != nil && occ.Room.Status != entities.RoomStatusScheduled {
+		return nil, usecaseErrors.ErrOccurrenceAlreadyLocked
+	}
+
+	newStart := input.NewStartTime.UTC()
+	newEnd := newStart.Add(series.Duration())
+	if input.NewEndTime != nil {
+		if !input.NewEndTime.After(newStart) {
+			return nil, usecaseErrors.ErrInvalidInput
+		}
+		newEnd = input.NewEndTime.UTC()
+	}
+
+	exception := &entities.SeriesException{
+		SeriesID:          input.SeriesID,
+		OriginalStartTime: occ.OriginalStartTime,
+		Type:              entities.SeriesExceptionRescheduled,
+		NewStartTime:      &newStart,
+		NewEndTime:        &newEnd,
+		Reason:            input.Reason,
+		CreatedBy:         input.UserID,
+	}
+	if err := s.seriesRepo.SaveException(ctx, exception); err != nil {
+		return nil, fmt.Errorf("failed to save exception: %w", err)
+	}
+
+	if occ.Room != nil {
+		occ.Room.ScheduledStartTime = &newStart
+		occ.Room.ScheduledEndTime = &newEnd
+		if err := s.roomRepo.Update(ctx, occ.Room); err != nil {
+			return nil, fmt.Errorf("failed to reschedule occurrence room: %w", err)
+		}
+		if err := s.roomRepo.ResetReminder(ctx, occ.Room.ID); err != nil {
+			return nil, fmt.Errorf("failed to reset occurrence reminder: %w", err)
+		}
+		occ.Room.ReminderSentAt = nil
+	}
+
+	occ.StartTime = newStart
+	occ.EndTime = newEnd
+	occ.Exception = exception
+
+	log.Printf("[Series] Occurrence rescheduled: series=%s, occurrence=%s, new_start=%s",
+		input.SeriesID, occ.OriginalStartTime.Format(time.RFC3339), newStart.Format(time.RFC3339))
+
+	return occ, nil
+}
+
+// GetSeriesSummaries retrieves the AI summaries of all occurrences
+func (s *SeriesService) GetSeriesSummaries(ctx context.Context, seriesID, userID uuid.UUID) ([]*OccurrenceSummary, error) {
+	if _, err := s.GetSeries(ctx, seriesID, userID); err != nil {
+		return nil, err
+	}
+
+	rooms, err := s.seriesRepo.FindOccurrenceRooms(ctx, seriesID, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get occurrence rooms: %w", err)
+	}
+
+	summaries := make([]*OccurrenceSummary, 0, len(rooms))
+	for _, r := range rooms {
+		if r.Status == entities.RoomStatusCancelled {
+			continue
+		}
+		summary, err := s.summaryRepo.GetMeetingSummaryByRoom(ctx, r.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get summary for room %s: %w", r.ID, err)
+		}
+		summaries = append(summaries, &OccurrenceSummary{Room: r, Summary: summary})
+	}
+
+	return summaries, nil
+}
+
+// GetSeriesActionItems retrieves the action items of all occurrences
+func (s *SeriesService) GetSeriesActionItems(ctx context.Context, seriesID, userID uuid.UUID) ([]*entities.ActionItem, error) {
+	if _, err := s.GetSeries(ctx, seriesID, userID); err != nil {
+		return nil, err
+	}
+
+	rooms, err := s.seriesRepo.FindOccurrenceRooms(ctx, seriesID, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get occurrence rooms: %w", err)
+	}
+
+	var items []*entities.ActionItem
+	for _, r := range rooms {
+		roomItems, err := s.summaryRepo.ListActionItemsByRoom(r.ID.String())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get action items for room %s: %w", r.ID, err)
+		}
+		items = append(items, roomItems...)
+	}
+
+	return items, nil
+}
+
+// StartWorker starts the background job that expands series ahead of time
+func (s *SeriesService) StartWorker(ctx context.Context) error {
+	s.workerMutex.Lock()
+	defer s.workerMutex.Unlock()
+
+	if s.isWorkerRunning {
+		return fmt.Errorf("series worker already running")
+	}
+
+	s.isWorkerRunning = true
+	s.workerStopChan = make(chan struct{})
+
+	s.workerWg.Add(1)
+	go s.expansionWorker(ctx)
+
+	return nil
+}
+
+// StopWorker gracefully stops the background job
+func (s *SeriesService) StopWorker() error {
+	s.workerMutex.Lock()
+	defer s.workerMutex.Unlock()
+
+	if !s.isWorkerRunning {
+		return fmt.Errorf("series worker not running")
+	}
+
+	close(s.workerStopChan)
+	s.workerWg.Wait()
+	s.isWorkerRunning = false
+
+	return nil
+}
+
+// expansionWorker periodically materializes upcoming occurrences of all active series
+func (s *SeriesService) expansionWorker(ctx context.Context) {
+	defer s.workerWg.Done()
+
+	ticker := time.NewTicker(expansionInterval)
+	defer ticker.Stop()
+
+	log.Println("[Series] 👷 Expansion worker started")
+
+	expand := func() {
+		created, err := s.ExpandUpcoming(ctx, DefaultExpansionHorizon)
+		if err != nil {
+			log.Printf("[Series] ❌ Failed to expand upcoming occurrences: %v", err)
+			return
+		}
+		if created > 0 {
+			log.Printf("[Series] ✅ Materialized %d upcoming occurrences", created)
+		}
+	}
+
+	// Run once at startup so a restart does not delay expansion by a full interval
+	expand()
+
+	for {
+		select {
+		case <-s.workerStopChan:
+			log.Println("[Series] 👷 Expansion worker stopping")
+			return
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			expand()
+		}
+	}
+}
+
+// getHostedSeries loads a series and checks that the user is its host
+func (s *SeriesService) getHostedSeries(ctx context.Context, seriesID, userID uuid.UUID) (*entities.MeetingSeries, error) {
+	series, err := s.findSeries(ctx, seriesID)
+	if err != nil {
+		return nil, err
+	}
+	if series.HostID != userID {
+		return nil, usecaseErrors.ErrNotHost
+	}
+	return series, nil
+}
+
+// canReadOccurrence reports whether the user took part in an occurrence or belongs to its organization.
+// Personal occurrences are open to any user by room access rules, so they require a participant.
+func (s *SeriesService) canReadOccurrence(ctx context.Context, r *entities.Room, userID uuid.UUID) (bool, error) {
+	participant, err := s.participantRepo.FindByRoomAndUser(ctx, r.ID, userID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, fmt.Errorf("failed to get participant: %w", err)
+	}
+	if participant != nil && !participant.IsRemoved {
+		return true, nil
+	}
+	if r.OrganizationID == nil {
+		return false, nil
+	}
+
+	if err := s.roomService.CanAccessRoom(ctx, r, userID); err != nil {
+		if errors.Is(err, usecaseErrors.ErrNotOrganizationMember) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// lookupOccurrence resolves a single occurrence by its original start time
+func (s *SeriesService) lookupOccurrence(ctx context.Context, series *entities.MeetingSeries, occurrenceStart time.Time) (*Occurrence, error) {
+	start := occurrenceStart.UTC()
+	occurrences, err := s.occurrences(ctx, series, start, start.Add(time.Second))
+	if err != nil {
+		return nil, err
+	}
+	occ := findOccurrence(occurrences, start)
+	if occ == nil {
+		return nil, usecaseErrors.ErrOccurrenceNotFound
+	}
+	return occ, nil
+}
+
+// expand materializes every pending occurrence whose original start is in [from, to)
+func (s *SeriesService) expand(ctx context.Context, series *entities.MeetingSeries, from, to time.Time) ([]*entities.Room, error) {
+	occurrences, err := s.occurrences(ctx, series, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	var created []*entities.Room
+	for _, occ := range occurrences {
+		if occ.Status != OccurrenceStatusPending {
+			continue
+		}
+		r, err := s.materialize(ctx, series, occ)
+		if err != nil {
+			return created, err
+		}
+		created = append(created, r)
+	}
+
+	if series.MaterializedUntil == nil || to.After(*series.MaterializedUntil) {
+		series.MaterializedUntil = &to
+		if err := s.seriesRepo.Update(ctx, series); err != nil {
+			return created, fmt.Errorf("failed to update series: %w", err)
+		}
+	}
+
+	return created, nil
+}
+
+// materialize creates the room backing an occurrence
+func (s *SeriesService) materialize(ctx context.Context, series *entities.MeetingSeries, occ *Occurrence) (*entities.Room, error) {
+	if occ.Status == OccurrenceStatusCancelled {
+		return nil, usecaseErrors.ErrOccurrenceCancelled
+	}
+	if occ.Room != nil {
+		return occ.Room, nil
+	}
+
+	var settings map[string]interface{}
+	if len(series.Settings) > 0 {
+		if err := json.Unmarshal(series.Settings, &settings); err != nil {
+			return nil, fmt.Errorf("failed to parse series settings: %w", err)
+		}
+	}
+
+	originalStart := occ.OriginalStartTime
+	start, end := occ.StartTime, occ.EndTime
+	output, err := s.roomService.CreateRoom(ctx, room.CreateRoomInput{
+		Name:                series.Name,
+		Description:         series.Description,
+		HostID:              series.HostID,
+		Type:                series.RoomType,
+		MaxParticipants:     series.MaxParticipants,
+		Settings:            settings,
+		ScheduledStartTime:  &start,
+		ScheduledEndTime:    &end,
+		SeriesID:            &series.ID,
+		OccurrenceStartTime: &originalStart,
+	})
+	if err != nil {
+		// Another request may have materialized the same occurrence concurrently
+		if existing, findErr := s.seriesRepo.FindOccurrenceRoom(ctx, series.ID, originalStart); findErr == nil {
+			return existing, nil
+		}
+		return nil, fmt.Errorf("failed to create occurrence room: %w", err)
+	}
+
+	occ.Room = output.Room
+	occ.Status = OccurrenceStatusMaterialized
+
+	log.Printf("[Series] Occurrence materialized: series=%s, occurrence=%s, room=%s",
+		series.ID, originalStart.Format(time.RFC3339), output.Room.ID)
+
+	return output.Room, nil
+}
+
+// occurrences expands the rule in [from, to) and merges exceptions and existing rooms.
+// Rescheduled occurrences moved into the window from outside are included as well.
+func (s *SeriesService) occurrences(ctx context.Context, series *entities.MeetingSeries, from, to time.Time) ([]*Occurrence, error) {
+	rule, err := rrule.Parse(series.RRule)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", usecaseErrors.ErrInvalidRecurrenceRule, err)
+	}
+	loc, err := time.LoadLocation(series.Timezone)
+	if err != nil {
+		return nil, usecaseErrors.ErrInvalidTimezone
+	}
+
+	exceptions, err := s.seriesRepo.FindExceptions(ctx, series.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get exceptions: %w", err)
+	}
+	exceptionsByStart := make(map[int64]*entities.SeriesException, len(exceptions))
+	for _, e := range exceptions {
+		exceptionsByStart[e.OriginalStartTime.Unix()] = e
+	}
+
+	rooms, err := s.seriesRepo.FindOccurrenceRooms(ctx, series.ID, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get occurrence rooms: %w", err)
+	}
+	roomsByStart := make(map[int64]*entities.Room, len(rooms))
+	for _, r := range rooms {
+		if r.OccurrenceStartTime != nil {
+			roomsByStart[r.OccurrenceStartTime.Unix()] = r
+		}
+	}
+
+	starts := rule.Between(series.StartTime.In(loc), from, to, maxOccurrencesPerQuery)
+	seen := make(map[int64]bool, len(starts))
+	occurrences := make([]*Occurrence, 0, len(starts))
+
+	build := func(original time.Time) *Occurrence {
+		original = original.UTC()
+		occ := &Occurrence{
+			OriginalStartTime: original,
+			StartTime:         original,
+			EndTime:           original.Add(series.Duration()),
+			Status:            OccurrenceStatusPending,
+		}
+		if e, ok := exceptionsByStart[original.Unix()]; ok {
+			occ.Exception = e
+			if e.IsCancelled() {
+				occ.Status = OccurrenceStatusCancelled
+			} else if e.NewStartTime != nil {
+				occ.StartTime = e.NewStartTime.UTC()
+				occ.EndTime = occ.StartTime.Add(series.Duration())
+				if e.NewEndTime != nil {
+					occ.EndTime = e.NewEndTime.UTC()
+				}
+			}
+		}
+		if r, ok := roomsByStart[original.Unix()]; ok {
+			occ.Room = r
+			if occ.Status != OccurrenceStatusCancelled {
+				occ.Status = OccurrenceStatusMaterialized
+			}
+		}
+		return occ
+	}
+
+	for _, start := range starts {
+		seen[start.Unix()] = true
+		occurrences = append(occurrences, build(start))
+	}
+
+	for _, e := range exceptions {
+		if e.IsCancelled() || e.NewStartTime == nil || seen[e.OriginalStartTime.Unix()] {
+			continue
+		}
+		if e.NewStartTime.Before(from) || !e.NewStartTime.Before(to) {
+			continue
+		}
+		occurrences = append(occurrences, build(e.OriginalStartTime))
+	}
+
+	sort.Slice(occurrences, func(i, j int) bool {
+		return occurrences[i].StartTime.Before(occurrences[j].StartTime)
+	})
+
+	return occurrences, nil
+}
+
+// isFinished reports whether the rule has no occurrence left after now
+func (s *SeriesService) isFinished(series *entities.MeetingSeries, now time.Time) (bool, error) {
+	rule, err := rrule.Parse(series.RRule)
+	if err != nil {
+		return false, err
+	}
+	if rule.Count == 0 && rule.Until == nil {
+		return false, nil
+	}
+	loc, err := time.LoadLocation(series.Timezone)
+	if err != nil {
+		return false, err
+	}
+	next := rule.Between(series.StartTime.In(loc), now, now.AddDate(100, 0, 0), 1)
+	return len(next) == 0, nil
+}
+
+// findOccurrence returns the occurrence with the given original start time
+func findOccurrence(occurrences []*Occurrence, originalStart time.Time) *Occurrence {
+	for _, occ := range occurrences {
+		if occ.OriginalStartTime.Equal(originalStart) {
+			return occ
+		}
+	}
+	return nil
+}
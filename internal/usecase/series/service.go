@@ -0,0 +1,57 @@
+package series
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+)
+
+// Service defines the interface for recurring meeting series use case
+type Service interface {
+	// CreateSeries creates a new recurring meeting series
+	CreateSeries(ctx context.Context, input CreateSeriesInput) (*entities.MeetingSeries, error)
+
+	// GetSeries retrieves a series the user may browse (host, occurrence participants and organization members)
+	GetSeries(ctx context.Context, seriesID, userID uuid.UUID) (*entities.MeetingSeries, error)
+
+	// ListSeries retrieves the series hosted by a user
+	ListSeries(ctx context.Context, hostID uuid.UUID, limit, offset int) ([]*entities.MeetingSeries, error)
+
+	// EndSeries stops a series and cancels its future occurrences (host only)
+	EndSeries(ctx context.Context, seriesID, userID uuid.UUID) error
+
+	// ListOccurrences expands the series rule in [from, to) and merges exceptions and generated rooms
+	ListOccurrences(ctx context.Context, seriesID, userID uuid.UUID, from, to time.Time) ([]*Occurrence, error)
+
+	// MaterializeOccurrence creates (or returns) the room for a single occurrence (host only)
+	MaterializeOccurrence(ctx context.Context, seriesID, userID uuid.UUID, occurrenceStart time.Time) (*entities.Room, error)
+
+	// ExpandSeries creates rooms for all upcoming occurrences up to the given time (host only)
+	ExpandSeries(ctx context.Context, seriesID, userID uuid.UUID, until time.Time) ([]*entities.Room, error)
+
+	// ExpandUpcoming creates rooms ahead of time for every active series
+	ExpandUpcoming(ctx context.Context, horizon time.Duration) (int, error)
+
+	// CancelOccurrence cancels a single occurrence (host only)
+	CancelOccurrence(ctx context.Context, seriesID, userID uuid.UUID, occurrenceStart time.Time, reason *string) error
+
+	// RescheduleOccurrence moves a single occurrence to a new time (host only)
+	RescheduleOccurrence(ctx context.Context, input RescheduleOccurrenceInput) (*Occurrence, error)
+
+	// GetSeriesSummaries retrieves the AI summaries of all occurrences
+	GetSeriesSummaries(ctx context.Context, seriesID, userID uuid.UUID) ([]*OccurrenceSummary, error)
+
+	// GetSeriesActionItems retrieves the action items of all occurrences
+	GetSeriesActionItems(ctx context.Context, seriesID, userID uuid.UUID) ([]*entities.ActionItem, error)
+
+	// StartWorker starts the background job that expands series ahead of time
+	StartWorker(ctx context.Context) error
+
+	// StopWorker gracefully stops the background job
+	StopWorker() error
+}
+
+// Ensure SeriesService implements Service interface
+var _ Service = (*SeriesService)(nil)
@@ -0,0 +1,90 @@
+-- +migrate Up
+
+-- ============================================================================
+-- MEETING_SERIES TABLE
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS meeting_series (
+    -- Primary Key
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+
+    -- References
+    host_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+
+    -- Template for generated rooms
+    name VARCHAR(255) NOT NULL,
+    description TEXT,
+    room_type VARCHAR(20) NOT NULL DEFAULT 'scheduled' CHECK (room_type IN ('public', 'private', 'scheduled')),
+    max_participants INT DEFAULT 10 CHECK (max_participants >= 2 AND max_participants <= 100),
+    settings JSONB DEFAULT '{}'::jsonb,
+
+    -- Recurrence (RFC 5545 RRULE evaluated in the series time zone)
+    rrule TEXT NOT NULL,
+    timezone VARCHAR(50) NOT NULL DEFAULT 'UTC',
+    start_time TIMESTAMP NOT NULL,
+    duration_minutes INT NOT NULL DEFAULT 60 CHECK (duration_minutes > 0),
+
+    -- Lifecycle
+    status VARCHAR(20) NOT NULL DEFAULT 'active' CHECK (status IN ('active', 'ended')),
+    materialized_until TIMESTAMP,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_meeting_series_host ON meeting_series(host_id);
+CREATE INDEX IF NOT EXISTS idx_meeting_series_status ON meeting_series(status);
+
+-- ============================================================================
+-- MEETING_SERIES_EXCEPTIONS TABLE
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS meeting_series_exceptions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    series_id UUID NOT NULL REFERENCES meeting_series(id) ON DELETE CASCADE,
+
+    -- Occurrence as generated by the rule
+    original_start_time TIMESTAMP NOT NULL,
+
+    type VARCHAR(20) NOT NULL CHECK (type IN ('cancelled', 'rescheduled')),
+    new_start_time TIMESTAMP,
+    new_end_time TIMESTAMP,
+    reason TEXT,
+
+    created_by UUID NOT NULL REFERENCES users(id),
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+
+    CONSTRAINT rescheduled_requires_time CHECK (
+        type <> 'rescheduled' OR new_start_time IS NOT NULL
+    )
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_series_exceptions_occurrence
+    ON meeting_series_exceptions(series_id, original_start_time);
+
+-- ============================================================================
+-- LINK ROOMS TO SERIES
+-- ============================================================================
+
+ALTER TABLE rooms
+ADD COLUMN IF NOT EXISTS series_id UUID REFERENCES meeting_series(id) ON DELETE SET NULL,
+ADD COLUMN IF NOT EXISTS occurrence_start_time TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_rooms_series ON rooms(series_id) WHERE series_id IS NOT NULL;
+
+-- One room per occurrence
+CREATE UNIQUE INDEX IF NOT EXISTS idx_rooms_series_occurrence
+    ON rooms(series_id, occurrence_start_time) WHERE series_id IS NOT NULL;
+
+COMMENT ON COLUMN rooms.series_id IS 'Recurring series this room was generated from';
+COMMENT ON COLUMN rooms.occurrence_start_time IS 'Original occurrence start time in the series rule';
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_rooms_series_occurrence;
+DROP INDEX IF EXISTS idx_rooms_series;
+ALTER TABLE rooms
+DROP COLUMN IF EXISTS occurrence_start_time,
+DROP COLUMN IF EXISTS series_id;
+
+DROP TABLE IF EXISTS meeting_series_exceptions;
+DROP TABLE IF EXISTS meeting_series;
@@ -0,0 +1,32 @@
+-- +migrate Up
+-- Move pending email invitations stored on participants into room_invitations.
+-- Tokens are 64 hex chars built from two random UUIDs; legacy invites get a 7 day expiry.
+INSERT INTO room_invitations (room_id, inviter_id, invitee_email, token, status, expires_at, created_at)
+SELECT
+    p.room_id,
+    p.invited_by,
+    LOWER(p.invited_email),
+    REPLACE(gen_random_uuid()::text || gen_random_uuid()::text, '-', ''),
+    'pending',
+    COALESCE(p.invited_at, NOW()) + INTERVAL '7 days',
+    COALESCE(p.invited_at, NOW())
+FROM participants p
+WHERE p.status = 'invited'
+  AND p.user_id IS NULL
+  AND p.invited_email IS NOT NULL
+  AND p.invited_by IS NOT NULL;
+
+-- The placeholder participants are recreated when an invitation is accepted
+DELETE FROM participants
+WHERE status = 'invited'
+  AND user_id IS NULL
+  AND invited_email IS NOT NULL
+  AND invited_by IS NOT NULL;
+
+-- +migrate Down
+INSERT INTO participants (room_id, role, status, invited_email, invited_by, invited_at)
+SELECT room_id, 'participant', 'invited', invitee_email, inviter_id, created_at
+FROM room_invitations
+WHERE status = 'pending' AND invitee_email IS NOT NULL;
+
+DELETE FROM room_invitations WHERE status = 'pending';
@@ -0,0 +1,14 @@
+-- +migrate Up
+
+-- ============================================================================
+-- MEETING REMINDER EMAILS
+-- ============================================================================
+
+ALTER TABLE rooms
+ADD COLUMN IF NOT EXISTS reminder_sent_at TIMESTAMP;
+
+COMMENT ON COLUMN rooms.reminder_sent_at IS 'When the pre-start reminder email was sent (NULL = not sent yet)';
+
+-- +migrate Down
+ALTER TABLE rooms
+DROP COLUMN IF EXISTS reminder_sent_at;
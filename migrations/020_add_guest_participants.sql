@@ -0,0 +1,39 @@
+-- +migrate Up
+
+-- ============================================================================
+-- GUEST PARTICIPANTS (join without an account)
+-- ============================================================================
+
+ALTER TABLE participants
+ADD COLUMN IF NOT EXISTS guest_name VARCHAR(100);
+
+-- Participants are identified by a user, an invited email or a guest display name
+ALTER TABLE participants DROP CONSTRAINT IF EXISTS check_user_or_email;
+ALTER TABLE participants
+ADD CONSTRAINT check_user_or_email
+CHECK (user_id IS NOT NULL OR invited_email IS NOT NULL OR guest_name IS NOT NULL);
+
+-- Guests that joined through an invitation link are looked up by invitation
+CREATE INDEX IF NOT EXISTS idx_participants_guest_invitation
+    ON participants(room_id, (metadata->>'invitation_id'))
+    WHERE user_id IS NULL;
+
+COMMENT ON COLUMN participants.guest_name IS 'Display name of guests joining without an account (user_id is NULL)';
+
+-- Shareable room codes for guest links
+UPDATE rooms
+SET slug = substr(md5(id::text), 1, 3) || '-' || substr(md5(id::text), 4, 4) || '-' || substr(md5(id::text), 8, 3)
+WHERE slug IS NULL;
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_participants_guest_invitation;
+
+DELETE FROM participants WHERE user_id IS NULL AND invited_email IS NULL;
+
+ALTER TABLE participants DROP CONSTRAINT IF EXISTS check_user_or_email;
+ALTER TABLE participants
+ADD CONSTRAINT check_user_or_email
+CHECK (user_id IS NOT NULL OR invited_email IS NOT NULL);
+
+ALTER TABLE participants
+DROP COLUMN IF EXISTS guest_name;
@@ -0,0 +1,33 @@
+-- +migrate Up
+
+-- ============================================================================
+-- BREAKOUT ROOMS
+-- ============================================================================
+
+ALTER TABLE rooms
+ADD COLUMN IF NOT EXISTS parent_room_id UUID REFERENCES rooms(id) ON DELETE CASCADE,
+ADD COLUMN IF NOT EXISTS breakout_ends_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_rooms_parent_room_id ON rooms(parent_room_id) WHERE parent_room_id IS NOT NULL;
+
+COMMENT ON COLUMN rooms.parent_room_id IS 'Parent meeting of a breakout room (NULL = regular room)';
+COMMENT ON COLUMN rooms.breakout_ends_at IS 'End of the breakout timer broadcast by the host (set on parent rooms)';
+
+ALTER TABLE participants
+ADD COLUMN IF NOT EXISTS breakout_room_id UUID REFERENCES rooms(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_participants_breakout_room_id ON participants(breakout_room_id) WHERE breakout_room_id IS NOT NULL;
+
+COMMENT ON COLUMN participants.breakout_room_id IS 'Breakout room the participant of the parent room is assigned to';
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_participants_breakout_room_id;
+
+ALTER TABLE participants
+DROP COLUMN IF EXISTS breakout_room_id;
+
+DROP INDEX IF EXISTS idx_rooms_parent_room_id;
+
+ALTER TABLE rooms
+DROP COLUMN IF EXISTS breakout_ends_at,
+DROP COLUMN IF EXISTS parent_room_id;
@@ -0,0 +1,18 @@
+-- +migrate Up
+
+-- ============================================================================
+-- SERVER-SIDE MEDIA MODERATION
+-- ============================================================================
+
+-- Hosts and co-hosts can revoke publishing; the flag survives reconnects
+ALTER TABLE participants
+ADD COLUMN IF NOT EXISTS can_publish BOOLEAN DEFAULT TRUE;
+
+UPDATE participants SET can_publish = TRUE WHERE can_publish IS NULL;
+
+COMMENT ON COLUMN participants.can_publish IS 'Whether the participant may publish audio/video (revoked by a moderator)';
+COMMENT ON COLUMN participants.is_muted IS 'Microphone muted by a moderator, mirrored from LiveKit';
+
+-- +migrate Down
+ALTER TABLE participants
+DROP COLUMN IF EXISTS can_publish;
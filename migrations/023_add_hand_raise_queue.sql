@@ -0,0 +1,40 @@
+-- +migrate Up
+
+-- ============================================================================
+-- HAND-RAISE QUEUE
+-- ============================================================================
+
+ALTER TABLE participants
+ADD COLUMN IF NOT EXISTS hand_raised_at TIMESTAMP,
+ADD COLUMN IF NOT EXISTS hand_queue_position INTEGER;
+
+CREATE INDEX IF NOT EXISTS idx_participants_hand_queue ON participants(room_id, hand_queue_position) WHERE is_hand_raised = TRUE;
+
+COMMENT ON COLUMN participants.hand_raised_at IS 'When the current raised hand went up';
+COMMENT ON COLUMN participants.hand_queue_position IS 'Speaking order among raised hands of the room (1 = next), reordered by moderators';
+
+-- History of raised hands for the meeting timeline
+CREATE TABLE IF NOT EXISTS hand_raises (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    room_id UUID NOT NULL REFERENCES rooms(id) ON DELETE CASCADE,
+    participant_id UUID NOT NULL REFERENCES participants(id) ON DELETE CASCADE,
+    raised_at TIMESTAMP NOT NULL,
+    lowered_at TIMESTAMP,
+    lowered_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_hand_raises_room_id ON hand_raises(room_id, raised_at);
+CREATE INDEX IF NOT EXISTS idx_hand_raises_open ON hand_raises(participant_id) WHERE lowered_at IS NULL;
+
+COMMENT ON TABLE hand_raises IS 'Raised hands of participants, shown on the meeting timeline';
+COMMENT ON COLUMN hand_raises.lowered_by IS 'Moderator who lowered the hand (NULL = the participant, or leaving the room)';
+
+-- +migrate Down
+DROP TABLE IF EXISTS hand_raises;
+
+DROP INDEX IF EXISTS idx_participants_hand_queue;
+
+ALTER TABLE participants
+DROP COLUMN IF EXISTS hand_queue_position,
+DROP COLUMN IF EXISTS hand_raised_at;
@@ -0,0 +1,44 @@
+-- +migrate Up
+
+-- ============================================================================
+-- ROOM LOCK AND PASSCODE
+-- ============================================================================
+
+ALTER TABLE rooms
+ADD COLUMN IF NOT EXISTS lock_mode VARCHAR(20),
+ADD COLUMN IF NOT EXISTS locked_at TIMESTAMP,
+ADD COLUMN IF NOT EXISTS locked_by UUID REFERENCES users(id) ON DELETE SET NULL,
+ADD COLUMN IF NOT EXISTS passcode_hash VARCHAR(255);
+
+ALTER TABLE rooms
+ADD CONSTRAINT rooms_lock_mode_check CHECK (lock_mode IS NULL OR lock_mode IN ('waiting_room', 'reject'));
+
+COMMENT ON COLUMN rooms.lock_mode IS 'What happens to new joins while the room is locked (NULL = unlocked)';
+COMMENT ON COLUMN rooms.passcode_hash IS 'bcrypt hash of the join passcode (NULL = no passcode)';
+
+-- Audit trail of access control changes and failed passcode attempts
+CREATE TABLE IF NOT EXISTS room_audit_logs (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    room_id UUID NOT NULL REFERENCES rooms(id) ON DELETE CASCADE,
+    actor_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    actor_ip VARCHAR(64),
+    action VARCHAR(50) NOT NULL,
+    details JSONB DEFAULT '{}',
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_room_audit_logs_room_id ON room_audit_logs(room_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_room_audit_logs_passcode_failed ON room_audit_logs(room_id, created_at) WHERE action = 'passcode.failed';
+
+COMMENT ON TABLE room_audit_logs IS 'Who locked, unlocked or changed the passcode of a room, and failed passcode attempts (rate limiting)';
+
+-- +migrate Down
+DROP TABLE IF EXISTS room_audit_logs;
+
+ALTER TABLE rooms DROP CONSTRAINT IF EXISTS rooms_lock_mode_check;
+
+ALTER TABLE rooms
+DROP COLUMN IF EXISTS passcode_hash,
+DROP COLUMN IF EXISTS locked_by,
+DROP COLUMN IF EXISTS locked_at,
+DROP COLUMN IF EXISTS lock_mode;
@@ -0,0 +1,38 @@
+-- +migrate Up
+
+-- ============================================================================
+-- PARTICIPANT WAITLIST
+-- ============================================================================
+
+-- Participants allowed into a full room wait for a free seat
+ALTER TABLE participants DROP CONSTRAINT IF EXISTS participants_status_check;
+ALTER TABLE participants ADD CONSTRAINT participants_status_check
+    CHECK (status IN ('invited', 'waiting', 'waitlisted', 'joined', 'left', 'removed', 'declined', 'denied'));
+
+ALTER TABLE participants
+ADD COLUMN IF NOT EXISTS waitlisted_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_participants_waitlist ON participants(room_id, waitlisted_at) WHERE status = 'waitlisted';
+
+COMMENT ON COLUMN participants.waitlisted_at IS 'Place in the waitlist of a full room, seated first come first served';
+
+-- current_participants is recounted from the participant rows from now on:
+-- fix the counts that drifted with the old increment/decrement updates
+UPDATE rooms r SET current_participants = (
+    SELECT COUNT(*) FROM participants p
+    WHERE p.room_id = r.id AND p.status = 'joined' AND p.left_at IS NULL
+);
+
+COMMENT ON COLUMN rooms.current_participants IS 'Joined participants of the room, recounted from participants on every change';
+
+-- +migrate Down
+UPDATE participants SET status = 'left', left_at = NOW() WHERE status = 'waitlisted';
+
+DROP INDEX IF EXISTS idx_participants_waitlist;
+
+ALTER TABLE participants
+DROP COLUMN IF EXISTS waitlisted_at;
+
+ALTER TABLE participants DROP CONSTRAINT IF EXISTS participants_status_check;
+ALTER TABLE participants ADD CONSTRAINT participants_status_check
+    CHECK (status IN ('invited', 'waiting', 'joined', 'left', 'removed', 'declined', 'denied'));
@@ -0,0 +1,29 @@
+-- +migrate Up
+
+-- ============================================================================
+-- ATTENDANCE SESSIONS
+-- ============================================================================
+
+-- One row per LiveKit session (identity + SID) so rejoins keep their history
+CREATE TABLE IF NOT EXISTS attendance_sessions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    room_id UUID NOT NULL REFERENCES rooms(id) ON DELETE CASCADE,
+    participant_id UUID NOT NULL REFERENCES participants(id) ON DELETE CASCADE,
+    identity VARCHAR(255) NOT NULL,
+    session_sid VARCHAR(255) NOT NULL,
+    joined_at TIMESTAMP NOT NULL,
+    left_at TIMESTAMP,
+    duration INTEGER,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_attendance_sessions_sid ON attendance_sessions(room_id, session_sid);
+CREATE INDEX IF NOT EXISTS idx_attendance_sessions_participant ON attendance_sessions(participant_id, joined_at);
+CREATE INDEX IF NOT EXISTS idx_attendance_sessions_open ON attendance_sessions(room_id) WHERE left_at IS NULL;
+
+COMMENT ON TABLE attendance_sessions IS 'LiveKit sessions of participants, filled from participant_joined/participant_left webhooks';
+COMMENT ON COLUMN attendance_sessions.session_sid IS 'LiveKit participant SID, new for every connection of the same identity';
+COMMENT ON COLUMN attendance_sessions.duration IS 'Seconds connected, set when the session ends';
+
+-- +migrate Down
+DROP TABLE IF EXISTS attendance_sessions;
@@ -0,0 +1,28 @@
+-- +migrate Up
+
+-- ============================================================================
+-- CHAT MESSAGES
+-- ============================================================================
+
+-- Meeting chat, kept after the LiveKit room closes and fed to the AI summary
+CREATE TABLE IF NOT EXISTS chat_messages (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    room_id UUID NOT NULL REFERENCES rooms(id) ON DELETE CASCADE,
+    participant_id UUID NOT NULL REFERENCES participants(id) ON DELETE CASCADE,
+    content TEXT NOT NULL,
+    reply_to_id UUID REFERENCES chat_messages(id) ON DELETE SET NULL,
+    edited_at TIMESTAMP,
+    deleted_at TIMESTAMP,
+    deleted_by UUID REFERENCES participants(id) ON DELETE SET NULL,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_chat_messages_room ON chat_messages(room_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_chat_messages_participant ON chat_messages(participant_id);
+
+COMMENT ON TABLE chat_messages IS 'In-meeting chat messages; deleted messages are kept for moderation but never shown or summarized';
+COMMENT ON COLUMN chat_messages.deleted_by IS 'Participant who deleted the message: the author, or a moderator';
+
+-- +migrate Down
+DROP TABLE IF EXISTS chat_messages;
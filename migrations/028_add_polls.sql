@@ -0,0 +1,56 @@
+-- +migrate Up
+
+-- ============================================================================
+-- POLLS
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS polls (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    room_id UUID NOT NULL REFERENCES rooms(id) ON DELETE CASCADE,
+    created_by UUID NOT NULL REFERENCES participants(id) ON DELETE CASCADE,
+    question TEXT NOT NULL,
+    type VARCHAR(20) NOT NULL CHECK (type IN ('single_choice', 'multiple_choice', 'free_text')),
+    anonymous BOOLEAN DEFAULT FALSE,
+    status VARCHAR(20) NOT NULL DEFAULT 'open' CHECK (status IN ('open', 'closed')),
+    closes_at TIMESTAMP,
+    close_when_all_voted BOOLEAN DEFAULT FALSE,
+    closed_at TIMESTAMP,
+    closed_by UUID REFERENCES participants(id) ON DELETE SET NULL,
+    close_reason VARCHAR(20),
+    results JSONB,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_polls_room ON polls(room_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_polls_due ON polls(closes_at) WHERE status = 'open' AND closes_at IS NOT NULL;
+
+COMMENT ON COLUMN polls.anonymous IS 'Voters are stored to enforce one vote each but never shown';
+COMMENT ON COLUMN polls.results IS 'Final tally stored when the poll closes, fed to the AI summary as a decision';
+
+CREATE TABLE IF NOT EXISTS poll_options (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    poll_id UUID NOT NULL REFERENCES polls(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    text VARCHAR(255) NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_poll_options_poll ON poll_options(poll_id, position);
+
+CREATE TABLE IF NOT EXISTS poll_votes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    poll_id UUID NOT NULL REFERENCES polls(id) ON DELETE CASCADE,
+    participant_id UUID NOT NULL REFERENCES participants(id) ON DELETE CASCADE,
+    option_id UUID REFERENCES poll_options(id) ON DELETE CASCADE,
+    text TEXT,
+    created_at TIMESTAMP DEFAULT NOW(),
+    CHECK (option_id IS NOT NULL OR text IS NOT NULL)
+);
+
+CREATE INDEX IF NOT EXISTS idx_poll_votes_poll ON poll_votes(poll_id, participant_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_poll_votes_option ON poll_votes(poll_id, participant_id, option_id) WHERE option_id IS NOT NULL;
+
+-- +migrate Down
+DROP TABLE IF EXISTS poll_votes;
+DROP TABLE IF EXISTS poll_options;
+DROP TABLE IF EXISTS polls;
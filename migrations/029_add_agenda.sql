@@ -0,0 +1,39 @@
+-- +migrate Up
+
+-- ============================================================================
+-- MEETING AGENDA
+-- ============================================================================
+
+-- Ordered agenda of a meeting, with owners and time boxes
+CREATE TABLE IF NOT EXISTS agenda_items (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    room_id UUID NOT NULL REFERENCES rooms(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    description TEXT,
+    owner_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    duration_minutes INTEGER NOT NULL DEFAULT 0 CHECK (duration_minutes >= 0),
+    started_at TIMESTAMP,
+    ended_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_agenda_items_room ON agenda_items(room_id, position);
+
+-- Item being discussed right now
+ALTER TABLE rooms ADD COLUMN IF NOT EXISTS current_agenda_item_id UUID REFERENCES agenda_items(id) ON DELETE SET NULL;
+
+-- Agenda-aware summaries
+ALTER TABLE meeting_summaries ADD COLUMN IF NOT EXISTS agenda_coverage JSONB;
+ALTER TABLE action_items ADD COLUMN IF NOT EXISTS agenda_item_id UUID REFERENCES agenda_items(id) ON DELETE SET NULL;
+
+COMMENT ON COLUMN agenda_items.duration_minutes IS 'Time box of the item, 0 when it has none';
+COMMENT ON COLUMN agenda_items.started_at IS 'First time the item became current during the meeting';
+COMMENT ON COLUMN meeting_summaries.agenda_coverage IS 'Per agenda item: whether it was covered and what was said, as found by the AI';
+
+-- +migrate Down
+ALTER TABLE action_items DROP COLUMN IF EXISTS agenda_item_id;
+ALTER TABLE meeting_summaries DROP COLUMN IF EXISTS agenda_coverage;
+ALTER TABLE rooms DROP COLUMN IF EXISTS current_agenda_item_id;
+DROP TABLE IF EXISTS agenda_items;
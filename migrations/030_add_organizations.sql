@@ -0,0 +1,71 @@
+-- +migrate Up
+
+-- ============================================================================
+-- ORGANIZATIONS (MULTI-TENANCY)
+-- ============================================================================
+
+-- Workspace that owns rooms and their recordings, summaries and action items
+CREATE TABLE IF NOT EXISTS organizations (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(255) NOT NULL,
+    slug VARCHAR(100) NOT NULL UNIQUE,
+    settings JSONB NOT NULL DEFAULT '{}',
+    created_by UUID NOT NULL REFERENCES users(id),
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS organization_members (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role VARCHAR(20) NOT NULL DEFAULT 'member' CHECK (role IN ('owner', 'admin', 'member')),
+    joined_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    UNIQUE (organization_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_organization_members_user ON organization_members(user_id);
+
+CREATE TABLE IF NOT EXISTS organization_invites (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    email VARCHAR(255) NOT NULL,
+    role VARCHAR(20) NOT NULL DEFAULT 'member' CHECK (role IN ('admin', 'member')),
+    token VARCHAR(255) NOT NULL UNIQUE,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    invited_by UUID NOT NULL REFERENCES users(id),
+    expires_at TIMESTAMP NOT NULL,
+    responded_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_organization_invites_org ON organization_invites(organization_id, status);
+CREATE INDEX IF NOT EXISTS idx_organization_invites_email ON organization_invites(LOWER(email));
+
+-- Tenant of rooms and of the data derived from them (NULL = personal, outside any organization)
+ALTER TABLE rooms ADD COLUMN IF NOT EXISTS organization_id UUID REFERENCES organizations(id) ON DELETE CASCADE;
+ALTER TABLE recordings ADD COLUMN IF NOT EXISTS organization_id UUID REFERENCES organizations(id) ON DELETE CASCADE;
+ALTER TABLE meeting_summaries ADD COLUMN IF NOT EXISTS organization_id UUID REFERENCES organizations(id) ON DELETE CASCADE;
+ALTER TABLE action_items ADD COLUMN IF NOT EXISTS organization_id UUID REFERENCES organizations(id) ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS idx_rooms_organization ON rooms(organization_id);
+CREATE INDEX IF NOT EXISTS idx_recordings_organization ON recordings(organization_id);
+CREATE INDEX IF NOT EXISTS idx_meeting_summaries_organization ON meeting_summaries(organization_id);
+CREATE INDEX IF NOT EXISTS idx_action_items_organization ON action_items(organization_id);
+
+COMMENT ON COLUMN organizations.settings IS 'Organization defaults: room settings applied to new rooms and AI behaviour';
+COMMENT ON COLUMN organization_invites.role IS 'Role given on acceptance; owners are promoted from members';
+COMMENT ON COLUMN rooms.organization_id IS 'Tenant of the room, NULL for personal rooms';
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_action_items_organization;
+DROP INDEX IF EXISTS idx_meeting_summaries_organization;
+DROP INDEX IF EXISTS idx_recordings_organization;
+DROP INDEX IF EXISTS idx_rooms_organization;
+ALTER TABLE action_items DROP COLUMN IF EXISTS organization_id;
+ALTER TABLE meeting_summaries DROP COLUMN IF EXISTS organization_id;
+ALTER TABLE recordings DROP COLUMN IF EXISTS organization_id;
+ALTER TABLE rooms DROP COLUMN IF EXISTS organization_id;
+DROP TABLE IF EXISTS organization_invites;
+DROP TABLE IF EXISTS organization_members;
+DROP TABLE IF EXISTS organizations;
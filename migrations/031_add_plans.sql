@@ -0,0 +1,49 @@
+-- +migrate Up
+
+-- ============================================================================
+-- PLANS AND USAGE QUOTAS
+-- ============================================================================
+
+-- What a user (for personal rooms) or an organization (for its rooms) may use.
+-- A limit of 0 means unlimited.
+CREATE TABLE IF NOT EXISTS plans (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    code VARCHAR(50) NOT NULL UNIQUE,
+    name VARCHAR(100) NOT NULL,
+    max_participants INT NOT NULL DEFAULT 10 CHECK (max_participants >= 2 AND max_participants <= 100),
+    max_concurrent_rooms INT NOT NULL DEFAULT 0 CHECK (max_concurrent_rooms >= 0),
+    monthly_meeting_minutes INT NOT NULL DEFAULT 0 CHECK (monthly_meeting_minutes >= 0),
+    recording_storage_bytes BIGINT NOT NULL DEFAULT 0 CHECK (recording_storage_bytes >= 0),
+    monthly_ai_minutes INT NOT NULL DEFAULT 0 CHECK (monthly_ai_minutes >= 0),
+    is_default BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+
+-- At most one plan applies to users and organizations without a plan
+CREATE UNIQUE INDEX IF NOT EXISTS idx_plans_default ON plans(is_default) WHERE is_default;
+
+INSERT INTO plans (code, name, max_participants, max_concurrent_rooms, monthly_meeting_minutes, recording_storage_bytes, monthly_ai_minutes, is_default)
+VALUES
+    ('free', 'Free', 10, 1, 600, 1073741824, 60, TRUE),
+    ('pro', 'Pro', 50, 5, 6000, 53687091200, 1200, FALSE),
+    ('business', 'Business', 100, 0, 0, 536870912000, 6000, FALSE)
+ON CONFLICT (code) DO NOTHING;
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS plan_id UUID REFERENCES plans(id) ON DELETE SET NULL;
+ALTER TABLE organizations ADD COLUMN IF NOT EXISTS plan_id UUID REFERENCES plans(id) ON DELETE SET NULL;
+
+-- Metering: active rooms and rooms started this month, per host and per organization
+CREATE INDEX IF NOT EXISTS idx_rooms_host_started ON rooms(host_id, started_at);
+CREATE INDEX IF NOT EXISTS idx_rooms_organization_started ON rooms(organization_id, started_at);
+
+COMMENT ON COLUMN users.plan_id IS 'Plan of the personal rooms of the user, NULL for the default plan';
+COMMENT ON COLUMN organizations.plan_id IS 'Plan of the rooms of the organization, NULL for the default plan';
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_rooms_organization_started;
+DROP INDEX IF EXISTS idx_rooms_host_started;
+ALTER TABLE organizations DROP COLUMN IF EXISTS plan_id;
+ALTER TABLE users DROP COLUMN IF EXISTS plan_id;
+DROP INDEX IF EXISTS idx_plans_default;
+DROP TABLE IF EXISTS plans;
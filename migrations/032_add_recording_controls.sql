@@ -0,0 +1,21 @@
+-- +migrate Up
+-- Recordings started from the API can be paused; each pause/resume starts a new egress segment,
+-- listed in recordings.metadata->'segments'
+
+ALTER TABLE recordings DROP CONSTRAINT IF EXISTS recordings_status_check;
+ALTER TABLE recordings ADD CONSTRAINT recordings_status_check
+    CHECK (status IN ('recording', 'paused', 'processing', 'completed', 'failed', 'deleted'));
+
+-- Webhooks look recordings up by the egress of their segments
+CREATE INDEX IF NOT EXISTS idx_recordings_egress ON recordings(livekit_egress_id) WHERE livekit_egress_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_recordings_segments ON recordings USING GIN ((metadata->'segments') jsonb_path_ops);
+
+-- +migrate Down
+
+DROP INDEX IF EXISTS idx_recordings_segments;
+DROP INDEX IF EXISTS idx_recordings_egress;
+
+UPDATE recordings SET status = 'processing' WHERE status = 'paused';
+ALTER TABLE recordings DROP CONSTRAINT IF EXISTS recordings_status_check;
+ALTER TABLE recordings ADD CONSTRAINT recordings_status_check
+    CHECK (status IN ('recording', 'processing', 'completed', 'failed', 'deleted'));
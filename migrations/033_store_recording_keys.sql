@@ -0,0 +1,14 @@
+-- +migrate Up
+-- The recordings bucket is private: AI jobs store the object key of their recording and the worker
+-- presigns a short-lived URL when submitting it. Jobs created before stored the URL of the file
+-- (https://minio.example.com/<bucket>/<key>); the bucket name is configuration (MINIO_BUCKET_NAME),
+-- so the worker reduces those URLs to their key when submitting. Here only the trailing whitespace
+-- (newlines) some egress locations were stored with is removed.
+
+UPDATE ai_jobs
+SET recording_url = btrim(recording_url, E' \t\r\n'),
+    updated_at = NOW()
+WHERE recording_url <> btrim(recording_url, E' \t\r\n');
+
+-- +migrate Down
+-- Whitespace is not restored
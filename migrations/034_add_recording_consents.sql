@@ -0,0 +1,30 @@
+-- +migrate Up
+
+-- ============================================================================
+-- RECORDING CONSENTS
+-- ============================================================================
+
+-- One row per participant and policy version they consented to be recorded under
+CREATE TABLE IF NOT EXISTS recording_consents (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    room_id UUID NOT NULL REFERENCES rooms(id) ON DELETE CASCADE,
+    participant_id UUID NOT NULL REFERENCES participants(id) ON DELETE CASCADE,
+    user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    display_name VARCHAR(255) NOT NULL,
+    policy_version VARCHAR(50) NOT NULL,
+    consented_at TIMESTAMP NOT NULL,
+    ip_address VARCHAR(64),
+    user_agent TEXT,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_recording_consents_participant ON recording_consents(participant_id, policy_version);
+CREATE INDEX IF NOT EXISTS idx_recording_consents_room ON recording_consents(room_id, consented_at);
+CREATE INDEX IF NOT EXISTS idx_recording_consents_user ON recording_consents(user_id) WHERE user_id IS NOT NULL;
+
+COMMENT ON TABLE recording_consents IS 'Consents of participants to be recorded, exported for audits';
+COMMENT ON COLUMN recording_consents.display_name IS 'Name of the participant when consenting (guests have no account)';
+COMMENT ON COLUMN recording_consents.policy_version IS 'Version of the recording policy consented to (RECORDING_CONSENT_POLICY_VERSION)';
+
+-- +migrate Down
+DROP TABLE IF EXISTS recording_consents;
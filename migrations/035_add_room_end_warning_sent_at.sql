@@ -0,0 +1,15 @@
+-- +migrate Up
+
+-- ============================================================================
+-- MEETING END WARNINGS
+-- ============================================================================
+
+-- Every API instance runs the scheduler: the mark makes sure one of them warns the room
+ALTER TABLE rooms
+ADD COLUMN IF NOT EXISTS end_warning_sent_at TIMESTAMP;
+
+COMMENT ON COLUMN rooms.end_warning_sent_at IS 'When the warning before the forced end of an overdue meeting was sent (NULL = not sent yet)';
+
+-- +migrate Down
+ALTER TABLE rooms
+DROP COLUMN IF EXISTS end_warning_sent_at;
@@ -0,0 +1,21 @@
+-- +migrate Up
+
+-- ============================================================================
+-- ONE TRANSCRIPTION JOB PER RECORDING
+-- ============================================================================
+
+-- Pausing a recording ends its egress and resuming starts a new one, so a recording has a file per segment.
+-- The job is created once the recording completed and transcribes every segment; the unique index keeps
+-- webhook retries and API instances from queuing a recording twice.
+ALTER TABLE ai_jobs
+ADD COLUMN IF NOT EXISTS recording_id UUID REFERENCES recordings(id) ON DELETE SET NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_ai_jobs_recording_id ON ai_jobs(recording_id) WHERE recording_id IS NOT NULL;
+
+COMMENT ON COLUMN ai_jobs.recording_id IS 'Recording transcribed by the job (NULL for jobs created before recordings had segments)';
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_ai_jobs_recording_id;
+
+ALTER TABLE ai_jobs
+DROP COLUMN IF EXISTS recording_id;
@@ -0,0 +1,40 @@
+-- +migrate Up
+-- AI jobs created before recording keys were stored still hold the URL of their file. The bucket name is
+-- configuration, but the recordings keep both the URL and the key of their files (the recording itself
+-- and each egress segment): the part of such a URL before its key is the address of the bucket. Job URLs
+-- under one of those addresses are rewritten to the key that follows it; the shortest address wins.
+-- URLs of files stored elsewhere are left as they are and still rejected by the worker.
+
+WITH recording_files AS (
+    SELECT file_url, file_path
+    FROM recordings
+    WHERE file_url IS NOT NULL AND file_path IS NOT NULL
+    UNION
+    SELECT segment->>'file_url', segment->>'file_path'
+    FROM recordings, jsonb_array_elements(
+        CASE WHEN jsonb_typeof(metadata->'segments') = 'array' THEN metadata->'segments' ELSE '[]'::jsonb END
+    ) AS segment
+),
+bucket_prefixes AS (
+    SELECT DISTINCT left(file_url, length(file_url) - length(file_path)) AS prefix
+    FROM recording_files
+    WHERE file_path <> ''
+      AND file_url ~ '^https?://'
+      AND right(file_url, length(file_path) + 1) = '/' || file_path
+),
+job_keys AS (
+    SELECT DISTINCT ON (j.id) j.id, substr(j.recording_url, length(p.prefix) + 1) AS recording_key
+    FROM ai_jobs j
+    JOIN bucket_prefixes p
+      ON left(j.recording_url, length(p.prefix)) = p.prefix
+     AND length(j.recording_url) > length(p.prefix)
+    ORDER BY j.id, length(p.prefix)
+)
+UPDATE ai_jobs
+SET recording_url = job_keys.recording_key,
+    updated_at = NOW()
+FROM job_keys
+WHERE ai_jobs.id = job_keys.id;
+
+-- +migrate Down
+-- Keys are kept: the worker downloads keys and URLs alike
@@ -127,8 +127,21 @@ func (g *GroqClient) GenerateSummary(ctx context.Context, transcript string) (st
 	return cr.Choices[0].Message.Content, nil
 }
 
-// GenerateStructuredAnalysis generates comprehensive meeting analysis with structured JSON output
-func (g *GroqClient) GenerateStructuredAnalysis(ctx context.Context, transcript string, language string) (string, error) {
+// AgendaItem is an item of the meeting agenda given to the analysis
+type AgendaItem struct {
+	Number          int // 1-based, as referenced by the analysis
+	Title           string
+	Description     string
+	Owner           string
+	DurationMinutes int  // Time box (0 = none)
+	StartSeconds    *int // When the host moved to the item, relative to the transcript (nil = never)
+	EndSeconds      *int // When the host moved on from the item
+}
+
+// GenerateStructuredAnalysis generates comprehensive meeting analysis with structured JSON output.
+// When the meeting has an agenda, the analysis maps its content to the agenda items and reports
+// which items were covered.
+func (g *GroqClient) GenerateStructuredAnalysis(ctx context.Context, transcript string, language string, agenda []AgendaItem) (string, error) {
 	// Wait for rate limit slot
 	if err := g.rateLimiter.Wait(ctx); err != nil {
 		return "", fmt.Errorf("rate limit wait cancelled: %w", err)
@@ -212,9 +225,14 @@ Lưu ý:
 - Importance/Priority: low, medium, high, urgent
 - Engagement level: low, medium, high
 - Bỏ qua filler words (ừm, à, um, uh)
+- Dòng có "(chat)" sau tên người nói là tin nhắn gõ trong khung chat của cuộc họp: đưa các link, quyết định và action items trong đó vào summary
 - Trả về ONLY valid JSON, không có text giải thích thêm`
 
 		userPrompt = fmt.Sprintf("Phân tích transcript cuộc họp sau:\n\n%s", cleanedTranscript)
+		if len(agenda) > 0 {
+			systemPrompt += agendaInstructionsVI
+			userPrompt = fmt.Sprintf("Agenda cuộc họp:\n%s\n%s", formatAgenda(agenda), userPrompt)
+		}
 	} else {
 		systemPrompt = `You are an AI specialized in meeting analysis. Your task is to analyze the transcript and return a structured JSON summary.
 
@@ -282,9 +300,14 @@ Notes:
 - Importance/Priority: low, medium, high, urgent
 - Engagement level: low, medium, high
 - Ignore filler words (um, uh, like, you know)
+- Lines with "(chat)" after the speaker name are messages typed in the meeting chat: include the links, decisions and action items they contain in the summary
 - Return ONLY valid JSON, no additional explanatory text`
 
 		userPrompt = fmt.Sprintf("Analyze the following meeting transcript:\n\n%s", cleanedTranscript)
+		if len(agenda) > 0 {
+			systemPrompt += agendaInstructionsEN
+			userPrompt = fmt.Sprintf("Meeting agenda:\n%s\n%s", formatAgenda(agenda), userPrompt)
+		}
 	}
 
 	reqBody := ChatRequest{
@@ -337,6 +360,47 @@ Notes:
 	return cr.Choices[0].Message.Content, nil
 }
 
+const agendaInstructionsVI = `
+
+Cuộc họp có agenda (được gửi kèm transcript):
+- Thêm "agenda_item": số thứ tự của mục agenda liên quan vào mỗi key_point, decision và action_item (0 nếu không thuộc mục nào)
+- Thêm "agenda_coverage": [{"agenda_item": 1, "title": "Tiêu đề mục", "covered": true, "summary": "Những gì đã thảo luận", "timestamp_seconds": 120}] với MỖI mục agenda
+- "covered": false cho các mục không được thảo luận trong cuộc họp, khi đó để trống summary
+- Thời điểm host chuyển sang một mục (nếu có) giúp xác định phần transcript thuộc mục đó`
+
+const agendaInstructionsEN = `
+
+The meeting has an agenda (sent along with the transcript):
+- Add "agenda_item": the number of the related agenda item to every key_point, decision and action_item (0 when it belongs to none)
+- Add "agenda_coverage": [{"agenda_item": 1, "title": "Item title", "covered": true, "summary": "What was discussed", "timestamp_seconds": 120}] with an entry for EVERY agenda item
+- "covered": false for items that were never discussed in the meeting, with an empty summary
+- The times the host moved to an item (when given) tell which part of the transcript belongs to it`
+
+// formatAgenda renders the agenda as numbered lines for the analysis prompt
+func formatAgenda(agenda []AgendaItem) string {
+	var sb strings.Builder
+	for _, item := range agenda {
+		sb.WriteString(fmt.Sprintf("%d. %s", item.Number, item.Title))
+		if item.Owner != "" {
+			sb.WriteString(fmt.Sprintf(" (owner: %s)", item.Owner))
+		}
+		if item.DurationMinutes > 0 {
+			sb.WriteString(fmt.Sprintf(" [%d min]", item.DurationMinutes))
+		}
+		if item.StartSeconds != nil {
+			sb.WriteString(fmt.Sprintf(" started at %02d:%02d", *item.StartSeconds/60, *item.StartSeconds%60))
+			if item.EndSeconds != nil {
+				sb.WriteString(fmt.Sprintf(", ended at %02d:%02d", *item.EndSeconds/60, *item.EndSeconds%60))
+			}
+		}
+		if item.Description != "" {
+			sb.WriteString(": " + item.Description)
+		}
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
 // CleanTranscript removes filler words, repeated phrases, and excess whitespace
 func CleanTranscript(transcript string) string {
 	text := transcript
@@ -11,14 +11,21 @@ import (
 
 // Config holds application configuration
 type Config struct {
-	Server   ServerConfig
-	Database DatabaseConfig
-	OAuth    OAuthConfig
-	JWT      JWTConfig
-	Storage  StorageConfig
-	LiveKit  LiveKitConfig
-	Assembly AssemblyAIConfig
-	Groq     GroqConfig
+	Server     ServerConfig
+	Database   DatabaseConfig
+	OAuth      OAuthConfig
+	JWT        JWTConfig
+	Storage    StorageConfig
+	LiveKit    LiveKitConfig
+	Assembly   AssemblyAIConfig
+	Groq       GroqConfig
+	Scheduler  SchedulerConfig
+	Reconciler ReconcilerConfig
+	Invitation InvitationConfig
+	Mail       MailConfig
+	Guest      GuestConfig
+	Recording  RecordingConfig
+	Redis      RedisConfig
 }
 
 // ServerConfig holds server configuration
@@ -73,13 +80,14 @@ type JWTConfig struct {
 
 // StorageConfig holds storage configuration
 type StorageConfig struct {
-	Type            string // "minio" or "s3"
-	Endpoint        string `envconfig:"MINIO_ENDPOINT"`
-	AccessKeyID     string `envconfig:"MINIO_ACCESS_KEY"`
-	SecretAccessKey string `envconfig:"MINIO_SECRET_KEY"`
-	BucketName      string `envconfig:"MINIO_BUCKET_NAME"`
-	UseSSL          bool   `envconfig:"MINIO_USE_SSL"`
-	PublicURL       string `envconfig:"MINIO_PUBLIC_URL"` // Public URL for external access (e.g., https://minio.example.com)
+	Type            string        // "minio" or "s3"
+	Endpoint        string        `envconfig:"MINIO_ENDPOINT"`
+	AccessKeyID     string        `envconfig:"MINIO_ACCESS_KEY"`
+	SecretAccessKey string        `envconfig:"MINIO_SECRET_KEY"`
+	BucketName      string        `envconfig:"MINIO_BUCKET_NAME"`
+	UseSSL          bool          `envconfig:"MINIO_USE_SSL"`
+	PublicURL       string        `envconfig:"MINIO_PUBLIC_URL"`                     // Public URL for external access (e.g., https://minio.example.com); presigned URLs are signed for it
+	PlaybackURLTTL  time.Duration `envconfig:"MINIO_PLAYBACK_URL_TTL" default:"15m"` // Lifetime of the URLs recordings are played from
 }
 
 // LiveKitConfig holds LiveKit configuration
@@ -105,6 +113,61 @@ type GroqConfig struct {
 	BaseURL string `envconfig:"GROQ_API_URL"`
 }
 
+// SchedulerConfig holds room lifecycle scheduler configuration
+type SchedulerConfig struct {
+	Enabled      bool          `envconfig:"SCHEDULER_ENABLED" default:"true"`
+	Interval     time.Duration `envconfig:"SCHEDULER_INTERVAL" default:"30s"`
+	NoShowGrace  time.Duration `envconfig:"SCHEDULER_NO_SHOW_GRACE" default:"15m"` // Cancel scheduled rooms nobody joined after this long
+	IdleTimeout  time.Duration `envconfig:"SCHEDULER_IDLE_TIMEOUT" default:"10m"`  // End active rooms that stayed empty this long
+	OverrunGrace time.Duration `envconfig:"SCHEDULER_OVERRUN_GRACE" default:"10m"` // Allowed overrun past scheduled end time
+	EndWarning   time.Duration `envconfig:"SCHEDULER_END_WARNING" default:"5m"`    // Warn participants this long before a forced end
+	ReminderLead time.Duration `envconfig:"SCHEDULER_REMINDER_LEAD" default:"15m"` // Email participants this long before a scheduled start (0 disables)
+}
+
+// ReconcilerConfig holds configuration of the worker that repairs room and participant state
+// when LiveKit webhooks are lost
+type ReconcilerConfig struct {
+	Enabled  bool          `envconfig:"RECONCILER_ENABLED" default:"true"`
+	Interval time.Duration `envconfig:"RECONCILER_INTERVAL" default:"1m"`
+	Grace    time.Duration `envconfig:"RECONCILER_GRACE" default:"2m"` // Time a participant (or room) gets to show up in LiveKit after joining
+}
+
+// InvitationConfig holds room invitation configuration
+type InvitationConfig struct {
+	DefaultTTL     time.Duration `envconfig:"INVITATION_DEFAULT_TTL" default:"168h"`    // Expiry of invitations created without an explicit one
+	MaxTTL         time.Duration `envconfig:"INVITATION_MAX_TTL" default:"720h"`        // Longest expiry a host may request
+	ExpiryInterval time.Duration `envconfig:"INVITATION_EXPIRY_INTERVAL" default:"15m"` // How often stale invitations are marked expired
+}
+
+// MailConfig holds outgoing email configuration
+type MailConfig struct {
+	Driver       string `envconfig:"MAIL_DRIVER" default:"log"` // smtp, file or log
+	From         string `envconfig:"MAIL_FROM" default:"Meeting Assistant <no-reply@meeting-assistant.local>"`
+	SMTPHost     string `envconfig:"SMTP_HOST"`
+	SMTPPort     int    `envconfig:"SMTP_PORT" default:"587"`
+	SMTPUsername string `envconfig:"SMTP_USERNAME"`
+	SMTPPassword string `envconfig:"SMTP_PASSWORD"`
+	FileDir      string `envconfig:"MAIL_FILE_DIR" default:"tmp/mail"` // Where the file driver writes .eml files
+}
+
+// GuestConfig holds configuration of guests joining without an account
+type GuestConfig struct {
+	TokenTTL time.Duration `envconfig:"GUEST_TOKEN_TTL" default:"4h"` // Lifetime of guest credentials and their LiveKit tokens
+}
+
+// RecordingConfig holds configuration of meeting recordings
+type RecordingConfig struct {
+	ConsentPolicyVersion string `envconfig:"RECORDING_CONSENT_POLICY_VERSION" default:"1"` // Version of the recording policy participants consent to; bump it to ask everyone again
+}
+
+// RedisConfig holds Redis configuration (used to fan out room events across API instances)
+type RedisConfig struct {
+	Host     string `envconfig:"REDIS_HOST"` // Empty keeps room events in-process (single instance)
+	Port     string `envconfig:"REDIS_PORT" default:"6379"`
+	Password string `envconfig:"REDIS_PASSWORD"`
+	DB       int    `envconfig:"REDIS_DB" default:"0"`
+}
+
 // Load loads configuration from environment variables
 func Load() (*Config, error) {
 	config := &Config{}
@@ -133,6 +196,14 @@ func (c *Config) GetDatabaseDSN() string {
 	)
 }
 
+// GetRedisAddr returns the Redis address, or an empty string when Redis is not configured
+func (c *Config) GetRedisAddr() string {
+	if c.Redis.Host == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s:%s", c.Redis.Host, c.Redis.Port)
+}
+
 // GetS3Endpoint returns the S3/MinIO endpoint with protocol
 func (s *StorageConfig) GetS3Endpoint() string {
 	protocol := "http://"
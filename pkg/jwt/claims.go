@@ -12,3 +12,14 @@ type Claims struct {
 	Role   string    `json:"role"`
 	jwt.RegisteredClaims
 }
+
+// GuestAudience is the audience of guest tokens; they are never accepted as access tokens
+const GuestAudience = "guest"
+
+// GuestClaims represents the claims of a short-lived, room-scoped guest token
+type GuestClaims struct {
+	ParticipantID uuid.UUID `json:"participant_id"`
+	RoomID        uuid.UUID `json:"room_id"`
+	Name          string    `json:"name"`
+	jwt.RegisteredClaims
+}
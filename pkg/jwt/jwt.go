@@ -2,6 +2,7 @@ package jwt
 
 import (
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -101,6 +102,53 @@ func (m *Manager) ValidateAccessToken(tokenString string) (*Claims, error) {
 		return nil, fmt.Errorf("invalid token")
 	}
 
+	// Guest tokens share the signing secret but must not authenticate users
+	if slices.Contains(claims.Audience, GuestAudience) {
+		return nil, fmt.Errorf("invalid token")
+	}
+
+	return claims, nil
+}
+
+// GenerateGuestToken generates a room-scoped token for a guest participant
+func (m *Manager) GenerateGuestToken(participantID, roomID uuid.UUID, name string, expiry time.Duration) (string, error) {
+	now := time.Now()
+	claims := &GuestClaims{
+		ParticipantID: participantID,
+		RoomID:        roomID,
+		Name:          name,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(now.Add(expiry)),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			Issuer:    m.issuer,
+			Subject:   participantID.String(),
+			Audience:  jwt.ClaimStrings{GuestAudience},
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString([]byte(m.accessSecret))
+}
+
+// ValidateGuestToken validates and parses a guest token
+func (m *Manager) ValidateGuestToken(tokenString string) (*GuestClaims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &GuestClaims{}, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return []byte(m.accessSecret), nil
+	}, jwt.WithAudience(GuestAudience))
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse token: %w", err)
+	}
+
+	claims, ok := token.Claims.(*GuestClaims)
+	if !ok || !token.Valid || claims.ParticipantID == uuid.Nil {
+		return nil, fmt.Errorf("invalid token")
+	}
+
 	return claims, nil
 }
 
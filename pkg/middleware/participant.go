@@ -1,16 +1,24 @@
 package middleware
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/google/uuid"
 	"github.com/johnquangdev/meeting-assistant/internal/domain/entities"
+	usecaseErrors "github.com/johnquangdev/meeting-assistant/internal/usecase/errors"
 	roomUsecase "github.com/johnquangdev/meeting-assistant/internal/usecase/room"
 	"github.com/labstack/echo/v4"
 )
 
 // RequireHostRole middleware: only allow host to perform action
 func RequireHostRole(roomService roomUsecase.Service) echo.MiddlewareFunc {
+	return RequireRoomPermission(roomService, entities.RoomActionManageCoHosts)
+}
+
+// RequireRoomPermission middleware: only allow the host, or co-hosts and participants the
+// permission matrix grants the action to
+func RequireRoomPermission(roomService roomUsecase.Service, action entities.RoomAction) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
 			roomID, err := uuid.Parse(c.Param("id"))
@@ -34,10 +42,16 @@ func RequireHostRole(roomService roomUsecase.Service) echo.MiddlewareFunc {
 					"message": err.Error(),
 				})
 			}
-			if room.HostID != userID {
+			if err := roomService.Authorize(c.Request().Context(), room, userID, action); err != nil {
+				if !errors.Is(err, usecaseErrors.ErrNotHost) {
+					return c.JSON(http.StatusInternalServerError, map[string]interface{}{
+						"error":   "internal_error",
+						"message": err.Error(),
+					})
+				}
 				return c.JSON(http.StatusForbidden, map[string]interface{}{
 					"error":   "not_host",
-					"message": "user is not the host",
+					"message": "user is not allowed to perform this action",
 				})
 			}
 			return next(c)
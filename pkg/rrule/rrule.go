@@ -0,0 +1,424 @@
+package rrule
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Frequency is the FREQ part of a recurrence rule
+type Frequency string
+
+const (
+	FrequencyDaily   Frequency = "DAILY"
+	FrequencyWeekly  Frequency = "WEEKLY"
+	FrequencyMonthly Frequency = "MONTHLY"
+	FrequencyYearly  Frequency = "YEARLY"
+)
+
+// maxPeriods bounds how many periods an expansion may walk through so a
+// rule that never matches (e.g. BYMONTHDAY=31 with BYMONTH=2) cannot loop forever
+const maxPeriods = 10000
+
+// WeekdayNum is a BYDAY entry, e.g. "MO", "2TU" or "-1FR"
+type WeekdayNum struct {
+	Day time.Weekday
+	N   int // 0 means every such weekday in the period
+}
+
+// Rule is the subset of RFC 5545 RRULE supported by the scheduler:
+// FREQ, INTERVAL, COUNT, UNTIL, BYDAY, BYMONTHDAY and BYMONTH
+type Rule struct {
+	Freq       Frequency
+	Interval   int
+	Count      int
+	Until      *time.Time
+	ByDay      []WeekdayNum
+	ByMonthDay []int
+	ByMonth    []int
+}
+
+var weekdayCodes = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// Parse parses an RRULE string such as "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE".
+// A leading "RRULE:" prefix is accepted.
+func Parse(s string) (*Rule, error) {
+	s = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(s), "RRULE:"))
+	if s == "" {
+		return nil, fmt.Errorf("empty recurrence rule")
+	}
+
+	rule := &Rule{Interval: 1}
+	for _, part := range strings.Split(s, ";") {
+		if part == "" {
+			continue
+		}
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("invalid rule part %q", part)
+		}
+		key, value := strings.ToUpper(kv[0]), strings.ToUpper(kv[1])
+
+		switch key {
+		case "FREQ":
+			switch Frequency(value) {
+			case FrequencyDaily, FrequencyWeekly, FrequencyMonthly, FrequencyYearly:
+				rule.Freq = Frequency(value)
+			default:
+				return nil, fmt.Errorf("unsupported FREQ %q", value)
+			}
+		case "INTERVAL":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("invalid INTERVAL %q", value)
+			}
+			rule.Interval = n
+		case "COUNT":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("invalid COUNT %q", value)
+			}
+			rule.Count = n
+		case "UNTIL":
+			until, err := parseUntil(value)
+			if err != nil {
+				return nil, err
+			}
+			rule.Until = &until
+		case "BYDAY":
+			for _, item := range strings.Split(value, ",") {
+				wd, err := parseWeekdayNum(item)
+				if err != nil {
+					return nil, err
+				}
+				rule.ByDay = append(rule.ByDay, wd)
+			}
+		case "BYMONTHDAY":
+			for _, item := range strings.Split(value, ",") {
+				n, err := strconv.Atoi(item)
+				if err != nil || n == 0 || n < -31 || n > 31 {
+					return nil, fmt.Errorf("invalid BYMONTHDAY %q", item)
+				}
+				rule.ByMonthDay = append(rule.ByMonthDay, n)
+			}
+		case "BYMONTH":
+			for _, item := range strings.Split(value, ",") {
+				n, err := strconv.Atoi(item)
+				if err != nil || n < 1 || n > 12 {
+					return nil, fmt.Errorf("invalid BYMONTH %q", item)
+				}
+				rule.ByMonth = append(rule.ByMonth, n)
+			}
+		case "WKST":
+			// Weeks always start on Monday; accept the default explicitly
+			if value != "MO" {
+				return nil, fmt.Errorf("unsupported WKST %q", value)
+			}
+		default:
+			return nil, fmt.Errorf("unsupported rule part %q", key)
+		}
+	}
+
+	if rule.Freq == "" {
+		return nil, fmt.Errorf("FREQ is required")
+	}
+	if rule.Count > 0 && rule.Until != nil {
+		return nil, fmt.Errorf("COUNT and UNTIL cannot both be set")
+	}
+	for _, wd := range rule.ByDay {
+		if wd.N != 0 && rule.Freq != FrequencyMonthly && rule.Freq != FrequencyYearly {
+			return nil, fmt.Errorf("numeric BYDAY is only valid with MONTHLY or YEARLY")
+		}
+	}
+
+	return rule, nil
+}
+
+func parseUntil(value string) (time.Time, error) {
+	layouts := []string{"20060102T150405Z", "20060102T150405", "20060102"}
+	for _, layout := range layouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			if layout == "20060102" {
+				// A date-only UNTIL includes the whole day
+				t = t.Add(24*time.Hour - time.Second)
+			}
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid UNTIL %q", value)
+}
+
+func parseWeekdayNum(item string) (WeekdayNum, error) {
+	if len(item) < 2 {
+		return WeekdayNum{}, fmt.Errorf("invalid BYDAY %q", item)
+	}
+	code := item[len(item)-2:]
+	day, ok := weekdayCodes[code]
+	if !ok {
+		return WeekdayNum{}, fmt.Errorf("invalid BYDAY %q", item)
+	}
+	wd := WeekdayNum{Day: day}
+	if prefix := item[:len(item)-2]; prefix != "" {
+		n, err := strconv.Atoi(prefix)
+		if err != nil || n == 0 || n < -5 || n > 5 {
+			return WeekdayNum{}, fmt.Errorf("invalid BYDAY %q", item)
+		}
+		wd.N = n
+	}
+	return wd, nil
+}
+
+// String renders the rule back into RRULE form
+func (r *Rule) String() string {
+	parts := []string{"FREQ=" + string(r.Freq)}
+	if r.Interval > 1 {
+		parts = append(parts, fmt.Sprintf("INTERVAL=%d", r.Interval))
+	}
+	if r.Count > 0 {
+		parts = append(parts, fmt.Sprintf("COUNT=%d", r.Count))
+	}
+	if r.Until != nil {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format("20060102T150405Z"))
+	}
+	if len(r.ByDay) > 0 {
+		days := make([]string, len(r.ByDay))
+		for i, wd := range r.ByDay {
+			code := strings.ToUpper(wd.Day.String()[:2])
+			if wd.N != 0 {
+				code = strconv.Itoa(wd.N) + code
+			}
+			days[i] = code
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	if len(r.ByMonthDay) > 0 {
+		parts = append(parts, "BYMONTHDAY="+joinInts(r.ByMonthDay))
+	}
+	if len(r.ByMonth) > 0 {
+		parts = append(parts, "BYMONTH="+joinInts(r.ByMonth))
+	}
+	return strings.Join(parts, ";")
+}
+
+func joinInts(values []int) string {
+	strs := make([]string, len(values))
+	for i, v := range values {
+		strs[i] = strconv.Itoa(v)
+	}
+	return strings.Join(strs, ",")
+}
+
+// Between returns the occurrences of the rule anchored at dtstart that fall
+// in [from, to). dtstart is always the first occurrence. Wall-clock time is
+// kept in dtstart's location, so pass dtstart in the series' time zone to get
+// DST-correct results. limit caps the number of returned occurrences (0 = no cap).
+func (r *Rule) Between(dtstart, from, to time.Time, limit int) []time.Time {
+	var out []time.Time
+	emitted := 0
+
+	for period := 0; period < maxPeriods; period++ {
+		candidates := r.expandPeriod(dtstart, period)
+		for _, t := range candidates {
+			if t.Before(dtstart) {
+				continue
+			}
+			if r.Until != nil && t.After(*r.Until) {
+				return out
+			}
+			if r.Count > 0 && emitted >= r.Count {
+				return out
+			}
+			emitted++
+
+			if !t.Before(to) {
+				return out
+			}
+			if !t.Before(from) {
+				out = append(out, t)
+				if limit > 0 && len(out) >= limit {
+					return out
+				}
+			}
+		}
+	}
+	return out
+}
+
+// Includes reports whether t is an occurrence of the rule anchored at dtstart
+func (r *Rule) Includes(dtstart, t time.Time) bool {
+	matches := r.Between(dtstart, t, t.Add(time.Second), 1)
+	return len(matches) == 1 && matches[0].Equal(t)
+}
+
+// expandPeriod returns the sorted candidate instants of the n-th period
+func (r *Rule) expandPeriod(dtstart time.Time, n int) []time.Time {
+	loc := dtstart.Location()
+	hour, min, sec := dtstart.Clock()
+	at := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, hour, min, sec, 0, loc)
+	}
+
+	var days []time.Time
+	switch r.Freq {
+	case FrequencyDaily:
+		day := dtstart.AddDate(0, 0, n*r.Interval)
+		if r.matchesLimits(day) {
+			days = append(days, day)
+		}
+
+	case FrequencyWeekly:
+		// Weeks start on Monday
+		offset := (int(dtstart.Weekday()) + 6) % 7
+		weekStart := dtstart.AddDate(0, 0, -offset+7*n*r.Interval)
+		if len(r.ByDay) == 0 {
+			days = append(days, weekStart.AddDate(0, 0, offset))
+		} else {
+			for _, wd := range r.ByDay {
+				day := weekStart.AddDate(0, 0, (int(wd.Day)+6)%7)
+				if r.matchesMonth(day) {
+					days = append(days, day)
+				}
+			}
+		}
+
+	case FrequencyMonthly:
+		first := at(dtstart.Year(), dtstart.Month(), 1).AddDate(0, n*r.Interval, 0)
+		if r.matchesMonth(first) {
+			days = r.expandMonth(first, dtstart.Day())
+		}
+
+	case FrequencyYearly:
+		year := dtstart.Year() + n*r.Interval
+		months := r.ByMonth
+		if len(months) == 0 {
+			months = []int{int(dtstart.Month())}
+		}
+		for _, m := range months {
+			first := at(year, time.Month(m), 1)
+			if len(r.ByDay) == 0 && len(r.ByMonthDay) == 0 {
+				if day := at(year, time.Month(m), dtstart.Day()); day.Month() == time.Month(m) {
+					days = append(days, day)
+				}
+				continue
+			}
+			days = append(days, r.expandMonth(first, dtstart.Day())...)
+		}
+	}
+
+	sort.Slice(days, func(i, j int) bool { return days[i].Before(days[j]) })
+	return dedupe(days)
+}
+
+// expandMonth returns the days of the month starting at first that match BYMONTHDAY/BYDAY
+func (r *Rule) expandMonth(first time.Time, defaultDay int) []time.Time {
+	lastDay := first.AddDate(0, 1, -1).Day()
+	var days []time.Time
+
+	switch {
+	case len(r.ByMonthDay) > 0:
+		for _, md := range r.ByMonthDay {
+			d := md
+			if d < 0 {
+				d = lastDay + d + 1
+			}
+			if d < 1 || d > lastDay {
+				continue
+			}
+			day := first.AddDate(0, 0, d-1)
+			if len(r.ByDay) == 0 || r.matchesWeekday(day) {
+				days = append(days, day)
+			}
+		}
+
+	case len(r.ByDay) > 0:
+		for _, wd := range r.ByDay {
+			var matching []time.Time
+			for d := 1; d <= lastDay; d++ {
+				day := first.AddDate(0, 0, d-1)
+				if day.Weekday() == wd.Day {
+					matching = append(matching, day)
+				}
+			}
+			switch {
+			case wd.N == 0:
+				days = append(days, matching...)
+			case wd.N > 0 && wd.N <= len(matching):
+				days = append(days, matching[wd.N-1])
+			case wd.N < 0 && -wd.N <= len(matching):
+				days = append(days, matching[len(matching)+wd.N])
+			}
+		}
+
+	default:
+		if defaultDay <= lastDay {
+			days = append(days, first.AddDate(0, 0, defaultDay-1))
+		}
+	}
+
+	return days
+}
+
+// matchesLimits applies BYMONTH, BYMONTHDAY and BYDAY as filters (used by DAILY)
+func (r *Rule) matchesLimits(t time.Time) bool {
+	if !r.matchesMonth(t) {
+		return false
+	}
+	if len(r.ByMonthDay) > 0 {
+		lastDay := time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, t.Location()).Day()
+		found := false
+		for _, md := range r.ByMonthDay {
+			if md == t.Day() || (md < 0 && lastDay+md+1 == t.Day()) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return len(r.ByDay) == 0 || r.matchesWeekday(t)
+}
+
+func (r *Rule) matchesMonth(t time.Time) bool {
+	if len(r.ByMonth) == 0 {
+		return true
+	}
+	for _, m := range r.ByMonth {
+		if time.Month(m) == t.Month() {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Rule) matchesWeekday(t time.Time) bool {
+	for _, wd := range r.ByDay {
+		if wd.Day == t.Weekday() {
+			return true
+		}
+	}
+	return false
+}
+
+func dedupe(days []time.Time) []time.Time {
+	if len(days) < 2 {
+		return days
+	}
+	out := days[:1]
+	for _, d := range days[1:] {
+		if !d.Equal(out[len(out)-1]) {
+			out = append(out, d)
+		}
+	}
+	return out
+}
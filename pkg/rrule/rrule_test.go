@@ -0,0 +1,71 @@
+package rrule
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParse_RoundTrip(t *testing.T) {
+	rule, err := Parse("RRULE:FREQ=MONTHLY;INTERVAL=2;BYDAY=-1FR;COUNT=5")
+	if err != nil {
+		t.Fatalf("parse failed: %v", err)
+	}
+	if got, want := rule.String(), "FREQ=MONTHLY;INTERVAL=2;COUNT=5;BYDAY=-1FR"; got != want {
+		t.Fatalf("unexpected rule string %q, want %q", got, want)
+	}
+
+	for _, bad := range []string{"", "INTERVAL=2", "FREQ=HOURLY", "FREQ=WEEKLY;BYDAY=2MO", "FREQ=DAILY;COUNT=2;UNTIL=20250101"} {
+		if _, err := Parse(bad); err == nil {
+			t.Fatalf("expected error for %q", bad)
+		}
+	}
+}
+
+func TestBetween_WeeklyByDay(t *testing.T) {
+	rule, err := Parse("FREQ=WEEKLY;BYDAY=MO,WE;COUNT=5")
+	if err != nil {
+		t.Fatalf("parse failed: %v", err)
+	}
+
+	// Wednesday 2025-01-01 09:00 UTC
+	dtstart := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	got := rule.Between(dtstart, dtstart, dtstart.AddDate(1, 0, 0), 0)
+
+	want := []string{"2025-01-01", "2025-01-06", "2025-01-08", "2025-01-13", "2025-01-15"}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d occurrences, got %d: %v", len(want), len(got), got)
+	}
+	for i, occ := range got {
+		if occ.Format("2006-01-02") != want[i] || occ.Hour() != 9 {
+			t.Fatalf("occurrence %d = %s, want %s 09:00", i, occ, want[i])
+		}
+	}
+}
+
+func TestBetween_MonthlyLastFridayKeepsWallClock(t *testing.T) {
+	rule, err := Parse("FREQ=MONTHLY;BYDAY=-1FR")
+	if err != nil {
+		t.Fatalf("parse failed: %v", err)
+	}
+
+	loc := time.FixedZone("ICT", 7*3600)
+	dtstart := time.Date(2025, 1, 31, 14, 30, 0, 0, loc)
+	got := rule.Between(dtstart, dtstart, time.Date(2025, 4, 1, 0, 0, 0, 0, loc), 0)
+
+	want := []string{"2025-01-31", "2025-02-28", "2025-03-28"}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d occurrences, got %d: %v", len(want), len(got), got)
+	}
+	for i, occ := range got {
+		if occ.Format("2006-01-02 15:04") != want[i]+" 14:30" {
+			t.Fatalf("occurrence %d = %s, want %s 14:30", i, occ, want[i])
+		}
+	}
+
+	if !rule.Includes(dtstart, time.Date(2025, 2, 28, 14, 30, 0, 0, loc)) {
+		t.Fatalf("expected 2025-02-28 to be an occurrence")
+	}
+	if rule.Includes(dtstart, time.Date(2025, 2, 21, 14, 30, 0, 0, loc)) {
+		t.Fatalf("did not expect 2025-02-21 to be an occurrence")
+	}
+}